@@ -0,0 +1,112 @@
+// Package billing contains models which are declared outside of model package.
+package billing
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Address is a postal address of invoice.
+type Address struct {
+	Street string
+	City   string
+}
+
+// Amount is a money amount in currency units, see options
+// transformer.custom_pb_to_go and transformer.custom_go_to_pb of field
+// Order.total_cents.
+type Amount float64
+
+// CentsToAmount transforms amount in cents into Amount.
+func CentsToAmount(cents int64) Amount {
+	return Amount(cents) / 100
+}
+
+// AmountToCents transforms Amount into amount in cents.
+func AmountToCents(a Amount) int64 {
+	return int64(math.Round(float64(a) * 100))
+}
+
+// Currency is an ISO 4217 currency code, see option transformer.with_errors
+// of message Refund.
+type Currency string
+
+// ParseCurrency transforms currency code into Currency, code must consist of
+// three upper case letters.
+func ParseCurrency(code string) (Currency, error) {
+	if len(code) != 3 || strings.ToUpper(code) != code {
+		return "", fmt.Errorf("invalid currency code %q", code)
+	}
+
+	return Currency(code), nil
+}
+
+// FormatCurrency transforms Currency into currency code, invalid codes are
+// not encoded.
+func FormatCurrency(c Currency) (string, error) {
+	if _, err := ParseCurrency(string(c)); err != nil {
+		return "", err
+	}
+
+	return string(c), nil
+}
+
+// Note is a plain text note of memo, see option transformer.with_context of
+// message Memo.
+type Note string
+
+type contextKey string
+
+const noteKey contextKey = "note-key"
+
+// WithNoteKey returns copy of ctx which carries key of sealed notes.
+func WithNoteKey(ctx context.Context, key byte) context.Context {
+	return context.WithValue(ctx, noteKey, key)
+}
+
+// OpenNote transforms sealed note into Note with key of ctx.
+func OpenNote(ctx context.Context, sealed string) Note {
+	b, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return ""
+	}
+
+	return Note(xorNote(ctx, b))
+}
+
+// SealNote transforms Note into sealed note with key of ctx.
+func SealNote(ctx context.Context, n Note) string {
+	return base64.StdEncoding.EncodeToString(xorNote(ctx, []byte(n)))
+}
+
+func xorNote(ctx context.Context, b []byte) []byte {
+	key, _ := ctx.Value(noteKey).(byte)
+	for i := range b {
+		b[i] ^= key
+	}
+
+	return b
+}
+
+// Checksum is a CRC-32 checksum of attachment, see option
+// transformer.bytes_converter of field Attachment.checksum.
+type Checksum [4]byte
+
+// ParseChecksum transforms bytes into Checksum, b must be 4 bytes long.
+func ParseChecksum(b []byte) (Checksum, error) {
+	var c Checksum
+	if len(b) != len(c) {
+		return c, fmt.Errorf("invalid checksum length %d", len(b))
+	}
+	copy(c[:], b)
+
+	return c, nil
+}
+
+// Bytes returns bytes of checksum c.
+func (c Checksum) Bytes() []byte {
+	return c[:]
+}
@@ -0,0 +1,161 @@
+// Package decimal is a minimal stand-in of github.com/shopspring/decimal which
+// is used by models of example, see option transformer.decimal.
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Decimal is an arbitrary-precision fixed-point decimal number, its value is
+// value * 10^exp.
+type Decimal struct {
+	value *big.Int
+	exp   int32
+}
+
+// New returns decimal value * 10^exp.
+func New(value int64, exp int32) Decimal {
+	return Decimal{value: big.NewInt(value), exp: exp}
+}
+
+// NewFromString returns decimal of s, such as "-12.345".
+func NewFromString(s string) (Decimal, error) {
+	digits, exp := s, int32(0)
+	if i := strings.Index(s, "."); i >= 0 {
+		digits, exp = s[:i]+s[i+1:], -int32(len(s)-i-1)
+	}
+
+	v, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("can't convert %s to decimal", s)
+	}
+
+	return Decimal{value: v, exp: exp}, nil
+}
+
+// String returns decimal d without trailing fractional zeros, e.g. "-12.3".
+func (d Decimal) String() string {
+	v := d.coef()
+	if d.exp >= 0 {
+		return new(big.Int).Mul(v, pow10(d.exp)).String()
+	}
+
+	sign, digits := "", new(big.Int).Abs(v).String()
+	if v.Sign() < 0 {
+		sign = "-"
+	}
+
+	if n := int(-d.exp) + 1 - len(digits); n > 0 {
+		digits = strings.Repeat("0", n) + digits
+	}
+	i := len(digits) + int(d.exp)
+	frac := strings.TrimRight(digits[i:], "0")
+	if frac == "" {
+		return sign + digits[:i]
+	}
+
+	return sign + digits[:i] + "." + frac
+}
+
+// Shift returns d * 10^n.
+func (d Decimal) Shift(n int32) Decimal {
+	return Decimal{value: d.coef(), exp: d.exp + n}
+}
+
+// IntPart returns integer part of d, fractional part is truncated.
+func (d Decimal) IntPart() int64 {
+	return d.Truncate(0).rescale(0).Int64()
+}
+
+// Add returns d + d2.
+func (d Decimal) Add(d2 Decimal) Decimal {
+	exp := min(d.exp, d2.exp)
+	return Decimal{value: new(big.Int).Add(d.rescale(exp), d2.rescale(exp)), exp: exp}
+}
+
+// Sub returns d - d2.
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	exp := min(d.exp, d2.exp)
+	return Decimal{value: new(big.Int).Sub(d.rescale(exp), d2.rescale(exp)), exp: exp}
+}
+
+// Round rounds d to places fractional digits, half away from zero.
+func (d Decimal) Round(places int32) Decimal {
+	return d.round(places, func(q, r, div *big.Int) bool { return twice(r).CmpAbs(div) >= 0 })
+}
+
+// RoundBank rounds d to places fractional digits, half to even.
+func (d Decimal) RoundBank(places int32) Decimal {
+	return d.round(places, func(q, r, div *big.Int) bool {
+		c := twice(r).CmpAbs(div)
+		return c > 0 || c == 0 && q.Bit(0) == 1
+	})
+}
+
+// Truncate rounds d to places fractional digits towards zero.
+func (d Decimal) Truncate(places int32) Decimal {
+	return d.round(places, func(q, r, div *big.Int) bool { return false })
+}
+
+// RoundCeil rounds d to places fractional digits towards positive infinity.
+func (d Decimal) RoundCeil(places int32) Decimal {
+	return d.round(places, func(q, r, div *big.Int) bool { return r.Sign() > 0 })
+}
+
+// RoundFloor rounds d to places fractional digits towards negative infinity.
+func (d Decimal) RoundFloor(places int32) Decimal {
+	return d.round(places, func(q, r, div *big.Int) bool { return r.Sign() < 0 })
+}
+
+// round truncates d to places fractional digits, quotient is moved away from
+// zero by one if away returns true for quotient, remainder and divisor.
+func (d Decimal) round(places int32, away func(q, r, div *big.Int) bool) Decimal {
+	if d.exp >= -places {
+		return d
+	}
+
+	div := pow10(-places - d.exp)
+	q, r := new(big.Int).QuoRem(d.coef(), div, new(big.Int))
+	if r.Sign() != 0 && away(q, r, div) {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+
+	return Decimal{value: q, exp: -places}
+}
+
+// rescale returns coefficient of d with exponent exp, digits are truncated if
+// exp is greater than exponent of d.
+func (d Decimal) rescale(exp int32) *big.Int {
+	if exp <= d.exp {
+		return new(big.Int).Mul(d.coef(), pow10(d.exp-exp))
+	}
+
+	return new(big.Int).Quo(d.coef(), pow10(exp-d.exp))
+}
+
+// coef returns coefficient of d, zero value of Decimal is 0.
+func (d Decimal) coef() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+
+	return d.value
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func twice(v *big.Int) *big.Int {
+	return new(big.Int).Lsh(v, 1)
+}
+
+func min(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
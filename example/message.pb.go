@@ -4,12 +4,21 @@
 package example
 
 import (
+	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
+	github_com_ZacxDev_protoc_gen_struct_transformer_example_model "github.com/ZacxDev/protoc-gen-struct-transformer/example/model"
+	_ "github.com/ZacxDev/protoc-gen-struct-transformer/example/v2"
 	_ "github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	rpc "github.com/gogo/googleapis/google/rpc"
+	_type "github.com/gogo/googleapis/google/type"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
+	types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -28,6 +37,62 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type StockStatus int32
+
+const (
+	StockStatus_STOCK_STATUS_UNKNOWN StockStatus = 0
+	StockStatus_IN_STOCK             StockStatus = 1
+	StockStatus_SOLD_OUT             StockStatus = 2
+)
+
+var StockStatus_name = map[int32]string{
+	0: "STOCK_STATUS_UNKNOWN",
+	1: "IN_STOCK",
+	2: "SOLD_OUT",
+}
+
+var StockStatus_value = map[string]int32{
+	"STOCK_STATUS_UNKNOWN": 0,
+	"IN_STOCK":             1,
+	"SOLD_OUT":             2,
+}
+
+func (x StockStatus) String() string {
+	return proto.EnumName(StockStatus_name, int32(x))
+}
+
+func (StockStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{0}
+}
+
+type Order_Status int32
+
+const (
+	Order_UNKNOWN Order_Status = 0
+	Order_PAID    Order_Status = 1
+	Order_SHIPPED Order_Status = 2
+)
+
+var Order_Status_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PAID",
+	2: "SHIPPED",
+}
+
+var Order_Status_value = map[string]int32{
+	"UNKNOWN": 0,
+	"PAID":    1,
+	"SHIPPED": 2,
+}
+
+func (x Order_Status) String() string {
+	return proto.EnumName(Order_Status_name, int32(x))
+}
+
+func (Order_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{5, 0}
+}
+
 type TheOne struct {
 	// Types that are valid to be assigned to TheDecl:
 	//	*TheOne_StringValue
@@ -336,7 +401,11 @@ type Product struct {
 	CustomField *CustomType `protobuf:"bytes,5,opt,name=custom_field,json=customField,proto3" json:"custom_field,omitempty"`
 	// Example of the custom transformer for the struct with oneof type in it
 	CustomOneof *CustomOneof `protobuf:"bytes,6,opt,name=custom_oneof,json=customOneof,proto3" json:"custom_oneof,omitempty"`
-	// Currently the plugin does not support oneof types rather than the specific example with `int64_value` and `string_value`
+	// Currently the plugin does not support oneof types
+	// rather than the specific example with `int64_value` and `string_value`
+	// In current implementation it generates the PbToPtrVal and ToPbValPtr
+	// TODO: change these method names to include either field name or field type to it
+	//       Changing method names will break backward compatibility with previous versions of the plugin
 	NotsupportedOneof *NotSupportedOneOf `protobuf:"bytes,7,opt,name=notsupported_oneof,json=notsupportedOneof,proto3" json:"notsupported_oneof,omitempty"`
 }
 
@@ -427,6 +496,13 @@ type Order struct {
 	FirstId  *TheOne `protobuf:"bytes,2,opt,name=first_id,json=firstId,proto3" json:"first_id,omitempty"`
 	SecondId *TheOne `protobuf:"bytes,3,opt,name=second_id,json=secondId,proto3" json:"second_id,omitempty"`
 	ThirdUrl *TheOne `protobuf:"bytes,4,opt,name=third_url,json=thirdUrl,proto3" json:"third_url,omitempty"`
+	// Repeated enums are transformed element-wise, []string gets value names.
+	Statuses []Order_Status `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=svc.example.Order_Status" json:"statuses,omitempty"`
+	// Enum is transformed into constants of model type OrderState by generated
+	// switch statements.
+	State Order_Status `protobuf:"varint,6,opt,name=state,proto3,enum=svc.example.Order_Status" json:"state,omitempty"`
+	// Amount in cents is transformed by functions of billing package verbatim.
+	TotalCents int64 `protobuf:"varint,7,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -490,6 +566,27 @@ func (m *Order) GetThirdUrl() *TheOne {
 	return nil
 }
 
+func (m *Order) GetStatuses() []Order_Status {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *Order) GetState() Order_Status {
+	if m != nil {
+		return m.State
+	}
+	return Order_UNKNOWN
+}
+
+func (m *Order) GetTotalCents() int64 {
+	if m != nil {
+		return m.TotalCents
+	}
+	return 0
+}
+
 type Address struct {
 	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -543,7 +640,8 @@ func (m *Address) GetType() string {
 }
 
 type Customer struct {
-	Id                      int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields with "sensitive" option are left empty by CustomerToPbRedacted.
 	Name                    string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Addresses               []*Address `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	DefaultAddress          *Address   `protobuf:"bytes,4,opt,name=default_address,json=defaultAddress,proto3" json:"default_address,omitempty"`
@@ -634,6 +732,68 @@ func (m *Customer) GetMapFieldToWithoutDigits() string {
 	return ""
 }
 
+// CustomerPatch is merged into existing Customer model, only fields which are
+// set in the message are changed.
+type CustomerPatch struct {
+	Name           *types.StringValue `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DefaultAddress *Address           `protobuf:"bytes,2,opt,name=default_address,json=defaultAddress,proto3" json:"default_address,omitempty"`
+	BillingAddress *Address           `protobuf:"bytes,3,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+}
+
+func (m *CustomerPatch) Reset()         { *m = CustomerPatch{} }
+func (m *CustomerPatch) String() string { return proto.CompactTextString(m) }
+func (*CustomerPatch) ProtoMessage()    {}
+func (*CustomerPatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{8}
+}
+func (m *CustomerPatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomerPatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomerPatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CustomerPatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerPatch.Merge(m, src)
+}
+func (m *CustomerPatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomerPatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerPatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerPatch proto.InternalMessageInfo
+
+func (m *CustomerPatch) GetName() *types.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *CustomerPatch) GetDefaultAddress() *Address {
+	if m != nil {
+		return m.DefaultAddress
+	}
+	return nil
+}
+
+func (m *CustomerPatch) GetBillingAddress() *Address {
+	if m != nil {
+		return m.BillingAddress
+	}
+	return nil
+}
+
 // opposite message order, usage of LineItem is earlier than message is defined.
 type LineItemUsage struct {
 	Item *LineItem   `protobuf:"bytes,1,opt,name=Item,proto3" json:"Item,omitempty"`
@@ -644,7 +804,7 @@ func (m *LineItemUsage) Reset()         { *m = LineItemUsage{} }
 func (m *LineItemUsage) String() string { return proto.CompactTextString(m) }
 func (*LineItemUsage) ProtoMessage()    {}
 func (*LineItemUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{8}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{9}
 }
 func (m *LineItemUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,7 +861,7 @@ func (m *LineItem) Reset()         { *m = LineItem{} }
 func (m *LineItem) String() string { return proto.CompactTextString(m) }
 func (*LineItem) ProtoMessage()    {}
 func (*LineItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{9}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{10}
 }
 func (m *LineItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +934,7 @@ func (m *Value2Pointer) Reset()         { *m = Value2Pointer{} }
 func (m *Value2Pointer) String() string { return proto.CompactTextString(m) }
 func (*Value2Pointer) ProtoMessage()    {}
 func (*Value2Pointer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{10}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{11}
 }
 func (m *Value2Pointer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,7 +979,7 @@ func (m *Pointer2Value) Reset()         { *m = Pointer2Value{} }
 func (m *Pointer2Value) String() string { return proto.CompactTextString(m) }
 func (*Pointer2Value) ProtoMessage()    {}
 func (*Pointer2Value) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{11}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{12}
 }
 func (m *Pointer2Value) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +1022,7 @@ func (m *SkippedMessageOne) Reset()         { *m = SkippedMessageOne{} }
 func (m *SkippedMessageOne) String() string { return proto.CompactTextString(m) }
 func (*SkippedMessageOne) ProtoMessage()    {}
 func (*SkippedMessageOne) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{12}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{13}
 }
 func (m *SkippedMessageOne) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,7 +1058,7 @@ func (m *SkippedMessageTwo) Reset()         { *m = SkippedMessageTwo{} }
 func (m *SkippedMessageTwo) String() string { return proto.CompactTextString(m) }
 func (*SkippedMessageTwo) ProtoMessage()    {}
 func (*SkippedMessageTwo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{13}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{14}
 }
 func (m *SkippedMessageTwo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -934,13 +1094,20 @@ type Timer struct {
 	TimeToStructPtr    *time.Time `protobuf:"bytes,4,opt,name=time_to_struct_ptr,json=timeToStructPtr,proto3,stdtime" json:"time_to_struct_ptr,omitempty"`
 	TimePtrToStruct    *time.Time `protobuf:"bytes,5,opt,name=time_ptr_to_struct,json=timePtrToStruct,proto3,stdtime" json:"time_ptr_to_struct,omitempty"`
 	TimePtrToPtrStruct *time.Time `protobuf:"bytes,6,opt,name=time_ptr_to_ptr_struct,json=timePtrToPtrStruct,proto3,stdtime" json:"time_ptr_to_ptr_struct,omitempty"`
+	// Zero time.Time becomes nil *time.Time and vice versa.
+	TimeToPtr time.Time  `protobuf:"bytes,7,opt,name=time_to_ptr,json=timeToPtr,proto3,stdtime" json:"time_to_ptr"`
+	PtrToTime *time.Time `protobuf:"bytes,8,opt,name=ptr_to_time,json=ptrToTime,proto3,stdtime" json:"ptr_to_time,omitempty"`
+	// Timestamp structures are transformed into time.Time by generated code,
+	// helper package is not used.
+	CreatedAt *types.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *types.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
 func (m *Timer) Reset()         { *m = Timer{} }
 func (m *Timer) String() string { return proto.CompactTextString(m) }
 func (*Timer) ProtoMessage()    {}
 func (*Timer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{14}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{15}
 }
 func (m *Timer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1011,6 +1178,34 @@ func (m *Timer) GetTimePtrToPtrStruct() *time.Time {
 	return nil
 }
 
+func (m *Timer) GetTimeToPtr() time.Time {
+	if m != nil {
+		return m.TimeToPtr
+	}
+	return time.Time{}
+}
+
+func (m *Timer) GetPtrToTime() *time.Time {
+	if m != nil {
+		return m.PtrToTime
+	}
+	return nil
+}
+
+func (m *Timer) GetCreatedAt() *types.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Timer) GetUpdatedAt() *types.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
 type Ints struct {
 	IntFor_32Value int32 `protobuf:"varint,1,opt,name=int_for_32_value,json=intFor32Value,proto3" json:"int_for_32_value,omitempty"`
 	IntFor_64Value int64 `protobuf:"varint,2,opt,name=int_for_64_value,json=intFor64Value,proto3" json:"int_for_64_value,omitempty"`
@@ -1023,7 +1218,7 @@ func (m *Ints) Reset()         { *m = Ints{} }
 func (m *Ints) String() string { return proto.CompactTextString(m) }
 func (*Ints) ProtoMessage()    {}
 func (*Ints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1ffb7dddb00b34f, []int{15}
+	return fileDescriptor_c1ffb7dddb00b34f, []int{16}
 }
 func (m *Ints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,1318 +1282,11091 @@ func (m *Ints) GetStringValue() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*TheOne)(nil), "svc.example.TheOne")
-	proto.RegisterType((*NotSupportedOneOf)(nil), "svc.example.NotSupportedOneOf")
-	proto.RegisterType((*CustomOneof)(nil), "svc.example.CustomOneof")
-	proto.RegisterType((*CustomType)(nil), "svc.example.CustomType")
-	proto.RegisterType((*Product)(nil), "svc.example.Product")
-	proto.RegisterType((*Order)(nil), "svc.example.Order")
-	proto.RegisterType((*Address)(nil), "svc.example.Address")
-	proto.RegisterType((*Customer)(nil), "svc.example.Customer")
-	proto.RegisterType((*LineItemUsage)(nil), "svc.example.LineItemUsage")
-	proto.RegisterType((*LineItem)(nil), "svc.example.LineItem")
-	proto.RegisterType((*Value2Pointer)(nil), "svc.example.Value2Pointer")
-	proto.RegisterType((*Pointer2Value)(nil), "svc.example.Pointer2Value")
-	proto.RegisterType((*SkippedMessageOne)(nil), "svc.example.SkippedMessageOne")
-	proto.RegisterType((*SkippedMessageTwo)(nil), "svc.example.SkippedMessageTwo")
-	proto.RegisterType((*Timer)(nil), "svc.example.Timer")
-	proto.RegisterType((*Ints)(nil), "svc.example.Ints")
+type Location struct {
+	City    string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
 }
 
-func init() { proto.RegisterFile("example/message.proto", fileDescriptor_c1ffb7dddb00b34f) }
+func (m *Location) Reset()         { *m = Location{} }
+func (m *Location) String() string { return proto.CompactTextString(m) }
+func (*Location) ProtoMessage()    {}
+func (*Location) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{17}
+}
+func (m *Location) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Location) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Location.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Location) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Location.Merge(m, src)
+}
+func (m *Location) XXX_Size() int {
+	return m.Size()
+}
+func (m *Location) XXX_DiscardUnknown() {
+	xxx_messageInfo_Location.DiscardUnknown(m)
+}
 
-var fileDescriptor_c1ffb7dddb00b34f = []byte{
-	// 1243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x16, 0x47, 0x92, 0x25, 0x1e, 0x59, 0x76, 0x3c, 0xb9, 0x29, 0x09, 0x20, 0x3b, 0xcc, 0xff,
-	0x03, 0xee, 0x46, 0x8e, 0xe5, 0xa2, 0x0b, 0xb5, 0x05, 0x12, 0xc5, 0x08, 0x22, 0xc4, 0xb6, 0x0c,
-	0x5a, 0x6e, 0x80, 0xa2, 0x28, 0x4b, 0x9b, 0x23, 0x79, 0x50, 0x8a, 0x43, 0x90, 0xa3, 0xa4, 0xe9,
-	0x0b, 0x14, 0xe8, 0x2a, 0xe8, 0xa2, 0x8b, 0x3e, 0x41, 0x1f, 0xa0, 0xe8, 0xc2, 0x0b, 0x2d, 0x02,
-	0x04, 0x08, 0xa0, 0x4d, 0x96, 0x5d, 0xb5, 0x85, 0xb2, 0xe8, 0x5b, 0x14, 0xc5, 0x5c, 0x28, 0x93,
-	0x89, 0x2f, 0x5d, 0x74, 0x61, 0x6b, 0x78, 0xf8, 0x9d, 0xef, 0x3b, 0x37, 0xce, 0x0c, 0x5c, 0x25,
-	0xdf, 0xb8, 0xc3, 0xd0, 0x27, 0x6b, 0x43, 0x12, 0xc7, 0xee, 0x80, 0x34, 0xc2, 0x88, 0x71, 0x86,
-	0x2b, 0xf1, 0xd3, 0xc3, 0x86, 0x7e, 0x75, 0xf3, 0x06, 0x0b, 0x39, 0x65, 0x41, 0xbc, 0xe6, 0x06,
-	0x01, 0xe3, 0xae, 0x5c, 0x2b, 0xdc, 0xcd, 0xff, 0xc9, 0x9f, 0x83, 0x51, 0xff, 0xde, 0xd3, 0xf5,
-	0xc6, 0x46, 0x63, 0x7d, 0x6d, 0xc0, 0x06, 0x4c, 0xda, 0xe4, 0x4a, 0xa3, 0x96, 0x07, 0x8c, 0x0d,
-	0x7c, 0xb2, 0x96, 0x80, 0xd7, 0x38, 0x1d, 0x92, 0x98, 0xbb, 0xc3, 0x50, 0x01, 0xac, 0x2f, 0x60,
-	0xae, 0x77, 0x44, 0xba, 0x01, 0xc1, 0x77, 0x60, 0x3e, 0xe6, 0x11, 0x0d, 0x06, 0xce, 0x53, 0xd7,
-	0x1f, 0x91, 0x9a, 0xb1, 0x62, 0xac, 0x9a, 0x8f, 0x72, 0x76, 0x45, 0x59, 0x3f, 0x13, 0x46, 0x7c,
-	0x1b, 0x2a, 0x34, 0xe0, 0x1f, 0x7d, 0xa8, 0x31, 0x68, 0xc5, 0x58, 0xcd, 0x3f, 0xca, 0xd9, 0x20,
-	0x8d, 0x12, 0xd2, 0x06, 0x28, 0xf3, 0x23, 0xe2, 0x78, 0xe4, 0xd0, 0xb7, 0x08, 0x2c, 0xed, 0x30,
-	0xbe, 0x37, 0x0a, 0x43, 0x16, 0x71, 0xe2, 0x75, 0x03, 0xd2, 0xed, 0xe3, 0x65, 0x80, 0x03, 0xc6,
-	0xfc, 0x94, 0x4c, 0xf9, 0x51, 0xce, 0x36, 0x85, 0x4d, 0x89, 0xbc, 0x1b, 0x09, 0x3a, 0x25, 0x92,
-	0x8c, 0xcc, 0x97, 0x50, 0x79, 0x30, 0x8a, 0x39, 0x1b, 0x76, 0x03, 0xc2, 0xfa, 0xff, 0x59, 0x26,
-	0x25, 0x28, 0xca, 0x97, 0x96, 0x05, 0xa0, 0xf8, 0x7b, 0xcf, 0x43, 0x82, 0xaf, 0x68, 0xb3, 0xe2,
-	0xb5, 0x35, 0xe6, 0x2f, 0x04, 0xa5, 0xdd, 0x88, 0x79, 0xa3, 0x43, 0x8e, 0x17, 0x00, 0x51, 0x4f,
-	0xbe, 0x2e, 0xda, 0x88, 0x7a, 0x18, 0x43, 0x21, 0x70, 0x87, 0x3a, 0x11, 0x5b, 0xae, 0xf1, 0xff,
-	0x21, 0xcf, 0x02, 0x52, 0xcb, 0xaf, 0x18, 0xab, 0x95, 0xe6, 0xe5, 0x46, 0xaa, 0xeb, 0x0d, 0xd5,
-	0x10, 0x5b, 0xbc, 0xc7, 0x77, 0xc1, 0x8c, 0xc9, 0x21, 0x0b, 0x3c, 0x87, 0x7a, 0xb5, 0xc2, 0xd9,
-	0xe0, 0xb2, 0x42, 0x75, 0x3c, 0x7c, 0x0f, 0xe6, 0x0f, 0x65, 0xb0, 0x4e, 0x9f, 0x12, 0xdf, 0xab,
-	0x15, 0xa5, 0xd3, 0xf5, 0x8c, 0xd3, 0x49, 0x36, 0xed, 0xc2, 0xeb, 0x09, 0x32, 0xec, 0x8a, 0x72,
-	0x79, 0x28, 0x3c, 0xf0, 0xfd, 0x19, 0x03, 0x13, 0xf5, 0xac, 0xcd, 0x49, 0x86, 0xda, 0x29, 0x0c,
-	0xb2, 0xde, 0x59, 0x0a, 0xd5, 0x82, 0x6d, 0xc0, 0x01, 0xe3, 0x71, 0xd2, 0x78, 0x4d, 0x54, 0x92,
-	0x44, 0xf5, 0x0c, 0xd1, 0x7b, 0xf3, 0x61, 0x2f, 0xa5, 0x3d, 0x25, 0x5d, 0xab, 0x32, 0x1d, 0xa3,
-	0xa4, 0xba, 0xd6, 0xaf, 0x06, 0x14, 0xbb, 0x91, 0x47, 0xa2, 0x54, 0x9d, 0xf3, 0xb2, 0xce, 0x0d,
-	0x28, 0xf7, 0x69, 0x14, 0x73, 0x51, 0x2b, 0x74, 0x76, 0xad, 0x4a, 0x12, 0xd4, 0xf1, 0xb2, 0xc5,
-	0xcd, 0xff, 0x9b, 0xe2, 0xde, 0x05, 0x93, 0x1f, 0xd1, 0xc8, 0x73, 0x46, 0x91, 0x7f, 0x6e, 0x3b,
-	0x24, 0x6a, 0x3f, 0xf2, 0x5b, 0xe6, 0x74, 0x8c, 0x54, 0xb8, 0x56, 0x0b, 0x4a, 0xf7, 0x3d, 0x2f,
-	0x22, 0x71, 0xfc, 0x5e, 0xe4, 0x18, 0x0a, 0xfc, 0x79, 0x38, 0x9b, 0x10, 0xb1, 0x56, 0x49, 0x6b,
-	0x07, 0xeb, 0x6f, 0x04, 0x65, 0x55, 0xf3, 0x53, 0xf2, 0x3e, 0x6d, 0xbe, 0x9a, 0x60, 0xba, 0xca,
-	0x97, 0xc4, 0xb5, 0xfc, 0x4a, 0x7e, 0xb5, 0xd2, 0xbc, 0x92, 0x89, 0x54, 0x33, 0xdb, 0x27, 0x30,
-	0xfc, 0x29, 0x2c, 0x7a, 0xa4, 0xef, 0x8e, 0x7c, 0xee, 0x68, 0xa3, 0xce, 0xf1, 0x74, 0xcf, 0x05,
-	0x0d, 0x4e, 0x92, 0x7a, 0x00, 0x8b, 0x07, 0xd4, 0xf7, 0xc5, 0x87, 0x97, 0xb8, 0x17, 0xcf, 0x76,
-	0x6f, 0x17, 0x5e, 0xff, 0xbe, 0x9c, 0xb3, 0x17, 0xb4, 0x4b, 0x42, 0xf2, 0x31, 0x54, 0x86, 0x6e,
-	0xa8, 0x66, 0xd7, 0x59, 0x97, 0xb3, 0x67, 0xb6, 0x6f, 0x1d, 0x4f, 0x90, 0xb9, 0xed, 0x86, 0x72,
-	0x3e, 0xd7, 0x5f, 0x4e, 0x10, 0x24, 0x0f, 0xce, 0xba, 0x6d, 0x0e, 0x93, 0x17, 0xf8, 0x31, 0xdc,
-	0x3a, 0x71, 0xe6, 0xcc, 0x79, 0x46, 0xf9, 0x11, 0x1b, 0x71, 0xc7, 0xa3, 0x03, 0xca, 0x63, 0x39,
-	0x7f, 0x66, 0xbb, 0x9a, 0x26, 0x6b, 0xda, 0xd7, 0x13, 0xf7, 0x1e, 0x7b, 0xa2, 0xe0, 0x9b, 0x12,
-	0xdd, 0x9a, 0x9f, 0x8e, 0xd1, 0xac, 0xe6, 0xd6, 0xb7, 0x50, 0xdd, 0xa2, 0x01, 0xe9, 0x70, 0x32,
-	0xdc, 0x17, 0xdb, 0x35, 0xfe, 0x00, 0x0a, 0xe2, 0x41, 0xb6, 0xa1, 0xd2, 0xbc, 0x9a, 0x49, 0x31,
-	0x41, 0xda, 0x12, 0x22, 0xa0, 0x5b, 0x34, 0xe6, 0x35, 0x24, 0xdb, 0x70, 0x16, 0x54, 0x40, 0x5a,
-	0x97, 0xa7, 0x63, 0xb4, 0xb8, 0xfd, 0x3c, 0x23, 0x65, 0x7d, 0x67, 0x40, 0x39, 0xb1, 0x88, 0xe6,
-	0x77, 0x36, 0x93, 0xe6, 0x77, 0x36, 0x45, 0xf3, 0x7b, 0xa9, 0xd1, 0x91, 0x5b, 0xd4, 0x1d, 0x80,
-	0x98, 0x0d, 0x89, 0xde, 0x01, 0xf2, 0x32, 0xed, 0xc2, 0xcf, 0xe2, 0x2b, 0x35, 0x85, 0x5d, 0x7d,
-	0xe6, 0x97, 0x20, 0xbf, 0x6f, 0x6f, 0xc9, 0x0e, 0x9b, 0xb6, 0x58, 0x0a, 0xcb, 0xde, 0xe3, 0x7d,
-	0xd9, 0xb4, 0xbc, 0x2d, 0x96, 0xad, 0x85, 0xe9, 0x18, 0xc1, 0x49, 0x38, 0x96, 0x03, 0x55, 0xb9,
-	0x37, 0x36, 0x77, 0x19, 0x0d, 0x38, 0x89, 0x44, 0xbb, 0x74, 0xaf, 0x9d, 0x80, 0xfa, 0xba, 0x18,
-	0xe7, 0xf5, 0x1b, 0x34, 0x7c, 0x87, 0xfa, 0xad, 0xa5, 0xe9, 0x18, 0x65, 0xf9, 0xac, 0xaf, 0xa0,
-	0xaa, 0x97, 0x4d, 0xb5, 0x4f, 0x7f, 0x02, 0x8b, 0x33, 0x01, 0xc6, 0x2f, 0x12, 0xb1, 0xab, 0x09,
-	0x3d, 0xe3, 0x33, 0x85, 0x0c, 0xa1, 0x75, 0x19, 0x96, 0xf6, 0xbe, 0xa6, 0x61, 0x48, 0xbc, 0x6d,
-	0x75, 0xf0, 0x76, 0x83, 0x53, 0x8c, 0xbd, 0x67, 0xcc, 0xfa, 0xa5, 0x00, 0xc5, 0x1e, 0x15, 0x1f,
-	0xdc, 0x26, 0x14, 0xc4, 0xc1, 0xa9, 0x95, 0x6f, 0x36, 0xd4, 0xa9, 0xda, 0x48, 0x4e, 0xd5, 0x46,
-	0x2f, 0x39, 0x55, 0xdb, 0x57, 0x8e, 0x27, 0xa8, 0x2c, 0x1e, 0xc5, 0x9f, 0x48, 0xf8, 0xc5, 0x1f,
-	0xcb, 0x86, 0x2d, 0xbd, 0xf1, 0x0e, 0x94, 0x43, 0x1e, 0x39, 0x92, 0x09, 0x5d, 0xc8, 0x74, 0xfd,
-	0x78, 0x82, 0x2a, 0xbb, 0x3c, 0x4a, 0x91, 0x19, 0x92, 0xac, 0x14, 0x2a, 0x23, 0x7e, 0x02, 0x0b,
-	0x82, 0x4b, 0x0c, 0x7a, 0xcc, 0xa3, 0xd1, 0x21, 0xd7, 0x7b, 0xd8, 0x79, 0xac, 0x57, 0xc5, 0xf0,
-	0xef, 0x8c, 0x7c, 0x3f, 0xce, 0x04, 0x38, 0x2f, 0x88, 0x7a, 0x6c, 0x4f, 0xd2, 0x60, 0x17, 0x70,
-	0x96, 0xd8, 0x09, 0x79, 0xa4, 0xb7, 0x82, 0xf3, 0xc8, 0x6b, 0xc7, 0x13, 0x34, 0xbf, 0xcb, 0xa3,
-	0x34, 0xbf, 0x8a, 0x79, 0x31, 0xcd, 0xbf, 0xcb, 0x23, 0xec, 0x68, 0x09, 0x59, 0x90, 0x59, 0xfc,
-	0xc5, 0x0b, 0x25, 0xae, 0x1d, 0x4f, 0x10, 0xcc, 0xf8, 0x9b, 0x59, 0x01, 0x51, 0xad, 0x24, 0x07,
-	0x0a, 0xd7, 0xd2, 0x02, 0xe2, 0x47, 0x8b, 0xcc, 0x5d, 0x28, 0x72, 0xe3, 0x78, 0x82, 0xaa, 0xe9,
-	0x3c, 0x4e, 0x74, 0xf0, 0x4c, 0x67, 0x97, 0x47, 0x4a, 0xaa, 0x55, 0x9d, 0x8e, 0x91, 0x29, 0x60,
-	0xdb, 0xcc, 0x23, 0xbe, 0xf5, 0x23, 0x82, 0x42, 0x27, 0xe0, 0x31, 0xde, 0x82, 0x4b, 0x34, 0xe0,
-	0x4e, 0x9f, 0x45, 0xce, 0x46, 0x33, 0x75, 0x17, 0x29, 0xb6, 0xef, 0x08, 0x81, 0x4e, 0xc0, 0x1f,
-	0xb2, 0x68, 0x43, 0x8d, 0xe5, 0xcb, 0x09, 0x5a, 0x50, 0x06, 0x47, 0x5b, 0xec, 0x2a, 0x4d, 0x03,
-	0xd2, 0x6c, 0xd9, 0x5b, 0x4b, 0x9a, 0x4d, 0x5f, 0x5d, 0x52, 0x6c, 0xda, 0x92, 0xb0, 0xe9, 0x47,
-	0xbc, 0x2c, 0xaf, 0x3f, 0xb3, 0xb0, 0xf2, 0xf2, 0xae, 0x02, 0xd2, 0x94, 0x06, 0xcc, 0x94, 0x0a,
-	0x72, 0x4f, 0x48, 0xdd, 0x8e, 0xf0, 0xed, 0x77, 0x6e, 0x59, 0x6a, 0xd7, 0x48, 0xdf, 0xb1, 0x54,
-	0x61, 0x44, 0x29, 0x64, 0x61, 0xda, 0xdd, 0xef, 0x5f, 0xa1, 0x6b, 0xb3, 0x5b, 0xaf, 0x30, 0xa9,
-	0xff, 0x8d, 0x01, 0xfb, 0xe1, 0x15, 0x2a, 0xca, 0xf5, 0x4f, 0xaf, 0x50, 0x49, 0x43, 0x5e, 0x4f,
-	0xeb, 0xc6, 0x9b, 0x69, 0xdd, 0xf8, 0x73, 0x5a, 0x37, 0x5e, 0xbc, 0xad, 0xe7, 0xde, 0xbc, 0xad,
-	0xe7, 0x7e, 0x7b, 0x5b, 0xcf, 0x7d, 0x9e, 0x00, 0x0e, 0xe6, 0x64, 0xef, 0x36, 0xfe, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x29, 0xd2, 0x1d, 0x83, 0x4b, 0x0b, 0x00, 0x00,
+var xxx_messageInfo_Location proto.InternalMessageInfo
+
+func (m *Location) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
 }
 
-func (m *TheOne) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Location) GetCountry() string {
+	if m != nil {
+		return m.Country
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TheOne) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Store struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields of Location message are merged into Store model with prefix
+	// "Location": LocationCity, LocationCountry.
+	Location *Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
 }
 
-func (m *TheOne) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TheDecl != nil {
-		{
-			size := m.TheDecl.Size()
-			i -= size
-			if _, err := m.TheDecl.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *Store) Reset()         { *m = Store{} }
+func (m *Store) String() string { return proto.CompactTextString(m) }
+func (*Store) ProtoMessage()    {}
+func (*Store) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{18}
+}
+func (m *Store) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Store) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Store.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *TheOne_StringValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Store) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Store.Merge(m, src)
 }
-
-func (m *TheOne_StringValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.StringValue)
-	copy(dAtA[i:], m.StringValue)
-	i = encodeVarintMessage(dAtA, i, uint64(len(m.StringValue)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *Store) XXX_Size() int {
+	return m.Size()
 }
-func (m *TheOne_Int64Value) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Store) XXX_DiscardUnknown() {
+	xxx_messageInfo_Store.DiscardUnknown(m)
 }
 
-func (m *TheOne_Int64Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintMessage(dAtA, i, uint64(m.Int64Value))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+var xxx_messageInfo_Store proto.InternalMessageInfo
+
+func (m *Store) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
-func (m *NotSupportedOneOf) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *Store) GetLocation() *Location {
+	if m != nil {
+		return m.Location
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NotSupportedOneOf) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Labels struct {
+	// Repeated wrappers are transformed element by element.
+	Names    []*types.StringValue `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Counters []*types.Int64Value  `protobuf:"bytes,2,rep,name=counters,proto3" json:"counters,omitempty"`
+	// Single wrappers are transformed into pointers or values of wrapped types
+	// chosen by model fields, nil wrapper becomes nil or zero value.
+	Title    *types.StringValue `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Priority *types.Int64Value  `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Pinned   *types.BoolValue   `protobuf:"bytes,5,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Tags     []string           `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (m *NotSupportedOneOf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TheDecl != nil {
-		{
-			size := m.TheDecl.Size()
-			i -= size
-			if _, err := m.TheDecl.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *Labels) Reset()         { *m = Labels{} }
+func (m *Labels) String() string { return proto.CompactTextString(m) }
+func (*Labels) ProtoMessage()    {}
+func (*Labels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{19}
+}
+func (m *Labels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Labels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Labels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *NotSupportedOneOf_BoolValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Labels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Labels.Merge(m, src)
 }
-
-func (m *NotSupportedOneOf_BoolValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i--
-	if m.BoolValue {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+func (m *Labels) XXX_Size() int {
+	return m.Size()
 }
-func (m *NotSupportedOneOf_StringValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Labels) XXX_DiscardUnknown() {
+	xxx_messageInfo_Labels.DiscardUnknown(m)
 }
 
-func (m *NotSupportedOneOf_StringValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.StringValue)
-	copy(dAtA[i:], m.StringValue)
-	i = encodeVarintMessage(dAtA, i, uint64(len(m.StringValue)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+var xxx_messageInfo_Labels proto.InternalMessageInfo
+
+func (m *Labels) GetNames() []*types.StringValue {
+	if m != nil {
+		return m.Names
+	}
+	return nil
 }
-func (m *CustomOneof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *Labels) GetCounters() []*types.Int64Value {
+	if m != nil {
+		return m.Counters
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CustomOneof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Labels) GetTitle() *types.StringValue {
+	if m != nil {
+		return m.Title
+	}
+	return nil
 }
 
-func (m *CustomOneof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size := m.Value.Size()
-			i -= size
-			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *Labels) GetPriority() *types.Int64Value {
+	if m != nil {
+		return m.Priority
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CustomOneof_StringValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Labels) GetPinned() *types.BoolValue {
+	if m != nil {
+		return m.Pinned
+	}
+	return nil
 }
 
-func (m *CustomOneof_StringValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.StringValue)
-	copy(dAtA[i:], m.StringValue)
-	i = encodeVarintMessage(dAtA, i, uint64(len(m.StringValue)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *Labels) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
 }
-func (m *CustomOneof_Int64Value) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type Schedule struct {
+	// Elements of repeated and map fields of well-known types are transformed
+	// the same way as single fields.
+	Dates     []time.Time                  `protobuf:"bytes,1,rep,name=dates,proto3,stdtime" json:"dates"`
+	Deadlines map[string]*time.Time        `protobuf:"bytes,2,rep,name=deadlines,proto3,stdtime" json:"deadlines,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Notes     map[int64]*types.StringValue `protobuf:"bytes,3,rep,name=notes,proto3" json:"notes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *CustomOneof_Int64Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintMessage(dAtA, i, uint64(m.Int64Value))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{20}
 }
-func (m *CustomType) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
 }
 
-func (m *CustomType) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetDates() []time.Time {
+	if m != nil {
+		return m.Dates
+	}
+	return nil
 }
 
-func (m *CustomType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *Schedule) GetDeadlines() map[string]*time.Time {
+	if m != nil {
+		return m.Deadlines
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Product) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Schedule) GetNotes() map[int64]*types.StringValue {
+	if m != nil {
+		return m.Notes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Product) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Operation struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// google.rpc.Status is transformed into error.
+	Result *rpc.Status `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NotsupportedOneof != nil {
-		{
-			size, err := m.NotsupportedOneof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.CustomOneof != nil {
-		{
-			size, err := m.CustomOneof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.CustomField != nil {
-		{
-			size, err := m.CustomField.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SecondId != nil {
-		{
-			size, err := m.SecondId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.One != nil {
-		{
-			size, err := m.One.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+func (m *Operation) Reset()         { *m = Operation{} }
+func (m *Operation) String() string { return proto.CompactTextString(m) }
+func (*Operation) ProtoMessage()    {}
+func (*Operation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{21}
+}
+func (m *Operation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *Operation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation.Merge(m, src)
+}
+func (m *Operation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation proto.InternalMessageInfo
+
+func (m *Operation) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Operation) GetResult() *rpc.Status {
+	if m != nil {
+		return m.Result
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Ticket struct {
+	// Fields with gogoproto.casttype and gogoproto.customtype are transformed
+	// considering actual Go type of proto structure field.
+	Id       github_com_ZacxDev_protoc_gen_struct_transformer_example_model.TicketID `protobuf:"varint,1,opt,name=id,proto3,casttype=github.com/ZacxDev/protoc-gen-struct-transformer/example/model.TicketID" json:"id,omitempty"`
+	ParentId github_com_ZacxDev_protoc_gen_struct_transformer_example_model.TicketID `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3,casttype=github.com/ZacxDev/protoc-gen-struct-transformer/example/model.TicketID" json:"parent_id,omitempty"`
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ThirdUrl != nil {
-		{
-			size, err := m.ThirdUrl.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+func (m *Ticket) Reset()         { *m = Ticket{} }
+func (m *Ticket) String() string { return proto.CompactTextString(m) }
+func (*Ticket) ProtoMessage()    {}
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{22}
+}
+func (m *Ticket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ticket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ticket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.SecondId != nil {
-		{
-			size, err := m.SecondId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *Ticket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ticket.Merge(m, src)
+}
+func (m *Ticket) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ticket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ticket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ticket proto.InternalMessageInfo
+
+func (m *Ticket) GetId() github_com_ZacxDev_protoc_gen_struct_transformer_example_model.TicketID {
+	if m != nil {
+		return m.Id
 	}
-	if m.FirstId != nil {
-		{
-			size, err := m.FirstId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Ticket) GetParentId() github_com_ZacxDev_protoc_gen_struct_transformer_example_model.TicketID {
+	if m != nil {
+		return m.ParentId
+	}
+	return 0
+}
+
+type AddressBook struct {
+	// In message.pb.go Addresses field will be of type []Address.
+	Addresses []Address `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses"`
+	// In message.pb.go PtrAddresses field will be of type []*Address.
+	PtrAddresses []*Address `protobuf:"bytes,2,rep,name=ptr_addresses,json=ptrAddresses,proto3" json:"ptr_addresses,omitempty"`
+	// AddressList is a list wrapper, model field is map[string][]Address.
+	AddressesByLabel map[string]*AddressList `protobuf:"bytes,3,rep,name=addresses_by_label,json=addressesByLabel,proto3" json:"addresses_by_label,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map values of messages with go_struct option are transformed with
+	// transformers of the message, model field is map[string]Address.
+	AddressesByName  map[string]*Address `protobuf:"bytes,4,rep,name=addresses_by_name,json=addressesByName,proto3" json:"addresses_by_name,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PtrAddressesById map[int64]*Address  `protobuf:"bytes,5,rep,name=ptr_addresses_by_id,json=ptrAddressesById,proto3" json:"ptr_addresses_by_id,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Scalar map values are copied.
+	Tags map[string]string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *AddressBook) Reset()         { *m = AddressBook{} }
+func (m *AddressBook) String() string { return proto.CompactTextString(m) }
+func (*AddressBook) ProtoMessage()    {}
+func (*AddressBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{23}
+}
+func (m *AddressBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *AddressBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBook.Merge(m, src)
+}
+func (m *AddressBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressBook proto.InternalMessageInfo
+
+func (m *AddressBook) GetAddresses() []Address {
+	if m != nil {
+		return m.Addresses
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Address) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AddressBook) GetPtrAddresses() []*Address {
+	if m != nil {
+		return m.PtrAddresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Address) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AddressBook) GetAddressesByLabel() map[string]*AddressList {
+	if m != nil {
+		return m.AddressesByLabel
+	}
+	return nil
 }
 
-func (m *Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
+func (m *AddressBook) GetAddressesByName() map[string]*Address {
+	if m != nil {
+		return m.AddressesByName
 	}
-	if m.Id != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *AddressBook) GetPtrAddressesById() map[int64]*Address {
+	if m != nil {
+		return m.PtrAddressesById
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Customer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AddressBook) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Customer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AddressList struct {
+	Items []*Address `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (m *Customer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MapFieldToWithoutDigits) > 0 {
-		i -= len(m.MapFieldToWithoutDigits)
-		copy(dAtA[i:], m.MapFieldToWithoutDigits)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.MapFieldToWithoutDigits)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.MapField_1) > 0 {
-		i -= len(m.MapField_1)
-		copy(dAtA[i:], m.MapField_1)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.MapField_1)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.BillingAddress.MarshalToSizedBuffer(dAtA[:i])
+func (m *AddressList) Reset()         { *m = AddressList{} }
+func (m *AddressList) String() string { return proto.CompactTextString(m) }
+func (*AddressList) ProtoMessage()    {}
+func (*AddressList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{24}
+}
+func (m *AddressList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMessage(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.DefaultAddress != nil {
-		{
-			size, err := m.DefaultAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+}
+func (m *AddressList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressList.Merge(m, src)
+}
+func (m *AddressList) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressList proto.InternalMessageInfo
+
+func (m *AddressList) GetItems() []*Address {
+	if m != nil {
+		return m.Items
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	return nil
+}
+
+// PostalAddress is transformed into structure of billing package, which is
+// imported by models file.
+type PostalAddress struct {
+	Street string `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	City   string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+}
+
+func (m *PostalAddress) Reset()         { *m = PostalAddress{} }
+func (m *PostalAddress) String() string { return proto.CompactTextString(m) }
+func (*PostalAddress) ProtoMessage()    {}
+func (*PostalAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{25}
+}
+func (m *PostalAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostalAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostalAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *PostalAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostalAddress.Merge(m, src)
+}
+func (m *PostalAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostalAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostalAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostalAddress proto.InternalMessageInfo
+
+func (m *PostalAddress) GetStreet() string {
+	if m != nil {
+		return m.Street
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LineItemUsage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PostalAddress) GetCity() string {
+	if m != nil {
+		return m.City
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LineItemUsage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Invoice struct {
+	Id                int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BillingAddress    *PostalAddress  `protobuf:"bytes,2,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	PreviousAddresses []PostalAddress `protobuf:"bytes,3,rep,name=previous_addresses,json=previousAddresses,proto3" json:"previous_addresses"`
 }
 
-func (m *LineItemUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.List) > 0 {
-		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Item != nil {
-		{
-			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+func (m *Invoice) Reset()         { *m = Invoice{} }
+func (m *Invoice) String() string { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()    {}
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{26}
+}
+func (m *Invoice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Invoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Invoice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Invoice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Invoice.Merge(m, src)
+}
+func (m *Invoice) XXX_Size() int {
+	return m.Size()
+}
+func (m *Invoice) XXX_DiscardUnknown() {
+	xxx_messageInfo_Invoice.DiscardUnknown(m)
 }
 
-func (m *LineItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Invoice proto.InternalMessageInfo
+
+func (m *Invoice) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LineItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Invoice) GetBillingAddress() *PostalAddress {
+	if m != nil {
+		return m.BillingAddress
+	}
+	return nil
 }
 
-func (m *LineItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SKU != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.SKU))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.URL)))
-		i--
-		dAtA[i] = 0x22
+func (m *Invoice) GetPreviousAddresses() []PostalAddress {
+	if m != nil {
+		return m.PreviousAddresses
 	}
-	if len(m.SomeField) > 0 {
-		i -= len(m.SomeField)
-		copy(dAtA[i:], m.SomeField)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.SomeField)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+type Shipment struct {
+	Id      int64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Parcels []*Shipment_Parcel                     `protobuf:"bytes,2,rep,name=parcels,proto3" json:"parcels,omitempty"`
+	Sizes   map[string]*Shipment_Parcel_Dimensions `protobuf:"bytes,3,rep,name=sizes,proto3" json:"sizes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Shipment) Reset()         { *m = Shipment{} }
+func (m *Shipment) String() string { return proto.CompactTextString(m) }
+func (*Shipment) ProtoMessage()    {}
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{27}
+}
+func (m *Shipment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Shipment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Shipment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Shipment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shipment.Merge(m, src)
+}
+func (m *Shipment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Shipment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shipment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Shipment proto.InternalMessageInfo
+
+func (m *Shipment) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.ID != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Shipment) GetParcels() []*Shipment_Parcel {
+	if m != nil {
+		return m.Parcels
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Value2Pointer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Shipment) GetSizes() map[string]*Shipment_Parcel_Dimensions {
+	if m != nil {
+		return m.Sizes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Value2Pointer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Shipment_Parcel struct {
+	Label      string                        `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Dimensions *Shipment_Parcel_Dimensions   `protobuf:"bytes,2,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	Boxes      []*Shipment_Parcel_Dimensions `protobuf:"bytes,3,rep,name=boxes,proto3" json:"boxes,omitempty"`
 }
 
-func (m *Value2Pointer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AddressNil.MarshalToSizedBuffer(dAtA[:i])
+func (m *Shipment_Parcel) Reset()         { *m = Shipment_Parcel{} }
+func (m *Shipment_Parcel) String() string { return proto.CompactTextString(m) }
+func (*Shipment_Parcel) ProtoMessage()    {}
+func (*Shipment_Parcel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{27, 0}
+}
+func (m *Shipment_Parcel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Shipment_Parcel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Shipment_Parcel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMessage(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *Pointer2Value) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Shipment_Parcel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shipment_Parcel.Merge(m, src)
 }
-
-func (m *Pointer2Value) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Shipment_Parcel) XXX_Size() int {
+	return m.Size()
+}
+func (m *Shipment_Parcel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shipment_Parcel.DiscardUnknown(m)
 }
 
-func (m *Pointer2Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AddressNotNil != nil {
-		{
-			size, err := m.AddressNotNil.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_Shipment_Parcel proto.InternalMessageInfo
+
+func (m *Shipment_Parcel) GetLabel() string {
+	if m != nil {
+		return m.Label
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SkippedMessageOne) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Shipment_Parcel) GetDimensions() *Shipment_Parcel_Dimensions {
+	if m != nil {
+		return m.Dimensions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SkippedMessageOne) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Shipment_Parcel) GetBoxes() []*Shipment_Parcel_Dimensions {
+	if m != nil {
+		return m.Boxes
+	}
+	return nil
 }
 
-func (m *SkippedMessageOne) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type Shipment_Parcel_Dimensions struct {
+	Width  int64 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *SkippedMessageTwo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Shipment_Parcel_Dimensions) Reset()         { *m = Shipment_Parcel_Dimensions{} }
+func (m *Shipment_Parcel_Dimensions) String() string { return proto.CompactTextString(m) }
+func (*Shipment_Parcel_Dimensions) ProtoMessage()    {}
+func (*Shipment_Parcel_Dimensions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{27, 0, 0}
+}
+func (m *Shipment_Parcel_Dimensions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Shipment_Parcel_Dimensions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Shipment_Parcel_Dimensions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *SkippedMessageTwo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Shipment_Parcel_Dimensions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shipment_Parcel_Dimensions.Merge(m, src)
+}
+func (m *Shipment_Parcel_Dimensions) XXX_Size() int {
+	return m.Size()
+}
+func (m *Shipment_Parcel_Dimensions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shipment_Parcel_Dimensions.DiscardUnknown(m)
 }
 
-func (m *SkippedMessageTwo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_Shipment_Parcel_Dimensions proto.InternalMessageInfo
+
+func (m *Shipment_Parcel_Dimensions) GetWidth() int64 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
 }
 
-func (m *Timer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Shipment_Parcel_Dimensions) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Timer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Refund transformers return errors of currency converters instead of panics.
+type Refund struct {
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 }
 
-func (m *Timer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TimePtrToPtrStruct != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimePtrToPtrStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToPtrStruct):])
-		if err14 != nil {
-			return 0, err14
-		}
-		i -= n14
-		i = encodeVarintMessage(dAtA, i, uint64(n14))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.TimePtrToStruct != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimePtrToStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToStruct):])
-		if err15 != nil {
-			return 0, err15
+func (m *Refund) Reset()         { *m = Refund{} }
+func (m *Refund) String() string { return proto.CompactTextString(m) }
+func (*Refund) ProtoMessage()    {}
+func (*Refund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{28}
+}
+func (m *Refund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Refund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Refund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n15
-		i = encodeVarintMessage(dAtA, i, uint64(n15))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if m.TimeToStructPtr != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimeToStructPtr, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeToStructPtr):])
-		if err16 != nil {
-			return 0, err16
-		}
-		i -= n16
-		i = encodeVarintMessage(dAtA, i, uint64(n16))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *Refund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Refund.Merge(m, src)
+}
+func (m *Refund) XXX_Size() int {
+	return m.Size()
+}
+func (m *Refund) XXX_DiscardUnknown() {
+	xxx_messageInfo_Refund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Refund proto.InternalMessageInfo
+
+func (m *Refund) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TimeToStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TimeToStruct):])
-	if err17 != nil {
-		return 0, err17
+	return 0
+}
+
+func (m *Refund) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
-	i -= n17
-	i = encodeVarintMessage(dAtA, i, uint64(n17))
-	i--
-	dAtA[i] = 0x1a
-	if m.PtrTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PtrTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PtrTime):])
-		if err18 != nil {
-			return 0, err18
+	return ""
+}
+
+type RefundBatch struct {
+	Refunds []*Refund `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	Largest *Refund   `protobuf:"bytes,2,opt,name=largest,proto3" json:"largest,omitempty"`
+}
+
+func (m *RefundBatch) Reset()         { *m = RefundBatch{} }
+func (m *RefundBatch) String() string { return proto.CompactTextString(m) }
+func (*RefundBatch) ProtoMessage()    {}
+func (*RefundBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{29}
+}
+func (m *RefundBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n18
-		i = encodeVarintMessage(dAtA, i, uint64(n18))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err19 != nil {
-		return 0, err19
+}
+func (m *RefundBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundBatch.Merge(m, src)
+}
+func (m *RefundBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundBatch proto.InternalMessageInfo
+
+func (m *RefundBatch) GetRefunds() []*Refund {
+	if m != nil {
+		return m.Refunds
 	}
-	i -= n19
-	i = encodeVarintMessage(dAtA, i, uint64(n19))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Ints) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RefundBatch) GetLargest() *Refund {
+	if m != nil {
+		return m.Largest
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Ints) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Memo transformers accept context, which is passed to note converters.
+type Memo struct {
+	Id     int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note   string   `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	Tags   []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Author string   `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
 }
 
-func (m *Ints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StringValue != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.StringValue))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Int64Value != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Int64Value))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Int32Value != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Int32Value))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.IntFor_64Value != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.IntFor_64Value))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.IntFor_32Value != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.IntFor_32Value))
-		i--
-		dAtA[i] = 0x8
+func (m *Memo) Reset()         { *m = Memo{} }
+func (m *Memo) String() string { return proto.CompactTextString(m) }
+func (*Memo) ProtoMessage()    {}
+func (*Memo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{30}
+}
+func (m *Memo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Memo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Memo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Memo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Memo.Merge(m, src)
+}
+func (m *Memo) XXX_Size() int {
+	return m.Size()
+}
+func (m *Memo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Memo.DiscardUnknown(m)
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Memo proto.InternalMessageInfo
+
+func (m *Memo) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *TheOne) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TheDecl != nil {
-		n += m.TheDecl.Size()
+
+func (m *Memo) GetNote() string {
+	if m != nil {
+		return m.Note
 	}
-	return n
+	return ""
 }
 
-func (m *TheOne_StringValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Memo) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	var l int
-	_ = l
-	l = len(m.StringValue)
-	n += 1 + l + sovMessage(uint64(l))
-	return n
+	return nil
 }
-func (m *TheOne_Int64Value) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Memo) GetAuthor() string {
+	if m != nil {
+		return m.Author
 	}
-	var l int
-	_ = l
-	n += 1 + sovMessage(uint64(m.Int64Value))
-	return n
+	return ""
 }
-func (m *NotSupportedOneOf) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TheDecl != nil {
-		n += m.TheDecl.Size()
-	}
-	return n
+
+// AuditLog is read-only, only proto to model transformers are generated.
+type AuditLog struct {
+	Author string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
 }
 
-func (m *NotSupportedOneOf_BoolValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 2
-	return n
+func (m *AuditLog) Reset()         { *m = AuditLog{} }
+func (m *AuditLog) String() string { return proto.CompactTextString(m) }
+func (*AuditLog) ProtoMessage()    {}
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{31}
 }
-func (m *NotSupportedOneOf_StringValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StringValue)
-	n += 1 + l + sovMessage(uint64(l))
-	return n
+func (m *AuditLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *CustomOneof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Value != nil {
-		n += m.Value.Size()
+}
+func (m *AuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLog.Merge(m, src)
+}
+func (m *AuditLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLog proto.InternalMessageInfo
+
+func (m *AuditLog) GetAuthor() string {
+	if m != nil {
+		return m.Author
 	}
-	return n
+	return ""
 }
 
-func (m *CustomOneof_StringValue) Size() (n int) {
-	if m == nil {
-		return 0
+type MemoThread struct {
+	Memos  []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	Pinned *Memo   `protobuf:"bytes,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+}
+
+func (m *MemoThread) Reset()         { *m = MemoThread{} }
+func (m *MemoThread) String() string { return proto.CompactTextString(m) }
+func (*MemoThread) ProtoMessage()    {}
+func (*MemoThread) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{32}
+}
+func (m *MemoThread) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemoThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemoThread.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.StringValue)
-	n += 1 + l + sovMessage(uint64(l))
-	return n
 }
-func (m *CustomOneof_Int64Value) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MemoThread) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoThread.Merge(m, src)
+}
+func (m *MemoThread) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemoThread) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoThread.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoThread proto.InternalMessageInfo
+
+func (m *MemoThread) GetMemos() []*Memo {
+	if m != nil {
+		return m.Memos
 	}
-	var l int
-	_ = l
-	n += 1 + sovMessage(uint64(m.Int64Value))
-	return n
+	return nil
 }
-func (m *CustomType) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+
+func (m *MemoThread) GetPinned() *Memo {
+	if m != nil {
+		return m.Pinned
 	}
-	return n
+	return nil
 }
 
-func (m *Product) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMessage(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.One != nil {
-		l = m.One.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.SecondId != nil {
-		l = m.SecondId.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.CustomField != nil {
-		l = m.CustomField.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.CustomOneof != nil {
-		l = m.CustomOneof.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.NotsupportedOneof != nil {
-		l = m.NotsupportedOneof.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
+type Card struct {
+	Number string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMessage(uint64(m.Id))
-	}
-	if m.FirstId != nil {
-		l = m.FirstId.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.SecondId != nil {
-		l = m.SecondId.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.ThirdUrl != nil {
-		l = m.ThirdUrl.Size()
-		n += 1 + l + sovMessage(uint64(l))
+func (m *Card) Reset()         { *m = Card{} }
+func (m *Card) String() string { return proto.CompactTextString(m) }
+func (*Card) ProtoMessage()    {}
+func (*Card) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{33}
+}
+func (m *Card) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Card) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Card.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Card) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Card.Merge(m, src)
+}
+func (m *Card) XXX_Size() int {
+	return m.Size()
+}
+func (m *Card) XXX_DiscardUnknown() {
+	xxx_messageInfo_Card.DiscardUnknown(m)
 }
 
-func (m *Address) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMessage(uint64(m.Id))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+var xxx_messageInfo_Card proto.InternalMessageInfo
+
+func (m *Card) GetNumber() string {
+	if m != nil {
+		return m.Number
 	}
-	return n
+	return ""
 }
 
-func (m *Customer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMessage(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if len(m.Addresses) > 0 {
-		for _, e := range m.Addresses {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
-		}
-	}
-	if m.DefaultAddress != nil {
-		l = m.DefaultAddress.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = m.BillingAddress.Size()
-	n += 1 + l + sovMessage(uint64(l))
-	l = len(m.MapField_1)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.MapFieldToWithoutDigits)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
+// Cases of oneof method are transformed into models of PaymentMethod sum
+// type, cases of oneof receipt are transformed into their own model fields.
+type Payment struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Method:
+	//	*Payment_Card
+	//	*Payment_Coupon
+	Method isPayment_Method `protobuf_oneof:"method"`
+	// Types that are valid to be assigned to Receipt:
+	//	*Payment_Email
+	//	*Payment_Phone
+	Receipt isPayment_Receipt `protobuf_oneof:"receipt"`
 }
 
-func (m *LineItemUsage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if len(m.List) > 0 {
-		for _, e := range m.List {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
+func (m *Payment) Reset()         { *m = Payment{} }
+func (m *Payment) String() string { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()    {}
+func (*Payment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{34}
+}
+func (m *Payment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Payment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payment.Merge(m, src)
+}
+func (m *Payment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Payment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payment.DiscardUnknown(m)
 }
 
-func (m *LineItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovMessage(uint64(m.ID))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.SomeField)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+var xxx_messageInfo_Payment proto.InternalMessageInfo
+
+type isPayment_Method interface {
+	isPayment_Method()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isPayment_Receipt interface {
+	isPayment_Receipt()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Payment_Card struct {
+	Card *Card `protobuf:"bytes,2,opt,name=card,proto3,oneof" json:"card,omitempty"`
+}
+type Payment_Coupon struct {
+	Coupon string `protobuf:"bytes,3,opt,name=coupon,proto3,oneof" json:"coupon,omitempty"`
+}
+type Payment_Email struct {
+	Email string `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
+}
+type Payment_Phone struct {
+	Phone string `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+}
+
+func (*Payment_Card) isPayment_Method()   {}
+func (*Payment_Coupon) isPayment_Method() {}
+func (*Payment_Email) isPayment_Receipt() {}
+func (*Payment_Phone) isPayment_Receipt() {}
+
+func (m *Payment) GetMethod() isPayment_Method {
+	if m != nil {
+		return m.Method
 	}
-	if m.SKU != 0 {
-		n += 1 + sovMessage(uint64(m.SKU))
+	return nil
+}
+func (m *Payment) GetReceipt() isPayment_Receipt {
+	if m != nil {
+		return m.Receipt
 	}
-	return n
+	return nil
 }
 
-func (m *Value2Pointer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Payment) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = m.AddressNil.Size()
-	n += 1 + l + sovMessage(uint64(l))
-	return n
+	return 0
 }
 
-func (m *Pointer2Value) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AddressNotNil != nil {
-		l = m.AddressNotNil.Size()
-		n += 1 + l + sovMessage(uint64(l))
+func (m *Payment) GetCard() *Card {
+	if x, ok := m.GetMethod().(*Payment_Card); ok {
+		return x.Card
 	}
-	return n
+	return nil
 }
 
-func (m *SkippedMessageOne) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Payment) GetCoupon() string {
+	if x, ok := m.GetMethod().(*Payment_Coupon); ok {
+		return x.Coupon
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *SkippedMessageTwo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Payment) GetEmail() string {
+	if x, ok := m.GetReceipt().(*Payment_Email); ok {
+		return x.Email
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *Timer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Payment) GetPhone() string {
+	if x, ok := m.GetReceipt().(*Payment_Phone); ok {
+		return x.Phone
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovMessage(uint64(l))
-	if m.PtrTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PtrTime)
-		n += 1 + l + sovMessage(uint64(l))
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Payment) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Payment_Card)(nil),
+		(*Payment_Coupon)(nil),
+		(*Payment_Email)(nil),
+		(*Payment_Phone)(nil),
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.TimeToStruct)
+}
+
+// PayoutTarget interface and its wrappers PayoutTargetCard and
+// PayoutTargetAccount are generated into models package.
+type Payout struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//	*Payout_Card
+	//	*Payout_Account
+	Target isPayout_Target `protobuf_oneof:"target"`
+}
+
+func (m *Payout) Reset()         { *m = Payout{} }
+func (m *Payout) String() string { return proto.CompactTextString(m) }
+func (*Payout) ProtoMessage()    {}
+func (*Payout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{35}
+}
+func (m *Payout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Payout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Payout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Payout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payout.Merge(m, src)
+}
+func (m *Payout) XXX_Size() int {
+	return m.Size()
+}
+func (m *Payout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Payout proto.InternalMessageInfo
+
+type isPayout_Target interface {
+	isPayout_Target()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Payout_Card struct {
+	Card *Card `protobuf:"bytes,2,opt,name=card,proto3,oneof" json:"card,omitempty"`
+}
+type Payout_Account struct {
+	Account string `protobuf:"bytes,3,opt,name=account,proto3,oneof" json:"account,omitempty"`
+}
+
+func (*Payout_Card) isPayout_Target()    {}
+func (*Payout_Account) isPayout_Target() {}
+
+func (m *Payout) GetTarget() isPayout_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *Payout) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Payout) GetCard() *Card {
+	if x, ok := m.GetTarget().(*Payout_Card); ok {
+		return x.Card
+	}
+	return nil
+}
+
+func (m *Payout) GetAccount() string {
+	if x, ok := m.GetTarget().(*Payout_Account); ok {
+		return x.Account
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Payout) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Payout_Card)(nil),
+		(*Payout_Account)(nil),
+	}
+}
+
+type RetryPolicy struct {
+	Backoff time.Duration  `protobuf:"bytes,1,opt,name=backoff,proto3,stdduration" json:"backoff"`
+	MaxWait *time.Duration `protobuf:"bytes,2,opt,name=max_wait,json=maxWait,proto3,stdduration" json:"max_wait,omitempty"`
+	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{36}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetBackoff() time.Duration {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetMaxWait() *time.Duration {
+	if m != nil {
+		return m.MaxWait
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+type Device struct {
+	Id         int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attributes *types.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Config     *types.Struct `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *Device) Reset()         { *m = Device{} }
+func (m *Device) String() string { return proto.CompactTextString(m) }
+func (*Device) ProtoMessage()    {}
+func (*Device) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{37}
+}
+func (m *Device) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Device) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Device.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Device) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Device.Merge(m, src)
+}
+func (m *Device) XXX_Size() int {
+	return m.Size()
+}
+func (m *Device) XXX_DiscardUnknown() {
+	xxx_messageInfo_Device.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Device proto.InternalMessageInfo
+
+func (m *Device) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Device) GetAttributes() *types.Struct {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Device) GetConfig() *types.Struct {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type Attachment struct {
+	Content  []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Caption  []byte `protobuf:"bytes,2,opt,name=caption,proto3" json:"caption,omitempty"`
+	Checksum []byte `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Id       string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId  []byte `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+}
+
+func (m *Attachment) Reset()         { *m = Attachment{} }
+func (m *Attachment) String() string { return proto.CompactTextString(m) }
+func (*Attachment) ProtoMessage()    {}
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{38}
+}
+func (m *Attachment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attachment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attachment.Merge(m, src)
+}
+func (m *Attachment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attachment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attachment proto.InternalMessageInfo
+
+func (m *Attachment) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *Attachment) GetCaption() []byte {
+	if m != nil {
+		return m.Caption
+	}
+	return nil
+}
+
+func (m *Attachment) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *Attachment) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Attachment) GetOwnerId() []byte {
+	if m != nil {
+		return m.OwnerId
+	}
+	return nil
+}
+
+type Quote struct {
+	Price         string       `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	DiscountCents int64        `protobuf:"varint,2,opt,name=discount_cents,json=discountCents,proto3" json:"discount_cents,omitempty"`
+	Total         *_type.Money `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *Quote) Reset()         { *m = Quote{} }
+func (m *Quote) String() string { return proto.CompactTextString(m) }
+func (*Quote) ProtoMessage()    {}
+func (*Quote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{39}
+}
+func (m *Quote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Quote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Quote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Quote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quote.Merge(m, src)
+}
+func (m *Quote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Quote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quote proto.InternalMessageInfo
+
+func (m *Quote) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *Quote) GetDiscountCents() int64 {
+	if m != nil {
+		return m.DiscountCents
+	}
+	return 0
+}
+
+func (m *Quote) GetTotal() *_type.Money {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+type Contact struct {
+	Nickname   string             `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Email      *types.StringValue `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Age        *types.Int32Value  `protobuf:"bytes,3,opt,name=age,proto3" json:"age,omitempty"`
+	VerifiedAt *types.Timestamp   `protobuf:"bytes,4,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+}
+
+func (m *Contact) Reset()         { *m = Contact{} }
+func (m *Contact) String() string { return proto.CompactTextString(m) }
+func (*Contact) ProtoMessage()    {}
+func (*Contact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{40}
+}
+func (m *Contact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Contact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Contact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Contact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contact.Merge(m, src)
+}
+func (m *Contact) XXX_Size() int {
+	return m.Size()
+}
+func (m *Contact) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contact proto.InternalMessageInfo
+
+func (m *Contact) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *Contact) GetEmail() *types.StringValue {
+	if m != nil {
+		return m.Email
+	}
+	return nil
+}
+
+func (m *Contact) GetAge() *types.Int32Value {
+	if m != nil {
+		return m.Age
+	}
+	return nil
+}
+
+func (m *Contact) GetVerifiedAt() *types.Timestamp {
+	if m != nil {
+		return m.VerifiedAt
+	}
+	return nil
+}
+
+type Team struct {
+	Id      int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Lead    *Contact   `protobuf:"bytes,3,opt,name=lead,proto3" json:"lead,omitempty"`
+	Members []*Contact `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *Team) Reset()         { *m = Team{} }
+func (m *Team) String() string { return proto.CompactTextString(m) }
+func (*Team) ProtoMessage()    {}
+func (*Team) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{41}
+}
+func (m *Team) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Team) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Team.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Team) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Team.Merge(m, src)
+}
+func (m *Team) XXX_Size() int {
+	return m.Size()
+}
+func (m *Team) XXX_DiscardUnknown() {
+	xxx_messageInfo_Team.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Team proto.InternalMessageInfo
+
+func (m *Team) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Team) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Team) GetLead() *Contact {
+	if m != nil {
+		return m.Lead
+	}
+	return nil
+}
+
+func (m *Team) GetMembers() []*Contact {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type ProductSize struct {
+	Width  int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ProductSize) Reset()         { *m = ProductSize{} }
+func (m *ProductSize) String() string { return proto.CompactTextString(m) }
+func (*ProductSize) ProtoMessage()    {}
+func (*ProductSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{42}
+}
+func (m *ProductSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProductSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProductSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProductSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductSize.Merge(m, src)
+}
+func (m *ProductSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProductSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProductSize proto.InternalMessageInfo
+
+func (m *ProductSize) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *ProductSize) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// CatalogProduct is transformed into message of the next version of API.
+type CatalogProduct struct {
+	Id           int64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string       `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Price        float64      `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Tags         []string     `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Dimensions   *ProductSize `protobuf:"bytes,5,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	Availability StockStatus  `protobuf:"varint,6,opt,name=availability,proto3,enum=svc.example.StockStatus" json:"availability,omitempty"`
+	ReleasedAt   *time.Time   `protobuf:"bytes,7,opt,name=released_at,json=releasedAt,proto3,stdtime" json:"released_at,omitempty"`
+}
+
+func (m *CatalogProduct) Reset()         { *m = CatalogProduct{} }
+func (m *CatalogProduct) String() string { return proto.CompactTextString(m) }
+func (*CatalogProduct) ProtoMessage()    {}
+func (*CatalogProduct) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ffb7dddb00b34f, []int{43}
+}
+func (m *CatalogProduct) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CatalogProduct) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CatalogProduct.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CatalogProduct) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogProduct.Merge(m, src)
+}
+func (m *CatalogProduct) XXX_Size() int {
+	return m.Size()
+}
+func (m *CatalogProduct) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogProduct.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogProduct proto.InternalMessageInfo
+
+func (m *CatalogProduct) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CatalogProduct) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CatalogProduct) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *CatalogProduct) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *CatalogProduct) GetDimensions() *ProductSize {
+	if m != nil {
+		return m.Dimensions
+	}
+	return nil
+}
+
+func (m *CatalogProduct) GetAvailability() StockStatus {
+	if m != nil {
+		return m.Availability
+	}
+	return StockStatus_STOCK_STATUS_UNKNOWN
+}
+
+func (m *CatalogProduct) GetReleasedAt() *time.Time {
+	if m != nil {
+		return m.ReleasedAt
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("svc.example.StockStatus", StockStatus_name, StockStatus_value)
+	proto.RegisterEnum("svc.example.Order_Status", Order_Status_name, Order_Status_value)
+	proto.RegisterType((*TheOne)(nil), "svc.example.TheOne")
+	proto.RegisterType((*NotSupportedOneOf)(nil), "svc.example.NotSupportedOneOf")
+	proto.RegisterType((*CustomOneof)(nil), "svc.example.CustomOneof")
+	proto.RegisterType((*CustomType)(nil), "svc.example.CustomType")
+	proto.RegisterType((*Product)(nil), "svc.example.Product")
+	proto.RegisterType((*Order)(nil), "svc.example.Order")
+	proto.RegisterType((*Address)(nil), "svc.example.Address")
+	proto.RegisterType((*Customer)(nil), "svc.example.Customer")
+	proto.RegisterType((*CustomerPatch)(nil), "svc.example.CustomerPatch")
+	proto.RegisterType((*LineItemUsage)(nil), "svc.example.LineItemUsage")
+	proto.RegisterType((*LineItem)(nil), "svc.example.LineItem")
+	proto.RegisterType((*Value2Pointer)(nil), "svc.example.Value2Pointer")
+	proto.RegisterType((*Pointer2Value)(nil), "svc.example.Pointer2Value")
+	proto.RegisterType((*SkippedMessageOne)(nil), "svc.example.SkippedMessageOne")
+	proto.RegisterType((*SkippedMessageTwo)(nil), "svc.example.SkippedMessageTwo")
+	proto.RegisterType((*Timer)(nil), "svc.example.Timer")
+	proto.RegisterType((*Ints)(nil), "svc.example.Ints")
+	proto.RegisterType((*Location)(nil), "svc.example.Location")
+	proto.RegisterType((*Store)(nil), "svc.example.Store")
+	proto.RegisterType((*Labels)(nil), "svc.example.Labels")
+	proto.RegisterType((*Schedule)(nil), "svc.example.Schedule")
+	proto.RegisterMapType((map[string]*time.Time)(nil), "svc.example.Schedule.DeadlinesEntry")
+	proto.RegisterMapType((map[int64]*types.StringValue)(nil), "svc.example.Schedule.NotesEntry")
+	proto.RegisterType((*Operation)(nil), "svc.example.Operation")
+	proto.RegisterType((*Ticket)(nil), "svc.example.Ticket")
+	proto.RegisterType((*AddressBook)(nil), "svc.example.AddressBook")
+	proto.RegisterMapType((map[string]*AddressList)(nil), "svc.example.AddressBook.AddressesByLabelEntry")
+	proto.RegisterMapType((map[string]*Address)(nil), "svc.example.AddressBook.AddressesByNameEntry")
+	proto.RegisterMapType((map[int64]*Address)(nil), "svc.example.AddressBook.PtrAddressesByIdEntry")
+	proto.RegisterMapType((map[string]string)(nil), "svc.example.AddressBook.TagsEntry")
+	proto.RegisterType((*AddressList)(nil), "svc.example.AddressList")
+	proto.RegisterType((*PostalAddress)(nil), "svc.example.PostalAddress")
+	proto.RegisterType((*Invoice)(nil), "svc.example.Invoice")
+	proto.RegisterType((*Shipment)(nil), "svc.example.Shipment")
+	proto.RegisterMapType((map[string]*Shipment_Parcel_Dimensions)(nil), "svc.example.Shipment.SizesEntry")
+	proto.RegisterType((*Shipment_Parcel)(nil), "svc.example.Shipment.Parcel")
+	proto.RegisterType((*Shipment_Parcel_Dimensions)(nil), "svc.example.Shipment.Parcel.Dimensions")
+	proto.RegisterType((*Refund)(nil), "svc.example.Refund")
+	proto.RegisterType((*RefundBatch)(nil), "svc.example.RefundBatch")
+	proto.RegisterType((*Memo)(nil), "svc.example.Memo")
+	proto.RegisterType((*AuditLog)(nil), "svc.example.AuditLog")
+	proto.RegisterType((*MemoThread)(nil), "svc.example.MemoThread")
+	proto.RegisterType((*Card)(nil), "svc.example.Card")
+	proto.RegisterType((*Payment)(nil), "svc.example.Payment")
+	proto.RegisterType((*Payout)(nil), "svc.example.Payout")
+	proto.RegisterType((*RetryPolicy)(nil), "svc.example.RetryPolicy")
+	proto.RegisterType((*Device)(nil), "svc.example.Device")
+	proto.RegisterType((*Attachment)(nil), "svc.example.Attachment")
+	proto.RegisterType((*Quote)(nil), "svc.example.Quote")
+	proto.RegisterType((*Contact)(nil), "svc.example.Contact")
+	proto.RegisterType((*Team)(nil), "svc.example.Team")
+	proto.RegisterType((*ProductSize)(nil), "svc.example.ProductSize")
+	proto.RegisterType((*CatalogProduct)(nil), "svc.example.CatalogProduct")
+}
+
+func init() { proto.RegisterFile("example/message.proto", fileDescriptor_c1ffb7dddb00b34f) }
+
+var fileDescriptor_c1ffb7dddb00b34f = []byte{
+	// 3649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4d, 0x6c, 0x24, 0xc7,
+	0x75, 0x66, 0xd7, 0xfc, 0xbf, 0x21, 0xb9, 0xdc, 0xda, 0xbf, 0x59, 0x4a, 0xd8, 0xa5, 0x47, 0x4a,
+	0xb4, 0x16, 0x76, 0x87, 0xbb, 0x94, 0xb4, 0x2b, 0x8d, 0xb5, 0xb0, 0x39, 0xa4, 0x24, 0xd2, 0xe2,
+	0x92, 0xe3, 0x9e, 0x59, 0xaf, 0x6d, 0xd8, 0x9e, 0x34, 0xbb, 0x8b, 0x33, 0x0d, 0xf6, 0x74, 0x75,
+	0xba, 0x6b, 0xb8, 0x4b, 0x03, 0x01, 0x84, 0x20, 0x41, 0x0c, 0x1f, 0x82, 0x85, 0x0f, 0x81, 0xe0,
+	0x93, 0xe1, 0x93, 0xa1, 0x4b, 0x82, 0x1c, 0x72, 0x20, 0x02, 0xae, 0x60, 0x40, 0xc0, 0x06, 0xa4,
+	0x00, 0x25, 0x97, 0x18, 0x3e, 0x38, 0x06, 0x85, 0x20, 0xbe, 0x18, 0xf0, 0xd1, 0x08, 0x82, 0xc0,
+	0xa8, 0xbf, 0x66, 0x37, 0x67, 0xf8, 0x63, 0x40, 0x87, 0x5d, 0x76, 0xbd, 0x7a, 0xef, 0x7b, 0xaf,
+	0x5e, 0xbd, 0x7a, 0xf5, 0xaa, 0x6a, 0xe0, 0x12, 0x79, 0x62, 0xf5, 0x03, 0x8f, 0xcc, 0xf6, 0x49,
+	0x14, 0x59, 0x5d, 0x52, 0x0b, 0x42, 0xca, 0x28, 0x2e, 0x47, 0x5b, 0x76, 0x4d, 0x75, 0x4d, 0x5f,
+	0xa5, 0x01, 0x73, 0xa9, 0x1f, 0xcd, 0x5a, 0xbe, 0x4f, 0x99, 0x25, 0xbe, 0x25, 0xdf, 0xf4, 0xcb,
+	0xe2, 0xcf, 0xfa, 0x60, 0xe3, 0x6b, 0x5b, 0x77, 0x6a, 0xaf, 0xd5, 0xee, 0xcc, 0x76, 0x69, 0x97,
+	0x0a, 0x9a, 0xf8, 0x52, 0x5c, 0xd7, 0xba, 0x94, 0x76, 0x3d, 0x32, 0xab, 0x99, 0x67, 0x9d, 0x41,
+	0x28, 0x60, 0x54, 0xff, 0x8b, 0x47, 0xfb, 0x23, 0x16, 0x0e, 0x6c, 0xa6, 0x7a, 0xaf, 0x1f, 0xed,
+	0x65, 0x6e, 0x9f, 0x44, 0xcc, 0xea, 0x07, 0xc7, 0xc1, 0x3f, 0x0e, 0xad, 0x20, 0x20, 0xa1, 0x36,
+	0xf2, 0x8a, 0xea, 0x0f, 0x03, 0x7b, 0x36, 0x62, 0x16, 0x1b, 0x1c, 0xed, 0x60, 0xdb, 0x01, 0x99,
+	0xed, 0x53, 0x9f, 0x6c, 0xab, 0x8e, 0x8a, 0xf6, 0xca, 0xd6, 0xdc, 0xac, 0x6d, 0x31, 0xcb, 0xa3,
+	0x5d, 0xd9, 0x53, 0xfd, 0x2e, 0xe4, 0xdb, 0x3d, 0xb2, 0xe6, 0x13, 0xfc, 0x12, 0x8c, 0x47, 0x2c,
+	0x74, 0xfd, 0x6e, 0x67, 0xcb, 0xf2, 0x06, 0xa4, 0x62, 0xcc, 0x18, 0x37, 0x4a, 0x4b, 0x63, 0x66,
+	0x59, 0x52, 0xbf, 0xc9, 0x89, 0xf8, 0x4b, 0x50, 0x76, 0x7d, 0x76, 0xf7, 0x75, 0xc5, 0x83, 0x66,
+	0x8c, 0x1b, 0x99, 0xa5, 0x31, 0x13, 0x04, 0x51, 0xb0, 0x34, 0x00, 0x8a, 0xac, 0x47, 0x3a, 0x0e,
+	0xb1, 0xbd, 0x2a, 0x81, 0xf3, 0xab, 0x94, 0xb5, 0x06, 0x41, 0x40, 0x43, 0x46, 0x9c, 0x35, 0x9f,
+	0xac, 0x6d, 0xe0, 0xeb, 0x00, 0xeb, 0x94, 0x7a, 0x09, 0x35, 0xc5, 0xa5, 0x31, 0xb3, 0xc4, 0x69,
+	0x52, 0xc9, 0x51, 0x4b, 0xd0, 0x08, 0x4b, 0x52, 0x6a, 0xbe, 0x0f, 0xe5, 0x85, 0x41, 0xc4, 0x68,
+	0x7f, 0xcd, 0x27, 0x74, 0xe3, 0x0b, 0x1b, 0x49, 0x01, 0x72, 0xa2, 0xb3, 0x5a, 0x05, 0x90, 0xf8,
+	0xed, 0xed, 0x80, 0xe0, 0x8b, 0x90, 0x4b, 0xe0, 0x9a, 0x8a, 0xe7, 0x7f, 0x10, 0x14, 0x9a, 0x21,
+	0x75, 0x06, 0x36, 0xc3, 0x93, 0x80, 0x5c, 0x47, 0x74, 0xe7, 0x4c, 0xe4, 0x3a, 0x18, 0x43, 0xd6,
+	0xb7, 0xfa, 0x6a, 0x20, 0xa6, 0xf8, 0xc6, 0x7f, 0x06, 0x19, 0xea, 0x93, 0x4a, 0x66, 0xc6, 0xb8,
+	0x51, 0x9e, 0xbb, 0x50, 0x4b, 0xc4, 0x67, 0x4d, 0x4e, 0x88, 0xc9, 0xfb, 0xf1, 0x6d, 0x28, 0x45,
+	0xc4, 0xa6, 0xbe, 0xd3, 0x71, 0x9d, 0x4a, 0xf6, 0x78, 0xe6, 0xa2, 0xe4, 0x5a, 0x76, 0xf0, 0xd7,
+	0x60, 0xdc, 0x16, 0xc6, 0x76, 0x36, 0x5c, 0xe2, 0x39, 0x95, 0x9c, 0x10, 0xba, 0x92, 0x12, 0x3a,
+	0x1c, 0x4d, 0x23, 0xfb, 0x7c, 0x0f, 0x19, 0x66, 0x59, 0x8a, 0xbc, 0xcb, 0x25, 0xf0, 0x7c, 0x8c,
+	0x40, 0xb9, 0x3f, 0x2b, 0x79, 0x81, 0x50, 0x19, 0x81, 0x20, 0xfc, 0x9d, 0x86, 0x90, 0x53, 0xf0,
+	0x00, 0xb0, 0x4f, 0x59, 0xa4, 0x27, 0x5e, 0x01, 0x15, 0x04, 0xd0, 0xb5, 0x14, 0xd0, 0x50, 0x7c,
+	0x98, 0xe7, 0x93, 0x92, 0x02, 0xae, 0x5e, 0x3e, 0xd8, 0x45, 0xda, 0xbb, 0xd5, 0xdf, 0x65, 0x20,
+	0xb7, 0x16, 0x3a, 0x24, 0x4c, 0xf8, 0x39, 0x23, 0xfc, 0x5c, 0x83, 0xe2, 0x86, 0x1b, 0x46, 0x8c,
+	0xfb, 0x0a, 0x1d, 0xef, 0xab, 0x82, 0x60, 0x5a, 0x76, 0xd2, 0xce, 0xcd, 0x9c, 0xc5, 0xb9, 0xb7,
+	0xa1, 0xc4, 0x7a, 0x6e, 0xe8, 0x74, 0x06, 0xa1, 0x77, 0xe2, 0x74, 0x08, 0xae, 0x87, 0xa1, 0x87,
+	0xdf, 0x80, 0xa2, 0x5c, 0xa3, 0x24, 0xaa, 0xe4, 0x66, 0x32, 0x37, 0x26, 0xe7, 0xae, 0xa6, 0x04,
+	0xc4, 0x48, 0x6a, 0x2d, 0xc1, 0x62, 0xc6, 0xac, 0x78, 0x1d, 0x72, 0xfc, 0x9b, 0x08, 0xe7, 0x9f,
+	0x24, 0xd3, 0xb8, 0xf3, 0x93, 0x7d, 0x74, 0xab, 0x39, 0xbf, 0xbc, 0x78, 0x5f, 0x90, 0x39, 0x95,
+	0x34, 0x2d, 0xd7, 0xb9, 0xd9, 0x5a, 0x5a, 0x6e, 0x36, 0xdf, 0x49, 0x92, 0x5b, 0x3d, 0x37, 0x08,
+	0x88, 0x63, 0x4a, 0x68, 0xfc, 0x5d, 0x28, 0x33, 0xca, 0x2c, 0xaf, 0x63, 0x13, 0x9f, 0x45, 0x62,
+	0x76, 0x32, 0x8d, 0xaf, 0xec, 0xec, 0xa1, 0x5c, 0x9b, 0x93, 0x7f, 0xb6, 0x8f, 0x2e, 0xad, 0xbb,
+	0x9e, 0xe7, 0xfa, 0xdd, 0xda, 0x02, 0xe7, 0x68, 0xd3, 0xf9, 0x3e, 0x1d, 0xf8, 0xec, 0xa3, 0x44,
+	0x87, 0xa4, 0xb4, 0xa9, 0x60, 0x30, 0x41, 0xe0, 0x89, 0xef, 0xea, 0x4d, 0xc8, 0x4b, 0x0b, 0x71,
+	0x19, 0x0a, 0x0f, 0x57, 0xdf, 0x5f, 0x5d, 0x7b, 0xb4, 0x3a, 0x35, 0x86, 0x8b, 0x90, 0xe5, 0xc6,
+	0x4e, 0x19, 0x9c, 0xac, 0x4c, 0x9c, 0x42, 0xf5, 0xab, 0x07, 0xbb, 0x48, 0xce, 0xea, 0xef, 0x77,
+	0x91, 0xf1, 0x87, 0x5d, 0x64, 0x7c, 0xfc, 0x0c, 0x19, 0xcf, 0x9f, 0x21, 0xa3, 0x5a, 0x87, 0xc2,
+	0xbc, 0xe3, 0x84, 0x24, 0x8a, 0x86, 0x26, 0x1c, 0x43, 0x96, 0xe7, 0x3a, 0xbd, 0xb0, 0xf8, 0xb7,
+	0x8c, 0x15, 0x25, 0x50, 0x7d, 0x9a, 0x81, 0xa2, 0x0c, 0xd5, 0x11, 0xe1, 0x52, 0x49, 0x2e, 0xcb,
+	0x46, 0xf6, 0x83, 0x7d, 0x64, 0xa8, 0xc5, 0x39, 0x07, 0x25, 0x4b, 0x22, 0x90, 0xa8, 0x92, 0x99,
+	0xc9, 0xdc, 0x28, 0xcf, 0x5d, 0x4c, 0xcd, 0x80, 0xc2, 0x37, 0x0f, 0xd9, 0xf0, 0x7d, 0x38, 0xe7,
+	0x90, 0x0d, 0x6b, 0xe0, 0xb1, 0x8e, 0x22, 0xaa, 0x00, 0x19, 0x2d, 0x39, 0xa9, 0x98, 0xf5, 0xd0,
+	0xde, 0x83, 0x73, 0xca, 0xa7, 0xb1, 0x78, 0xee, 0x78, 0xf1, 0x46, 0x91, 0x5b, 0xfb, 0xfc, 0xd7,
+	0xd7, 0xc7, 0xcc, 0x49, 0x25, 0xa6, 0x81, 0xbe, 0x02, 0xe5, 0xbe, 0x15, 0xc8, 0xc5, 0xdf, 0xb9,
+	0x23, 0xe2, 0xa7, 0xd4, 0x78, 0x61, 0x67, 0x0f, 0x95, 0x1e, 0x58, 0x81, 0x58, 0xe0, 0x77, 0x7e,
+	0xb1, 0x87, 0x40, 0x37, 0x3a, 0x77, 0xcc, 0x52, 0x5f, 0x77, 0xe0, 0xf7, 0xe1, 0x85, 0x43, 0x61,
+	0x46, 0x3b, 0x8f, 0x5d, 0xd6, 0xa3, 0x03, 0xd6, 0x71, 0xdc, 0xae, 0xab, 0x42, 0xa4, 0xd4, 0x98,
+	0x48, 0x82, 0xcd, 0x99, 0x57, 0xb4, 0x78, 0x9b, 0x3e, 0x92, 0xec, 0x8b, 0x82, 0xbb, 0x7e, 0xf1,
+	0x60, 0x17, 0xc5, 0xde, 0xff, 0xed, 0x2e, 0x32, 0x7e, 0xce, 0xa7, 0xf3, 0x53, 0x03, 0x26, 0x34,
+	0xb1, 0x69, 0x31, 0xbb, 0x87, 0x6f, 0xab, 0x79, 0x30, 0xc4, 0x78, 0x5f, 0xac, 0xc9, 0x5d, 0xac,
+	0xa6, 0xb7, 0xbf, 0x5a, 0xeb, 0x30, 0x6d, 0xab, 0xf9, 0x19, 0xe1, 0x6b, 0xf4, 0x27, 0xf8, 0xfa,
+	0xfe, 0xb0, 0xaf, 0x33, 0x27, 0x89, 0xa7, 0x3d, 0x5c, 0x1f, 0xff, 0xe7, 0x67, 0x87, 0xe3, 0xaa,
+	0xfe, 0x00, 0x26, 0x56, 0x5c, 0x9f, 0x2c, 0x33, 0xd2, 0x7f, 0xc8, 0x2b, 0x0e, 0xfc, 0x65, 0xc8,
+	0xf2, 0x86, 0x1a, 0xce, 0xa5, 0x14, 0xa4, 0xe6, 0x34, 0x05, 0x0b, 0x67, 0x5d, 0x71, 0x23, 0x56,
+	0x41, 0x33, 0x99, 0x13, 0x58, 0x39, 0x4b, 0xfd, 0xc2, 0xc1, 0x2e, 0x3a, 0xf7, 0x60, 0x3b, 0xa5,
+	0xaa, 0xfa, 0x77, 0x06, 0x14, 0x35, 0x85, 0x87, 0xf7, 0xf2, 0xa2, 0x0e, 0xef, 0xe5, 0x45, 0xbe,
+	0x38, 0xda, 0x89, 0xc5, 0xc1, 0xbf, 0xf1, 0x4b, 0x00, 0x11, 0xed, 0x13, 0xb5, 0x35, 0x64, 0x64,
+	0xe0, 0xff, 0x9c, 0xa7, 0xef, 0x12, 0xa7, 0xcb, 0xfc, 0x3f, 0x05, 0x99, 0x87, 0xe6, 0x8a, 0x88,
+	0xde, 0x92, 0xc9, 0x3f, 0x39, 0xa5, 0xf5, 0xfe, 0x43, 0x11, 0x90, 0x19, 0x93, 0x7f, 0xd6, 0x27,
+	0x0f, 0x76, 0x11, 0x1c, 0x9a, 0x53, 0xed, 0xc0, 0x84, 0x98, 0xa0, 0xb9, 0x26, 0x75, 0x7d, 0x46,
+	0x42, 0x1e, 0x86, 0xca, 0xb7, 0x1d, 0xdf, 0xf5, 0x2a, 0xc6, 0xf1, 0xfe, 0x6d, 0x64, 0x45, 0x1c,
+	0x83, 0x62, 0x5f, 0x75, 0xbd, 0xfa, 0xf9, 0x83, 0x5d, 0x94, 0xc6, 0xab, 0xfe, 0x05, 0x4c, 0xa8,
+	0xcf, 0x39, 0xd1, 0x81, 0xdf, 0x86, 0x73, 0xb1, 0x02, 0xca, 0x4e, 0x53, 0x62, 0x4e, 0x68, 0x78,
+	0xca, 0x62, 0x0d, 0x29, 0xc0, 0xea, 0x05, 0x38, 0xdf, 0xda, 0x14, 0x09, 0xf2, 0x81, 0xac, 0x1d,
+	0xd7, 0xfc, 0x11, 0xc4, 0xf6, 0x63, 0x5a, 0xfd, 0x65, 0x1e, 0x72, 0x6d, 0x97, 0xa7, 0x94, 0x45,
+	0xc8, 0xf2, 0xea, 0x4d, 0x69, 0x9e, 0x1e, 0x0a, 0xdd, 0xb6, 0x2e, 0xed, 0x1a, 0x17, 0x77, 0xf6,
+	0x50, 0x91, 0x37, 0xf9, 0x3f, 0x3e, 0xe0, 0xa7, 0xff, 0x75, 0xdd, 0x30, 0x85, 0x34, 0x5e, 0x85,
+	0x62, 0xc0, 0xc2, 0x8e, 0x40, 0x42, 0xa7, 0x22, 0x5d, 0xd9, 0xd9, 0x43, 0xe5, 0x26, 0x0b, 0x13,
+	0x60, 0x86, 0x00, 0x2b, 0x04, 0x92, 0x88, 0x1f, 0xc1, 0x24, 0xc7, 0xe2, 0x0b, 0x58, 0x56, 0x9e,
+	0x95, 0xcc, 0xa9, 0xa8, 0x97, 0xf8, 0xa2, 0x5e, 0x1d, 0x78, 0x5e, 0x94, 0x32, 0x70, 0x9c, 0x03,
+	0xb5, 0x69, 0x4b, 0xc0, 0x60, 0x0b, 0x70, 0x1a, 0xb8, 0x13, 0xb0, 0xb0, 0x92, 0x3d, 0x15, 0xbc,
+	0xb2, 0xb3, 0x87, 0xc6, 0x9b, 0x2c, 0x4c, 0xe2, 0x4b, 0x9b, 0xcf, 0x25, 0xf1, 0x9b, 0x2c, 0xc4,
+	0x1d, 0xa5, 0x42, 0x38, 0x24, 0xb6, 0x3f, 0x77, 0xaa, 0x8a, 0xcb, 0x3b, 0x7b, 0x08, 0x62, 0xfc,
+	0xb9, 0xb4, 0x02, 0xee, 0x2d, 0x3d, 0x06, 0x17, 0x2e, 0x27, 0x15, 0xf0, 0x3f, 0x4a, 0x49, 0xfe,
+	0x54, 0x25, 0x57, 0x77, 0xf6, 0xd0, 0x44, 0x72, 0x1c, 0x87, 0x7a, 0x70, 0xac, 0xa7, 0xc9, 0x42,
+	0xa5, 0x6a, 0x0d, 0xca, 0xda, 0x5d, 0xdc, 0x4f, 0x85, 0x53, 0xf1, 0x2f, 0xec, 0xec, 0xa1, 0x42,
+	0x5b, 0x02, 0xc5, 0x53, 0x50, 0x92, 0x2e, 0xe2, 0xce, 0x59, 0x83, 0xb2, 0x32, 0x5b, 0xc4, 0x4a,
+	0xf1, 0x6c, 0x80, 0x2a, 0x56, 0x62, 0x53, 0x4b, 0x3c, 0x4e, 0xa8, 0x88, 0x94, 0xaf, 0x02, 0xd8,
+	0x21, 0xb1, 0x78, 0x89, 0x66, 0xb1, 0x4a, 0xe9, 0x54, 0xbc, 0xec, 0x53, 0xbe, 0x49, 0x96, 0x94,
+	0xcc, 0x3c, 0xe3, 0x00, 0x83, 0xc0, 0xd1, 0x00, 0x70, 0x56, 0x00, 0x25, 0x33, 0xcf, 0xea, 0x13,
+	0x07, 0xbb, 0xa8, 0xc4, 0xfb, 0x1f, 0x50, 0x87, 0x78, 0xd5, 0x7f, 0x40, 0x90, 0x5d, 0xf6, 0x59,
+	0x84, 0x57, 0x60, 0xca, 0xf5, 0x59, 0x67, 0x83, 0x86, 0x9d, 0xd7, 0xe6, 0x12, 0x85, 0x7c, 0xae,
+	0xf1, 0x12, 0x9f, 0x84, 0x65, 0x9f, 0xbd, 0x4b, 0xc3, 0xd7, 0xe4, 0xd2, 0xfd, 0xc5, 0x1e, 0x9a,
+	0x94, 0x84, 0x8e, 0xa2, 0x98, 0x13, 0x6e, 0x92, 0x21, 0x89, 0x96, 0x2e, 0xf9, 0x93, 0x68, 0x77,
+	0x5f, 0x3f, 0x8a, 0x76, 0xf7, 0xf5, 0x14, 0x9a, 0x6a, 0xe2, 0xeb, 0xe2, 0xec, 0x10, 0x9b, 0x95,
+	0x11, 0x85, 0x3e, 0x08, 0x52, 0x92, 0x21, 0xd6, 0x94, 0x15, 0x79, 0x33, 0x71, 0xb4, 0xc0, 0x5f,
+	0x3a, 0x72, 0x44, 0x91, 0x99, 0x35, 0x79, 0x40, 0x91, 0x8e, 0xe1, 0xae, 0x90, 0x8e, 0x79, 0x13,
+	0x8a, 0x2b, 0xd4, 0x16, 0xa7, 0x4c, 0x9e, 0xd9, 0x6d, 0x97, 0x6d, 0xab, 0x03, 0x88, 0xf8, 0xc6,
+	0x15, 0x28, 0xd8, 0xbc, 0x14, 0x0b, 0xb7, 0x55, 0xc2, 0xd7, 0xcd, 0xea, 0x26, 0xe4, 0x5a, 0x8c,
+	0x86, 0x64, 0xa8, 0xfe, 0x59, 0x80, 0xa2, 0xa7, 0x20, 0x55, 0xda, 0x39, 0xb2, 0x03, 0xa9, 0xce,
+	0xc6, 0xd4, 0x67, 0x7b, 0xc8, 0xf8, 0xd5, 0x1e, 0x8a, 0x2d, 0x30, 0x63, 0x41, 0x61, 0xa6, 0xc4,
+	0xe7, 0x3b, 0x7c, 0x75, 0x07, 0x41, 0x7e, 0xc5, 0x5a, 0x27, 0x5e, 0x84, 0xe7, 0x20, 0xc7, 0x37,
+	0xeb, 0xa8, 0x62, 0xcc, 0x64, 0x4e, 0xdd, 0xd7, 0x25, 0x2b, 0xbe, 0x07, 0x45, 0x61, 0x36, 0x09,
+	0x23, 0xb5, 0x29, 0xbe, 0x30, 0x24, 0xb6, 0x1c, 0xbb, 0xd1, 0x8c, 0x99, 0xb9, 0x32, 0xe6, 0x32,
+	0x4f, 0x1f, 0xa8, 0x4e, 0x51, 0x26, 0x58, 0xb9, 0xb2, 0x20, 0x74, 0x69, 0xc8, 0x5d, 0x29, 0x73,
+	0xd8, 0xc9, 0xca, 0x34, 0x33, 0x9e, 0x83, 0x7c, 0xe0, 0xfa, 0x3e, 0x71, 0x8e, 0xcd, 0x4b, 0x0d,
+	0x7d, 0x98, 0x35, 0x15, 0xa7, 0x28, 0x55, 0xad, 0x6e, 0x54, 0xc9, 0xcf, 0x64, 0x44, 0xa9, 0x6a,
+	0x75, 0x23, 0xb1, 0x89, 0x2a, 0x6f, 0xfd, 0x90, 0x97, 0x46, 0x3f, 0xcc, 0x40, 0xb1, 0x65, 0xf7,
+	0x88, 0x33, 0xf0, 0x08, 0xae, 0x43, 0x8e, 0xaf, 0x11, 0xed, 0xbe, 0x93, 0x16, 0x55, 0x31, 0xce,
+	0x15, 0x52, 0x04, 0x2f, 0x41, 0xc9, 0x21, 0x96, 0xe3, 0xb9, 0x3e, 0xd1, 0x7e, 0x7c, 0x39, 0x35,
+	0xb5, 0x5a, 0x4b, 0x6d, 0x51, 0xb3, 0xbd, 0xc3, 0x63, 0xa5, 0x91, 0x95, 0x09, 0x22, 0x16, 0xc6,
+	0x77, 0x21, 0xe7, 0x53, 0x16, 0x57, 0xc1, 0x33, 0xa3, 0x51, 0x56, 0x29, 0x53, 0x08, 0xa6, 0x64,
+	0x9f, 0xfe, 0x16, 0x4c, 0xa6, 0xa1, 0x79, 0x0d, 0xb1, 0x49, 0x74, 0xcc, 0xf2, 0x4f, 0x7c, 0x5b,
+	0x1f, 0xa4, 0x4f, 0xdd, 0xf3, 0xd4, 0x21, 0xbb, 0x8e, 0xde, 0x34, 0xa6, 0xbf, 0x09, 0x70, 0xa8,
+	0x2e, 0x89, 0x9a, 0x91, 0xa8, 0x73, 0x69, 0xd4, 0x53, 0x22, 0x21, 0xc6, 0xad, 0x8f, 0xf3, 0x6a,
+	0x55, 0x8f, 0xa8, 0xfa, 0x7d, 0x28, 0xad, 0x05, 0x44, 0xde, 0xea, 0xe0, 0xcb, 0xf1, 0xc2, 0x29,
+	0x35, 0xf2, 0x3b, 0x7b, 0x08, 0x2d, 0x2f, 0x8a, 0x05, 0xf4, 0x2a, 0xe4, 0x43, 0x12, 0x0d, 0x3c,
+	0xa6, 0x74, 0x61, 0xad, 0x2b, 0x0c, 0x6c, 0x7d, 0xa4, 0x53, 0x1c, 0x72, 0x39, 0xc7, 0x90, 0xd5,
+	0xdf, 0x19, 0x90, 0x6f, 0xbb, 0xf6, 0x26, 0xe1, 0x9b, 0x6a, 0xbc, 0x2c, 0x1b, 0xdf, 0x90, 0xe8,
+	0xff, 0xfb, 0xeb, 0xeb, 0xef, 0x75, 0x5d, 0xd6, 0x1b, 0xac, 0xd7, 0x6c, 0xda, 0x9f, 0xfd, 0x8e,
+	0x65, 0x3f, 0x59, 0x24, 0x5b, 0xf2, 0x42, 0xc8, 0xbe, 0xd5, 0x25, 0xfe, 0x2d, 0xb9, 0x65, 0xdd,
+	0x62, 0xa1, 0xe5, 0x47, 0x1b, 0x34, 0xec, 0x93, 0x70, 0x36, 0xbe, 0xf9, 0xe2, 0xf9, 0xa2, 0x26,
+	0xc1, 0x95, 0xa1, 0x0c, 0x4a, 0x81, 0x15, 0x12, 0x3f, 0x3e, 0x19, 0x67, 0x1a, 0x8f, 0x78, 0x3d,
+	0xd2, 0x14, 0xc4, 0x2f, 0x56, 0x5f, 0x51, 0x6a, 0x5a, 0x76, 0xea, 0xc0, 0xc3, 0x5b, 0xd2, 0xab,
+	0xff, 0x92, 0x87, 0xb2, 0xae, 0xf7, 0x28, 0xdd, 0xc4, 0x6f, 0x26, 0x4f, 0x58, 0xc6, 0x4c, 0xe6,
+	0x94, 0xe2, 0xf0, 0x90, 0x19, 0xbf, 0x05, 0x13, 0x7c, 0x0f, 0x3c, 0x94, 0x46, 0xc7, 0x4b, 0x9b,
+	0xe3, 0x01, 0x0b, 0xe7, 0x63, 0xd1, 0x75, 0xc0, 0xb1, 0x58, 0x67, 0x7d, 0xbb, 0xe3, 0xf1, 0xa5,
+	0xa7, 0x22, 0xbb, 0x36, 0x52, 0x3b, 0xa5, 0x9b, 0xb5, 0x58, 0xbe, 0xb1, 0x2d, 0xd6, 0xaa, 0x5a,
+	0x29, 0xbf, 0xe1, 0x55, 0xf3, 0x94, 0x75, 0xa4, 0x13, 0x7f, 0x1b, 0xce, 0xa7, 0x74, 0x88, 0x93,
+	0x4d, 0x56, 0xa8, 0xb8, 0x75, 0x16, 0x15, 0xab, 0x56, 0x9f, 0xc8, 0x95, 0x74, 0xce, 0x4a, 0x53,
+	0xf1, 0xf7, 0xe0, 0x42, 0x6a, 0xe4, 0x1c, 0xde, 0x75, 0x2a, 0xb9, 0x53, 0xec, 0x6f, 0x26, 0x5c,
+	0xd0, 0xd8, 0x5e, 0x76, 0x24, 0xfa, 0x54, 0x70, 0x84, 0x8c, 0xef, 0x26, 0x32, 0x54, 0x79, 0xae,
+	0x7a, 0x2c, 0x5e, 0xdb, 0xea, 0xaa, 0xb5, 0x2e, 0xf8, 0xa7, 0xbf, 0x07, 0x97, 0x46, 0xba, 0x68,
+	0xc4, 0x8a, 0xaf, 0xa5, 0xd7, 0x66, 0x65, 0x94, 0x0e, 0x7e, 0xda, 0x49, 0xae, 0xf7, 0x6f, 0xc1,
+	0xc5, 0x51, 0xee, 0x19, 0x81, 0xfe, 0x6a, 0x1a, 0x7d, 0x74, 0x44, 0x24, 0x90, 0xbf, 0x0d, 0x97,
+	0x46, 0xfa, 0x66, 0x44, 0x52, 0xf9, 0x53, 0xa1, 0xef, 0x41, 0x29, 0x76, 0xd3, 0x08, 0x4b, 0x2f,
+	0x26, 0xe1, 0x4a, 0xc9, 0x2c, 0x74, 0xee, 0x60, 0x17, 0x25, 0x17, 0x4a, 0xf5, 0x2d, 0x28, 0x27,
+	0x1c, 0xc3, 0x0d, 0x71, 0x19, 0xe9, 0x9f, 0xb8, 0x66, 0x4c, 0xc9, 0x52, 0x6d, 0xf2, 0x23, 0x53,
+	0xc4, 0x2c, 0x4f, 0xd1, 0xf1, 0x65, 0xc8, 0x47, 0x2c, 0x24, 0x84, 0x29, 0x5b, 0x54, 0x2b, 0xae,
+	0x27, 0xd0, 0x61, 0x3d, 0x21, 0xcf, 0x9b, 0xf1, 0x2d, 0x8f, 0xba, 0x4e, 0xf9, 0x57, 0x03, 0x0a,
+	0xcb, 0xfe, 0x16, 0x75, 0xed, 0x51, 0xd5, 0xc4, 0xd0, 0xa1, 0x5a, 0xe7, 0xf5, 0xa4, 0x8d, 0x29,
+	0x8b, 0x86, 0x2e, 0x2f, 0xd6, 0x00, 0x07, 0x21, 0xd9, 0x72, 0xe9, 0x20, 0xea, 0x1c, 0xbd, 0x81,
+	0x39, 0x01, 0x47, 0x65, 0x89, 0xf3, 0x5a, 0x36, 0x9e, 0x53, 0x79, 0x1b, 0xa4, 0x4c, 0xae, 0xfe,
+	0x1f, 0xdf, 0x5f, 0x7b, 0x6e, 0xd0, 0x27, 0x3e, 0x1b, 0xb2, 0xff, 0x2e, 0x14, 0x02, 0x2b, 0xb4,
+	0x89, 0xa7, 0x33, 0xca, 0x8b, 0xe9, 0xbd, 0x4e, 0xc9, 0xd5, 0x9a, 0x82, 0xc9, 0xd4, 0xcc, 0x7c,
+	0x87, 0x8c, 0xdc, 0x1f, 0x1c, 0xb7, 0x43, 0x6a, 0xa9, 0x16, 0x67, 0x51, 0x3b, 0xa4, 0x60, 0x9f,
+	0xfe, 0x7f, 0x03, 0xf2, 0x12, 0x8b, 0x87, 0x83, 0x4c, 0x45, 0xea, 0x46, 0x59, 0x34, 0xf0, 0x7b,
+	0x00, 0x8e, 0xdb, 0x27, 0x7e, 0xc4, 0xdf, 0x27, 0x94, 0x2f, 0x5f, 0x39, 0xc9, 0xa6, 0xda, 0x62,
+	0xcc, 0x6e, 0x26, 0x44, 0xf1, 0x7d, 0xc8, 0xad, 0xd3, 0x27, 0xb1, 0x85, 0x67, 0xc6, 0x90, 0x52,
+	0xd3, 0x5f, 0x07, 0x38, 0x24, 0x72, 0x5b, 0x1f, 0xbb, 0x0e, 0xeb, 0x29, 0xcf, 0xc9, 0x06, 0x8f,
+	0xac, 0x1e, 0x71, 0xbb, 0x3d, 0xb9, 0x13, 0x66, 0x4c, 0xd5, 0x92, 0xd7, 0x04, 0x87, 0xd2, 0x72,
+	0x4b, 0x90, 0x9a, 0xa6, 0x2d, 0x80, 0x43, 0xaf, 0x8c, 0x58, 0x24, 0xf7, 0xd3, 0x6b, 0xee, 0xec,
+	0x66, 0x1f, 0xdd, 0xd3, 0x15, 0x6b, 0xf5, 0xaf, 0x20, 0x6f, 0x92, 0x8d, 0x81, 0xef, 0x0c, 0xcd,
+	0x7d, 0x0b, 0x8a, 0xf6, 0x20, 0x0c, 0x89, 0x6f, 0xab, 0x45, 0xd0, 0xb8, 0x97, 0xbc, 0xfd, 0x6c,
+	0x5a, 0x61, 0x44, 0x16, 0x14, 0xc3, 0x47, 0xfb, 0xe8, 0xb2, 0xee, 0x78, 0x97, 0x86, 0x7d, 0x8b,
+	0xe9, 0x9e, 0x7f, 0xe2, 0x47, 0x9b, 0x18, 0x48, 0x56, 0x77, 0x52, 0xe1, 0x07, 0xbc, 0xba, 0xfb,
+	0xc0, 0x80, 0xb2, 0x6c, 0x36, 0xc4, 0xb5, 0xd7, 0x2d, 0x28, 0x84, 0xa2, 0xa9, 0x17, 0x73, 0xfa,
+	0x26, 0x59, 0xb2, 0x9a, 0x9a, 0x87, 0xb3, 0x7b, 0x56, 0xd8, 0x25, 0x11, 0x1b, 0x79, 0xb7, 0xad,
+	0xd9, 0x15, 0x8f, 0x58, 0xbf, 0x49, 0x75, 0xc2, 0x84, 0x1f, 0x1b, 0x90, 0x7d, 0x40, 0xfa, 0x74,
+	0xc8, 0x01, 0x6f, 0x43, 0x96, 0xd7, 0x6d, 0x6a, 0xf0, 0x37, 0x7e, 0xb6, 0x8f, 0xa6, 0xf4, 0x18,
+	0xd7, 0x02, 0xe2, 0xf3, 0x82, 0xeb, 0xa3, 0x04, 0xad, 0x45, 0x2c, 0x8f, 0xd3, 0x4c, 0x21, 0x15,
+	0xd7, 0xb6, 0x99, 0xc3, 0xda, 0x96, 0x47, 0x84, 0x35, 0x60, 0x3d, 0x1a, 0xaa, 0x7b, 0x24, 0xd5,
+	0xaa, 0x4f, 0x1d, 0xec, 0x22, 0x61, 0xc3, 0xd3, 0x67, 0xc8, 0xf8, 0x90, 0x1b, 0x75, 0x07, 0x8a,
+	0xf3, 0x03, 0xc7, 0x65, 0x2b, 0xb4, 0x9b, 0x90, 0x32, 0x52, 0x52, 0xe2, 0x94, 0x21, 0xb8, 0x7e,
+	0xca, 0x45, 0x18, 0x00, 0x87, 0x68, 0xf7, 0x42, 0x62, 0x39, 0xf8, 0x15, 0xc8, 0xf5, 0x49, 0x9f,
+	0x6a, 0x37, 0x9e, 0x4f, 0xf9, 0x85, 0xf3, 0x99, 0xb2, 0x1f, 0x7f, 0x39, 0xae, 0xdb, 0xa5, 0x07,
+	0x47, 0x70, 0x2a, 0x86, 0x3a, 0x16, 0xf7, 0x5b, 0xb1, 0x0e, 0x6e, 0x6c, 0x75, 0x16, 0xb2, 0x0b,
+	0x56, 0xe8, 0x70, 0x23, 0xfd, 0x41, 0x7f, 0x9d, 0xc4, 0x46, 0xca, 0x96, 0xcc, 0xdd, 0x9c, 0xa3,
+	0x69, 0x6d, 0x8b, 0x80, 0xdb, 0x37, 0xa0, 0xa0, 0xbe, 0x87, 0x3c, 0xfe, 0x16, 0x64, 0x6d, 0x2b,
+	0x1c, 0x6d, 0x09, 0xc7, 0x68, 0x4c, 0xed, 0xec, 0xa3, 0xf1, 0x57, 0x13, 0x70, 0x4b, 0x63, 0xa6,
+	0x10, 0xc1, 0x2f, 0x43, 0xde, 0xa6, 0x83, 0x80, 0xfa, 0xea, 0x02, 0x0f, 0x76, 0xf6, 0x51, 0x7e,
+	0x41, 0x50, 0x96, 0xc6, 0x4c, 0xd5, 0x87, 0x2f, 0x43, 0x8e, 0xf4, 0x2d, 0x57, 0x3e, 0x53, 0x94,
+	0x96, 0x0c, 0x53, 0x36, 0x39, 0x3d, 0xe8, 0xf1, 0xa7, 0xa7, 0x9c, 0xa6, 0x8b, 0xa6, 0x7a, 0x63,
+	0x91, 0xaa, 0x1a, 0x45, 0xc8, 0xf7, 0x09, 0xeb, 0x51, 0xa7, 0x51, 0xe2, 0x51, 0x6a, 0x13, 0x37,
+	0x60, 0xd5, 0xbf, 0x15, 0x19, 0x6b, 0x9b, 0x0e, 0x86, 0x47, 0xf3, 0xca, 0x29, 0xa3, 0x89, 0x6d,
+	0x9f, 0x86, 0x82, 0x65, 0x8b, 0x53, 0x9b, 0x34, 0x7e, 0x69, 0xcc, 0xd4, 0x04, 0x9d, 0x1c, 0xb8,
+	0x82, 0xc6, 0x34, 0xe4, 0x19, 0x8f, 0x64, 0x86, 0xa7, 0x0e, 0xfe, 0x13, 0x8d, 0x4b, 0x6a, 0x5b,
+	0x50, 0xaa, 0xff, 0x2d, 0x16, 0x12, 0x0b, 0xb7, 0x9b, 0xd4, 0x73, 0x6d, 0x9e, 0x28, 0x0a, 0xeb,
+	0x96, 0xbd, 0x49, 0x37, 0x36, 0xd4, 0x3d, 0xdc, 0xd5, 0xa1, 0x9a, 0x7f, 0x51, 0x3d, 0xd0, 0xca,
+	0xa3, 0xd2, 0x87, 0xe2, 0xb6, 0x4c, 0xc9, 0xe0, 0x3a, 0x14, 0xfb, 0xd6, 0x93, 0xce, 0x63, 0xcb,
+	0xd5, 0x2b, 0xeb, 0x04, 0xf9, 0xac, 0x94, 0xed, 0x5b, 0x4f, 0x1e, 0x59, 0x2e, 0xc3, 0x5f, 0x87,
+	0x02, 0x73, 0xfb, 0x84, 0x0e, 0xf4, 0x15, 0xdb, 0x09, 0xa2, 0xe2, 0x86, 0xad, 0x2d, 0xb9, 0x1f,
+	0x44, 0x1f, 0xef, 0x23, 0x24, 0xb1, 0x14, 0x80, 0x0c, 0x9f, 0xc4, 0xb8, 0xaa, 0x1f, 0x1a, 0x90,
+	0x5f, 0x24, 0x5b, 0xa3, 0x36, 0xdb, 0x7b, 0x00, 0x16, 0x63, 0xa1, 0xbb, 0x3e, 0x60, 0x44, 0xef,
+	0x0d, 0x57, 0x46, 0x9d, 0x74, 0x06, 0x36, 0x33, 0x13, 0xac, 0xf8, 0x0d, 0x1e, 0x3b, 0xfe, 0x86,
+	0xdb, 0xad, 0x64, 0x4e, 0x14, 0x6a, 0x64, 0x9f, 0xf3, 0x6c, 0xa6, 0x98, 0x65, 0x2e, 0x93, 0xb6,
+	0x7c, 0xa0, 0x2e, 0xf1, 0x61, 0x9e, 0x31, 0xcb, 0xee, 0x89, 0xe0, 0x16, 0x97, 0x0f, 0x3e, 0x23,
+	0xbe, 0xac, 0x2c, 0xc6, 0x4d, 0xdd, 0x14, 0x3d, 0x56, 0x10, 0x5f, 0x31, 0x8c, 0x9b, 0xba, 0x89,
+	0x57, 0xa0, 0x68, 0xf7, 0x88, 0xbd, 0x19, 0x0d, 0xfa, 0xc2, 0x96, 0xf1, 0xc6, 0xed, 0x5f, 0xed,
+	0xa3, 0x9b, 0xe9, 0x9c, 0xab, 0x18, 0x62, 0xaa, 0x26, 0xd4, 0x1a, 0xdb, 0x8c, 0x44, 0x66, 0x8c,
+	0xa0, 0x1c, 0x24, 0x53, 0x0d, 0x77, 0xd0, 0x55, 0x28, 0xd2, 0xc7, 0x3e, 0x09, 0x65, 0x81, 0x2c,
+	0x14, 0x8b, 0xf6, 0xb2, 0x5a, 0xda, 0x87, 0xc6, 0x8b, 0xf1, 0xfc, 0xa3, 0x01, 0xb9, 0x6f, 0x0c,
+	0x78, 0x2e, 0x9b, 0x86, 0x5c, 0x10, 0xba, 0xb6, 0x7a, 0xdd, 0x6d, 0x64, 0x7f, 0xcb, 0xdd, 0x20,
+	0x49, 0xf8, 0xab, 0x30, 0xe9, 0xb8, 0x91, 0x08, 0x56, 0xf5, 0x66, 0x26, 0xcf, 0x52, 0xfc, 0x7a,
+	0xb3, 0xb8, 0xa8, 0x7a, 0xb8, 0xc0, 0xef, 0xf7, 0x11, 0xfa, 0x03, 0x17, 0x9c, 0xd0, 0xfc, 0xe2,
+	0x4d, 0x8c, 0x9f, 0xe9, 0xc5, 0x0b, 0x59, 0x25, 0x93, 0x3e, 0x2f, 0xf2, 0x87, 0xab, 0xda, 0x03,
+	0xfe, 0x60, 0xdf, 0x98, 0xe2, 0xf2, 0x7f, 0xfd, 0x29, 0x7f, 0x60, 0x90, 0xfb, 0x88, 0x29, 0x45,
+	0x64, 0x0a, 0x14, 0x36, 0x0a, 0x8b, 0xff, 0xc3, 0x80, 0xc2, 0x02, 0xf5, 0x99, 0x65, 0x33, 0x3c,
+	0x0d, 0x45, 0xdf, 0xb5, 0x37, 0xe3, 0x47, 0x94, 0x92, 0x19, 0xb7, 0xf9, 0x71, 0x58, 0xa6, 0x81,
+	0x33, 0x1d, 0x87, 0x65, 0x8a, 0xb8, 0x05, 0x19, 0xab, 0xab, 0xaf, 0x52, 0x46, 0xde, 0x89, 0xe8,
+	0x6b, 0x36, 0xce, 0xc7, 0xaf, 0xfa, 0xb7, 0x48, 0xe8, 0x6e, 0xb8, 0xf2, 0x12, 0xf0, 0xd4, 0xeb,
+	0x60, 0x13, 0x34, 0xfb, 0x3c, 0x93, 0x69, 0x47, 0x0d, 0xa4, 0xfa, 0xf7, 0x06, 0x64, 0xdb, 0xc4,
+	0xea, 0x8f, 0x7a, 0xe8, 0x1b, 0x7a, 0x41, 0xbf, 0x01, 0x59, 0x8f, 0x58, 0xce, 0xc8, 0xa7, 0x1b,
+	0x05, 0x68, 0x0a, 0x0e, 0x5c, 0x83, 0x42, 0x9f, 0xf0, 0x14, 0x1d, 0xa9, 0x93, 0xd8, 0x68, 0x66,
+	0xcd, 0x54, 0x2f, 0xf2, 0x3d, 0x8a, 0xdb, 0x51, 0x6d, 0x43, 0x59, 0x3d, 0x3b, 0xf3, 0x52, 0x25,
+	0x5d, 0xfc, 0xe4, 0x46, 0x17, 0x3f, 0xb9, 0xb8, 0xf8, 0xb9, 0xb2, 0xf3, 0x0c, 0x5d, 0x48, 0x2a,
+	0xda, 0x9a, 0x13, 0x75, 0x60, 0xf5, 0xdf, 0x10, 0x4c, 0x2e, 0xc8, 0x9f, 0x61, 0x0c, 0xff, 0x64,
+	0x40, 0x0e, 0xf8, 0xcf, 0xf5, 0x7d, 0x96, 0xdc, 0x91, 0xa7, 0x7e, 0xf4, 0x29, 0x1a, 0x77, 0xdc,
+	0x28, 0xf0, 0x2c, 0x79, 0xa4, 0xd4, 0x77, 0x58, 0x17, 0x75, 0xb8, 0x72, 0x2f, 0xc4, 0x81, 0xaa,
+	0x37, 0xe4, 0x6c, 0x62, 0x43, 0x7e, 0x33, 0x55, 0x4e, 0xe6, 0x46, 0x1c, 0xc0, 0x12, 0x23, 0x4d,
+	0xd5, 0x8f, 0x6f, 0xc3, 0xb8, 0xb5, 0x65, 0xb9, 0x9e, 0xb5, 0xee, 0x7a, 0xfc, 0x98, 0x20, 0x9f,
+	0xa4, 0xd3, 0xb2, 0x2d, 0x46, 0xed, 0x4d, 0x75, 0xe5, 0x91, 0xe2, 0xc6, 0xf3, 0x50, 0x0e, 0x89,
+	0x47, 0xac, 0x48, 0x46, 0x47, 0xe1, 0x0c, 0x57, 0xc4, 0x3c, 0x2d, 0x82, 0x16, 0x9a, 0x67, 0xf5,
+	0xe9, 0x9d, 0x67, 0xe8, 0xf2, 0x11, 0x47, 0x2a, 0x63, 0x5f, 0x5d, 0x80, 0x72, 0x42, 0x37, 0xae,
+	0xc0, 0xc5, 0x56, 0x7b, 0x6d, 0xe1, 0xfd, 0x4e, 0xab, 0x3d, 0xdf, 0x7e, 0xd8, 0xea, 0x1c, 0x3e,
+	0x3c, 0x8f, 0x43, 0x71, 0x79, 0xb5, 0x23, 0x3a, 0xa7, 0x0c, 0xde, 0x6a, 0xad, 0xad, 0x2c, 0x76,
+	0xd6, 0x1e, 0xb6, 0xa7, 0xd0, 0xdc, 0xdf, 0x18, 0x30, 0x2e, 0x9f, 0xc9, 0x49, 0x28, 0xf2, 0xed,
+	0x1b, 0x50, 0x5e, 0x10, 0x77, 0xdc, 0x82, 0x8a, 0xf1, 0xf0, 0xf3, 0xfb, 0xf4, 0x08, 0x1a, 0xbe,
+	0x07, 0xe5, 0x47, 0xbc, 0xd8, 0x12, 0xad, 0xe8, 0xac, 0x62, 0xb7, 0x8d, 0xe9, 0xec, 0xc7, 0xff,
+	0x8e, 0x8c, 0xc6, 0x4f, 0x8d, 0x1f, 0x7d, 0x82, 0xde, 0x49, 0xdd, 0xab, 0xc8, 0xff, 0x6b, 0x5d,
+	0x7a, 0xf3, 0x08, 0x99, 0xf4, 0xe9, 0x30, 0x35, 0x90, 0xdb, 0x77, 0xad, 0x4b, 0x7f, 0xfc, 0x09,
+	0xca, 0x09, 0xda, 0x4f, 0x3e, 0x41, 0x05, 0xc5, 0xf4, 0xd1, 0x27, 0xe8, 0x5a, 0xc3, 0x72, 0x4c,
+	0xf2, 0x97, 0x03, 0x12, 0xb1, 0x9b, 0xcd, 0x50, 0xfc, 0xaa, 0xc1, 0xe5, 0xd9, 0xf8, 0x5d, 0xcb,
+	0xf5, 0x06, 0x21, 0x79, 0x7e, 0x70, 0xcd, 0xf8, 0xec, 0xe0, 0x9a, 0xf1, 0x9b, 0x83, 0x6b, 0xc6,
+	0xd3, 0xcf, 0xaf, 0x8d, 0x7d, 0xf6, 0xf9, 0xb5, 0xb1, 0x5f, 0x7e, 0x7e, 0x6d, 0xec, 0x3b, 0x1a,
+	0x62, 0x3d, 0x2f, 0x26, 0xec, 0xb5, 0x3f, 0x0e, 0x00, 0x48, 0xa0, 0x79, 0x22, 0x68, 0x25, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OrderServiceClient is the client API for OrderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OrderServiceClient interface {
+	CreateOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*Order, error)
+	// Streaming methods are not adapted.
+	WatchOrders(ctx context.Context, in *Order, opts ...grpc.CallOption) (OrderService_WatchOrdersClient, error)
+}
+
+type orderServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOrderServiceClient(cc *grpc.ClientConn) OrderServiceClient {
+	return &orderServiceClient{cc}
+}
+
+func (c *orderServiceClient) CreateOrder(ctx context.Context, in *Order, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/svc.example.OrderService/CreateOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) WatchOrders(ctx context.Context, in *Order, opts ...grpc.CallOption) (OrderService_WatchOrdersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_OrderService_serviceDesc.Streams[0], "/svc.example.OrderService/WatchOrders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderServiceWatchOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrderService_WatchOrdersClient interface {
+	Recv() (*Order, error)
+	grpc.ClientStream
+}
+
+type orderServiceWatchOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderServiceWatchOrdersClient) Recv() (*Order, error) {
+	m := new(Order)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// OrderServiceServer is the server API for OrderService service.
+type OrderServiceServer interface {
+	CreateOrder(context.Context, *Order) (*Order, error)
+	// Streaming methods are not adapted.
+	WatchOrders(*Order, OrderService_WatchOrdersServer) error
+}
+
+// UnimplementedOrderServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedOrderServiceServer struct {
+}
+
+func (*UnimplementedOrderServiceServer) CreateOrder(ctx context.Context, req *Order) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (*UnimplementedOrderServiceServer) WatchOrders(req *Order, srv OrderService_WatchOrdersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrders not implemented")
+}
+
+func RegisterOrderServiceServer(s *grpc.Server, srv OrderServiceServer) {
+	s.RegisterService(&_OrderService_serviceDesc, srv)
+}
+
+func _OrderService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Order)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/svc.example.OrderService/CreateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateOrder(ctx, req.(*Order))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_WatchOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Order)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).WatchOrders(m, &orderServiceWatchOrdersServer{stream})
+}
+
+type OrderService_WatchOrdersServer interface {
+	Send(*Order) error
+	grpc.ServerStream
+}
+
+type orderServiceWatchOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderServiceWatchOrdersServer) Send(m *Order) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _OrderService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "svc.example.OrderService",
+	HandlerType: (*OrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrder",
+			Handler:    _OrderService_CreateOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrders",
+			Handler:       _OrderService_WatchOrders_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "example/message.proto",
+}
+
+func (m *TheOne) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TheOne) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TheOne) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TheDecl != nil {
+		{
+			size := m.TheDecl.Size()
+			i -= size
+			if _, err := m.TheDecl.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TheOne_StringValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TheOne_StringValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.StringValue)
+	copy(dAtA[i:], m.StringValue)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.StringValue)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *TheOne_Int64Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TheOne_Int64Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintMessage(dAtA, i, uint64(m.Int64Value))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *NotSupportedOneOf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotSupportedOneOf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotSupportedOneOf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TheDecl != nil {
+		{
+			size := m.TheDecl.Size()
+			i -= size
+			if _, err := m.TheDecl.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotSupportedOneOf_BoolValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotSupportedOneOf_BoolValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.BoolValue {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *NotSupportedOneOf_StringValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotSupportedOneOf_StringValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.StringValue)
+	copy(dAtA[i:], m.StringValue)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.StringValue)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *CustomOneof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomOneof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomOneof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomOneof_StringValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomOneof_StringValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.StringValue)
+	copy(dAtA[i:], m.StringValue)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.StringValue)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *CustomOneof_Int64Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomOneof_Int64Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintMessage(dAtA, i, uint64(m.Int64Value))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *CustomType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Product) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Product) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NotsupportedOneof != nil {
+		{
+			size, err := m.NotsupportedOneof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CustomOneof != nil {
+		{
+			size, err := m.CustomOneof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CustomField != nil {
+		{
+			size, err := m.CustomField.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SecondId != nil {
+		{
+			size, err := m.SecondId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.One != nil {
+		{
+			size, err := m.One.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalCents != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TotalCents))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.State != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Statuses) > 0 {
+		dAtA7 := make([]byte, len(m.Statuses)*10)
+		var j6 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintMessage(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ThirdUrl != nil {
+		{
+			size, err := m.ThirdUrl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SecondId != nil {
+		{
+			size, err := m.SecondId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FirstId != nil {
+		{
+			size, err := m.FirstId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Address) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Address) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Customer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Customer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Customer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MapFieldToWithoutDigits) > 0 {
+		i -= len(m.MapFieldToWithoutDigits)
+		copy(dAtA[i:], m.MapFieldToWithoutDigits)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MapFieldToWithoutDigits)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MapField_1) > 0 {
+		i -= len(m.MapField_1)
+		copy(dAtA[i:], m.MapField_1)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MapField_1)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.BillingAddress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.DefaultAddress != nil {
+		{
+			size, err := m.DefaultAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomerPatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomerPatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomerPatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BillingAddress != nil {
+		{
+			size, err := m.BillingAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DefaultAddress != nil {
+		{
+			size, err := m.DefaultAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name != nil {
+		{
+			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LineItemUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LineItemUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LineItemUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LineItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LineItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LineItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SKU != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SKU))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SomeField) > 0 {
+		i -= len(m.SomeField)
+		copy(dAtA[i:], m.SomeField)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SomeField)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Value2Pointer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Value2Pointer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Value2Pointer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AddressNil.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Pointer2Value) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pointer2Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pointer2Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddressNotNil != nil {
+		{
+			size, err := m.AddressNotNil.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SkippedMessageOne) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkippedMessageOne) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkippedMessageOne) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SkippedMessageTwo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkippedMessageTwo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkippedMessageTwo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Timer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Timer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Timer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PtrToTime != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PtrToTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PtrToTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintMessage(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x42
+	}
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TimeToPtr, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TimeToPtr):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintMessage(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x3a
+	if m.TimePtrToPtrStruct != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimePtrToPtrStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToPtrStruct):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintMessage(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TimePtrToStruct != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimePtrToStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToStruct):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintMessage(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeToStructPtr != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimeToStructPtr, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeToStructPtr):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintMessage(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x22
+	}
+	n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.TimeToStruct, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.TimeToStruct):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintMessage(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x1a
+	if m.PtrTime != nil {
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PtrTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PtrTime):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintMessage(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x12
+	}
+	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err28 != nil {
+		return 0, err28
+	}
+	i -= n28
+	i = encodeVarintMessage(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Ints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StringValue != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.StringValue))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Int64Value != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Int64Value))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Int32Value != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Int32Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IntFor_64Value != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.IntFor_64Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IntFor_32Value != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.IntFor_32Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Location) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Location) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Store) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Store) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Store) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Location != nil {
+		{
+			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Labels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Labels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Labels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Pinned != nil {
+		{
+			size, err := m.Pinned.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Priority != nil {
+		{
+			size, err := m.Priority.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Title != nil {
+		{
+			size, err := m.Title.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Counters) > 0 {
+		for iNdEx := len(m.Counters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Names[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Notes) > 0 {
+		for k := range m.Notes {
+			v := m.Notes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMessage(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintMessage(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Deadlines) > 0 {
+		for k := range m.Deadlines {
+			v := m.Deadlines[k]
+			baseI := i
+			if v != nil {
+				n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo((*v), dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime((*v)):])
+				if err34 != nil {
+					return 0, err34
+				}
+				i -= n34
+				i = encodeVarintMessage(dAtA, i, uint64(n34))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Dates) > 0 {
+		for iNdEx := len(m.Dates) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Dates[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Dates[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintMessage(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Ticket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ticket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ticket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParentId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ParentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMessage(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PtrAddressesById) > 0 {
+		for k := range m.PtrAddressesById {
+			v := m.PtrAddressesById[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMessage(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintMessage(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AddressesByName) > 0 {
+		for k := range m.AddressesByName {
+			v := m.AddressesByName[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMessage(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddressesByLabel) > 0 {
+		for k := range m.AddressesByLabel {
+			v := m.AddressesByLabel[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMessage(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PtrAddresses) > 0 {
+		for iNdEx := len(m.PtrAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PtrAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostalAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostalAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostalAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Street) > 0 {
+		i -= len(m.Street)
+		copy(dAtA[i:], m.Street)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Street)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Invoice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Invoice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Invoice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousAddresses) > 0 {
+		for iNdEx := len(m.PreviousAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BillingAddress != nil {
+		{
+			size, err := m.BillingAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Shipment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Shipment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Shipment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sizes) > 0 {
+		for k := range m.Sizes {
+			v := m.Sizes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMessage(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Parcels) > 0 {
+		for iNdEx := len(m.Parcels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parcels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Shipment_Parcel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Shipment_Parcel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Shipment_Parcel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Boxes) > 0 {
+		for iNdEx := len(m.Boxes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Boxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Dimensions != nil {
+		{
+			size, err := m.Dimensions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Shipment_Parcel_Dimensions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Shipment_Parcel_Dimensions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Shipment_Parcel_Dimensions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Width != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Refund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Refund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Refund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Largest != nil {
+		{
+			size, err := m.Largest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Refunds) > 0 {
+		for iNdEx := len(m.Refunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Memo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Memo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Memo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemoThread) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoThread) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemoThread) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pinned != nil {
+		{
+			size, err := m.Pinned.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Memos) > 0 {
+		for iNdEx := len(m.Memos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Memos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Card) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Card) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Card) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		{
+			size := m.Receipt.Size()
+			i -= size
+			if _, err := m.Receipt.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Method != nil {
+		{
+			size := m.Method.Size()
+			i -= size
+			if _, err := m.Method.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payment_Card) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment_Card) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Card != nil {
+		{
+			size, err := m.Card.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Payment_Coupon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment_Coupon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Coupon)
+	copy(dAtA[i:], m.Coupon)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.Coupon)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *Payment_Email) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment_Email) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Email)
+	copy(dAtA[i:], m.Email)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.Email)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *Payment_Phone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment_Phone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Phone)
+	copy(dAtA[i:], m.Phone)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.Phone)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *Payout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Target != nil {
+		{
+			size := m.Target.Size()
+			i -= size
+			if _, err := m.Target.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payout_Card) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payout_Card) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Card != nil {
+		{
+			size, err := m.Card.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Payout_Account) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payout_Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Account)
+	copy(dAtA[i:], m.Account)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.Account)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n46, err46 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err46 != nil {
+			return 0, err46
+		}
+		i -= n46
+		i = encodeVarintMessage(dAtA, i, uint64(n46))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxWait != nil {
+		n47, err47 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxWait, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxWait):])
+		if err47 != nil {
+			return 0, err47
+		}
+		i -= n47
+		i = encodeVarintMessage(dAtA, i, uint64(n47))
+		i--
+		dAtA[i] = 0x12
+	}
+	n48, err48 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Backoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Backoff):])
+	if err48 != nil {
+		return 0, err48
+	}
+	i -= n48
+	i = encodeVarintMessage(dAtA, i, uint64(n48))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Device) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Device) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Device) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Attributes != nil {
+		{
+			size, err := m.Attributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attachment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attachment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attachment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerId) > 0 {
+		i -= len(m.OwnerId)
+		copy(dAtA[i:], m.OwnerId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.OwnerId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Caption) > 0 {
+		i -= len(m.Caption)
+		copy(dAtA[i:], m.Caption)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Caption)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Quote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Quote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != nil {
+		{
+			size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DiscountCents != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.DiscountCents))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Contact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Contact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Contact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerifiedAt != nil {
+		{
+			size, err := m.VerifiedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Age != nil {
+		{
+			size, err := m.Age.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Email != nil {
+		{
+			size, err := m.Email.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Team) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Team) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Team) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Lead != nil {
+		{
+			size, err := m.Lead.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProductSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProductSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProductSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Width != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CatalogProduct) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CatalogProduct) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CatalogProduct) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleasedAt != nil {
+		n56, err56 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReleasedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReleasedAt):])
+		if err56 != nil {
+			return 0, err56
+		}
+		i -= n56
+		i = encodeVarintMessage(dAtA, i, uint64(n56))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Availability != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Availability))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Dimensions != nil {
+		{
+			size, err := m.Dimensions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TheOne) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheDecl != nil {
+		n += m.TheDecl.Size()
+	}
+	return n
+}
+
+func (m *TheOne_StringValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StringValue)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *TheOne_Int64Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMessage(uint64(m.Int64Value))
+	return n
+}
+func (m *NotSupportedOneOf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheDecl != nil {
+		n += m.TheDecl.Size()
+	}
+	return n
+}
+
+func (m *NotSupportedOneOf_BoolValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *NotSupportedOneOf_StringValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StringValue)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *CustomOneof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *CustomOneof_StringValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StringValue)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *CustomOneof_Int64Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMessage(uint64(m.Int64Value))
+	return n
+}
+func (m *CustomType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Product) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.One != nil {
+		l = m.One.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SecondId != nil {
+		l = m.SecondId.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CustomField != nil {
+		l = m.CustomField.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CustomOneof != nil {
+		l = m.CustomOneof.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.NotsupportedOneof != nil {
+		l = m.NotsupportedOneof.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	if m.FirstId != nil {
+		l = m.FirstId.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SecondId != nil {
+		l = m.SecondId.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ThirdUrl != nil {
+		l = m.ThirdUrl.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovMessage(uint64(e))
+		}
+		n += 1 + sovMessage(uint64(l)) + l
+	}
+	if m.State != 0 {
+		n += 1 + sovMessage(uint64(m.State))
+	}
+	if m.TotalCents != 0 {
+		n += 1 + sovMessage(uint64(m.TotalCents))
+	}
+	return n
+}
+
+func (m *Address) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Customer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.DefaultAddress != nil {
+		l = m.DefaultAddress.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = m.BillingAddress.Size()
+	n += 1 + l + sovMessage(uint64(l))
+	l = len(m.MapField_1)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.MapFieldToWithoutDigits)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *CustomerPatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = m.Name.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.DefaultAddress != nil {
+		l = m.DefaultAddress.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.BillingAddress != nil {
+		l = m.BillingAddress.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *LineItemUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.List) > 0 {
+		for _, e := range m.List {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LineItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMessage(uint64(m.ID))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.SomeField)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SKU != 0 {
+		n += 1 + sovMessage(uint64(m.SKU))
+	}
+	return n
+}
+
+func (m *Value2Pointer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AddressNil.Size()
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+
+func (m *Pointer2Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddressNotNil != nil {
+		l = m.AddressNotNil.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *SkippedMessageOne) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SkippedMessageTwo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Timer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMessage(uint64(l))
+	if m.PtrTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PtrTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.TimeToStruct)
+	n += 1 + l + sovMessage(uint64(l))
+	if m.TimeToStructPtr != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeToStructPtr)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TimePtrToStruct != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToStruct)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TimePtrToPtrStruct != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToPtrStruct)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.TimeToPtr)
+	n += 1 + l + sovMessage(uint64(l))
+	if m.PtrToTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PtrToTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Ints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntFor_32Value != 0 {
+		n += 1 + sovMessage(uint64(m.IntFor_32Value))
+	}
+	if m.IntFor_64Value != 0 {
+		n += 1 + sovMessage(uint64(m.IntFor_64Value))
+	}
+	if m.Int32Value != 0 {
+		n += 1 + sovMessage(uint64(m.Int32Value))
+	}
+	if m.Int64Value != 0 {
+		n += 1 + sovMessage(uint64(m.Int64Value))
+	}
+	if m.StringValue != 0 {
+		n += 1 + sovMessage(uint64(m.StringValue))
+	}
+	return n
+}
+
+func (m *Location) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Store) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	if m.Location != nil {
+		l = m.Location.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Labels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, e := range m.Names {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.Counters) > 0 {
+		for _, e := range m.Counters {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.Title != nil {
+		l = m.Title.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Priority != nil {
+		l = m.Priority.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Pinned != nil {
+		l = m.Pinned.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dates) > 0 {
+		for _, e := range m.Dates {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.Deadlines) > 0 {
+		for k, v := range m.Deadlines {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = github_com_gogo_protobuf_types.SizeOfStdTime(*v)
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Notes) > 0 {
+		for k, v := range m.Notes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + sovMessage(uint64(k)) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Operation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Ticket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	if m.ParentId != 0 {
+		n += 1 + sovMessage(uint64(m.ParentId))
+	}
+	return n
+}
+
+func (m *AddressBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.PtrAddresses) > 0 {
+		for _, e := range m.PtrAddresses {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.AddressesByLabel) > 0 {
+		for k, v := range m.AddressesByLabel {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.AddressesByName) > 0 {
+		for k, v := range m.AddressesByName {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PtrAddressesById) > 0 {
+		for k, v := range m.PtrAddressesById {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + sovMessage(uint64(k)) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + len(v) + sovMessage(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *AddressList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PostalAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Street)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Invoice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	if m.BillingAddress != nil {
+		l = m.BillingAddress.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.PreviousAddresses) > 0 {
+		for _, e := range m.PreviousAddresses {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Shipment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	if len(m.Parcels) > 0 {
+		for _, e := range m.Parcels {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.Sizes) > 0 {
+		for k, v := range m.Sizes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Shipment_Parcel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Dimensions != nil {
+		l = m.Dimensions.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Boxes) > 0 {
+		for _, e := range m.Boxes {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Shipment_Parcel_Dimensions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Width != 0 {
+		n += 1 + sovMessage(uint64(m.Width))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMessage(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Refund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *RefundBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refunds) > 0 {
+		for _, e := range m.Refunds {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.Largest != nil {
+		l = m.Largest.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Memo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *AuditLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *MemoThread) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Memos) > 0 {
+		for _, e := range m.Memos {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.Pinned != nil {
+		l = m.Pinned.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Card) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Payment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	if m.Method != nil {
+		n += m.Method.Size()
+	}
+	if m.Receipt != nil {
+		n += m.Receipt.Size()
+	}
+	return n
+}
+
+func (m *Payment_Card) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Card != nil {
+		l = m.Card.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *Payment_Coupon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coupon)
 	n += 1 + l + sovMessage(uint64(l))
-	if m.TimeToStructPtr != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeToStructPtr)
+	return n
+}
+func (m *Payment_Email) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *Payment_Phone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phone)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *Payout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	if m.Target != nil {
+		n += m.Target.Size()
+	}
+	return n
+}
+
+func (m *Payout_Card) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Card != nil {
+		l = m.Card.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+func (m *Payout_Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Backoff)
+	n += 1 + l + sovMessage(uint64(l))
+	if m.MaxWait != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxWait)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Device) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Attachment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Caption)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.OwnerId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Quote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Price)
+	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
-	if m.TimePtrToStruct != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToStruct)
-		n += 1 + l + sovMessage(uint64(l))
+	if m.DiscountCents != 0 {
+		n += 1 + sovMessage(uint64(m.DiscountCents))
+	}
+	if m.Total != nil {
+		l = m.Total.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Contact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nickname)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Email != nil {
+		l = m.Email.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Age != nil {
+		l = m.Age.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.VerifiedAt != nil {
+		l = m.VerifiedAt.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Team) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Lead != nil {
+		l = m.Lead.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProductSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Width != 0 {
+		n += 1 + sovMessage(uint64(m.Width))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMessage(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CatalogProduct) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessage(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.Dimensions != nil {
+		l = m.Dimensions.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Availability != 0 {
+		n += 1 + sovMessage(uint64(m.Availability))
+	}
+	if m.ReleasedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReleasedAt)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TheOne) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TheOne: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TheOne: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TheDecl = &TheOne_StringValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TheDecl = &TheOne_Int64Value{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotSupportedOneOf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotSupportedOneOf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotSupportedOneOf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.TheDecl = &NotSupportedOneOf_BoolValue{b}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TheDecl = &NotSupportedOneOf_StringValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomOneof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomOneof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomOneof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &CustomOneof_StringValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &CustomOneof_Int64Value{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Product) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Product: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Product: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field One", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.One == nil {
+				m.One = &TheOne{}
+			}
+			if err := m.One.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondId == nil {
+				m.SecondId = &TheOne{}
+			}
+			if err := m.SecondId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomField", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomField == nil {
+				m.CustomField = &CustomType{}
+			}
+			if err := m.CustomField.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomOneof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomOneof == nil {
+				m.CustomOneof = &CustomOneof{}
+			}
+			if err := m.CustomOneof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotsupportedOneof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotsupportedOneof == nil {
+				m.NotsupportedOneof = &NotSupportedOneOf{}
+			}
+			if err := m.NotsupportedOneof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstId == nil {
+				m.FirstId = &TheOne{}
+			}
+			if err := m.FirstId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondId == nil {
+				m.SecondId = &TheOne{}
+			}
+			if err := m.SecondId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThirdUrl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ThirdUrl == nil {
+				m.ThirdUrl = &TheOne{}
+			}
+			if err := m.ThirdUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v Order_Status
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Order_Status(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMessage
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]Order_Status, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Order_Status
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Order_Status(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Order_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCents", wireType)
+			}
+			m.TotalCents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCents |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Address) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Address: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Address: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Customer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Customer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Customer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, &Address{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultAddress == nil {
+				m.DefaultAddress = &Address{}
+			}
+			if err := m.DefaultAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BillingAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BillingAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapField_1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MapField_1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapFieldToWithoutDigits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MapFieldToWithoutDigits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomerPatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomerPatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomerPatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Name == nil {
+				m.Name = &types.StringValue{}
+			}
+			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultAddress == nil {
+				m.DefaultAddress = &Address{}
+			}
+			if err := m.DefaultAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BillingAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BillingAddress == nil {
+				m.BillingAddress = &Address{}
+			}
+			if err := m.BillingAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LineItemUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LineItemUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LineItemUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &LineItem{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = append(m.List, &LineItem{})
+			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LineItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LineItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LineItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SomeField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SomeField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SKU", wireType)
+			}
+			m.SKU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SKU |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Value2Pointer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Value2Pointer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Value2Pointer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressNil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddressNil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pointer2Value) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pointer2Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pointer2Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressNotNil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddressNotNil == nil {
+				m.AddressNotNil = &Address{}
+			}
+			if err := m.AddressNotNil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SkippedMessageOne) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkippedMessageOne: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkippedMessageOne: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SkippedMessageTwo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkippedMessageTwo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkippedMessageTwo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Timer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Timer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Timer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PtrTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PtrTime == nil {
+				m.PtrTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PtrTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToStruct", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.TimeToStruct, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToStructPtr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeToStructPtr == nil {
+				m.TimeToStructPtr = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimeToStructPtr, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimePtrToStruct", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimePtrToStruct == nil {
+				m.TimePtrToStruct = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimePtrToStruct, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimePtrToPtrStruct", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimePtrToPtrStruct == nil {
+				m.TimePtrToPtrStruct = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimePtrToPtrStruct, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToPtr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.TimeToPtr, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PtrToTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PtrToTime == nil {
+				m.PtrToTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PtrToTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntFor_32Value", wireType)
+			}
+			m.IntFor_32Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntFor_32Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntFor_64Value", wireType)
+			}
+			m.IntFor_64Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntFor_64Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			m.Int32Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Int32Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			m.Int64Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Int64Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			m.StringValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StringValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Location) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Location: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Location: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Store) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Store: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Store: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Location == nil {
+				m.Location = &Location{}
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Labels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Labels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Labels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, &types.StringValue{})
+			if err := m.Names[len(m.Names)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counters = append(m.Counters, &types.Int64Value{})
+			if err := m.Counters[len(m.Counters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Title == nil {
+				m.Title = &types.StringValue{}
+			}
+			if err := m.Title.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Priority == nil {
+				m.Priority = &types.Int64Value{}
+			}
+			if err := m.Priority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pinned == nil {
+				m.Pinned = &types.BoolValue{}
+			}
+			if err := m.Pinned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dates = append(m.Dates, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.Dates[len(m.Dates)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadlines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadlines == nil {
+				m.Deadlines = make(map[string]*time.Time)
+			}
+			var mapkey string
+			mapvalue := new(time.Time)
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(mapvalue, dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Deadlines[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Notes == nil {
+				m.Notes = make(map[int64]*types.StringValue)
+			}
+			var mapkey int64
+			var mapvalue *types.StringValue
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.StringValue{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Notes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &rpc.Status{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ticket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ticket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ticket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= github_com_ZacxDev_protoc_gen_struct_transformer_example_model.TicketID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			m.ParentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentId |= github_com_ZacxDev_protoc_gen_struct_transformer_example_model.TicketID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, Address{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PtrAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PtrAddresses = append(m.PtrAddresses, &Address{})
+			if err := m.PtrAddresses[len(m.PtrAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressesByLabel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddressesByLabel == nil {
+				m.AddressesByLabel = make(map[string]*AddressList)
+			}
+			var mapkey string
+			var mapvalue *AddressList
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AddressList{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AddressesByLabel[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressesByName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddressesByName == nil {
+				m.AddressesByName = make(map[string]*Address)
+			}
+			var mapkey string
+			var mapvalue *Address
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Address{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AddressesByName[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PtrAddressesById", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PtrAddressesById == nil {
+				m.PtrAddressesById = make(map[int64]*Address)
+			}
+			var mapkey int64
+			var mapvalue *Address
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Address{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PtrAddressesById[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Address{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostalAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostalAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostalAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Street", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Street = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Invoice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Invoice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Invoice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BillingAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BillingAddress == nil {
+				m.BillingAddress = &PostalAddress{}
+			}
+			if err := m.BillingAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAddresses = append(m.PreviousAddresses, PostalAddress{})
+			if err := m.PreviousAddresses[len(m.PreviousAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.TimePtrToPtrStruct != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimePtrToPtrStruct)
-		n += 1 + l + sovMessage(uint64(l))
+	return nil
+}
+func (m *Shipment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Shipment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Shipment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parcels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parcels = append(m.Parcels, &Shipment_Parcel{})
+			if err := m.Parcels[len(m.Parcels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sizes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sizes == nil {
+				m.Sizes = make(map[string]*Shipment_Parcel_Dimensions)
+			}
+			var mapkey string
+			var mapvalue *Shipment_Parcel_Dimensions
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Shipment_Parcel_Dimensions{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sizes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Shipment_Parcel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Parcel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Parcel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dimensions == nil {
+				m.Dimensions = &Shipment_Parcel_Dimensions{}
+			}
+			if err := m.Dimensions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Boxes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Boxes = append(m.Boxes, &Shipment_Parcel_Dimensions{})
+			if err := m.Boxes[len(m.Boxes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Ints) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IntFor_32Value != 0 {
-		n += 1 + sovMessage(uint64(m.IntFor_32Value))
-	}
-	if m.IntFor_64Value != 0 {
-		n += 1 + sovMessage(uint64(m.IntFor_64Value))
-	}
-	if m.Int32Value != 0 {
-		n += 1 + sovMessage(uint64(m.Int32Value))
-	}
-	if m.Int64Value != 0 {
-		n += 1 + sovMessage(uint64(m.Int64Value))
-	}
-	if m.StringValue != 0 {
-		n += 1 + sovMessage(uint64(m.StringValue))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *TheOne) Unmarshal(dAtA []byte) error {
+func (m *Shipment_Parcel_Dimensions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2421,17 +12389,17 @@ func (m *TheOne) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TheOne: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dimensions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TheOne: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dimensions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
 			}
-			var stringLen uint64
+			m.Width = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -2441,29 +12409,16 @@ func (m *TheOne) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Width |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TheDecl = &TheOne_StringValue{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -2473,12 +12428,11 @@ func (m *TheOne) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TheDecl = &TheOne_Int64Value{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2503,7 +12457,7 @@ func (m *TheOne) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotSupportedOneOf) Unmarshal(dAtA []byte) error {
+func (m *Refund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2526,17 +12480,17 @@ func (m *NotSupportedOneOf) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NotSupportedOneOf: wiretype end group for non-group")
+			return fmt.Errorf("proto: Refund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NotSupportedOneOf: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Refund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -2546,16 +12500,14 @@ func (m *NotSupportedOneOf) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.TheDecl = &NotSupportedOneOf_BoolValue{b}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2583,7 +12535,7 @@ func (m *NotSupportedOneOf) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TheDecl = &NotSupportedOneOf_StringValue{string(dAtA[iNdEx:postIndex])}
+			m.Currency = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2609,7 +12561,7 @@ func (m *NotSupportedOneOf) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomOneof) Unmarshal(dAtA []byte) error {
+func (m *RefundBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2632,15 +12584,189 @@ func (m *CustomOneof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomOneof: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefundBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomOneof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefundBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunds = append(m.Refunds, &Refund{})
+			if err := m.Refunds[len(m.Refunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Largest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Largest == nil {
+				m.Largest = &Refund{}
+			}
+			if err := m.Largest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Memo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Memo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Memo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2668,13 +12794,13 @@ func (m *CustomOneof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = &CustomOneof_StringValue{string(dAtA[iNdEx:postIndex])}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -2684,12 +12810,24 @@ func (m *CustomOneof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Value = &CustomOneof_Int64Value{v}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2714,7 +12852,7 @@ func (m *CustomOneof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomType) Unmarshal(dAtA []byte) error {
+func (m *AuditLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2737,15 +12875,15 @@ func (m *CustomType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomType: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2773,7 +12911,7 @@ func (m *CustomType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2799,7 +12937,7 @@ func (m *CustomType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Product) Unmarshal(dAtA []byte) error {
+func (m *MemoThread) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2822,66 +12960,15 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Product: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemoThread: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Product: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoThread: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field One", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2908,16 +12995,14 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.One == nil {
-				m.One = &TheOne{}
-			}
-			if err := m.One.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Memos = append(m.Memos, &Memo{})
+			if err := m.Memos[len(m.Memos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2944,90 +13029,71 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecondId == nil {
-				m.SecondId = &TheOne{}
+			if m.Pinned == nil {
+				m.Pinned = &Memo{}
 			}
-			if err := m.SecondId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pinned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomField", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthMessage
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CustomField == nil {
-				m.CustomField = &CustomType{}
-			}
-			if err := m.CustomField.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomOneof", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Card) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CustomOneof == nil {
-				m.CustomOneof = &CustomOneof{}
-			}
-			if err := m.CustomOneof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Card: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Card: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotsupportedOneof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3037,27 +13103,23 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NotsupportedOneof == nil {
-				m.NotsupportedOneof = &NotSupportedOneOf{}
-			}
-			if err := m.NotsupportedOneof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Number = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3083,7 +13145,7 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *Payment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3106,10 +13168,10 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: Payment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Payment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3133,7 +13195,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3160,18 +13222,49 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FirstId == nil {
-				m.FirstId = &TheOne{}
-			}
-			if err := m.FirstId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &Card{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Method = &Payment_Card{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coupon", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = &Payment_Coupon{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3181,33 +13274,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecondId == nil {
-				m.SecondId = &TheOne{}
-			}
-			if err := m.SecondId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receipt = &Payment_Email{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThirdUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3217,27 +13306,23 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ThirdUrl == nil {
-				m.ThirdUrl = &TheOne{}
-			}
-			if err := m.ThirdUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receipt = &Payment_Phone{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3263,7 +13348,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Address) Unmarshal(dAtA []byte) error {
+func (m *Payout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3286,10 +13371,10 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Address: wiretype end group for non-group")
+			return fmt.Errorf("proto: Payout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Address: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Payout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3313,7 +13398,42 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Card{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &Payout_Card{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3341,7 +13461,7 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Target = &Payout_Account{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3367,7 +13487,7 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Customer) Unmarshal(dAtA []byte) error {
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3390,66 +13510,15 @@ func (m *Customer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Customer: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Customer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3476,14 +13545,13 @@ func (m *Customer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, &Address{})
-			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Backoff, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWait", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3510,16 +13578,16 @@ func (m *Customer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultAddress == nil {
-				m.DefaultAddress = &Address{}
+			if m.MaxWait == nil {
+				m.MaxWait = new(time.Duration)
 			}
-			if err := m.DefaultAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxWait, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BillingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3546,73 +13614,12 @@ func (m *Customer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BillingAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MapField_1", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MapField_1 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MapFieldToWithoutDigits", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MapFieldToWithoutDigits = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3638,7 +13645,7 @@ func (m *Customer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LineItemUsage) Unmarshal(dAtA []byte) error {
+func (m *Device) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3661,15 +13668,34 @@ func (m *LineItemUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LineItemUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: Device: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LineItemUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3696,16 +13722,16 @@ func (m *LineItemUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &LineItem{}
+			if m.Attributes == nil {
+				m.Attributes = &types.Struct{}
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3732,8 +13758,10 @@ func (m *LineItemUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.List = append(m.List, &LineItem{})
-			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &types.Struct{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3761,7 +13789,7 @@ func (m *LineItemUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LineItem) Unmarshal(dAtA []byte) error {
+func (m *Attachment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3784,17 +13812,17 @@ func (m *LineItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LineItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: Attachment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LineItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Attachment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			m.ID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3804,16 +13832,31 @@ func (m *LineItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caption", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3823,29 +13866,31 @@ func (m *LineItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Caption = append(m.Caption[:0], dAtA[iNdEx:postIndex]...)
+			if m.Caption == nil {
+				m.Caption = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SomeField", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3855,27 +13900,29 @@ func (m *LineItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SomeField = string(dAtA[iNdEx:postIndex])
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3903,13 +13950,13 @@ func (m *LineItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SKU", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
 			}
-			m.SKU = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3919,11 +13966,26 @@ func (m *LineItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SKU |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerId = append(m.OwnerId[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerId == nil {
+				m.OwnerId = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3948,7 +14010,7 @@ func (m *LineItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Value2Pointer) Unmarshal(dAtA []byte) error {
+func (m *Quote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3971,15 +14033,66 @@ func (m *Value2Pointer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Value2Pointer: wiretype end group for non-group")
+			return fmt.Errorf("proto: Quote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Value2Pointer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Quote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressNil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountCents", wireType)
+			}
+			m.DiscountCents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscountCents |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4006,7 +14119,10 @@ func (m *Value2Pointer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AddressNil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Total == nil {
+				m.Total = &_type.Money{}
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4034,7 +14150,7 @@ func (m *Value2Pointer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pointer2Value) Unmarshal(dAtA []byte) error {
+func (m *Contact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4057,15 +14173,47 @@ func (m *Pointer2Value) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pointer2Value: wiretype end group for non-group")
+			return fmt.Errorf("proto: Contact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pointer2Value: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Contact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressNotNil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nickname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4092,119 +14240,85 @@ func (m *Pointer2Value) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AddressNotNil == nil {
-				m.AddressNotNil = &Address{}
+			if m.Email == nil {
+				m.Email = &types.StringValue{}
 			}
-			if err := m.AddressNotNil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Email.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SkippedMessageOne) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
+			if m.Age == nil {
+				m.Age = &types.Int32Value{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Age.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAt", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SkippedMessageOne: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SkippedMessageOne: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SkippedMessageTwo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.VerifiedAt == nil {
+				m.VerifiedAt = &types.Timestamp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.VerifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SkippedMessageTwo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SkippedMessageTwo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4229,7 +14343,7 @@ func (m *SkippedMessageTwo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Timer) Unmarshal(dAtA []byte) error {
+func (m *Team) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4252,17 +14366,17 @@ func (m *Timer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Timer: wiretype end group for non-group")
+			return fmt.Errorf("proto: Team: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Timer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Team: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4272,30 +14386,16 @@ func (m *Timer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PtrTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4305,31 +14405,27 @@ func (m *Timer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PtrTime == nil {
-				m.PtrTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PtrTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeToStruct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lead", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4356,13 +14452,16 @@ func (m *Timer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.TimeToStruct, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Lead == nil {
+				m.Lead = &Contact{}
+			}
+			if err := m.Lead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeToStructPtr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4389,18 +14488,69 @@ func (m *Timer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TimeToStructPtr == nil {
-				m.TimeToStructPtr = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimeToStructPtr, dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &Contact{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimePtrToStruct", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProductSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProductSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProductSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			m.Width = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4410,33 +14560,16 @@ func (m *Timer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Width |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TimePtrToStruct == nil {
-				m.TimePtrToStruct = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimePtrToStruct, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimePtrToPtrStruct", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4446,28 +14579,11 @@ func (m *Timer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TimePtrToPtrStruct == nil {
-				m.TimePtrToPtrStruct = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimePtrToPtrStruct, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4492,7 +14608,7 @@ func (m *Timer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Ints) Unmarshal(dAtA []byte) error {
+func (m *CatalogProduct) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4515,17 +14631,17 @@ func (m *Ints) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Ints: wiretype end group for non-group")
+			return fmt.Errorf("proto: CatalogProduct: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ints: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CatalogProduct: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntFor_32Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.IntFor_32Value = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4535,16 +14651,16 @@ func (m *Ints) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IntFor_32Value |= int32(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntFor_64Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.IntFor_64Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4554,16 +14670,40 @@ func (m *Ints) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IntFor_64Value |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Int32Value = 0
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4573,16 +14713,29 @@ func (m *Ints) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Int32Value |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
 			}
-			m.Int64Value = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimensions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4592,16 +14745,33 @@ func (m *Ints) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Int64Value |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dimensions == nil {
+				m.Dimensions = &ProductSize{}
+			}
+			if err := m.Dimensions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Availability", wireType)
 			}
-			m.StringValue = 0
+			m.Availability = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4611,11 +14781,47 @@ func (m *Ints) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StringValue |= int64(b&0x7F) << shift
+				m.Availability |= StockStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReleasedAt == nil {
+				m.ReleasedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ReleasedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
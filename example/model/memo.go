@@ -0,0 +1,29 @@
+package model
+
+import "github.com/ZacxDev/protoc-gen-struct-transformer/example/billing"
+
+type (
+	// Audit contains author of changes, fields of embedded Audit are promoted
+	// into models.
+	Audit struct {
+		Author string
+	}
+
+	// Memo has note which is sealed by transformers.
+	Memo struct {
+		Audit
+		ID   int64
+		Note billing.Note
+		Tags MemoTags
+	}
+
+	// MemoTags is a named slice type, it's transformed like []string.
+	MemoTags []string
+
+	// MemoThread contains memos, context of its transformers is passed to
+	// transformers of memos.
+	MemoThread struct {
+		Memos  []Memo
+		Pinned *Memo
+	}
+)
@@ -1,9 +1,14 @@
 package model
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
+	"github.com/ZacxDev/protoc-gen-struct-transformer/example/billing"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/example/decimal"
 	"github.com/ZacxDev/protoc-gen-struct-transformer/example/nulls"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/example/uuid"
 )
 
 type (
@@ -22,6 +27,9 @@ type (
 		FirstID  string
 		SecondID string
 		ThirdURL string
+		Statuses []string
+		State    OrderState
+		Total    billing.Amount
 	}
 
 	Address struct {
@@ -68,6 +76,12 @@ type (
 
 		NullsTime2    nulls.Time
 		PtrNullsTime2 *nulls.Time
+
+		TimePtr *time.Time
+		PtrTime time.Time
+
+		CreatedAt time.Time
+		UpdatedAt *time.Time
 	}
 
 	IntsModel struct {
@@ -77,4 +91,162 @@ type (
 		Int64Value    int64
 		StringValue   string
 	}
+
+	// Store contains fields of embedded Location message.
+	Store struct {
+		ID              int
+		LocationCity    string
+		LocationCountry string
+	}
+
+	// Labels contains fields which are transformed from repeated wrappers.
+	Labels struct {
+		Names    []string
+		Counters []*int64
+		Title    *string
+		Priority *int64
+		Pinned   bool
+		Tags     []string
+	}
+
+	// Schedule contains fields which are transformed from repeated and map
+	// fields of well-known types.
+	Schedule struct {
+		Dates     []time.Time
+		Deadlines map[string]nulls.Time
+		Notes     map[int64]string
+	}
+
+	// Operation contains result of operation as an error.
+	Operation struct {
+		ID     string
+		Result error
+	}
+
+	// TicketID is used as gogoproto.casttype of proto fields.
+	TicketID int64
+
+	// Ticket contains fields which are cast types in proto structure.
+	Ticket struct {
+		ID       TicketID
+		ParentID int64
+	}
+
+	// AddressBook contains lists of values and pointers.
+	AddressBook struct {
+		Addresses        []Address
+		PtrAddresses     []*Address
+		AddressesByLabel map[string][]Address
+		AddressesByName  map[string]Address
+		PtrAddressesByID map[int64]*Address
+		Tags             map[string]string
+	}
+
+	// Invoice contains addresses of billing package.
+	Invoice struct {
+		ID                int
+		BillingAddress    *billing.Address
+		PreviousAddresses []billing.Address
+	}
+
+	// Shipment contains models of nested messages.
+	Shipment struct {
+		ID      int64
+		Parcels []Parcel
+		Sizes   map[string]*Dimensions
+	}
+
+	// Parcel is a model of nested message Shipment.Parcel.
+	Parcel struct {
+		Label      string
+		Dimensions *Dimensions
+		Boxes      []Dimensions
+	}
+
+	// Dimensions is a model of deeply nested message Shipment.Parcel.Dimensions.
+	Dimensions struct {
+		Width  int64
+		Height int64
+	}
+
+	// Refund has currency which is validated by transformers.
+	Refund struct {
+		ID       int64
+		Currency billing.Currency
+	}
+
+	// Contact has nullable fields of database/sql types.
+	Contact struct {
+		Nickname   sql.NullString
+		Email      sql.NullString
+		Age        sql.NullInt64
+		VerifiedAt sql.NullTime
+	}
+
+	// Team is an entity in the way ent generates them, its edges are held
+	// by Edges field.
+	Team struct {
+		ID    int64
+		Name  string
+		Edges TeamEdges
+	}
+
+	// TeamEdges holds edges of Team, edges are nil unless they are loaded.
+	TeamEdges struct {
+		Lead        *Contact
+		Members     []*Contact
+		loadedTypes [2]bool
+	}
+
+	// RefundBatch contains refunds, errors of their transformers are
+	// returned by transformers of the batch.
+	RefundBatch struct {
+		Refunds []Refund
+		Largest *Refund
+	}
+
+	// RetryPolicy has durations as time.Duration and as number of
+	// milliseconds.
+	RetryPolicy struct {
+		Backoff   time.Duration
+		MaxWait   *time.Duration
+		TimeoutMs int64
+	}
+
+	// Device has loosely-typed attributes and configuration of
+	// google.protobuf.Struct fields.
+	Device struct {
+		ID         int64
+		Attributes map[string]interface{}
+		Config     json.RawMessage
+	}
+
+	// Attachment has bytes fields as []byte, string and billing.Checksum,
+	// string and bytes identifiers are UUIDs.
+	Attachment struct {
+		Content  []byte
+		Caption  string
+		Checksum billing.Checksum
+		ID       uuid.UUID
+		OwnerID  *uuid.UUID
+	}
+
+	// Quote has decimal amounts of string, int64 and google.type.Money
+	// fields, currency code of total is held by Currency.
+	Quote struct {
+		Price    decimal.Decimal
+		Discount decimal.Decimal
+		Total    decimal.Decimal
+		Currency billing.Currency
+	}
+)
+
+// OrderState is a model representation of order status, see option
+// transformer.enum_mapping of field Order.state.
+type OrderState string
+
+// Order states.
+const (
+	OrderStatePaid    OrderState = "paid"
+	OrderStateShipped OrderState = "shipped"
 )
@@ -0,0 +1,37 @@
+package model
+
+type (
+	// PaymentMethod is a sum type of oneof method, it's implemented by models
+	// of all oneof cases.
+	PaymentMethod interface {
+		isPaymentMethod()
+	}
+
+	// CardPayment is a payment by card.
+	CardPayment struct {
+		Number string
+	}
+
+	// Coupon is a payment by coupon code.
+	Coupon string
+
+	// Payment has a method of one of types and a receipt contact, which is
+	// either email or phone.
+	Payment struct {
+		ID     int64
+		Method PaymentMethod
+		Email  string
+		Phone  string
+	}
+
+	// Payout has a target of PayoutTarget sum type, which is generated with
+	// wrappers of cases.
+	Payout struct {
+		ID     int64
+		Target PayoutTarget
+	}
+)
+
+func (*CardPayment) isPaymentMethod() {}
+
+func (Coupon) isPaymentMethod() {}
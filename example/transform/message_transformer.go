@@ -5,11 +5,27 @@
 package transform
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/ZacxDev/protoc-gen-struct-transformer/example"
+	billing "github.com/ZacxDev/protoc-gen-struct-transformer/example/billing"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/example/decimal"
 	"github.com/ZacxDev/protoc-gen-struct-transformer/example/helpers"
 	"github.com/ZacxDev/protoc-gen-struct-transformer/example/model"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/example/nulls"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/example/uuid"
+	v2 "github.com/ZacxDev/protoc-gen-struct-transformer/example/v2"
+	_type "github.com/gogo/googleapis/google/type"
+	"github.com/gogo/protobuf/types"
+	protov1 "github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // Oneof: "the_decl"
@@ -25,6 +41,8 @@ import (
 // field skipped: some_field
 // message "SkippedMessageOne" has no option "transformer.go_struct", skipped...
 // message "SkippedMessageTwo" has no option "transformer.go_struct", skipped...
+// message "Location" has no option "transformer.go_struct", skipped...
+// message "AddressList" has no option "transformer.go_struct", skipped...
 func PbToProductPtr(src *example.Product, opts ...TransformParam) *model.Product {
 	if src == nil {
 		return nil
@@ -53,10 +71,13 @@ func PbToProductPtrVal(src *example.Product, opts ...TransformParam) model.Produ
 }
 
 func PbToProductPtrValList(src []*example.Product, opts ...TransformParam) []model.Product {
-	resp := make([]model.Product, len(src))
+	resp := make([]model.Product, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToProduct(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToProduct(*s, opts...))
 	}
 
 	return resp
@@ -64,7 +85,7 @@ func PbToProductPtrValList(src []*example.Product, opts ...TransformParam) []mod
 
 // PbToProductList is DEPRECATED. Use PbToProductPtrValList instead.
 func PbToProductList(src []*example.Product, opts ...TransformParam) []model.Product {
-	return PbToProductPtrValList(src)
+	return PbToProductPtrValList(src, opts...)
 }
 
 func PbToProduct(src example.Product, opts ...TransformParam) model.Product {
@@ -98,6 +119,43 @@ func PbToProductValList(src []example.Product, opts ...TransformParam) []model.P
 	return resp
 }
 
+func PbToProductValPtrList(src []example.Product, opts ...TransformParam) []*model.Product {
+	resp := make([]*model.Product, len(src))
+
+	for i, s := range src {
+		g := PbToProduct(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToProductFieldNames maps example.Product field names to model.Product field names.
+var PbToProductFieldNames = map[string]string{
+	"id":                 "ID",
+	"name":               "Name",
+	"one":                "One",
+	"second_id":          "SecondID",
+	"custom_field":       "CustomField",
+	"custom_oneof":       "CustomOneof",
+	"notsupported_oneof": "NotsupportedOneof",
+}
+
+// PbToProductJSONNames maps example.Product JSON field names to model.Product JSON field names.
+var PbToProductJSONNames = map[string]string{
+	"id":                "id",
+	"name":              "name",
+	"one":               "one",
+	"secondId":          "two",
+	"customField":       "custom_field",
+	"customOneof":       "custom_oneof",
+	"notsupportedOneof": "notsupported_oneof",
+}
+
+// PbToProductSchemaHash is a hash of fields mapping between example.Product and model.Product.
+// It changes when mapped fields or their types are changed.
+const PbToProductSchemaHash = "abea8ffefcb6fe905af39841af15e63b203db4e2a3a0d709dd159859e982c0c0"
+
 func ProductToPbPtr(src *model.Product, opts ...TransformParam) *example.Product {
 	if src == nil {
 		return nil
@@ -138,7 +196,7 @@ func ProductToPbValPtrList(src []model.Product, opts ...TransformParam) []*examp
 
 // ProductToPbList is DEPRECATED. Use ProductToPbValPtrList instead.
 func ProductToPbList(src []model.Product, opts ...TransformParam) []*example.Product {
-	return ProductToPbValPtrList(src)
+	return ProductToPbValPtrList(src, opts...)
 }
 
 func ProductToPb(src model.Product, opts ...TransformParam) example.Product {
@@ -175,6 +233,41 @@ func ProductToPbValList(src []model.Product, opts ...TransformParam) []example.P
 	return resp
 }
 
+func ProductToPbPtrValList(src []*model.Product, opts ...TransformParam) []example.Product {
+	resp := make([]example.Product, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, ProductToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// ProductToPbFieldNames maps model.Product field names to example.Product field names.
+var ProductToPbFieldNames = map[string]string{
+	"ID":                "id",
+	"Name":              "name",
+	"One":               "one",
+	"SecondID":          "second_id",
+	"CustomField":       "custom_field",
+	"CustomOneof":       "custom_oneof",
+	"NotsupportedOneof": "notsupported_oneof",
+}
+
+// ProductToPbJSONNames maps model.Product JSON field names to example.Product JSON field names.
+var ProductToPbJSONNames = map[string]string{
+	"id":                 "id",
+	"name":               "name",
+	"one":                "one",
+	"two":                "secondId",
+	"custom_field":       "customField",
+	"custom_oneof":       "customOneof",
+	"notsupported_oneof": "notsupportedOneof",
+}
+
 func PbToOrderPtr(src *example.Order, opts ...TransformParam) *model.Order {
 	if src == nil {
 		return nil
@@ -203,10 +296,13 @@ func PbToOrderPtrVal(src *example.Order, opts ...TransformParam) model.Order {
 }
 
 func PbToOrderPtrValList(src []*example.Order, opts ...TransformParam) []model.Order {
-	resp := make([]model.Order, len(src))
+	resp := make([]model.Order, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToOrder(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToOrder(*s, opts...))
 	}
 
 	return resp
@@ -214,7 +310,7 @@ func PbToOrderPtrValList(src []*example.Order, opts ...TransformParam) []model.O
 
 // PbToOrderList is DEPRECATED. Use PbToOrderPtrValList instead.
 func PbToOrderList(src []*example.Order, opts ...TransformParam) []model.Order {
-	return PbToOrderPtrValList(src)
+	return PbToOrderPtrValList(src, opts...)
 }
 
 func PbToOrder(src example.Order, opts ...TransformParam) model.Order {
@@ -223,10 +319,20 @@ func PbToOrder(src example.Order, opts ...TransformParam) model.Order {
 		FirstID:  TheOneToString(src.FirstId),
 		SecondID: TheOneToString(src.SecondId),
 		ThirdURL: TheOneToString(src.ThirdUrl),
+		State:    PbToOrderStateEnum(src.State),
 	}
 
 	applyOptions(opts...)
 
+	if src.Statuses != nil {
+		s.Statuses = make([]string, len(src.Statuses))
+		for i, v := range src.Statuses {
+			s.Statuses[i] = v.String()
+		}
+	}
+
+	s.Total = billing.CentsToAmount(src.TotalCents)
+
 	return s
 }
 
@@ -245,6 +351,224 @@ func PbToOrderValList(src []example.Order, opts ...TransformParam) []model.Order
 	return resp
 }
 
+func PbToOrderValPtrList(src []example.Order, opts ...TransformParam) []*model.Order {
+	resp := make([]*model.Order, len(src))
+
+	for i, s := range src {
+		g := PbToOrder(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToOrderFieldNames maps example.Order field names to model.Order field names.
+var PbToOrderFieldNames = map[string]string{
+	"id":          "ID",
+	"first_id":    "FirstID",
+	"second_id":   "SecondID",
+	"third_url":   "ThirdURL",
+	"statuses":    "Statuses",
+	"state":       "State",
+	"total_cents": "Total",
+}
+
+// PbToOrderJSONNames maps example.Order JSON field names to model.Order JSON field names.
+var PbToOrderJSONNames = map[string]string{
+	"id":         "ID",
+	"firstId":    "FirstID",
+	"secondId":   "SecondID",
+	"thirdUrl":   "ThirdURL",
+	"statuses":   "Statuses",
+	"state":      "State",
+	"totalCents": "Total",
+}
+
+// PbToOrderStateEnum transforms example.Order_Status into model.OrderState by (transformer.enum_mapping) option of field State,
+// unmapped values become zero value.
+func PbToOrderStateEnum(v example.Order_Status) (d model.OrderState) {
+	switch v {
+	case example.Order_PAID:
+		return model.OrderStatePaid
+	case example.Order_SHIPPED:
+		return model.OrderStateShipped
+	}
+
+	return d
+}
+
+// PbToOrderInto transforms example.Order pointer into existing model.Order field by field, so dst isn't allocated. Slices and maps of dst which are filled element by element are reused. Zero value is set if src is nil.
+func PbToOrderInto(src *example.Order, dst *model.Order, opts ...TransformParam) {
+	if src == nil {
+		*dst = model.Order{
+			Statuses: dst.Statuses[:0],
+		}
+		return
+	}
+
+	s := dst
+	*s = model.Order{
+		ID:       int(src.Id),
+		FirstID:  TheOneToString(src.FirstId),
+		SecondID: TheOneToString(src.SecondId),
+		ThirdURL: TheOneToString(src.ThirdUrl),
+		State:    PbToOrderStateEnum(src.State),
+		Statuses: dst.Statuses[:0],
+	}
+
+	applyOptions(opts...)
+
+	if src.Statuses != nil {
+		s.Statuses = append(s.Statuses[:0], make([]string, len(src.Statuses))...)
+		for i, v := range src.Statuses {
+			s.Statuses[i] = v.String()
+		}
+	}
+
+	s.Total = billing.CentsToAmount(src.TotalCents)
+}
+
+// PbToOrderListInto transforms example.Order list into dst and returns resulting list, backing array of dst is reused if it has enough capacity.
+func PbToOrderListInto(src []*example.Order, dst []model.Order, opts ...TransformParam) []model.Order {
+	if cap(dst) < len(src) {
+		dst = make([]model.Order, len(src))
+	}
+	dst = dst[:len(src)]
+
+	for i := range src {
+		PbToOrderInto(src[i], &dst[i], opts...)
+	}
+
+	return dst
+}
+
+// pbToOrderPool holds model.Order structures released by PbToOrderRelease.
+var pbToOrderPool = sync.Pool{
+	New: func() interface{} {
+		return &model.Order{}
+	},
+}
+
+// PbToOrderAcquire returns model.Order with zero fields from pool, it should be returned with PbToOrderRelease once it's not used. Slices and maps reused by PbToOrderInto are empty but may keep capacity of released structures.
+func PbToOrderAcquire() *model.Order {
+	return pbToOrderPool.Get().(*model.Order)
+}
+
+// PbToOrderRelease resets dst fields and puts it into pool, dst and structures it refers to must not be used after release. Slices and maps reused by PbToOrderInto are kept empty, so they are refilled by next transformation.
+func PbToOrderRelease(dst *model.Order) {
+	if dst == nil {
+		return
+	}
+
+	*dst = model.Order{
+		Statuses: dst.Statuses[:0],
+	}
+	pbToOrderPool.Put(dst)
+}
+
+// PbToOrderPooled transforms example.Order pointer into model.Order acquired from pool with PbToOrderInto, nil is returned if src is nil. Result should be released with PbToOrderRelease.
+func PbToOrderPooled(src *example.Order, opts ...TransformParam) *model.Order {
+	if src == nil {
+		return nil
+	}
+
+	dst := PbToOrderAcquire()
+	PbToOrderInto(src, dst, opts...)
+
+	return dst
+}
+
+// PbToOrderSchemaHash is a hash of fields mapping between example.Order and model.Order.
+// It changes when mapped fields or their types are changed.
+const PbToOrderSchemaHash = "f0fd0beb3d8c84f8bb62bd70b606691f27ad8d6cceccef3a9d3522d9157ade1f"
+
+// OrderPbBuilder builds example.Order out of model.Order fields.
+type OrderPbBuilder struct {
+	model model.Order
+	sets  []func(*model.Order)
+}
+
+// NewOrderPbBuilder returns builder of example.Order. Fields set by With methods
+// override fields of model passed to FromModel regardless of calls order.
+func NewOrderPbBuilder() *OrderPbBuilder {
+	return &OrderPbBuilder{}
+}
+
+// FromModel sets model which is used as a base for built message.
+func (b *OrderPbBuilder) FromModel(m model.Order) *OrderPbBuilder {
+	b.model = m
+	return b
+}
+
+// WithID sets ID field of model.
+func (b *OrderPbBuilder) WithID(v int) *OrderPbBuilder {
+	b.sets = append(b.sets, func(m *model.Order) { m.ID = v })
+	return b
+}
+
+// WithFirstID sets FirstID field of model.
+func (b *OrderPbBuilder) WithFirstID(v string) *OrderPbBuilder {
+	b.sets = append(b.sets, func(m *model.Order) { m.FirstID = v })
+	return b
+}
+
+// WithSecondID sets SecondID field of model.
+func (b *OrderPbBuilder) WithSecondID(v string) *OrderPbBuilder {
+	b.sets = append(b.sets, func(m *model.Order) { m.SecondID = v })
+	return b
+}
+
+// WithThirdURL sets ThirdURL field of model.
+func (b *OrderPbBuilder) WithThirdURL(v string) *OrderPbBuilder {
+	b.sets = append(b.sets, func(m *model.Order) { m.ThirdURL = v })
+	return b
+}
+
+// WithStatuses sets Statuses field of model.
+func (b *OrderPbBuilder) WithStatuses(v []string) *OrderPbBuilder {
+	b.sets = append(b.sets, func(m *model.Order) { m.Statuses = v })
+	return b
+}
+
+// WithState sets State field of model.
+func (b *OrderPbBuilder) WithState(v model.OrderState) *OrderPbBuilder {
+	b.sets = append(b.sets, func(m *model.Order) { m.State = v })
+	return b
+}
+
+// WithTotal sets Total field of model.
+func (b *OrderPbBuilder) WithTotal(v billing.Amount) *OrderPbBuilder {
+	b.sets = append(b.sets, func(m *model.Order) { m.Total = v })
+	return b
+}
+
+// Build returns message built out of model and fields set by With methods.
+func (b *OrderPbBuilder) Build(opts ...TransformParam) *example.Order {
+	m := b.model
+	for _, set := range b.sets {
+		set(&m)
+	}
+
+	return OrderToPbPtr(&m, opts...)
+}
+
+// JSONToOrder decodes proto-JSON representation of example.Order and
+// transforms it into model.Order.
+func JSONToOrder(data []byte, opts ...TransformParam) (model.Order, error) {
+	var src example.Order
+	if err := protojson.Unmarshal(data, protov1.MessageV2(&src)); err != nil {
+		return model.Order{}, err
+	}
+
+	return PbToOrderPtrVal(&src, opts...), nil
+}
+
+// OrderToJSON transforms model.Order into example.Order and encodes
+// it into proto-JSON.
+func OrderToJSON(src model.Order, opts ...TransformParam) ([]byte, error) {
+	return protojson.Marshal(protov1.MessageV2(OrderToPbValPtr(src, opts...)))
+}
+
 func OrderToPbPtr(src *model.Order, opts ...TransformParam) *example.Order {
 	if src == nil {
 		return nil
@@ -285,7 +609,7 @@ func OrderToPbValPtrList(src []model.Order, opts ...TransformParam) []*example.O
 
 // OrderToPbList is DEPRECATED. Use OrderToPbValPtrList instead.
 func OrderToPbList(src []model.Order, opts ...TransformParam) []*example.Order {
-	return OrderToPbValPtrList(src)
+	return OrderToPbValPtrList(src, opts...)
 }
 
 func OrderToPb(src model.Order, opts ...TransformParam) example.Order {
@@ -294,6 +618,7 @@ func OrderToPb(src model.Order, opts ...TransformParam) example.Order {
 		FirstId:  &example.TheOne{},
 		SecondId: &example.TheOne{},
 		ThirdUrl: &example.TheOne{},
+		State:    OrderStateEnumToPb(src.State),
 	}
 
 	applyOptions(opts...)
@@ -301,6 +626,16 @@ func OrderToPb(src model.Order, opts ...TransformParam) example.Order {
 	StringToTheOne(src.FirstID, s.FirstId, version)
 	StringToTheOne(src.SecondID, s.SecondId, version)
 	StringToTheOne(src.ThirdURL, s.ThirdUrl, version)
+
+	if src.Statuses != nil {
+		s.Statuses = make([]example.Order_Status, len(src.Statuses))
+		for i, v := range src.Statuses {
+			s.Statuses[i] = example.Order_Status(example.Order_Status_value[v])
+		}
+	}
+
+	s.TotalCents = billing.AmountToCents(src.Total)
+
 	return s
 }
 
@@ -319,6 +654,142 @@ func OrderToPbValList(src []model.Order, opts ...TransformParam) []example.Order
 	return resp
 }
 
+func OrderToPbPtrValList(src []*model.Order, opts ...TransformParam) []example.Order {
+	resp := make([]example.Order, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, OrderToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// OrderToPbFieldNames maps model.Order field names to example.Order field names.
+var OrderToPbFieldNames = map[string]string{
+	"ID":       "id",
+	"FirstID":  "first_id",
+	"SecondID": "second_id",
+	"ThirdURL": "third_url",
+	"Statuses": "statuses",
+	"State":    "state",
+	"Total":    "total_cents",
+}
+
+// OrderToPbJSONNames maps model.Order JSON field names to example.Order JSON field names.
+var OrderToPbJSONNames = map[string]string{
+	"ID":       "id",
+	"FirstID":  "firstId",
+	"SecondID": "secondId",
+	"ThirdURL": "thirdUrl",
+	"Statuses": "statuses",
+	"State":    "state",
+	"Total":    "totalCents",
+}
+
+// OrderStateEnumToPb transforms model.OrderState into example.Order_Status by (transformer.enum_mapping) option of field State,
+// unmapped values become zero value.
+func OrderStateEnumToPb(v model.OrderState) (d example.Order_Status) {
+	switch v {
+	case model.OrderStatePaid:
+		return example.Order_PAID
+	case model.OrderStateShipped:
+		return example.Order_SHIPPED
+	}
+
+	return d
+}
+
+// OrderToPbInto transforms model.Order pointer into existing example.Order field by field, so dst isn't allocated. Slices and maps of dst which are filled element by element are reused. Zero value is set if src is nil.
+func OrderToPbInto(src *model.Order, dst *example.Order, opts ...TransformParam) {
+	if src == nil {
+		*dst = example.Order{
+			Statuses: dst.Statuses[:0],
+		}
+		return
+	}
+
+	s := dst
+	*s = example.Order{
+		Id:       int64(src.ID),
+		FirstId:  &example.TheOne{},
+		SecondId: &example.TheOne{},
+		ThirdUrl: &example.TheOne{},
+		State:    OrderStateEnumToPb(src.State),
+		Statuses: dst.Statuses[:0],
+	}
+
+	applyOptions(opts...)
+
+	StringToTheOne(src.FirstID, s.FirstId, version)
+	StringToTheOne(src.SecondID, s.SecondId, version)
+	StringToTheOne(src.ThirdURL, s.ThirdUrl, version)
+
+	if src.Statuses != nil {
+		s.Statuses = append(s.Statuses[:0], make([]example.Order_Status, len(src.Statuses))...)
+		for i, v := range src.Statuses {
+			s.Statuses[i] = example.Order_Status(example.Order_Status_value[v])
+		}
+	}
+
+	s.TotalCents = billing.AmountToCents(src.Total)
+}
+
+// OrderToPbListInto transforms model.Order list into dst and returns resulting list, backing array of dst is reused if it has enough capacity, as well as structures which elements of dst point to.
+func OrderToPbListInto(src []model.Order, dst []*example.Order, opts ...TransformParam) []*example.Order {
+	if cap(dst) < len(src) {
+		dst = make([]*example.Order, len(src))
+	}
+	dst = dst[:len(src)]
+
+	for i := range src {
+		if dst[i] == nil {
+			dst[i] = &example.Order{}
+		}
+		OrderToPbInto(&src[i], dst[i], opts...)
+	}
+
+	return dst
+}
+
+// orderToPbPool holds example.Order structures released by OrderToPbRelease.
+var orderToPbPool = sync.Pool{
+	New: func() interface{} {
+		return &example.Order{}
+	},
+}
+
+// OrderToPbAcquire returns example.Order with zero fields from pool, it should be returned with OrderToPbRelease once it's not used. Slices and maps reused by OrderToPbInto are empty but may keep capacity of released structures.
+func OrderToPbAcquire() *example.Order {
+	return orderToPbPool.Get().(*example.Order)
+}
+
+// OrderToPbRelease resets dst fields and puts it into pool, dst and structures it refers to must not be used after release. Slices and maps reused by OrderToPbInto are kept empty, so they are refilled by next transformation.
+func OrderToPbRelease(dst *example.Order) {
+	if dst == nil {
+		return
+	}
+
+	*dst = example.Order{
+		Statuses: dst.Statuses[:0],
+	}
+	orderToPbPool.Put(dst)
+}
+
+// OrderToPbPooled transforms model.Order pointer into example.Order acquired from pool with OrderToPbInto, nil is returned if src is nil. Result should be released with OrderToPbRelease.
+func OrderToPbPooled(src *model.Order, opts ...TransformParam) *example.Order {
+	if src == nil {
+		return nil
+	}
+
+	dst := OrderToPbAcquire()
+	OrderToPbInto(src, dst, opts...)
+
+	return dst
+}
+
 func PbToAddressPtr(src *example.Address, opts ...TransformParam) *model.Address {
 	if src == nil {
 		return nil
@@ -347,10 +818,13 @@ func PbToAddressPtrVal(src *example.Address, opts ...TransformParam) model.Addre
 }
 
 func PbToAddressPtrValList(src []*example.Address, opts ...TransformParam) []model.Address {
-	resp := make([]model.Address, len(src))
+	resp := make([]model.Address, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToAddress(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToAddress(*s, opts...))
 	}
 
 	return resp
@@ -358,7 +832,7 @@ func PbToAddressPtrValList(src []*example.Address, opts ...TransformParam) []mod
 
 // PbToAddressList is DEPRECATED. Use PbToAddressPtrValList instead.
 func PbToAddressList(src []*example.Address, opts ...TransformParam) []model.Address {
-	return PbToAddressPtrValList(src)
+	return PbToAddressPtrValList(src, opts...)
 }
 
 func PbToAddress(src example.Address, opts ...TransformParam) model.Address {
@@ -387,6 +861,33 @@ func PbToAddressValList(src []example.Address, opts ...TransformParam) []model.A
 	return resp
 }
 
+func PbToAddressValPtrList(src []example.Address, opts ...TransformParam) []*model.Address {
+	resp := make([]*model.Address, len(src))
+
+	for i, s := range src {
+		g := PbToAddress(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToAddressFieldNames maps example.Address field names to model.Address field names.
+var PbToAddressFieldNames = map[string]string{
+	"id":   "ID",
+	"type": "Type",
+}
+
+// PbToAddressJSONNames maps example.Address JSON field names to model.Address JSON field names.
+var PbToAddressJSONNames = map[string]string{
+	"id":   "ID",
+	"type": "Type",
+}
+
+// PbToAddressSchemaHash is a hash of fields mapping between example.Address and model.Address.
+// It changes when mapped fields or their types are changed.
+const PbToAddressSchemaHash = "b7bee95f2d6ee7efb5e2fb26238e318f94b6ee4056029a6cd79ca20b52fdc4f3"
+
 func AddressToPbPtr(src *model.Address, opts ...TransformParam) *example.Address {
 	if src == nil {
 		return nil
@@ -427,7 +928,7 @@ func AddressToPbValPtrList(src []model.Address, opts ...TransformParam) []*examp
 
 // AddressToPbList is DEPRECATED. Use AddressToPbValPtrList instead.
 func AddressToPbList(src []model.Address, opts ...TransformParam) []*example.Address {
-	return AddressToPbValPtrList(src)
+	return AddressToPbValPtrList(src, opts...)
 }
 
 func AddressToPb(src model.Address, opts ...TransformParam) example.Address {
@@ -456,6 +957,31 @@ func AddressToPbValList(src []model.Address, opts ...TransformParam) []example.A
 	return resp
 }
 
+func AddressToPbPtrValList(src []*model.Address, opts ...TransformParam) []example.Address {
+	resp := make([]example.Address, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, AddressToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// AddressToPbFieldNames maps model.Address field names to example.Address field names.
+var AddressToPbFieldNames = map[string]string{
+	"ID":   "id",
+	"Type": "type",
+}
+
+// AddressToPbJSONNames maps model.Address JSON field names to example.Address JSON field names.
+var AddressToPbJSONNames = map[string]string{
+	"ID":   "id",
+	"Type": "type",
+}
+
 func PbToCustomerPtr(src *example.Customer, opts ...TransformParam) *model.Customer {
 	if src == nil {
 		return nil
@@ -484,10 +1010,13 @@ func PbToCustomerPtrVal(src *example.Customer, opts ...TransformParam) model.Cus
 }
 
 func PbToCustomerPtrValList(src []*example.Customer, opts ...TransformParam) []model.Customer {
-	resp := make([]model.Customer, len(src))
+	resp := make([]model.Customer, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToCustomer(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToCustomer(*s, opts...))
 	}
 
 	return resp
@@ -495,7 +1024,7 @@ func PbToCustomerPtrValList(src []*example.Customer, opts ...TransformParam) []m
 
 // PbToCustomerList is DEPRECATED. Use PbToCustomerPtrValList instead.
 func PbToCustomerList(src []*example.Customer, opts ...TransformParam) []model.Customer {
-	return PbToCustomerPtrValList(src)
+	return PbToCustomerPtrValList(src, opts...)
 }
 
 func PbToCustomer(src example.Customer, opts ...TransformParam) model.Customer {
@@ -529,6 +1058,232 @@ func PbToCustomerValList(src []example.Customer, opts ...TransformParam) []model
 	return resp
 }
 
+func PbToCustomerValPtrList(src []example.Customer, opts ...TransformParam) []*model.Customer {
+	resp := make([]*model.Customer, len(src))
+
+	for i, s := range src {
+		g := PbToCustomer(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToCustomerFieldNames maps example.Customer field names to model.Customer field names.
+var PbToCustomerFieldNames = map[string]string{
+	"id":                          "ID",
+	"name":                        "Name",
+	"addresses":                   "Addresses",
+	"default_address":             "DefaultAddress",
+	"billing_address":             "BillingAddress",
+	"map_field_1":                 "MapField1",
+	"map_field_to_without_digits": "MapField2",
+}
+
+// PbToCustomerJSONNames maps example.Customer JSON field names to model.Customer JSON field names.
+var PbToCustomerJSONNames = map[string]string{
+	"id":                      "ID",
+	"name":                    "Name",
+	"addresses":               "Addresses",
+	"defaultAddress":          "DefaultAddress",
+	"billingAddress":          "BillingAddress",
+	"mapField1":               "MapField1",
+	"mapFieldToWithoutDigits": "MapField2",
+}
+
+// PbToCustomerSchemaHash is a hash of fields mapping between example.Customer and model.Customer.
+// It changes when mapped fields or their types are changed.
+const PbToCustomerSchemaHash = "50a57b812ac209697580f043bb0f892e2c65da79a3590790a7453d5f5fd18d11"
+
+// CustomerPatch contains model.Customer fields which are present in example.Customer, nil field is not present.
+type CustomerPatch struct {
+	ID             *int
+	Name           *string
+	Addresses      []model.Address
+	DefaultAddress *model.Address
+	BillingAddress *model.Address
+	MapField1      *string
+	MapField2      *string
+}
+
+// PbToCustomerPatch returns CustomerPatch with fields which are present in src. Message
+// fields are present if they are not nil, repeated, string and bytes fields if
+// they are not empty, other scalar fields if they have non-zero values.
+func PbToCustomerPatch(src *example.Customer, opts ...TransformParam) CustomerPatch {
+	patch := CustomerPatch{}
+	if src == nil {
+		return patch
+	}
+
+	m := PbToCustomerPtr(src, opts...)
+	if src.Id != 0 {
+		patch.ID = &m.ID
+	}
+	if src.Name != "" {
+		patch.Name = &m.Name
+	}
+	if len(src.Addresses) > 0 {
+		patch.Addresses = m.Addresses
+	}
+	if src.DefaultAddress != nil {
+		patch.DefaultAddress = m.DefaultAddress
+	}
+	patch.BillingAddress = &m.BillingAddress
+	if src.MapField_1 != "" {
+		patch.MapField1 = &m.MapField1
+	}
+	if src.MapFieldToWithoutDigits != "" {
+		patch.MapField2 = &m.MapField2
+	}
+
+	return patch
+}
+
+// Apply sets dst fields which are present in patch.
+func (p CustomerPatch) Apply(dst *model.Customer) {
+	if p.ID != nil {
+		dst.ID = *p.ID
+	}
+	if p.Name != nil {
+		dst.Name = *p.Name
+	}
+	if p.Addresses != nil {
+		dst.Addresses = p.Addresses
+	}
+	if p.DefaultAddress != nil {
+		dst.DefaultAddress = p.DefaultAddress
+	}
+	if p.BillingAddress != nil {
+		dst.BillingAddress = *p.BillingAddress
+	}
+	if p.MapField1 != nil {
+		dst.MapField1 = *p.MapField1
+	}
+	if p.MapField2 != nil {
+		dst.MapField2 = *p.MapField2
+	}
+}
+
+// ApplyChanges sets dst fields which are present in patch and returns names
+// of dst fields which values are changed, e.g. for audit logs.
+func (p CustomerPatch) ApplyChanges(dst *model.Customer) []string {
+	var changed []string
+	if p.ID != nil && dst.ID != *p.ID {
+		dst.ID = *p.ID
+		changed = append(changed, "ID")
+	}
+	if p.Name != nil && dst.Name != *p.Name {
+		dst.Name = *p.Name
+		changed = append(changed, "Name")
+	}
+	if p.Addresses != nil && !reflect.DeepEqual(dst.Addresses, p.Addresses) {
+		dst.Addresses = p.Addresses
+		changed = append(changed, "Addresses")
+	}
+	if p.DefaultAddress != nil && !reflect.DeepEqual(dst.DefaultAddress, p.DefaultAddress) {
+		dst.DefaultAddress = p.DefaultAddress
+		changed = append(changed, "DefaultAddress")
+	}
+	if p.BillingAddress != nil && !reflect.DeepEqual(dst.BillingAddress, *p.BillingAddress) {
+		dst.BillingAddress = *p.BillingAddress
+		changed = append(changed, "BillingAddress")
+	}
+	if p.MapField1 != nil && dst.MapField1 != *p.MapField1 {
+		dst.MapField1 = *p.MapField1
+		changed = append(changed, "MapField1")
+	}
+	if p.MapField2 != nil && dst.MapField2 != *p.MapField2 {
+		dst.MapField2 = *p.MapField2
+		changed = append(changed, "MapField2")
+	}
+
+	return changed
+}
+
+// ApplyPbToCustomerMasked sets fields of dst which are listed in paths of field mask to
+// values of src fields, other fields are not changed. Paths are proto field names,
+// fields of embedded messages are addressed by dot-separated paths, e.g.
+// "address.city". Nil src sets listed fields to zero values. An error is returned
+// for unknown paths.
+func ApplyPbToCustomerMasked(src *example.Customer, dst *model.Customer, mask *types.FieldMask, opts ...TransformParam) error {
+	m := PbToCustomerPtrVal(src, opts...)
+
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "id":
+			dst.ID = m.ID
+		case "name":
+			dst.Name = m.Name
+		case "addresses":
+			dst.Addresses = m.Addresses
+		case "default_address":
+			dst.DefaultAddress = m.DefaultAddress
+		case "billing_address":
+			dst.BillingAddress = m.BillingAddress
+		case "map_field_1":
+			dst.MapField1 = m.MapField1
+		case "map_field_to_without_digits":
+			dst.MapField2 = m.MapField2
+		default:
+			return fmt.Errorf("field mask path %q is not a field of example.Customer", path)
+		}
+	}
+
+	return nil
+}
+
+// ApplyPbToCustomerMaskedChanges sets fields of dst which are listed in paths of field
+// mask, like ApplyPbToCustomerMasked, and returns names of dst fields which values are
+// changed, e.g. for audit logs.
+func ApplyPbToCustomerMaskedChanges(src *example.Customer, dst *model.Customer, mask *types.FieldMask, opts ...TransformParam) ([]string, error) {
+	m := PbToCustomerPtrVal(src, opts...)
+
+	var changed []string
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "id":
+			if dst.ID != m.ID {
+				dst.ID = m.ID
+				changed = append(changed, "ID")
+			}
+		case "name":
+			if dst.Name != m.Name {
+				dst.Name = m.Name
+				changed = append(changed, "Name")
+			}
+		case "addresses":
+			if !reflect.DeepEqual(dst.Addresses, m.Addresses) {
+				dst.Addresses = m.Addresses
+				changed = append(changed, "Addresses")
+			}
+		case "default_address":
+			if !reflect.DeepEqual(dst.DefaultAddress, m.DefaultAddress) {
+				dst.DefaultAddress = m.DefaultAddress
+				changed = append(changed, "DefaultAddress")
+			}
+		case "billing_address":
+			if !reflect.DeepEqual(dst.BillingAddress, m.BillingAddress) {
+				dst.BillingAddress = m.BillingAddress
+				changed = append(changed, "BillingAddress")
+			}
+		case "map_field_1":
+			if dst.MapField1 != m.MapField1 {
+				dst.MapField1 = m.MapField1
+				changed = append(changed, "MapField1")
+			}
+		case "map_field_to_without_digits":
+			if dst.MapField2 != m.MapField2 {
+				dst.MapField2 = m.MapField2
+				changed = append(changed, "MapField2")
+			}
+		default:
+			return nil, fmt.Errorf("field mask path %q is not a field of example.Customer", path)
+		}
+	}
+
+	return changed, nil
+}
+
 func CustomerToPbPtr(src *model.Customer, opts ...TransformParam) *example.Customer {
 	if src == nil {
 		return nil
@@ -569,7 +1324,7 @@ func CustomerToPbValPtrList(src []model.Customer, opts ...TransformParam) []*exa
 
 // CustomerToPbList is DEPRECATED. Use CustomerToPbValPtrList instead.
 func CustomerToPbList(src []model.Customer, opts ...TransformParam) []*example.Customer {
-	return CustomerToPbValPtrList(src)
+	return CustomerToPbValPtrList(src, opts...)
 }
 
 func CustomerToPb(src model.Customer, opts ...TransformParam) example.Customer {
@@ -603,20 +1358,79 @@ func CustomerToPbValList(src []model.Customer, opts ...TransformParam) []example
 	return resp
 }
 
-func PbToMyLineItemUsagePtr(src *example.LineItemUsage, opts ...TransformParam) *model.MyLineItemUsage {
-	if src == nil {
-		return nil
-	}
-
-	d := PbToMyLineItemUsage(*src, opts...)
-	return &d
-}
-
-func PbToMyLineItemUsagePtrList(src []*example.LineItemUsage, opts ...TransformParam) []*model.MyLineItemUsage {
-	resp := make([]*model.MyLineItemUsage, len(src))
+func CustomerToPbPtrValList(src []*model.Customer, opts ...TransformParam) []example.Customer {
+	resp := make([]example.Customer, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToMyLineItemUsagePtr(s, opts...)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, CustomerToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// CustomerToPbFieldNames maps model.Customer field names to example.Customer field names.
+var CustomerToPbFieldNames = map[string]string{
+	"ID":             "id",
+	"Name":           "name",
+	"Addresses":      "addresses",
+	"DefaultAddress": "default_address",
+	"BillingAddress": "billing_address",
+	"MapField1":      "map_field_1",
+	"MapField2":      "map_field_to_without_digits",
+}
+
+// CustomerToPbJSONNames maps model.Customer JSON field names to example.Customer JSON field names.
+var CustomerToPbJSONNames = map[string]string{
+	"ID":             "id",
+	"Name":           "name",
+	"Addresses":      "addresses",
+	"DefaultAddress": "defaultAddress",
+	"BillingAddress": "billingAddress",
+	"MapField1":      "mapField1",
+	"MapField2":      "mapFieldToWithoutDigits",
+}
+
+// CustomerToPbRedacted transforms model.Customer into example.Customer, sensitive
+// fields are left empty, so the message can be logged or returned to clients
+// which are not allowed to see them.
+func CustomerToPbRedacted(src model.Customer, opts ...TransformParam) example.Customer {
+	dst := CustomerToPb(src, opts...)
+
+	var empty example.Customer
+	dst.Name = empty.Name
+	dst.BillingAddress = empty.BillingAddress
+
+	return dst
+}
+
+// CustomerToPbRedactedPtr transforms model.Customer pointer into example.Customer pointer, sensitive
+// fields are left empty.
+func CustomerToPbRedactedPtr(src *model.Customer, opts ...TransformParam) *example.Customer {
+	if src == nil {
+		return nil
+	}
+
+	dst := CustomerToPbRedacted(*src, opts...)
+	return &dst
+}
+
+func PbToMyLineItemUsagePtr(src *example.LineItemUsage, opts ...TransformParam) *model.MyLineItemUsage {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToMyLineItemUsage(*src, opts...)
+	return &d
+}
+
+func PbToMyLineItemUsagePtrList(src []*example.LineItemUsage, opts ...TransformParam) []*model.MyLineItemUsage {
+	resp := make([]*model.MyLineItemUsage, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMyLineItemUsagePtr(s, opts...)
 	}
 
 	return resp
@@ -631,10 +1445,13 @@ func PbToMyLineItemUsagePtrVal(src *example.LineItemUsage, opts ...TransformPara
 }
 
 func PbToMyLineItemUsagePtrValList(src []*example.LineItemUsage, opts ...TransformParam) []model.MyLineItemUsage {
-	resp := make([]model.MyLineItemUsage, len(src))
+	resp := make([]model.MyLineItemUsage, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToMyLineItemUsage(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToMyLineItemUsage(*s, opts...))
 	}
 
 	return resp
@@ -642,7 +1459,7 @@ func PbToMyLineItemUsagePtrValList(src []*example.LineItemUsage, opts ...Transfo
 
 // PbToMyLineItemUsageList is DEPRECATED. Use PbToMyLineItemUsagePtrValList instead.
 func PbToMyLineItemUsageList(src []*example.LineItemUsage, opts ...TransformParam) []model.MyLineItemUsage {
-	return PbToMyLineItemUsagePtrValList(src)
+	return PbToMyLineItemUsagePtrValList(src, opts...)
 }
 
 func PbToMyLineItemUsage(src example.LineItemUsage, opts ...TransformParam) model.MyLineItemUsage {
@@ -671,6 +1488,33 @@ func PbToMyLineItemUsageValList(src []example.LineItemUsage, opts ...TransformPa
 	return resp
 }
 
+func PbToMyLineItemUsageValPtrList(src []example.LineItemUsage, opts ...TransformParam) []*model.MyLineItemUsage {
+	resp := make([]*model.MyLineItemUsage, len(src))
+
+	for i, s := range src {
+		g := PbToMyLineItemUsage(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToMyLineItemUsageFieldNames maps example.LineItemUsage field names to model.MyLineItemUsage field names.
+var PbToMyLineItemUsageFieldNames = map[string]string{
+	"Item": "Item",
+	"List": "List",
+}
+
+// PbToMyLineItemUsageJSONNames maps example.LineItemUsage JSON field names to model.MyLineItemUsage JSON field names.
+var PbToMyLineItemUsageJSONNames = map[string]string{
+	"Item": "Item",
+	"List": "List",
+}
+
+// PbToMyLineItemUsageSchemaHash is a hash of fields mapping between example.LineItemUsage and model.MyLineItemUsage.
+// It changes when mapped fields or their types are changed.
+const PbToMyLineItemUsageSchemaHash = "2ef5a772e41fb06061e7c93491ddb486ac7a5b958701a0c699e533ff78292f88"
+
 func MyLineItemUsageToPbPtr(src *model.MyLineItemUsage, opts ...TransformParam) *example.LineItemUsage {
 	if src == nil {
 		return nil
@@ -711,7 +1555,7 @@ func MyLineItemUsageToPbValPtrList(src []model.MyLineItemUsage, opts ...Transfor
 
 // MyLineItemUsageToPbList is DEPRECATED. Use MyLineItemUsageToPbValPtrList instead.
 func MyLineItemUsageToPbList(src []model.MyLineItemUsage, opts ...TransformParam) []*example.LineItemUsage {
-	return MyLineItemUsageToPbValPtrList(src)
+	return MyLineItemUsageToPbValPtrList(src, opts...)
 }
 
 func MyLineItemUsageToPb(src model.MyLineItemUsage, opts ...TransformParam) example.LineItemUsage {
@@ -740,6 +1584,31 @@ func MyLineItemUsageToPbValList(src []model.MyLineItemUsage, opts ...TransformPa
 	return resp
 }
 
+func MyLineItemUsageToPbPtrValList(src []*model.MyLineItemUsage, opts ...TransformParam) []example.LineItemUsage {
+	resp := make([]example.LineItemUsage, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, MyLineItemUsageToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// MyLineItemUsageToPbFieldNames maps model.MyLineItemUsage field names to example.LineItemUsage field names.
+var MyLineItemUsageToPbFieldNames = map[string]string{
+	"Item": "Item",
+	"List": "List",
+}
+
+// MyLineItemUsageToPbJSONNames maps model.MyLineItemUsage JSON field names to example.LineItemUsage JSON field names.
+var MyLineItemUsageToPbJSONNames = map[string]string{
+	"Item": "Item",
+	"List": "List",
+}
+
 func PbToMyLineItemPtr(src *example.LineItem, opts ...TransformParam) *model.MyLineItem {
 	if src == nil {
 		return nil
@@ -768,10 +1637,13 @@ func PbToMyLineItemPtrVal(src *example.LineItem, opts ...TransformParam) model.M
 }
 
 func PbToMyLineItemPtrValList(src []*example.LineItem, opts ...TransformParam) []model.MyLineItem {
-	resp := make([]model.MyLineItem, len(src))
+	resp := make([]model.MyLineItem, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToMyLineItem(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToMyLineItem(*s, opts...))
 	}
 
 	return resp
@@ -779,7 +1651,7 @@ func PbToMyLineItemPtrValList(src []*example.LineItem, opts ...TransformParam) [
 
 // PbToMyLineItemList is DEPRECATED. Use PbToMyLineItemPtrValList instead.
 func PbToMyLineItemList(src []*example.LineItem, opts ...TransformParam) []model.MyLineItem {
-	return PbToMyLineItemPtrValList(src)
+	return PbToMyLineItemPtrValList(src, opts...)
 }
 
 func PbToMyLineItem(src example.LineItem, opts ...TransformParam) model.MyLineItem {
@@ -810,6 +1682,37 @@ func PbToMyLineItemValList(src []example.LineItem, opts ...TransformParam) []mod
 	return resp
 }
 
+func PbToMyLineItemValPtrList(src []example.LineItem, opts ...TransformParam) []*model.MyLineItem {
+	resp := make([]*model.MyLineItem, len(src))
+
+	for i, s := range src {
+		g := PbToMyLineItem(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToMyLineItemFieldNames maps example.LineItem field names to model.MyLineItem field names.
+var PbToMyLineItemFieldNames = map[string]string{
+	"ID":   "ID",
+	"Type": "Type",
+	"URL":  "URL",
+	"SKU":  "SKU",
+}
+
+// PbToMyLineItemJSONNames maps example.LineItem JSON field names to model.MyLineItem JSON field names.
+var PbToMyLineItemJSONNames = map[string]string{
+	"ID":   "ID",
+	"Type": "Type",
+	"URL":  "URL",
+	"SKU":  "SKU",
+}
+
+// PbToMyLineItemSchemaHash is a hash of fields mapping between example.LineItem and model.MyLineItem.
+// It changes when mapped fields or their types are changed.
+const PbToMyLineItemSchemaHash = "7452cf88e091b6ad2724bd231863a0f6c8bd51fee1074588c8652b87b8778457"
+
 func MyLineItemToPbPtr(src *model.MyLineItem, opts ...TransformParam) *example.LineItem {
 	if src == nil {
 		return nil
@@ -850,7 +1753,7 @@ func MyLineItemToPbValPtrList(src []model.MyLineItem, opts ...TransformParam) []
 
 // MyLineItemToPbList is DEPRECATED. Use MyLineItemToPbValPtrList instead.
 func MyLineItemToPbList(src []model.MyLineItem, opts ...TransformParam) []*example.LineItem {
-	return MyLineItemToPbValPtrList(src)
+	return MyLineItemToPbValPtrList(src, opts...)
 }
 
 func MyLineItemToPb(src model.MyLineItem, opts ...TransformParam) example.LineItem {
@@ -881,6 +1784,35 @@ func MyLineItemToPbValList(src []model.MyLineItem, opts ...TransformParam) []exa
 	return resp
 }
 
+func MyLineItemToPbPtrValList(src []*model.MyLineItem, opts ...TransformParam) []example.LineItem {
+	resp := make([]example.LineItem, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, MyLineItemToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// MyLineItemToPbFieldNames maps model.MyLineItem field names to example.LineItem field names.
+var MyLineItemToPbFieldNames = map[string]string{
+	"ID":   "ID",
+	"Type": "Type",
+	"URL":  "URL",
+	"SKU":  "SKU",
+}
+
+// MyLineItemToPbJSONNames maps model.MyLineItem JSON field names to example.LineItem JSON field names.
+var MyLineItemToPbJSONNames = map[string]string{
+	"ID":   "ID",
+	"Type": "Type",
+	"URL":  "URL",
+	"SKU":  "SKU",
+}
+
 func PbToValue2PointerPtr(src *example.Value2Pointer, opts ...TransformParam) *model.Value2Pointer {
 	if src == nil {
 		return nil
@@ -909,10 +1841,13 @@ func PbToValue2PointerPtrVal(src *example.Value2Pointer, opts ...TransformParam)
 }
 
 func PbToValue2PointerPtrValList(src []*example.Value2Pointer, opts ...TransformParam) []model.Value2Pointer {
-	resp := make([]model.Value2Pointer, len(src))
+	resp := make([]model.Value2Pointer, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToValue2Pointer(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToValue2Pointer(*s, opts...))
 	}
 
 	return resp
@@ -920,7 +1855,7 @@ func PbToValue2PointerPtrValList(src []*example.Value2Pointer, opts ...Transform
 
 // PbToValue2PointerList is DEPRECATED. Use PbToValue2PointerPtrValList instead.
 func PbToValue2PointerList(src []*example.Value2Pointer, opts ...TransformParam) []model.Value2Pointer {
-	return PbToValue2PointerPtrValList(src)
+	return PbToValue2PointerPtrValList(src, opts...)
 }
 
 func PbToValue2Pointer(src example.Value2Pointer, opts ...TransformParam) model.Value2Pointer {
@@ -948,6 +1883,31 @@ func PbToValue2PointerValList(src []example.Value2Pointer, opts ...TransformPara
 	return resp
 }
 
+func PbToValue2PointerValPtrList(src []example.Value2Pointer, opts ...TransformParam) []*model.Value2Pointer {
+	resp := make([]*model.Value2Pointer, len(src))
+
+	for i, s := range src {
+		g := PbToValue2Pointer(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToValue2PointerFieldNames maps example.Value2Pointer field names to model.Value2Pointer field names.
+var PbToValue2PointerFieldNames = map[string]string{
+	"address_nil": "AddressNil",
+}
+
+// PbToValue2PointerJSONNames maps example.Value2Pointer JSON field names to model.Value2Pointer JSON field names.
+var PbToValue2PointerJSONNames = map[string]string{
+	"addressNil": "AddressNil",
+}
+
+// PbToValue2PointerSchemaHash is a hash of fields mapping between example.Value2Pointer and model.Value2Pointer.
+// It changes when mapped fields or their types are changed.
+const PbToValue2PointerSchemaHash = "1513fe7f504be84dbfc43278beb17cc454be8b15ce71c32c74cba9a0887ef367"
+
 func Value2PointerToPbPtr(src *model.Value2Pointer, opts ...TransformParam) *example.Value2Pointer {
 	if src == nil {
 		return nil
@@ -988,7 +1948,7 @@ func Value2PointerToPbValPtrList(src []model.Value2Pointer, opts ...TransformPar
 
 // Value2PointerToPbList is DEPRECATED. Use Value2PointerToPbValPtrList instead.
 func Value2PointerToPbList(src []model.Value2Pointer, opts ...TransformParam) []*example.Value2Pointer {
-	return Value2PointerToPbValPtrList(src)
+	return Value2PointerToPbValPtrList(src, opts...)
 }
 
 func Value2PointerToPb(src model.Value2Pointer, opts ...TransformParam) example.Value2Pointer {
@@ -1016,6 +1976,29 @@ func Value2PointerToPbValList(src []model.Value2Pointer, opts ...TransformParam)
 	return resp
 }
 
+func Value2PointerToPbPtrValList(src []*model.Value2Pointer, opts ...TransformParam) []example.Value2Pointer {
+	resp := make([]example.Value2Pointer, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, Value2PointerToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// Value2PointerToPbFieldNames maps model.Value2Pointer field names to example.Value2Pointer field names.
+var Value2PointerToPbFieldNames = map[string]string{
+	"AddressNil": "address_nil",
+}
+
+// Value2PointerToPbJSONNames maps model.Value2Pointer JSON field names to example.Value2Pointer JSON field names.
+var Value2PointerToPbJSONNames = map[string]string{
+	"AddressNil": "addressNil",
+}
+
 func PbToPointer2ValuePtr(src *example.Pointer2Value, opts ...TransformParam) *model.Pointer2Value {
 	if src == nil {
 		return nil
@@ -1044,10 +2027,13 @@ func PbToPointer2ValuePtrVal(src *example.Pointer2Value, opts ...TransformParam)
 }
 
 func PbToPointer2ValuePtrValList(src []*example.Pointer2Value, opts ...TransformParam) []model.Pointer2Value {
-	resp := make([]model.Pointer2Value, len(src))
+	resp := make([]model.Pointer2Value, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToPointer2Value(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToPointer2Value(*s, opts...))
 	}
 
 	return resp
@@ -1055,7 +2041,7 @@ func PbToPointer2ValuePtrValList(src []*example.Pointer2Value, opts ...Transform
 
 // PbToPointer2ValueList is DEPRECATED. Use PbToPointer2ValuePtrValList instead.
 func PbToPointer2ValueList(src []*example.Pointer2Value, opts ...TransformParam) []model.Pointer2Value {
-	return PbToPointer2ValuePtrValList(src)
+	return PbToPointer2ValuePtrValList(src, opts...)
 }
 
 func PbToPointer2Value(src example.Pointer2Value, opts ...TransformParam) model.Pointer2Value {
@@ -1083,6 +2069,31 @@ func PbToPointer2ValueValList(src []example.Pointer2Value, opts ...TransformPara
 	return resp
 }
 
+func PbToPointer2ValueValPtrList(src []example.Pointer2Value, opts ...TransformParam) []*model.Pointer2Value {
+	resp := make([]*model.Pointer2Value, len(src))
+
+	for i, s := range src {
+		g := PbToPointer2Value(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToPointer2ValueFieldNames maps example.Pointer2Value field names to model.Pointer2Value field names.
+var PbToPointer2ValueFieldNames = map[string]string{
+	"address_not_nil": "AddressNotNil",
+}
+
+// PbToPointer2ValueJSONNames maps example.Pointer2Value JSON field names to model.Pointer2Value JSON field names.
+var PbToPointer2ValueJSONNames = map[string]string{
+	"addressNotNil": "AddressNotNil",
+}
+
+// PbToPointer2ValueSchemaHash is a hash of fields mapping between example.Pointer2Value and model.Pointer2Value.
+// It changes when mapped fields or their types are changed.
+const PbToPointer2ValueSchemaHash = "fc15b56f60a53a1b20ab1d52421540180dd60fb5e6628c26b11ea9d6df68e98b"
+
 func Pointer2ValueToPbPtr(src *model.Pointer2Value, opts ...TransformParam) *example.Pointer2Value {
 	if src == nil {
 		return nil
@@ -1123,7 +2134,7 @@ func Pointer2ValueToPbValPtrList(src []model.Pointer2Value, opts ...TransformPar
 
 // Pointer2ValueToPbList is DEPRECATED. Use Pointer2ValueToPbValPtrList instead.
 func Pointer2ValueToPbList(src []model.Pointer2Value, opts ...TransformParam) []*example.Pointer2Value {
-	return Pointer2ValueToPbValPtrList(src)
+	return Pointer2ValueToPbValPtrList(src, opts...)
 }
 
 func Pointer2ValueToPb(src model.Pointer2Value, opts ...TransformParam) example.Pointer2Value {
@@ -1151,6 +2162,29 @@ func Pointer2ValueToPbValList(src []model.Pointer2Value, opts ...TransformParam)
 	return resp
 }
 
+func Pointer2ValueToPbPtrValList(src []*model.Pointer2Value, opts ...TransformParam) []example.Pointer2Value {
+	resp := make([]example.Pointer2Value, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, Pointer2ValueToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// Pointer2ValueToPbFieldNames maps model.Pointer2Value field names to example.Pointer2Value field names.
+var Pointer2ValueToPbFieldNames = map[string]string{
+	"AddressNotNil": "address_not_nil",
+}
+
+// Pointer2ValueToPbJSONNames maps model.Pointer2Value JSON field names to example.Pointer2Value JSON field names.
+var Pointer2ValueToPbJSONNames = map[string]string{
+	"AddressNotNil": "addressNotNil",
+}
+
 func PbToTimeModelPtr(src *example.Timer, opts ...TransformParam) *model.TimeModel {
 	if src == nil {
 		return nil
@@ -1179,10 +2213,13 @@ func PbToTimeModelPtrVal(src *example.Timer, opts ...TransformParam) model.TimeM
 }
 
 func PbToTimeModelPtrValList(src []*example.Timer, opts ...TransformParam) []model.TimeModel {
-	resp := make([]model.TimeModel, len(src))
+	resp := make([]model.TimeModel, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToTimeModel(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToTimeModel(*s, opts...))
 	}
 
 	return resp
@@ -1190,7 +2227,7 @@ func PbToTimeModelPtrValList(src []*example.Timer, opts ...TransformParam) []mod
 
 // PbToTimeModelList is DEPRECATED. Use PbToTimeModelPtrValList instead.
 func PbToTimeModelList(src []*example.Timer, opts ...TransformParam) []model.TimeModel {
-	return PbToTimeModelPtrValList(src)
+	return PbToTimeModelPtrValList(src, opts...)
 }
 
 func PbToTimeModel(src example.Timer, opts ...TransformParam) model.TimeModel {
@@ -1205,6 +2242,24 @@ func PbToTimeModel(src example.Timer, opts ...TransformParam) model.TimeModel {
 
 	applyOptions(opts...)
 
+	if !src.TimeToPtr.IsZero() {
+		v := src.TimeToPtr
+		s.TimePtr = &v
+	}
+
+	if src.PtrToTime != nil {
+		s.PtrTime = *src.PtrToTime
+	}
+
+	if src.CreatedAt != nil {
+		s.CreatedAt = time.Unix(src.CreatedAt.Seconds, int64(src.CreatedAt.Nanos)).UTC()
+	}
+
+	if src.UpdatedAt != nil {
+		t := time.Unix(src.UpdatedAt.Seconds, int64(src.UpdatedAt.Nanos)).UTC()
+		s.UpdatedAt = &t
+	}
+
 	return s
 }
 
@@ -1223,6 +2278,49 @@ func PbToTimeModelValList(src []example.Timer, opts ...TransformParam) []model.T
 	return resp
 }
 
+func PbToTimeModelValPtrList(src []example.Timer, opts ...TransformParam) []*model.TimeModel {
+	resp := make([]*model.TimeModel, len(src))
+
+	for i, s := range src {
+		g := PbToTimeModel(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToTimeModelFieldNames maps example.Timer field names to model.TimeModel field names.
+var PbToTimeModelFieldNames = map[string]string{
+	"time":                   "TimeTime",
+	"ptr_time":               "PtrTimeTime",
+	"time_to_struct":         "NullsTime",
+	"time_to_struct_ptr":     "PtrNullsTime",
+	"time_ptr_to_struct":     "NullsTime2",
+	"time_ptr_to_ptr_struct": "PtrNullsTime2",
+	"time_to_ptr":            "TimePtr",
+	"ptr_to_time":            "PtrTime",
+	"created_at":             "CreatedAt",
+	"updated_at":             "UpdatedAt",
+}
+
+// PbToTimeModelJSONNames maps example.Timer JSON field names to model.TimeModel JSON field names.
+var PbToTimeModelJSONNames = map[string]string{
+	"time":               "TimeTime",
+	"ptrTime":            "PtrTimeTime",
+	"timeToStruct":       "NullsTime",
+	"timeToStructPtr":    "PtrNullsTime",
+	"timePtrToStruct":    "NullsTime2",
+	"timePtrToPtrStruct": "PtrNullsTime2",
+	"timeToPtr":          "TimePtr",
+	"ptrToTime":          "PtrTime",
+	"createdAt":          "CreatedAt",
+	"updatedAt":          "UpdatedAt",
+}
+
+// PbToTimeModelSchemaHash is a hash of fields mapping between example.Timer and model.TimeModel.
+// It changes when mapped fields or their types are changed.
+const PbToTimeModelSchemaHash = "2cb6a55e2e36b535f0b8950da42379ee529ee8bc2f81eeb3587ce9040d67b2f2"
+
 func TimeModelToPbPtr(src *model.TimeModel, opts ...TransformParam) *example.Timer {
 	if src == nil {
 		return nil
@@ -1263,7 +2361,7 @@ func TimeModelToPbValPtrList(src []model.TimeModel, opts ...TransformParam) []*e
 
 // TimeModelToPbList is DEPRECATED. Use TimeModelToPbValPtrList instead.
 func TimeModelToPbList(src []model.TimeModel, opts ...TransformParam) []*example.Timer {
-	return TimeModelToPbValPtrList(src)
+	return TimeModelToPbValPtrList(src, opts...)
 }
 
 func TimeModelToPb(src model.TimeModel, opts ...TransformParam) example.Timer {
@@ -1278,6 +2376,23 @@ func TimeModelToPb(src model.TimeModel, opts ...TransformParam) example.Timer {
 
 	applyOptions(opts...)
 
+	if src.TimePtr != nil {
+		s.TimeToPtr = *src.TimePtr
+	}
+
+	if !src.PtrTime.IsZero() {
+		v := src.PtrTime
+		s.PtrToTime = &v
+	}
+
+	if !src.CreatedAt.IsZero() {
+		s.CreatedAt = &types.Timestamp{Seconds: src.CreatedAt.Unix(), Nanos: int32(src.CreatedAt.Nanosecond())}
+	}
+
+	if src.UpdatedAt != nil {
+		s.UpdatedAt = &types.Timestamp{Seconds: src.UpdatedAt.Unix(), Nanos: int32(src.UpdatedAt.Nanosecond())}
+	}
+
 	return s
 }
 
@@ -1296,6 +2411,47 @@ func TimeModelToPbValList(src []model.TimeModel, opts ...TransformParam) []examp
 	return resp
 }
 
+func TimeModelToPbPtrValList(src []*model.TimeModel, opts ...TransformParam) []example.Timer {
+	resp := make([]example.Timer, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, TimeModelToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// TimeModelToPbFieldNames maps model.TimeModel field names to example.Timer field names.
+var TimeModelToPbFieldNames = map[string]string{
+	"TimeTime":      "time",
+	"PtrTimeTime":   "ptr_time",
+	"NullsTime":     "time_to_struct",
+	"PtrNullsTime":  "time_to_struct_ptr",
+	"NullsTime2":    "time_ptr_to_struct",
+	"PtrNullsTime2": "time_ptr_to_ptr_struct",
+	"TimePtr":       "time_to_ptr",
+	"PtrTime":       "ptr_to_time",
+	"CreatedAt":     "created_at",
+	"UpdatedAt":     "updated_at",
+}
+
+// TimeModelToPbJSONNames maps model.TimeModel JSON field names to example.Timer JSON field names.
+var TimeModelToPbJSONNames = map[string]string{
+	"TimeTime":      "time",
+	"PtrTimeTime":   "ptrTime",
+	"NullsTime":     "timeToStruct",
+	"PtrNullsTime":  "timeToStructPtr",
+	"NullsTime2":    "timePtrToStruct",
+	"PtrNullsTime2": "timePtrToPtrStruct",
+	"TimePtr":       "timeToPtr",
+	"PtrTime":       "ptrToTime",
+	"CreatedAt":     "createdAt",
+	"UpdatedAt":     "updatedAt",
+}
+
 func PbToIntsModelPtr(src *example.Ints, opts ...TransformParam) *model.IntsModel {
 	if src == nil {
 		return nil
@@ -1324,10 +2480,13 @@ func PbToIntsModelPtrVal(src *example.Ints, opts ...TransformParam) model.IntsMo
 }
 
 func PbToIntsModelPtrValList(src []*example.Ints, opts ...TransformParam) []model.IntsModel {
-	resp := make([]model.IntsModel, len(src))
+	resp := make([]model.IntsModel, 0, len(src))
 
-	for i, s := range src {
-		resp[i] = PbToIntsModel(*s)
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToIntsModel(*s, opts...))
 	}
 
 	return resp
@@ -1335,7 +2494,7 @@ func PbToIntsModelPtrValList(src []*example.Ints, opts ...TransformParam) []mode
 
 // PbToIntsModelList is DEPRECATED. Use PbToIntsModelPtrValList instead.
 func PbToIntsModelList(src []*example.Ints, opts ...TransformParam) []model.IntsModel {
-	return PbToIntsModelPtrValList(src)
+	return PbToIntsModelPtrValList(src, opts...)
 }
 
 func PbToIntsModel(src example.Ints, opts ...TransformParam) model.IntsModel {
@@ -1367,6 +2526,39 @@ func PbToIntsModelValList(src []example.Ints, opts ...TransformParam) []model.In
 	return resp
 }
 
+func PbToIntsModelValPtrList(src []example.Ints, opts ...TransformParam) []*model.IntsModel {
+	resp := make([]*model.IntsModel, len(src))
+
+	for i, s := range src {
+		g := PbToIntsModel(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToIntsModelFieldNames maps example.Ints field names to model.IntsModel field names.
+var PbToIntsModelFieldNames = map[string]string{
+	"int_for_32_value": "IntFor32Value",
+	"int_for_64_value": "IntFor64Value",
+	"int32_value":      "Int32Value",
+	"int64_value":      "Int64Value",
+	"string_value":     "StringValue",
+}
+
+// PbToIntsModelJSONNames maps example.Ints JSON field names to model.IntsModel JSON field names.
+var PbToIntsModelJSONNames = map[string]string{
+	"intFor32Value": "IntFor32Value",
+	"intFor64Value": "IntFor64Value",
+	"int32Value":    "Int32Value",
+	"int64Value":    "Int64Value",
+	"stringValue":   "StringValue",
+}
+
+// PbToIntsModelSchemaHash is a hash of fields mapping between example.Ints and model.IntsModel.
+// It changes when mapped fields or their types are changed.
+const PbToIntsModelSchemaHash = "79b087d0a171ef937e05924827d42af910f1ab14277a906e57c581918e0788dc"
+
 func IntsModelToPbPtr(src *model.IntsModel, opts ...TransformParam) *example.Ints {
 	if src == nil {
 		return nil
@@ -1407,7 +2599,7 @@ func IntsModelToPbValPtrList(src []model.IntsModel, opts ...TransformParam) []*e
 
 // IntsModelToPbList is DEPRECATED. Use IntsModelToPbValPtrList instead.
 func IntsModelToPbList(src []model.IntsModel, opts ...TransformParam) []*example.Ints {
-	return IntsModelToPbValPtrList(src)
+	return IntsModelToPbValPtrList(src, opts...)
 }
 
 func IntsModelToPb(src model.IntsModel, opts ...TransformParam) example.Ints {
@@ -1439,30 +2631,6045 @@ func IntsModelToPbValList(src []model.IntsModel, opts ...TransformParam) []examp
 	return resp
 }
 
-type OneofTheDecl interface {
-	GetStringValue() string
-	GetInt64Value() int64
+func IntsModelToPbPtrValList(src []*model.IntsModel, opts ...TransformParam) []example.Ints {
+	resp := make([]example.Ints, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, IntsModelToPb(*s, opts...))
+	}
+
+	return resp
 }
 
-func TheOneToString(src OneofTheDecl) string {
-	if s := src.GetStringValue(); s != "" {
-		return s
+// IntsModelToPbFieldNames maps model.IntsModel field names to example.Ints field names.
+var IntsModelToPbFieldNames = map[string]string{
+	"IntFor32Value": "int_for_32_value",
+	"IntFor64Value": "int_for_64_value",
+	"Int32Value":    "int32_value",
+	"Int64Value":    "int64_value",
+	"StringValue":   "string_value",
+}
+
+// IntsModelToPbJSONNames maps model.IntsModel JSON field names to example.Ints JSON field names.
+var IntsModelToPbJSONNames = map[string]string{
+	"IntFor32Value": "intFor32Value",
+	"IntFor64Value": "intFor64Value",
+	"Int32Value":    "int32Value",
+	"Int64Value":    "int64Value",
+	"StringValue":   "stringValue",
+}
+
+func PbToStorePtr(src *example.Store, opts ...TransformParam) *model.Store {
+	if src == nil {
+		return nil
 	}
 
-	if i := src.GetInt64Value(); i != 0 {
-		return strconv.FormatInt(i, 10)
+	d := PbToStore(*src, opts...)
+	return &d
+}
+
+func PbToStorePtrList(src []*example.Store, opts ...TransformParam) []*model.Store {
+	resp := make([]*model.Store, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToStorePtr(s, opts...)
 	}
 
-	return "<nil>"
+	return resp
 }
 
-func StringToTheOne(s string, dst *example.TheOne, v string) {
-	i, err := strconv.ParseInt(s, 10, 64)
-	if err != nil || v == "v2" {
-		dst.TheDecl = &example.TheOne_StringValue{StringValue: s}
-		return
+func PbToStorePtrVal(src *example.Store, opts ...TransformParam) model.Store {
+	if src == nil {
+		return model.Store{}
 	}
 
-	dst.TheDecl = &example.TheOne_Int64Value{Int64Value: i}
-	return
+	return PbToStore(*src, opts...)
+}
+
+func PbToStorePtrValList(src []*example.Store, opts ...TransformParam) []model.Store {
+	resp := make([]model.Store, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToStore(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToStoreList is DEPRECATED. Use PbToStorePtrValList instead.
+func PbToStoreList(src []*example.Store, opts ...TransformParam) []model.Store {
+	return PbToStorePtrValList(src, opts...)
+}
+
+func PbToStore(src example.Store, opts ...TransformParam) model.Store {
+	s := model.Store{
+		ID:              int(src.Id),
+		LocationCity:    src.GetLocation().GetCity(),
+		LocationCountry: src.GetLocation().GetCountry(),
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToStoreValPtr(src example.Store, opts ...TransformParam) *model.Store {
+	d := PbToStore(src, opts...)
+	return &d
+}
+
+func PbToStoreValList(src []example.Store, opts ...TransformParam) []model.Store {
+	resp := make([]model.Store, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToStore(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToStoreValPtrList(src []example.Store, opts ...TransformParam) []*model.Store {
+	resp := make([]*model.Store, len(src))
+
+	for i, s := range src {
+		g := PbToStore(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToStoreFieldNames maps example.Store field names to model.Store field names.
+var PbToStoreFieldNames = map[string]string{
+	"id":               "ID",
+	"location.city":    "LocationCity",
+	"location.country": "LocationCountry",
+}
+
+// PbToStoreJSONNames maps example.Store JSON field names to model.Store JSON field names.
+var PbToStoreJSONNames = map[string]string{
+	"id":               "ID",
+	"location.city":    "LocationCity",
+	"location.country": "LocationCountry",
+}
+
+// PbToStoreSchemaHash is a hash of fields mapping between example.Store and model.Store.
+// It changes when mapped fields or their types are changed.
+const PbToStoreSchemaHash = "08c55531ced05ecb61e5b3ca85411828061083ffde0af72d17c38f761a658756"
+
+// StorePatch contains model.Store fields which are present in example.Store, nil field is not present.
+type StorePatch struct {
+	ID              *int
+	LocationCity    *string
+	LocationCountry *string
+}
+
+// PbToStorePatch returns StorePatch with fields which are present in src. Message
+// fields are present if they are not nil, repeated, string and bytes fields if
+// they are not empty, other scalar fields if they have non-zero values.
+func PbToStorePatch(src *example.Store, opts ...TransformParam) StorePatch {
+	patch := StorePatch{}
+	if src == nil {
+		return patch
+	}
+
+	m := PbToStorePtr(src, opts...)
+	if src.Id != 0 {
+		patch.ID = &m.ID
+	}
+	if src.Location != nil && src.Location.City != "" {
+		patch.LocationCity = &m.LocationCity
+	}
+	if src.Location != nil && src.Location.Country != "" {
+		patch.LocationCountry = &m.LocationCountry
+	}
+
+	return patch
+}
+
+// Apply sets dst fields which are present in patch.
+func (p StorePatch) Apply(dst *model.Store) {
+	if p.ID != nil {
+		dst.ID = *p.ID
+	}
+	if p.LocationCity != nil {
+		dst.LocationCity = *p.LocationCity
+	}
+	if p.LocationCountry != nil {
+		dst.LocationCountry = *p.LocationCountry
+	}
+}
+
+// ApplyChanges sets dst fields which are present in patch and returns names
+// of dst fields which values are changed, e.g. for audit logs.
+func (p StorePatch) ApplyChanges(dst *model.Store) []string {
+	var changed []string
+	if p.ID != nil && dst.ID != *p.ID {
+		dst.ID = *p.ID
+		changed = append(changed, "ID")
+	}
+	if p.LocationCity != nil && dst.LocationCity != *p.LocationCity {
+		dst.LocationCity = *p.LocationCity
+		changed = append(changed, "LocationCity")
+	}
+	if p.LocationCountry != nil && dst.LocationCountry != *p.LocationCountry {
+		dst.LocationCountry = *p.LocationCountry
+		changed = append(changed, "LocationCountry")
+	}
+
+	return changed
+}
+
+func StoreToPbPtr(src *model.Store, opts ...TransformParam) *example.Store {
+	if src == nil {
+		return nil
+	}
+
+	d := StoreToPb(*src, opts...)
+	return &d
+}
+
+func StoreToPbPtrList(src []*model.Store, opts ...TransformParam) []*example.Store {
+	resp := make([]*example.Store, len(src))
+
+	for i, s := range src {
+		resp[i] = StoreToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func StoreToPbPtrVal(src *model.Store, opts ...TransformParam) example.Store {
+	if src == nil {
+		return example.Store{}
+	}
+
+	return StoreToPb(*src, opts...)
+}
+
+func StoreToPbValPtrList(src []model.Store, opts ...TransformParam) []*example.Store {
+	resp := make([]*example.Store, len(src))
+
+	for i, s := range src {
+		g := StoreToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// StoreToPbList is DEPRECATED. Use StoreToPbValPtrList instead.
+func StoreToPbList(src []model.Store, opts ...TransformParam) []*example.Store {
+	return StoreToPbValPtrList(src, opts...)
+}
+
+func StoreToPb(src model.Store, opts ...TransformParam) example.Store {
+	s := example.Store{
+		Id: int64(src.ID),
+		Location: &example.Location{
+			City:    src.LocationCity,
+			Country: src.LocationCountry,
+		},
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func StoreToPbValPtr(src model.Store, opts ...TransformParam) *example.Store {
+	d := StoreToPb(src, opts...)
+	return &d
+}
+
+func StoreToPbValList(src []model.Store, opts ...TransformParam) []example.Store {
+	resp := make([]example.Store, len(src))
+
+	for i, s := range src {
+		resp[i] = StoreToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func StoreToPbPtrValList(src []*model.Store, opts ...TransformParam) []example.Store {
+	resp := make([]example.Store, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, StoreToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// StoreToPbFieldNames maps model.Store field names to example.Store field names.
+var StoreToPbFieldNames = map[string]string{
+	"ID":              "id",
+	"LocationCity":    "location.city",
+	"LocationCountry": "location.country",
+}
+
+// StoreToPbJSONNames maps model.Store JSON field names to example.Store JSON field names.
+var StoreToPbJSONNames = map[string]string{
+	"ID":              "id",
+	"LocationCity":    "location.city",
+	"LocationCountry": "location.country",
+}
+
+func PbToLabelsPtr(src *example.Labels, opts ...TransformParam) *model.Labels {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToLabels(*src, opts...)
+	return &d
+}
+
+func PbToLabelsPtrList(src []*example.Labels, opts ...TransformParam) []*model.Labels {
+	resp := make([]*model.Labels, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToLabelsPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToLabelsPtrVal(src *example.Labels, opts ...TransformParam) model.Labels {
+	if src == nil {
+		return model.Labels{}
+	}
+
+	return PbToLabels(*src, opts...)
+}
+
+func PbToLabelsPtrValList(src []*example.Labels, opts ...TransformParam) []model.Labels {
+	resp := make([]model.Labels, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToLabels(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToLabelsList is DEPRECATED. Use PbToLabelsPtrValList instead.
+func PbToLabelsList(src []*example.Labels, opts ...TransformParam) []model.Labels {
+	return PbToLabelsPtrValList(src, opts...)
+}
+
+func PbToLabels(src example.Labels, opts ...TransformParam) model.Labels {
+	s := model.Labels{
+		Tags: src.Tags,
+	}
+
+	applyOptions(opts...)
+
+	if src.Names != nil {
+		s.Names = make([]string, len(src.Names))
+		for i, v := range src.Names {
+			s.Names[i] = v.GetValue()
+		}
+	} else {
+		s.Names = []string{}
+	}
+
+	if src.Counters != nil {
+		s.Counters = make([]*int64, len(src.Counters))
+		for i, v := range src.Counters {
+			if v == nil {
+				continue
+			}
+			e := v.GetValue()
+			s.Counters[i] = &e
+		}
+	} else {
+		s.Counters = []*int64{}
+	}
+
+	if src.Title != nil {
+		s.Title = new(string)
+		*s.Title = src.Title.Value
+	}
+
+	if src.Priority != nil {
+		s.Priority = new(int64)
+		*s.Priority = src.Priority.Value
+	}
+
+	s.Pinned = src.Pinned.GetValue()
+
+	if s.Tags == nil {
+		s.Tags = []string{}
+	}
+
+	return s
+}
+
+func PbToLabelsValPtr(src example.Labels, opts ...TransformParam) *model.Labels {
+	d := PbToLabels(src, opts...)
+	return &d
+}
+
+func PbToLabelsValList(src []example.Labels, opts ...TransformParam) []model.Labels {
+	resp := make([]model.Labels, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToLabels(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToLabelsValPtrList(src []example.Labels, opts ...TransformParam) []*model.Labels {
+	resp := make([]*model.Labels, len(src))
+
+	for i, s := range src {
+		g := PbToLabels(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToLabelsFieldNames maps example.Labels field names to model.Labels field names.
+var PbToLabelsFieldNames = map[string]string{
+	"names":    "Names",
+	"counters": "Counters",
+	"title":    "Title",
+	"priority": "Priority",
+	"pinned":   "Pinned",
+	"tags":     "Tags",
+}
+
+// PbToLabelsJSONNames maps example.Labels JSON field names to model.Labels JSON field names.
+var PbToLabelsJSONNames = map[string]string{
+	"names":    "Names",
+	"counters": "Counters",
+	"title":    "Title",
+	"priority": "Priority",
+	"pinned":   "Pinned",
+	"tags":     "Tags",
+}
+
+// PbToLabelsSchemaHash is a hash of fields mapping between example.Labels and model.Labels.
+// It changes when mapped fields or their types are changed.
+const PbToLabelsSchemaHash = "a954570482fcddec57a961f0d6eff8cb63381580b3bb88c4efa2b4eff14aa81e"
+
+func LabelsToPbPtr(src *model.Labels, opts ...TransformParam) *example.Labels {
+	if src == nil {
+		return nil
+	}
+
+	d := LabelsToPb(*src, opts...)
+	return &d
+}
+
+func LabelsToPbPtrList(src []*model.Labels, opts ...TransformParam) []*example.Labels {
+	resp := make([]*example.Labels, len(src))
+
+	for i, s := range src {
+		resp[i] = LabelsToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func LabelsToPbPtrVal(src *model.Labels, opts ...TransformParam) example.Labels {
+	if src == nil {
+		return example.Labels{}
+	}
+
+	return LabelsToPb(*src, opts...)
+}
+
+func LabelsToPbValPtrList(src []model.Labels, opts ...TransformParam) []*example.Labels {
+	resp := make([]*example.Labels, len(src))
+
+	for i, s := range src {
+		g := LabelsToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// LabelsToPbList is DEPRECATED. Use LabelsToPbValPtrList instead.
+func LabelsToPbList(src []model.Labels, opts ...TransformParam) []*example.Labels {
+	return LabelsToPbValPtrList(src, opts...)
+}
+
+func LabelsToPb(src model.Labels, opts ...TransformParam) example.Labels {
+	s := example.Labels{
+		Tags: src.Tags,
+	}
+
+	applyOptions(opts...)
+
+	if src.Names != nil {
+		s.Names = make([]*types.StringValue, len(src.Names))
+		for i, v := range src.Names {
+			e := types.StringValue{Value: v}
+			s.Names[i] = &e
+		}
+	} else {
+		s.Names = []*types.StringValue{}
+	}
+
+	if src.Counters != nil {
+		s.Counters = make([]*types.Int64Value, len(src.Counters))
+		for i, v := range src.Counters {
+			if v == nil {
+				continue
+			}
+			e := types.Int64Value{Value: *v}
+			s.Counters[i] = &e
+		}
+	} else {
+		s.Counters = []*types.Int64Value{}
+	}
+
+	if src.Title != nil {
+		s.Title = &types.StringValue{Value: *src.Title}
+	}
+
+	if src.Priority != nil {
+		s.Priority = &types.Int64Value{Value: *src.Priority}
+	}
+
+	s.Pinned = &types.BoolValue{Value: src.Pinned}
+
+	if s.Tags == nil {
+		s.Tags = []string{}
+	}
+
+	return s
+}
+
+func LabelsToPbValPtr(src model.Labels, opts ...TransformParam) *example.Labels {
+	d := LabelsToPb(src, opts...)
+	return &d
+}
+
+func LabelsToPbValList(src []model.Labels, opts ...TransformParam) []example.Labels {
+	resp := make([]example.Labels, len(src))
+
+	for i, s := range src {
+		resp[i] = LabelsToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func LabelsToPbPtrValList(src []*model.Labels, opts ...TransformParam) []example.Labels {
+	resp := make([]example.Labels, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, LabelsToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// LabelsToPbFieldNames maps model.Labels field names to example.Labels field names.
+var LabelsToPbFieldNames = map[string]string{
+	"Names":    "names",
+	"Counters": "counters",
+	"Title":    "title",
+	"Priority": "priority",
+	"Pinned":   "pinned",
+	"Tags":     "tags",
+}
+
+// LabelsToPbJSONNames maps model.Labels JSON field names to example.Labels JSON field names.
+var LabelsToPbJSONNames = map[string]string{
+	"Names":    "names",
+	"Counters": "counters",
+	"Title":    "title",
+	"Priority": "priority",
+	"Pinned":   "pinned",
+	"Tags":     "tags",
+}
+
+func PbToSchedulePtr(src *example.Schedule, opts ...TransformParam) *model.Schedule {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToSchedule(*src, opts...)
+	return &d
+}
+
+func PbToSchedulePtrList(src []*example.Schedule, opts ...TransformParam) []*model.Schedule {
+	resp := make([]*model.Schedule, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToSchedulePtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToSchedulePtrVal(src *example.Schedule, opts ...TransformParam) model.Schedule {
+	if src == nil {
+		return model.Schedule{}
+	}
+
+	return PbToSchedule(*src, opts...)
+}
+
+func PbToSchedulePtrValList(src []*example.Schedule, opts ...TransformParam) []model.Schedule {
+	resp := make([]model.Schedule, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToSchedule(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToScheduleList is DEPRECATED. Use PbToSchedulePtrValList instead.
+func PbToScheduleList(src []*example.Schedule, opts ...TransformParam) []model.Schedule {
+	return PbToSchedulePtrValList(src, opts...)
+}
+
+func PbToSchedule(src example.Schedule, opts ...TransformParam) model.Schedule {
+	s := model.Schedule{}
+
+	applyOptions(opts...)
+
+	if src.Dates != nil {
+		s.Dates = make([]time.Time, len(src.Dates))
+		for i, v := range src.Dates {
+			s.Dates[i] = v
+		}
+	}
+
+	if src.Deadlines != nil {
+		s.Deadlines = make(map[string]nulls.Time, len(src.Deadlines))
+		for k, v := range src.Deadlines {
+			if v == nil {
+				continue
+			}
+			s.Deadlines[k] = helpers.TimeToNullsTime(*v)
+		}
+	}
+
+	if src.Notes != nil {
+		s.Notes = make(map[int64]string, len(src.Notes))
+		for k, v := range src.Notes {
+			s.Notes[k] = v.GetValue()
+		}
+	}
+
+	return s
+}
+
+func PbToScheduleValPtr(src example.Schedule, opts ...TransformParam) *model.Schedule {
+	d := PbToSchedule(src, opts...)
+	return &d
+}
+
+func PbToScheduleValList(src []example.Schedule, opts ...TransformParam) []model.Schedule {
+	resp := make([]model.Schedule, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToSchedule(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToScheduleValPtrList(src []example.Schedule, opts ...TransformParam) []*model.Schedule {
+	resp := make([]*model.Schedule, len(src))
+
+	for i, s := range src {
+		g := PbToSchedule(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToScheduleFieldNames maps example.Schedule field names to model.Schedule field names.
+var PbToScheduleFieldNames = map[string]string{
+	"dates":     "Dates",
+	"deadlines": "Deadlines",
+	"notes":     "Notes",
+}
+
+// PbToScheduleJSONNames maps example.Schedule JSON field names to model.Schedule JSON field names.
+var PbToScheduleJSONNames = map[string]string{
+	"dates":     "Dates",
+	"deadlines": "Deadlines",
+	"notes":     "Notes",
+}
+
+// PbToScheduleSchemaHash is a hash of fields mapping between example.Schedule and model.Schedule.
+// It changes when mapped fields or their types are changed.
+const PbToScheduleSchemaHash = "0e9dc1075222ecb7438c82a8cc46244e3573cce1b51a3d5a388a4b80cf94d70e"
+
+func ScheduleToPbPtr(src *model.Schedule, opts ...TransformParam) *example.Schedule {
+	if src == nil {
+		return nil
+	}
+
+	d := ScheduleToPb(*src, opts...)
+	return &d
+}
+
+func ScheduleToPbPtrList(src []*model.Schedule, opts ...TransformParam) []*example.Schedule {
+	resp := make([]*example.Schedule, len(src))
+
+	for i, s := range src {
+		resp[i] = ScheduleToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func ScheduleToPbPtrVal(src *model.Schedule, opts ...TransformParam) example.Schedule {
+	if src == nil {
+		return example.Schedule{}
+	}
+
+	return ScheduleToPb(*src, opts...)
+}
+
+func ScheduleToPbValPtrList(src []model.Schedule, opts ...TransformParam) []*example.Schedule {
+	resp := make([]*example.Schedule, len(src))
+
+	for i, s := range src {
+		g := ScheduleToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// ScheduleToPbList is DEPRECATED. Use ScheduleToPbValPtrList instead.
+func ScheduleToPbList(src []model.Schedule, opts ...TransformParam) []*example.Schedule {
+	return ScheduleToPbValPtrList(src, opts...)
+}
+
+func ScheduleToPb(src model.Schedule, opts ...TransformParam) example.Schedule {
+	s := example.Schedule{}
+
+	applyOptions(opts...)
+
+	if src.Dates != nil {
+		s.Dates = make([]time.Time, len(src.Dates))
+		for i, v := range src.Dates {
+			s.Dates[i] = v
+		}
+	}
+
+	if src.Deadlines != nil {
+		s.Deadlines = make(map[string]*time.Time, len(src.Deadlines))
+		for k, v := range src.Deadlines {
+			e := helpers.NullsTimeToTime(v)
+			s.Deadlines[k] = &e
+		}
+	}
+
+	if src.Notes != nil {
+		s.Notes = make(map[int64]*types.StringValue, len(src.Notes))
+		for k, v := range src.Notes {
+			e := types.StringValue{Value: v}
+			s.Notes[k] = &e
+		}
+	}
+
+	return s
+}
+
+func ScheduleToPbValPtr(src model.Schedule, opts ...TransformParam) *example.Schedule {
+	d := ScheduleToPb(src, opts...)
+	return &d
+}
+
+func ScheduleToPbValList(src []model.Schedule, opts ...TransformParam) []example.Schedule {
+	resp := make([]example.Schedule, len(src))
+
+	for i, s := range src {
+		resp[i] = ScheduleToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func ScheduleToPbPtrValList(src []*model.Schedule, opts ...TransformParam) []example.Schedule {
+	resp := make([]example.Schedule, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, ScheduleToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// ScheduleToPbFieldNames maps model.Schedule field names to example.Schedule field names.
+var ScheduleToPbFieldNames = map[string]string{
+	"Dates":     "dates",
+	"Deadlines": "deadlines",
+	"Notes":     "notes",
+}
+
+// ScheduleToPbJSONNames maps model.Schedule JSON field names to example.Schedule JSON field names.
+var ScheduleToPbJSONNames = map[string]string{
+	"Dates":     "dates",
+	"Deadlines": "deadlines",
+	"Notes":     "notes",
+}
+
+func PbToOperationPtr(src *example.Operation, opts ...TransformParam) *model.Operation {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToOperation(*src, opts...)
+	return &d
+}
+
+func PbToOperationPtrList(src []*example.Operation, opts ...TransformParam) []*model.Operation {
+	resp := make([]*model.Operation, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToOperationPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToOperationPtrVal(src *example.Operation, opts ...TransformParam) model.Operation {
+	if src == nil {
+		return model.Operation{}
+	}
+
+	return PbToOperation(*src, opts...)
+}
+
+func PbToOperationPtrValList(src []*example.Operation, opts ...TransformParam) []model.Operation {
+	resp := make([]model.Operation, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToOperation(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToOperationList is DEPRECATED. Use PbToOperationPtrValList instead.
+func PbToOperationList(src []*example.Operation, opts ...TransformParam) []model.Operation {
+	return PbToOperationPtrValList(src, opts...)
+}
+
+func PbToOperation(src example.Operation, opts ...TransformParam) model.Operation {
+	s := model.Operation{
+		ID:     src.Id,
+		Result: StatusToError(src.Result),
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToOperationValPtr(src example.Operation, opts ...TransformParam) *model.Operation {
+	d := PbToOperation(src, opts...)
+	return &d
+}
+
+func PbToOperationValList(src []example.Operation, opts ...TransformParam) []model.Operation {
+	resp := make([]model.Operation, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToOperation(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToOperationValPtrList(src []example.Operation, opts ...TransformParam) []*model.Operation {
+	resp := make([]*model.Operation, len(src))
+
+	for i, s := range src {
+		g := PbToOperation(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToOperationFieldNames maps example.Operation field names to model.Operation field names.
+var PbToOperationFieldNames = map[string]string{
+	"id":     "ID",
+	"result": "Result",
+}
+
+// PbToOperationJSONNames maps example.Operation JSON field names to model.Operation JSON field names.
+var PbToOperationJSONNames = map[string]string{
+	"id":     "ID",
+	"result": "Result",
+}
+
+// PbToOperationSchemaHash is a hash of fields mapping between example.Operation and model.Operation.
+// It changes when mapped fields or their types are changed.
+const PbToOperationSchemaHash = "9510ac59331e7811b72abcbe1210f71d1293b07ac0463c5591ce3b77432fc86b"
+
+func OperationToPbPtr(src *model.Operation, opts ...TransformParam) *example.Operation {
+	if src == nil {
+		return nil
+	}
+
+	d := OperationToPb(*src, opts...)
+	return &d
+}
+
+func OperationToPbPtrList(src []*model.Operation, opts ...TransformParam) []*example.Operation {
+	resp := make([]*example.Operation, len(src))
+
+	for i, s := range src {
+		resp[i] = OperationToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func OperationToPbPtrVal(src *model.Operation, opts ...TransformParam) example.Operation {
+	if src == nil {
+		return example.Operation{}
+	}
+
+	return OperationToPb(*src, opts...)
+}
+
+func OperationToPbValPtrList(src []model.Operation, opts ...TransformParam) []*example.Operation {
+	resp := make([]*example.Operation, len(src))
+
+	for i, s := range src {
+		g := OperationToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// OperationToPbList is DEPRECATED. Use OperationToPbValPtrList instead.
+func OperationToPbList(src []model.Operation, opts ...TransformParam) []*example.Operation {
+	return OperationToPbValPtrList(src, opts...)
+}
+
+func OperationToPb(src model.Operation, opts ...TransformParam) example.Operation {
+	s := example.Operation{
+		Id:     src.ID,
+		Result: ErrorToStatus(src.Result),
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func OperationToPbValPtr(src model.Operation, opts ...TransformParam) *example.Operation {
+	d := OperationToPb(src, opts...)
+	return &d
+}
+
+func OperationToPbValList(src []model.Operation, opts ...TransformParam) []example.Operation {
+	resp := make([]example.Operation, len(src))
+
+	for i, s := range src {
+		resp[i] = OperationToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func OperationToPbPtrValList(src []*model.Operation, opts ...TransformParam) []example.Operation {
+	resp := make([]example.Operation, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, OperationToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// OperationToPbFieldNames maps model.Operation field names to example.Operation field names.
+var OperationToPbFieldNames = map[string]string{
+	"ID":     "id",
+	"Result": "result",
+}
+
+// OperationToPbJSONNames maps model.Operation JSON field names to example.Operation JSON field names.
+var OperationToPbJSONNames = map[string]string{
+	"ID":     "id",
+	"Result": "result",
+}
+
+func PbToTicketPtr(src *example.Ticket, opts ...TransformParam) *model.Ticket {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToTicket(*src, opts...)
+	return &d
+}
+
+func PbToTicketPtrList(src []*example.Ticket, opts ...TransformParam) []*model.Ticket {
+	resp := make([]*model.Ticket, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToTicketPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToTicketPtrVal(src *example.Ticket, opts ...TransformParam) model.Ticket {
+	if src == nil {
+		return model.Ticket{}
+	}
+
+	return PbToTicket(*src, opts...)
+}
+
+func PbToTicketPtrValList(src []*example.Ticket, opts ...TransformParam) []model.Ticket {
+	resp := make([]model.Ticket, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToTicket(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToTicketList is DEPRECATED. Use PbToTicketPtrValList instead.
+func PbToTicketList(src []*example.Ticket, opts ...TransformParam) []model.Ticket {
+	return PbToTicketPtrValList(src, opts...)
+}
+
+func PbToTicket(src example.Ticket, opts ...TransformParam) model.Ticket {
+	s := model.Ticket{
+		ID:       src.Id,
+		ParentID: int64(src.ParentId),
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToTicketValPtr(src example.Ticket, opts ...TransformParam) *model.Ticket {
+	d := PbToTicket(src, opts...)
+	return &d
+}
+
+func PbToTicketValList(src []example.Ticket, opts ...TransformParam) []model.Ticket {
+	resp := make([]model.Ticket, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToTicket(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToTicketValPtrList(src []example.Ticket, opts ...TransformParam) []*model.Ticket {
+	resp := make([]*model.Ticket, len(src))
+
+	for i, s := range src {
+		g := PbToTicket(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToTicketFieldNames maps example.Ticket field names to model.Ticket field names.
+var PbToTicketFieldNames = map[string]string{
+	"id":        "ID",
+	"parent_id": "ParentID",
+}
+
+// PbToTicketJSONNames maps example.Ticket JSON field names to model.Ticket JSON field names.
+var PbToTicketJSONNames = map[string]string{
+	"id":       "ID",
+	"parentId": "ParentID",
+}
+
+// PbToTicketSchemaHash is a hash of fields mapping between example.Ticket and model.Ticket.
+// It changes when mapped fields or their types are changed.
+const PbToTicketSchemaHash = "2e0d838ff6dc17ea54a704dad09ab3298e361604ae065a135b82ed35e4a8d8dd"
+
+func TicketToPbPtr(src *model.Ticket, opts ...TransformParam) *example.Ticket {
+	if src == nil {
+		return nil
+	}
+
+	d := TicketToPb(*src, opts...)
+	return &d
+}
+
+func TicketToPbPtrList(src []*model.Ticket, opts ...TransformParam) []*example.Ticket {
+	resp := make([]*example.Ticket, len(src))
+
+	for i, s := range src {
+		resp[i] = TicketToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func TicketToPbPtrVal(src *model.Ticket, opts ...TransformParam) example.Ticket {
+	if src == nil {
+		return example.Ticket{}
+	}
+
+	return TicketToPb(*src, opts...)
+}
+
+func TicketToPbValPtrList(src []model.Ticket, opts ...TransformParam) []*example.Ticket {
+	resp := make([]*example.Ticket, len(src))
+
+	for i, s := range src {
+		g := TicketToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// TicketToPbList is DEPRECATED. Use TicketToPbValPtrList instead.
+func TicketToPbList(src []model.Ticket, opts ...TransformParam) []*example.Ticket {
+	return TicketToPbValPtrList(src, opts...)
+}
+
+func TicketToPb(src model.Ticket, opts ...TransformParam) example.Ticket {
+	s := example.Ticket{
+		Id:       src.ID,
+		ParentId: model.TicketID(src.ParentID),
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func TicketToPbValPtr(src model.Ticket, opts ...TransformParam) *example.Ticket {
+	d := TicketToPb(src, opts...)
+	return &d
+}
+
+func TicketToPbValList(src []model.Ticket, opts ...TransformParam) []example.Ticket {
+	resp := make([]example.Ticket, len(src))
+
+	for i, s := range src {
+		resp[i] = TicketToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func TicketToPbPtrValList(src []*model.Ticket, opts ...TransformParam) []example.Ticket {
+	resp := make([]example.Ticket, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, TicketToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// TicketToPbFieldNames maps model.Ticket field names to example.Ticket field names.
+var TicketToPbFieldNames = map[string]string{
+	"ID":       "id",
+	"ParentID": "parent_id",
+}
+
+// TicketToPbJSONNames maps model.Ticket JSON field names to example.Ticket JSON field names.
+var TicketToPbJSONNames = map[string]string{
+	"ID":       "id",
+	"ParentID": "parentId",
+}
+
+func PbToAddressBookPtr(src *example.AddressBook, opts ...TransformParam) *model.AddressBook {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToAddressBook(*src, opts...)
+	return &d
+}
+
+func PbToAddressBookPtrList(src []*example.AddressBook, opts ...TransformParam) []*model.AddressBook {
+	resp := make([]*model.AddressBook, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToAddressBookPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToAddressBookPtrVal(src *example.AddressBook, opts ...TransformParam) model.AddressBook {
+	if src == nil {
+		return model.AddressBook{}
+	}
+
+	return PbToAddressBook(*src, opts...)
+}
+
+func PbToAddressBookPtrValList(src []*example.AddressBook, opts ...TransformParam) []model.AddressBook {
+	resp := make([]model.AddressBook, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToAddressBook(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToAddressBookList is DEPRECATED. Use PbToAddressBookPtrValList instead.
+func PbToAddressBookList(src []*example.AddressBook, opts ...TransformParam) []model.AddressBook {
+	return PbToAddressBookPtrValList(src, opts...)
+}
+
+func PbToAddressBook(src example.AddressBook, opts ...TransformParam) model.AddressBook {
+	s := model.AddressBook{
+		Addresses:    PbToAddressValList(src.Addresses, opts...),
+		PtrAddresses: PbToAddressPtrList(src.PtrAddresses, opts...),
+	}
+
+	applyOptions(opts...)
+
+	if src.AddressesByLabel != nil {
+		s.AddressesByLabel = make(map[string][]model.Address, len(src.AddressesByLabel))
+		for k, v := range src.AddressesByLabel {
+			s.AddressesByLabel[k] = PbToAddressPtrValList(v.GetItems())
+		}
+	}
+
+	if src.AddressesByName != nil {
+		s.AddressesByName = make(map[string]model.Address, len(src.AddressesByName))
+		for k, v := range src.AddressesByName {
+			s.AddressesByName[k] = PbToAddressPtrVal(v, opts...)
+		}
+	}
+
+	if src.PtrAddressesById != nil {
+		s.PtrAddressesByID = make(map[int64]*model.Address, len(src.PtrAddressesById))
+		for k, v := range src.PtrAddressesById {
+			s.PtrAddressesByID[k] = PbToAddressPtr(v, opts...)
+		}
+	}
+
+	if src.Tags != nil {
+		s.Tags = make(map[string]string, len(src.Tags))
+		for k, v := range src.Tags {
+			s.Tags[k] = v
+		}
+	}
+
+	return s
+}
+
+func PbToAddressBookValPtr(src example.AddressBook, opts ...TransformParam) *model.AddressBook {
+	d := PbToAddressBook(src, opts...)
+	return &d
+}
+
+func PbToAddressBookValList(src []example.AddressBook, opts ...TransformParam) []model.AddressBook {
+	resp := make([]model.AddressBook, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToAddressBook(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToAddressBookValPtrList(src []example.AddressBook, opts ...TransformParam) []*model.AddressBook {
+	resp := make([]*model.AddressBook, len(src))
+
+	for i, s := range src {
+		g := PbToAddressBook(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToAddressBookFieldNames maps example.AddressBook field names to model.AddressBook field names.
+var PbToAddressBookFieldNames = map[string]string{
+	"addresses":           "Addresses",
+	"ptr_addresses":       "PtrAddresses",
+	"addresses_by_label":  "AddressesByLabel",
+	"addresses_by_name":   "AddressesByName",
+	"ptr_addresses_by_id": "PtrAddressesByID",
+	"tags":                "Tags",
+}
+
+// PbToAddressBookJSONNames maps example.AddressBook JSON field names to model.AddressBook JSON field names.
+var PbToAddressBookJSONNames = map[string]string{
+	"addresses":        "Addresses",
+	"ptrAddresses":     "PtrAddresses",
+	"addressesByLabel": "AddressesByLabel",
+	"addressesByName":  "AddressesByName",
+	"ptrAddressesById": "PtrAddressesByID",
+	"tags":             "Tags",
+}
+
+// PbToAddressBookSchemaHash is a hash of fields mapping between example.AddressBook and model.AddressBook.
+// It changes when mapped fields or their types are changed.
+const PbToAddressBookSchemaHash = "6e30ff14b0d2c89061d61a73856184c1d8104d4eb5b7cdfc1f757a432335b437"
+
+func AddressBookToPbPtr(src *model.AddressBook, opts ...TransformParam) *example.AddressBook {
+	if src == nil {
+		return nil
+	}
+
+	d := AddressBookToPb(*src, opts...)
+	return &d
+}
+
+func AddressBookToPbPtrList(src []*model.AddressBook, opts ...TransformParam) []*example.AddressBook {
+	resp := make([]*example.AddressBook, len(src))
+
+	for i, s := range src {
+		resp[i] = AddressBookToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func AddressBookToPbPtrVal(src *model.AddressBook, opts ...TransformParam) example.AddressBook {
+	if src == nil {
+		return example.AddressBook{}
+	}
+
+	return AddressBookToPb(*src, opts...)
+}
+
+func AddressBookToPbValPtrList(src []model.AddressBook, opts ...TransformParam) []*example.AddressBook {
+	resp := make([]*example.AddressBook, len(src))
+
+	for i, s := range src {
+		g := AddressBookToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// AddressBookToPbList is DEPRECATED. Use AddressBookToPbValPtrList instead.
+func AddressBookToPbList(src []model.AddressBook, opts ...TransformParam) []*example.AddressBook {
+	return AddressBookToPbValPtrList(src, opts...)
+}
+
+func AddressBookToPb(src model.AddressBook, opts ...TransformParam) example.AddressBook {
+	s := example.AddressBook{
+		Addresses:    AddressToPbValList(src.Addresses, opts...),
+		PtrAddresses: AddressToPbPtrList(src.PtrAddresses, opts...),
+	}
+
+	applyOptions(opts...)
+
+	if src.AddressesByLabel != nil {
+		s.AddressesByLabel = make(map[string]*example.AddressList, len(src.AddressesByLabel))
+		for k, v := range src.AddressesByLabel {
+			e := example.AddressList{Items: AddressToPbValPtrList(v)}
+			s.AddressesByLabel[k] = &e
+		}
+	}
+
+	if src.AddressesByName != nil {
+		s.AddressesByName = make(map[string]*example.Address, len(src.AddressesByName))
+		for k, v := range src.AddressesByName {
+			s.AddressesByName[k] = AddressToPbValPtr(v, opts...)
+		}
+	}
+
+	if src.PtrAddressesByID != nil {
+		s.PtrAddressesById = make(map[int64]*example.Address, len(src.PtrAddressesByID))
+		for k, v := range src.PtrAddressesByID {
+			s.PtrAddressesById[k] = AddressToPbPtr(v, opts...)
+		}
+	}
+
+	if src.Tags != nil {
+		s.Tags = make(map[string]string, len(src.Tags))
+		for k, v := range src.Tags {
+			s.Tags[k] = v
+		}
+	}
+
+	return s
+}
+
+func AddressBookToPbValPtr(src model.AddressBook, opts ...TransformParam) *example.AddressBook {
+	d := AddressBookToPb(src, opts...)
+	return &d
+}
+
+func AddressBookToPbValList(src []model.AddressBook, opts ...TransformParam) []example.AddressBook {
+	resp := make([]example.AddressBook, len(src))
+
+	for i, s := range src {
+		resp[i] = AddressBookToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func AddressBookToPbPtrValList(src []*model.AddressBook, opts ...TransformParam) []example.AddressBook {
+	resp := make([]example.AddressBook, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, AddressBookToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// AddressBookToPbFieldNames maps model.AddressBook field names to example.AddressBook field names.
+var AddressBookToPbFieldNames = map[string]string{
+	"Addresses":        "addresses",
+	"PtrAddresses":     "ptr_addresses",
+	"AddressesByLabel": "addresses_by_label",
+	"AddressesByName":  "addresses_by_name",
+	"PtrAddressesByID": "ptr_addresses_by_id",
+	"Tags":             "tags",
+}
+
+// AddressBookToPbJSONNames maps model.AddressBook JSON field names to example.AddressBook JSON field names.
+var AddressBookToPbJSONNames = map[string]string{
+	"Addresses":        "addresses",
+	"PtrAddresses":     "ptrAddresses",
+	"AddressesByLabel": "addressesByLabel",
+	"AddressesByName":  "addressesByName",
+	"PtrAddressesByID": "ptrAddressesById",
+	"Tags":             "tags",
+}
+
+func PbToBillingAddressPtr(src *example.PostalAddress, opts ...TransformParam) *billing.Address {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToBillingAddress(*src, opts...)
+	return &d
+}
+
+func PbToBillingAddressPtrList(src []*example.PostalAddress, opts ...TransformParam) []*billing.Address {
+	resp := make([]*billing.Address, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToBillingAddressPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToBillingAddressPtrVal(src *example.PostalAddress, opts ...TransformParam) billing.Address {
+	if src == nil {
+		return billing.Address{}
+	}
+
+	return PbToBillingAddress(*src, opts...)
+}
+
+func PbToBillingAddressPtrValList(src []*example.PostalAddress, opts ...TransformParam) []billing.Address {
+	resp := make([]billing.Address, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToBillingAddress(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToBillingAddressList is DEPRECATED. Use PbToBillingAddressPtrValList instead.
+func PbToBillingAddressList(src []*example.PostalAddress, opts ...TransformParam) []billing.Address {
+	return PbToBillingAddressPtrValList(src, opts...)
+}
+
+func PbToBillingAddress(src example.PostalAddress, opts ...TransformParam) billing.Address {
+	s := billing.Address{
+		Street: src.Street,
+		City:   src.City,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToBillingAddressValPtr(src example.PostalAddress, opts ...TransformParam) *billing.Address {
+	d := PbToBillingAddress(src, opts...)
+	return &d
+}
+
+func PbToBillingAddressValList(src []example.PostalAddress, opts ...TransformParam) []billing.Address {
+	resp := make([]billing.Address, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToBillingAddress(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToBillingAddressValPtrList(src []example.PostalAddress, opts ...TransformParam) []*billing.Address {
+	resp := make([]*billing.Address, len(src))
+
+	for i, s := range src {
+		g := PbToBillingAddress(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToBillingAddressFieldNames maps example.PostalAddress field names to billing.Address field names.
+var PbToBillingAddressFieldNames = map[string]string{
+	"street": "Street",
+	"city":   "City",
+}
+
+// PbToBillingAddressJSONNames maps example.PostalAddress JSON field names to billing.Address JSON field names.
+var PbToBillingAddressJSONNames = map[string]string{
+	"street": "Street",
+	"city":   "City",
+}
+
+// PbToBillingAddressSchemaHash is a hash of fields mapping between example.PostalAddress and billing.Address.
+// It changes when mapped fields or their types are changed.
+const PbToBillingAddressSchemaHash = "60d56fd8db84005af3876b11526558f64359b8d0aaaf21fb913746dcd2741257"
+
+func BillingAddressToPbPtr(src *billing.Address, opts ...TransformParam) *example.PostalAddress {
+	if src == nil {
+		return nil
+	}
+
+	d := BillingAddressToPb(*src, opts...)
+	return &d
+}
+
+func BillingAddressToPbPtrList(src []*billing.Address, opts ...TransformParam) []*example.PostalAddress {
+	resp := make([]*example.PostalAddress, len(src))
+
+	for i, s := range src {
+		resp[i] = BillingAddressToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func BillingAddressToPbPtrVal(src *billing.Address, opts ...TransformParam) example.PostalAddress {
+	if src == nil {
+		return example.PostalAddress{}
+	}
+
+	return BillingAddressToPb(*src, opts...)
+}
+
+func BillingAddressToPbValPtrList(src []billing.Address, opts ...TransformParam) []*example.PostalAddress {
+	resp := make([]*example.PostalAddress, len(src))
+
+	for i, s := range src {
+		g := BillingAddressToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// BillingAddressToPbList is DEPRECATED. Use BillingAddressToPbValPtrList instead.
+func BillingAddressToPbList(src []billing.Address, opts ...TransformParam) []*example.PostalAddress {
+	return BillingAddressToPbValPtrList(src, opts...)
+}
+
+func BillingAddressToPb(src billing.Address, opts ...TransformParam) example.PostalAddress {
+	s := example.PostalAddress{
+		Street: src.Street,
+		City:   src.City,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func BillingAddressToPbValPtr(src billing.Address, opts ...TransformParam) *example.PostalAddress {
+	d := BillingAddressToPb(src, opts...)
+	return &d
+}
+
+func BillingAddressToPbValList(src []billing.Address, opts ...TransformParam) []example.PostalAddress {
+	resp := make([]example.PostalAddress, len(src))
+
+	for i, s := range src {
+		resp[i] = BillingAddressToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func BillingAddressToPbPtrValList(src []*billing.Address, opts ...TransformParam) []example.PostalAddress {
+	resp := make([]example.PostalAddress, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, BillingAddressToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// BillingAddressToPbFieldNames maps billing.Address field names to example.PostalAddress field names.
+var BillingAddressToPbFieldNames = map[string]string{
+	"Street": "street",
+	"City":   "city",
+}
+
+// BillingAddressToPbJSONNames maps billing.Address JSON field names to example.PostalAddress JSON field names.
+var BillingAddressToPbJSONNames = map[string]string{
+	"Street": "street",
+	"City":   "city",
+}
+
+func PbToInvoicePtr(src *example.Invoice, opts ...TransformParam) *model.Invoice {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToInvoice(*src, opts...)
+	return &d
+}
+
+func PbToInvoicePtrList(src []*example.Invoice, opts ...TransformParam) []*model.Invoice {
+	resp := make([]*model.Invoice, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToInvoicePtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToInvoicePtrVal(src *example.Invoice, opts ...TransformParam) model.Invoice {
+	if src == nil {
+		return model.Invoice{}
+	}
+
+	return PbToInvoice(*src, opts...)
+}
+
+func PbToInvoicePtrValList(src []*example.Invoice, opts ...TransformParam) []model.Invoice {
+	resp := make([]model.Invoice, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToInvoice(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToInvoiceList is DEPRECATED. Use PbToInvoicePtrValList instead.
+func PbToInvoiceList(src []*example.Invoice, opts ...TransformParam) []model.Invoice {
+	return PbToInvoicePtrValList(src, opts...)
+}
+
+func PbToInvoice(src example.Invoice, opts ...TransformParam) model.Invoice {
+	s := model.Invoice{
+		ID:                int(src.Id),
+		BillingAddress:    PbToBillingAddressPtr(src.BillingAddress, opts...),
+		PreviousAddresses: PbToBillingAddressValList(src.PreviousAddresses, opts...),
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToInvoiceValPtr(src example.Invoice, opts ...TransformParam) *model.Invoice {
+	d := PbToInvoice(src, opts...)
+	return &d
+}
+
+func PbToInvoiceValList(src []example.Invoice, opts ...TransformParam) []model.Invoice {
+	resp := make([]model.Invoice, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToInvoice(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToInvoiceValPtrList(src []example.Invoice, opts ...TransformParam) []*model.Invoice {
+	resp := make([]*model.Invoice, len(src))
+
+	for i, s := range src {
+		g := PbToInvoice(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToInvoiceFieldNames maps example.Invoice field names to model.Invoice field names.
+var PbToInvoiceFieldNames = map[string]string{
+	"id":                 "ID",
+	"billing_address":    "BillingAddress",
+	"previous_addresses": "PreviousAddresses",
+}
+
+// PbToInvoiceJSONNames maps example.Invoice JSON field names to model.Invoice JSON field names.
+var PbToInvoiceJSONNames = map[string]string{
+	"id":                "ID",
+	"billingAddress":    "BillingAddress",
+	"previousAddresses": "PreviousAddresses",
+}
+
+// PbToInvoiceSchemaHash is a hash of fields mapping between example.Invoice and model.Invoice.
+// It changes when mapped fields or their types are changed.
+const PbToInvoiceSchemaHash = "5886e981a76fe711519fc5b7b7a98005be93e80b64a90d5ae7e9642d6f28be79"
+
+func InvoiceToPbPtr(src *model.Invoice, opts ...TransformParam) *example.Invoice {
+	if src == nil {
+		return nil
+	}
+
+	d := InvoiceToPb(*src, opts...)
+	return &d
+}
+
+func InvoiceToPbPtrList(src []*model.Invoice, opts ...TransformParam) []*example.Invoice {
+	resp := make([]*example.Invoice, len(src))
+
+	for i, s := range src {
+		resp[i] = InvoiceToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func InvoiceToPbPtrVal(src *model.Invoice, opts ...TransformParam) example.Invoice {
+	if src == nil {
+		return example.Invoice{}
+	}
+
+	return InvoiceToPb(*src, opts...)
+}
+
+func InvoiceToPbValPtrList(src []model.Invoice, opts ...TransformParam) []*example.Invoice {
+	resp := make([]*example.Invoice, len(src))
+
+	for i, s := range src {
+		g := InvoiceToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// InvoiceToPbList is DEPRECATED. Use InvoiceToPbValPtrList instead.
+func InvoiceToPbList(src []model.Invoice, opts ...TransformParam) []*example.Invoice {
+	return InvoiceToPbValPtrList(src, opts...)
+}
+
+func InvoiceToPb(src model.Invoice, opts ...TransformParam) example.Invoice {
+	s := example.Invoice{
+		Id:                int64(src.ID),
+		BillingAddress:    BillingAddressToPbPtr(src.BillingAddress, opts...),
+		PreviousAddresses: BillingAddressToPbValList(src.PreviousAddresses, opts...),
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func InvoiceToPbValPtr(src model.Invoice, opts ...TransformParam) *example.Invoice {
+	d := InvoiceToPb(src, opts...)
+	return &d
+}
+
+func InvoiceToPbValList(src []model.Invoice, opts ...TransformParam) []example.Invoice {
+	resp := make([]example.Invoice, len(src))
+
+	for i, s := range src {
+		resp[i] = InvoiceToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func InvoiceToPbPtrValList(src []*model.Invoice, opts ...TransformParam) []example.Invoice {
+	resp := make([]example.Invoice, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, InvoiceToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// InvoiceToPbFieldNames maps model.Invoice field names to example.Invoice field names.
+var InvoiceToPbFieldNames = map[string]string{
+	"ID":                "id",
+	"BillingAddress":    "billing_address",
+	"PreviousAddresses": "previous_addresses",
+}
+
+// InvoiceToPbJSONNames maps model.Invoice JSON field names to example.Invoice JSON field names.
+var InvoiceToPbJSONNames = map[string]string{
+	"ID":                "id",
+	"BillingAddress":    "billingAddress",
+	"PreviousAddresses": "previousAddresses",
+}
+
+func PbToShipmentPtr(src *example.Shipment, opts ...TransformParam) *model.Shipment {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToShipment(*src, opts...)
+	return &d
+}
+
+func PbToShipmentPtrList(src []*example.Shipment, opts ...TransformParam) []*model.Shipment {
+	resp := make([]*model.Shipment, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToShipmentPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToShipmentPtrVal(src *example.Shipment, opts ...TransformParam) model.Shipment {
+	if src == nil {
+		return model.Shipment{}
+	}
+
+	return PbToShipment(*src, opts...)
+}
+
+func PbToShipmentPtrValList(src []*example.Shipment, opts ...TransformParam) []model.Shipment {
+	resp := make([]model.Shipment, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToShipment(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToShipmentList is DEPRECATED. Use PbToShipmentPtrValList instead.
+func PbToShipmentList(src []*example.Shipment, opts ...TransformParam) []model.Shipment {
+	return PbToShipmentPtrValList(src, opts...)
+}
+
+func PbToShipment(src example.Shipment, opts ...TransformParam) model.Shipment {
+	s := model.Shipment{
+		ID:      src.Id,
+		Parcels: PbToParcelPtrValList(src.Parcels, opts...),
+	}
+
+	applyOptions(opts...)
+
+	if src.Sizes != nil {
+		s.Sizes = make(map[string]*model.Dimensions, len(src.Sizes))
+		for k, v := range src.Sizes {
+			s.Sizes[k] = PbToDimensionsPtr(v, opts...)
+		}
+	}
+
+	return s
+}
+
+func PbToShipmentValPtr(src example.Shipment, opts ...TransformParam) *model.Shipment {
+	d := PbToShipment(src, opts...)
+	return &d
+}
+
+func PbToShipmentValList(src []example.Shipment, opts ...TransformParam) []model.Shipment {
+	resp := make([]model.Shipment, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToShipment(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToShipmentValPtrList(src []example.Shipment, opts ...TransformParam) []*model.Shipment {
+	resp := make([]*model.Shipment, len(src))
+
+	for i, s := range src {
+		g := PbToShipment(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToShipmentFieldNames maps example.Shipment field names to model.Shipment field names.
+var PbToShipmentFieldNames = map[string]string{
+	"id":      "ID",
+	"parcels": "Parcels",
+	"sizes":   "Sizes",
+}
+
+// PbToShipmentJSONNames maps example.Shipment JSON field names to model.Shipment JSON field names.
+var PbToShipmentJSONNames = map[string]string{
+	"id":      "ID",
+	"parcels": "Parcels",
+	"sizes":   "Sizes",
+}
+
+// PbToShipmentSchemaHash is a hash of fields mapping between example.Shipment and model.Shipment.
+// It changes when mapped fields or their types are changed.
+const PbToShipmentSchemaHash = "50c395c42674a6f199436c9eddaccb49a55007e8d8b9de7646c4c6dd75acb7fa"
+
+func ShipmentToPbPtr(src *model.Shipment, opts ...TransformParam) *example.Shipment {
+	if src == nil {
+		return nil
+	}
+
+	d := ShipmentToPb(*src, opts...)
+	return &d
+}
+
+func ShipmentToPbPtrList(src []*model.Shipment, opts ...TransformParam) []*example.Shipment {
+	resp := make([]*example.Shipment, len(src))
+
+	for i, s := range src {
+		resp[i] = ShipmentToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func ShipmentToPbPtrVal(src *model.Shipment, opts ...TransformParam) example.Shipment {
+	if src == nil {
+		return example.Shipment{}
+	}
+
+	return ShipmentToPb(*src, opts...)
+}
+
+func ShipmentToPbValPtrList(src []model.Shipment, opts ...TransformParam) []*example.Shipment {
+	resp := make([]*example.Shipment, len(src))
+
+	for i, s := range src {
+		g := ShipmentToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// ShipmentToPbList is DEPRECATED. Use ShipmentToPbValPtrList instead.
+func ShipmentToPbList(src []model.Shipment, opts ...TransformParam) []*example.Shipment {
+	return ShipmentToPbValPtrList(src, opts...)
+}
+
+func ShipmentToPb(src model.Shipment, opts ...TransformParam) example.Shipment {
+	s := example.Shipment{
+		Id:      src.ID,
+		Parcels: ParcelToPbValPtrList(src.Parcels, opts...),
+	}
+
+	applyOptions(opts...)
+
+	if src.Sizes != nil {
+		s.Sizes = make(map[string]*example.Shipment_Parcel_Dimensions, len(src.Sizes))
+		for k, v := range src.Sizes {
+			s.Sizes[k] = DimensionsToPbPtr(v, opts...)
+		}
+	}
+
+	return s
+}
+
+func ShipmentToPbValPtr(src model.Shipment, opts ...TransformParam) *example.Shipment {
+	d := ShipmentToPb(src, opts...)
+	return &d
+}
+
+func ShipmentToPbValList(src []model.Shipment, opts ...TransformParam) []example.Shipment {
+	resp := make([]example.Shipment, len(src))
+
+	for i, s := range src {
+		resp[i] = ShipmentToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func ShipmentToPbPtrValList(src []*model.Shipment, opts ...TransformParam) []example.Shipment {
+	resp := make([]example.Shipment, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, ShipmentToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// ShipmentToPbFieldNames maps model.Shipment field names to example.Shipment field names.
+var ShipmentToPbFieldNames = map[string]string{
+	"ID":      "id",
+	"Parcels": "parcels",
+	"Sizes":   "sizes",
+}
+
+// ShipmentToPbJSONNames maps model.Shipment JSON field names to example.Shipment JSON field names.
+var ShipmentToPbJSONNames = map[string]string{
+	"ID":      "id",
+	"Parcels": "parcels",
+	"Sizes":   "sizes",
+}
+
+func PbToParcelPtr(src *example.Shipment_Parcel, opts ...TransformParam) *model.Parcel {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToParcel(*src, opts...)
+	return &d
+}
+
+func PbToParcelPtrList(src []*example.Shipment_Parcel, opts ...TransformParam) []*model.Parcel {
+	resp := make([]*model.Parcel, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToParcelPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToParcelPtrVal(src *example.Shipment_Parcel, opts ...TransformParam) model.Parcel {
+	if src == nil {
+		return model.Parcel{}
+	}
+
+	return PbToParcel(*src, opts...)
+}
+
+func PbToParcelPtrValList(src []*example.Shipment_Parcel, opts ...TransformParam) []model.Parcel {
+	resp := make([]model.Parcel, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToParcel(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToParcelList is DEPRECATED. Use PbToParcelPtrValList instead.
+func PbToParcelList(src []*example.Shipment_Parcel, opts ...TransformParam) []model.Parcel {
+	return PbToParcelPtrValList(src, opts...)
+}
+
+func PbToParcel(src example.Shipment_Parcel, opts ...TransformParam) model.Parcel {
+	s := model.Parcel{
+		Label:      src.Label,
+		Dimensions: PbToDimensionsPtr(src.Dimensions, opts...),
+		Boxes:      PbToDimensionsPtrValList(src.Boxes, opts...),
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToParcelValPtr(src example.Shipment_Parcel, opts ...TransformParam) *model.Parcel {
+	d := PbToParcel(src, opts...)
+	return &d
+}
+
+func PbToParcelValList(src []example.Shipment_Parcel, opts ...TransformParam) []model.Parcel {
+	resp := make([]model.Parcel, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToParcel(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToParcelValPtrList(src []example.Shipment_Parcel, opts ...TransformParam) []*model.Parcel {
+	resp := make([]*model.Parcel, len(src))
+
+	for i, s := range src {
+		g := PbToParcel(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToParcelFieldNames maps example.Shipment_Parcel field names to model.Parcel field names.
+var PbToParcelFieldNames = map[string]string{
+	"label":      "Label",
+	"dimensions": "Dimensions",
+	"boxes":      "Boxes",
+}
+
+// PbToParcelJSONNames maps example.Shipment_Parcel JSON field names to model.Parcel JSON field names.
+var PbToParcelJSONNames = map[string]string{
+	"label":      "Label",
+	"dimensions": "Dimensions",
+	"boxes":      "Boxes",
+}
+
+// PbToParcelSchemaHash is a hash of fields mapping between example.Shipment_Parcel and model.Parcel.
+// It changes when mapped fields or their types are changed.
+const PbToParcelSchemaHash = "72d3cda2d5cc173a51422c8f0a4a3f4d005a74be1da4560f74ffb38406750bc6"
+
+func ParcelToPbPtr(src *model.Parcel, opts ...TransformParam) *example.Shipment_Parcel {
+	if src == nil {
+		return nil
+	}
+
+	d := ParcelToPb(*src, opts...)
+	return &d
+}
+
+func ParcelToPbPtrList(src []*model.Parcel, opts ...TransformParam) []*example.Shipment_Parcel {
+	resp := make([]*example.Shipment_Parcel, len(src))
+
+	for i, s := range src {
+		resp[i] = ParcelToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func ParcelToPbPtrVal(src *model.Parcel, opts ...TransformParam) example.Shipment_Parcel {
+	if src == nil {
+		return example.Shipment_Parcel{}
+	}
+
+	return ParcelToPb(*src, opts...)
+}
+
+func ParcelToPbValPtrList(src []model.Parcel, opts ...TransformParam) []*example.Shipment_Parcel {
+	resp := make([]*example.Shipment_Parcel, len(src))
+
+	for i, s := range src {
+		g := ParcelToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// ParcelToPbList is DEPRECATED. Use ParcelToPbValPtrList instead.
+func ParcelToPbList(src []model.Parcel, opts ...TransformParam) []*example.Shipment_Parcel {
+	return ParcelToPbValPtrList(src, opts...)
+}
+
+func ParcelToPb(src model.Parcel, opts ...TransformParam) example.Shipment_Parcel {
+	s := example.Shipment_Parcel{
+		Label:      src.Label,
+		Dimensions: DimensionsToPbPtr(src.Dimensions, opts...),
+		Boxes:      DimensionsToPbValPtrList(src.Boxes, opts...),
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func ParcelToPbValPtr(src model.Parcel, opts ...TransformParam) *example.Shipment_Parcel {
+	d := ParcelToPb(src, opts...)
+	return &d
+}
+
+func ParcelToPbValList(src []model.Parcel, opts ...TransformParam) []example.Shipment_Parcel {
+	resp := make([]example.Shipment_Parcel, len(src))
+
+	for i, s := range src {
+		resp[i] = ParcelToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func ParcelToPbPtrValList(src []*model.Parcel, opts ...TransformParam) []example.Shipment_Parcel {
+	resp := make([]example.Shipment_Parcel, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, ParcelToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// ParcelToPbFieldNames maps model.Parcel field names to example.Shipment_Parcel field names.
+var ParcelToPbFieldNames = map[string]string{
+	"Label":      "label",
+	"Dimensions": "dimensions",
+	"Boxes":      "boxes",
+}
+
+// ParcelToPbJSONNames maps model.Parcel JSON field names to example.Shipment_Parcel JSON field names.
+var ParcelToPbJSONNames = map[string]string{
+	"Label":      "label",
+	"Dimensions": "dimensions",
+	"Boxes":      "boxes",
+}
+
+func PbToDimensionsPtr(src *example.Shipment_Parcel_Dimensions, opts ...TransformParam) *model.Dimensions {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToDimensions(*src, opts...)
+	return &d
+}
+
+func PbToDimensionsPtrList(src []*example.Shipment_Parcel_Dimensions, opts ...TransformParam) []*model.Dimensions {
+	resp := make([]*model.Dimensions, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToDimensionsPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToDimensionsPtrVal(src *example.Shipment_Parcel_Dimensions, opts ...TransformParam) model.Dimensions {
+	if src == nil {
+		return model.Dimensions{}
+	}
+
+	return PbToDimensions(*src, opts...)
+}
+
+func PbToDimensionsPtrValList(src []*example.Shipment_Parcel_Dimensions, opts ...TransformParam) []model.Dimensions {
+	resp := make([]model.Dimensions, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToDimensions(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToDimensionsList is DEPRECATED. Use PbToDimensionsPtrValList instead.
+func PbToDimensionsList(src []*example.Shipment_Parcel_Dimensions, opts ...TransformParam) []model.Dimensions {
+	return PbToDimensionsPtrValList(src, opts...)
+}
+
+func PbToDimensions(src example.Shipment_Parcel_Dimensions, opts ...TransformParam) model.Dimensions {
+	s := model.Dimensions{
+		Width:  src.Width,
+		Height: src.Height,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToDimensionsValPtr(src example.Shipment_Parcel_Dimensions, opts ...TransformParam) *model.Dimensions {
+	d := PbToDimensions(src, opts...)
+	return &d
+}
+
+func PbToDimensionsValList(src []example.Shipment_Parcel_Dimensions, opts ...TransformParam) []model.Dimensions {
+	resp := make([]model.Dimensions, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToDimensions(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToDimensionsValPtrList(src []example.Shipment_Parcel_Dimensions, opts ...TransformParam) []*model.Dimensions {
+	resp := make([]*model.Dimensions, len(src))
+
+	for i, s := range src {
+		g := PbToDimensions(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToDimensionsFieldNames maps example.Shipment_Parcel_Dimensions field names to model.Dimensions field names.
+var PbToDimensionsFieldNames = map[string]string{
+	"width":  "Width",
+	"height": "Height",
+}
+
+// PbToDimensionsJSONNames maps example.Shipment_Parcel_Dimensions JSON field names to model.Dimensions JSON field names.
+var PbToDimensionsJSONNames = map[string]string{
+	"width":  "Width",
+	"height": "Height",
+}
+
+// PbToDimensionsSchemaHash is a hash of fields mapping between example.Shipment_Parcel_Dimensions and model.Dimensions.
+// It changes when mapped fields or their types are changed.
+const PbToDimensionsSchemaHash = "67c1df402458a54cb9c2ff5c09008020ca2d18e9fbbac6095572162aa3074ee1"
+
+func DimensionsToPbPtr(src *model.Dimensions, opts ...TransformParam) *example.Shipment_Parcel_Dimensions {
+	if src == nil {
+		return nil
+	}
+
+	d := DimensionsToPb(*src, opts...)
+	return &d
+}
+
+func DimensionsToPbPtrList(src []*model.Dimensions, opts ...TransformParam) []*example.Shipment_Parcel_Dimensions {
+	resp := make([]*example.Shipment_Parcel_Dimensions, len(src))
+
+	for i, s := range src {
+		resp[i] = DimensionsToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func DimensionsToPbPtrVal(src *model.Dimensions, opts ...TransformParam) example.Shipment_Parcel_Dimensions {
+	if src == nil {
+		return example.Shipment_Parcel_Dimensions{}
+	}
+
+	return DimensionsToPb(*src, opts...)
+}
+
+func DimensionsToPbValPtrList(src []model.Dimensions, opts ...TransformParam) []*example.Shipment_Parcel_Dimensions {
+	resp := make([]*example.Shipment_Parcel_Dimensions, len(src))
+
+	for i, s := range src {
+		g := DimensionsToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// DimensionsToPbList is DEPRECATED. Use DimensionsToPbValPtrList instead.
+func DimensionsToPbList(src []model.Dimensions, opts ...TransformParam) []*example.Shipment_Parcel_Dimensions {
+	return DimensionsToPbValPtrList(src, opts...)
+}
+
+func DimensionsToPb(src model.Dimensions, opts ...TransformParam) example.Shipment_Parcel_Dimensions {
+	s := example.Shipment_Parcel_Dimensions{
+		Width:  src.Width,
+		Height: src.Height,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func DimensionsToPbValPtr(src model.Dimensions, opts ...TransformParam) *example.Shipment_Parcel_Dimensions {
+	d := DimensionsToPb(src, opts...)
+	return &d
+}
+
+func DimensionsToPbValList(src []model.Dimensions, opts ...TransformParam) []example.Shipment_Parcel_Dimensions {
+	resp := make([]example.Shipment_Parcel_Dimensions, len(src))
+
+	for i, s := range src {
+		resp[i] = DimensionsToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func DimensionsToPbPtrValList(src []*model.Dimensions, opts ...TransformParam) []example.Shipment_Parcel_Dimensions {
+	resp := make([]example.Shipment_Parcel_Dimensions, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, DimensionsToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// DimensionsToPbFieldNames maps model.Dimensions field names to example.Shipment_Parcel_Dimensions field names.
+var DimensionsToPbFieldNames = map[string]string{
+	"Width":  "width",
+	"Height": "height",
+}
+
+// DimensionsToPbJSONNames maps model.Dimensions JSON field names to example.Shipment_Parcel_Dimensions JSON field names.
+var DimensionsToPbJSONNames = map[string]string{
+	"Width":  "width",
+	"Height": "height",
+}
+
+func PbToRefundPtr(src *example.Refund, opts ...TransformParam) (*model.Refund, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToRefund(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToRefundPtrList(src []*example.Refund, opts ...TransformParam) ([]*model.Refund, error) {
+	resp := make([]*model.Refund, len(src))
+
+	for i, s := range src {
+		d, err := PbToRefundPtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToRefundPtrVal(src *example.Refund, opts ...TransformParam) (model.Refund, error) {
+	if src == nil {
+		return model.Refund{}, nil
+	}
+
+	return PbToRefund(*src, opts...)
+}
+
+func PbToRefundPtrValList(src []*example.Refund, opts ...TransformParam) ([]model.Refund, error) {
+	resp := make([]model.Refund, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := PbToRefund(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// PbToRefundList is DEPRECATED. Use PbToRefundPtrValList instead.
+func PbToRefundList(src []*example.Refund, opts ...TransformParam) ([]model.Refund, error) {
+	return PbToRefundPtrValList(src, opts...)
+}
+
+func PbToRefund(src example.Refund, opts ...TransformParam) (model.Refund, error) {
+	s := model.Refund{
+		ID: src.Id,
+	}
+
+	applyOptions(opts...)
+
+	vCurrency, err := billing.ParseCurrency(src.Currency)
+	if err != nil {
+		return model.Refund{}, fmt.Errorf("field Currency: %w", err)
+	}
+	s.Currency = vCurrency
+
+	return s, nil
+}
+
+func PbToRefundValPtr(src example.Refund, opts ...TransformParam) (*model.Refund, error) {
+	d, err := PbToRefund(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToRefundValList(src []example.Refund, opts ...TransformParam) ([]model.Refund, error) {
+	resp := make([]model.Refund, len(src))
+
+	for i, s := range src {
+		d, err := PbToRefund(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToRefundValPtrList(src []example.Refund, opts ...TransformParam) ([]*model.Refund, error) {
+	resp := make([]*model.Refund, len(src))
+
+	for i, s := range src {
+		g, err := PbToRefund(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// PbToRefundFieldNames maps example.Refund field names to model.Refund field names.
+var PbToRefundFieldNames = map[string]string{
+	"id":       "ID",
+	"currency": "Currency",
+}
+
+// PbToRefundJSONNames maps example.Refund JSON field names to model.Refund JSON field names.
+var PbToRefundJSONNames = map[string]string{
+	"id":       "ID",
+	"currency": "Currency",
+}
+
+// PbToRefundSchemaHash is a hash of fields mapping between example.Refund and model.Refund.
+// It changes when mapped fields or their types are changed.
+const PbToRefundSchemaHash = "344e71766359a43d9681b03dc1d49f61a74c9134be091f9fe662418c33bdab2e"
+
+func RefundToPbPtr(src *model.Refund, opts ...TransformParam) (*example.Refund, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := RefundToPb(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func RefundToPbPtrList(src []*model.Refund, opts ...TransformParam) ([]*example.Refund, error) {
+	resp := make([]*example.Refund, len(src))
+
+	for i, s := range src {
+		d, err := RefundToPbPtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func RefundToPbPtrVal(src *model.Refund, opts ...TransformParam) (example.Refund, error) {
+	if src == nil {
+		return example.Refund{}, nil
+	}
+
+	return RefundToPb(*src, opts...)
+}
+
+func RefundToPbValPtrList(src []model.Refund, opts ...TransformParam) ([]*example.Refund, error) {
+	resp := make([]*example.Refund, len(src))
+
+	for i, s := range src {
+		g, err := RefundToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// RefundToPbList is DEPRECATED. Use RefundToPbValPtrList instead.
+func RefundToPbList(src []model.Refund, opts ...TransformParam) ([]*example.Refund, error) {
+	return RefundToPbValPtrList(src, opts...)
+}
+
+func RefundToPb(src model.Refund, opts ...TransformParam) (example.Refund, error) {
+	s := example.Refund{
+		Id: src.ID,
+	}
+
+	applyOptions(opts...)
+
+	vCurrency, err := billing.FormatCurrency(src.Currency)
+	if err != nil {
+		return example.Refund{}, fmt.Errorf("field Currency: %w", err)
+	}
+	s.Currency = vCurrency
+
+	return s, nil
+}
+
+func RefundToPbValPtr(src model.Refund, opts ...TransformParam) (*example.Refund, error) {
+	d, err := RefundToPb(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func RefundToPbValList(src []model.Refund, opts ...TransformParam) ([]example.Refund, error) {
+	resp := make([]example.Refund, len(src))
+
+	for i, s := range src {
+		d, err := RefundToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func RefundToPbPtrValList(src []*model.Refund, opts ...TransformParam) ([]example.Refund, error) {
+	resp := make([]example.Refund, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := RefundToPb(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// RefundToPbFieldNames maps model.Refund field names to example.Refund field names.
+var RefundToPbFieldNames = map[string]string{
+	"ID":       "id",
+	"Currency": "currency",
+}
+
+// RefundToPbJSONNames maps model.Refund JSON field names to example.Refund JSON field names.
+var RefundToPbJSONNames = map[string]string{
+	"ID":       "id",
+	"Currency": "currency",
+}
+
+func PbToRefundBatchPtr(src *example.RefundBatch, opts ...TransformParam) (*model.RefundBatch, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToRefundBatch(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToRefundBatchPtrList(src []*example.RefundBatch, opts ...TransformParam) ([]*model.RefundBatch, error) {
+	resp := make([]*model.RefundBatch, len(src))
+
+	for i, s := range src {
+		d, err := PbToRefundBatchPtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToRefundBatchPtrVal(src *example.RefundBatch, opts ...TransformParam) (model.RefundBatch, error) {
+	if src == nil {
+		return model.RefundBatch{}, nil
+	}
+
+	return PbToRefundBatch(*src, opts...)
+}
+
+func PbToRefundBatchPtrValList(src []*example.RefundBatch, opts ...TransformParam) ([]model.RefundBatch, error) {
+	resp := make([]model.RefundBatch, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := PbToRefundBatch(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// PbToRefundBatchList is DEPRECATED. Use PbToRefundBatchPtrValList instead.
+func PbToRefundBatchList(src []*example.RefundBatch, opts ...TransformParam) ([]model.RefundBatch, error) {
+	return PbToRefundBatchPtrValList(src, opts...)
+}
+
+func PbToRefundBatch(src example.RefundBatch, opts ...TransformParam) (model.RefundBatch, error) {
+	s := model.RefundBatch{}
+
+	applyOptions(opts...)
+
+	vRefunds, err := PbToRefundPtrValList(src.Refunds, opts...)
+	if err != nil {
+		return model.RefundBatch{}, fmt.Errorf("field Refunds: %w", err)
+	}
+	s.Refunds = vRefunds
+
+	vLargest, err := PbToRefundPtr(src.Largest, opts...)
+	if err != nil {
+		return model.RefundBatch{}, fmt.Errorf("field Largest: %w", err)
+	}
+	s.Largest = vLargest
+
+	return s, nil
+}
+
+func PbToRefundBatchValPtr(src example.RefundBatch, opts ...TransformParam) (*model.RefundBatch, error) {
+	d, err := PbToRefundBatch(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToRefundBatchValList(src []example.RefundBatch, opts ...TransformParam) ([]model.RefundBatch, error) {
+	resp := make([]model.RefundBatch, len(src))
+
+	for i, s := range src {
+		d, err := PbToRefundBatch(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToRefundBatchValPtrList(src []example.RefundBatch, opts ...TransformParam) ([]*model.RefundBatch, error) {
+	resp := make([]*model.RefundBatch, len(src))
+
+	for i, s := range src {
+		g, err := PbToRefundBatch(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// PbToRefundBatchFieldNames maps example.RefundBatch field names to model.RefundBatch field names.
+var PbToRefundBatchFieldNames = map[string]string{
+	"refunds": "Refunds",
+	"largest": "Largest",
+}
+
+// PbToRefundBatchJSONNames maps example.RefundBatch JSON field names to model.RefundBatch JSON field names.
+var PbToRefundBatchJSONNames = map[string]string{
+	"refunds": "Refunds",
+	"largest": "Largest",
+}
+
+// PbToRefundBatchSchemaHash is a hash of fields mapping between example.RefundBatch and model.RefundBatch.
+// It changes when mapped fields or their types are changed.
+const PbToRefundBatchSchemaHash = "0ce2fad6ff03844e487f5c45b0e5610fc95d806019bca587552b94f6dfd248c4"
+
+func RefundBatchToPbPtr(src *model.RefundBatch, opts ...TransformParam) (*example.RefundBatch, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := RefundBatchToPb(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func RefundBatchToPbPtrList(src []*model.RefundBatch, opts ...TransformParam) ([]*example.RefundBatch, error) {
+	resp := make([]*example.RefundBatch, len(src))
+
+	for i, s := range src {
+		d, err := RefundBatchToPbPtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func RefundBatchToPbPtrVal(src *model.RefundBatch, opts ...TransformParam) (example.RefundBatch, error) {
+	if src == nil {
+		return example.RefundBatch{}, nil
+	}
+
+	return RefundBatchToPb(*src, opts...)
+}
+
+func RefundBatchToPbValPtrList(src []model.RefundBatch, opts ...TransformParam) ([]*example.RefundBatch, error) {
+	resp := make([]*example.RefundBatch, len(src))
+
+	for i, s := range src {
+		g, err := RefundBatchToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// RefundBatchToPbList is DEPRECATED. Use RefundBatchToPbValPtrList instead.
+func RefundBatchToPbList(src []model.RefundBatch, opts ...TransformParam) ([]*example.RefundBatch, error) {
+	return RefundBatchToPbValPtrList(src, opts...)
+}
+
+func RefundBatchToPb(src model.RefundBatch, opts ...TransformParam) (example.RefundBatch, error) {
+	s := example.RefundBatch{}
+
+	applyOptions(opts...)
+
+	vRefunds, err := RefundToPbValPtrList(src.Refunds, opts...)
+	if err != nil {
+		return example.RefundBatch{}, fmt.Errorf("field Refunds: %w", err)
+	}
+	s.Refunds = vRefunds
+
+	vLargest, err := RefundToPbPtr(src.Largest, opts...)
+	if err != nil {
+		return example.RefundBatch{}, fmt.Errorf("field Largest: %w", err)
+	}
+	s.Largest = vLargest
+
+	return s, nil
+}
+
+func RefundBatchToPbValPtr(src model.RefundBatch, opts ...TransformParam) (*example.RefundBatch, error) {
+	d, err := RefundBatchToPb(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func RefundBatchToPbValList(src []model.RefundBatch, opts ...TransformParam) ([]example.RefundBatch, error) {
+	resp := make([]example.RefundBatch, len(src))
+
+	for i, s := range src {
+		d, err := RefundBatchToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func RefundBatchToPbPtrValList(src []*model.RefundBatch, opts ...TransformParam) ([]example.RefundBatch, error) {
+	resp := make([]example.RefundBatch, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := RefundBatchToPb(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// RefundBatchToPbFieldNames maps model.RefundBatch field names to example.RefundBatch field names.
+var RefundBatchToPbFieldNames = map[string]string{
+	"Refunds": "refunds",
+	"Largest": "largest",
+}
+
+// RefundBatchToPbJSONNames maps model.RefundBatch JSON field names to example.RefundBatch JSON field names.
+var RefundBatchToPbJSONNames = map[string]string{
+	"Refunds": "refunds",
+	"Largest": "largest",
+}
+
+func PbToMemoPtr(ctx context.Context, src *example.Memo, opts ...TransformParam) *model.Memo {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToMemo(ctx, *src, opts...)
+	return &d
+}
+
+func PbToMemoPtrList(ctx context.Context, src []*example.Memo, opts ...TransformParam) []*model.Memo {
+	resp := make([]*model.Memo, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMemoPtr(ctx, s, opts...)
+	}
+
+	return resp
+}
+
+func PbToMemoPtrVal(ctx context.Context, src *example.Memo, opts ...TransformParam) model.Memo {
+	if src == nil {
+		return model.Memo{}
+	}
+
+	return PbToMemo(ctx, *src, opts...)
+}
+
+func PbToMemoPtrValList(ctx context.Context, src []*example.Memo, opts ...TransformParam) []model.Memo {
+	resp := make([]model.Memo, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToMemo(ctx, *s, opts...))
+	}
+
+	return resp
+}
+
+// PbToMemoList is DEPRECATED. Use PbToMemoPtrValList instead.
+func PbToMemoList(ctx context.Context, src []*example.Memo, opts ...TransformParam) []model.Memo {
+	return PbToMemoPtrValList(ctx, src, opts...)
+}
+
+func PbToMemo(ctx context.Context, src example.Memo, opts ...TransformParam) model.Memo {
+	s := model.Memo{
+		ID:   src.Id,
+		Tags: src.Tags,
+	}
+
+	applyOptions(opts...)
+
+	s.Note = billing.OpenNote(ctx, src.Note)
+
+	s.Author = src.Author
+
+	return s
+}
+
+func PbToMemoValPtr(ctx context.Context, src example.Memo, opts ...TransformParam) *model.Memo {
+	d := PbToMemo(ctx, src, opts...)
+	return &d
+}
+
+func PbToMemoValList(ctx context.Context, src []example.Memo, opts ...TransformParam) []model.Memo {
+	resp := make([]model.Memo, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMemo(ctx, s, opts...)
+	}
+
+	return resp
+}
+
+func PbToMemoValPtrList(ctx context.Context, src []example.Memo, opts ...TransformParam) []*model.Memo {
+	resp := make([]*model.Memo, len(src))
+
+	for i, s := range src {
+		g := PbToMemo(ctx, s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToMemoFieldNames maps example.Memo field names to model.Memo field names.
+var PbToMemoFieldNames = map[string]string{
+	"id":     "ID",
+	"note":   "Note",
+	"tags":   "Tags",
+	"author": "Author",
+}
+
+// PbToMemoJSONNames maps example.Memo JSON field names to model.Memo JSON field names.
+var PbToMemoJSONNames = map[string]string{
+	"id":     "ID",
+	"note":   "Note",
+	"tags":   "Tags",
+	"author": "Author",
+}
+
+// PbToMemoSchemaHash is a hash of fields mapping between example.Memo and model.Memo.
+// It changes when mapped fields or their types are changed.
+const PbToMemoSchemaHash = "f9a427e186ca540fe1d9d309068c38740e273275702137226b9e5e7637659458"
+
+func MemoToPbPtr(ctx context.Context, src *model.Memo, opts ...TransformParam) *example.Memo {
+	if src == nil {
+		return nil
+	}
+
+	d := MemoToPb(ctx, *src, opts...)
+	return &d
+}
+
+func MemoToPbPtrList(ctx context.Context, src []*model.Memo, opts ...TransformParam) []*example.Memo {
+	resp := make([]*example.Memo, len(src))
+
+	for i, s := range src {
+		resp[i] = MemoToPbPtr(ctx, s, opts...)
+	}
+
+	return resp
+}
+
+func MemoToPbPtrVal(ctx context.Context, src *model.Memo, opts ...TransformParam) example.Memo {
+	if src == nil {
+		return example.Memo{}
+	}
+
+	return MemoToPb(ctx, *src, opts...)
+}
+
+func MemoToPbValPtrList(ctx context.Context, src []model.Memo, opts ...TransformParam) []*example.Memo {
+	resp := make([]*example.Memo, len(src))
+
+	for i, s := range src {
+		g := MemoToPb(ctx, s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// MemoToPbList is DEPRECATED. Use MemoToPbValPtrList instead.
+func MemoToPbList(ctx context.Context, src []model.Memo, opts ...TransformParam) []*example.Memo {
+	return MemoToPbValPtrList(ctx, src, opts...)
+}
+
+func MemoToPb(ctx context.Context, src model.Memo, opts ...TransformParam) example.Memo {
+	s := example.Memo{
+		Id:     src.ID,
+		Tags:   src.Tags,
+		Author: src.Author,
+	}
+
+	applyOptions(opts...)
+
+	s.Note = billing.SealNote(ctx, src.Note)
+
+	return s
+}
+
+func MemoToPbValPtr(ctx context.Context, src model.Memo, opts ...TransformParam) *example.Memo {
+	d := MemoToPb(ctx, src, opts...)
+	return &d
+}
+
+func MemoToPbValList(ctx context.Context, src []model.Memo, opts ...TransformParam) []example.Memo {
+	resp := make([]example.Memo, len(src))
+
+	for i, s := range src {
+		resp[i] = MemoToPb(ctx, s, opts...)
+	}
+
+	return resp
+}
+
+func MemoToPbPtrValList(ctx context.Context, src []*model.Memo, opts ...TransformParam) []example.Memo {
+	resp := make([]example.Memo, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, MemoToPb(ctx, *s, opts...))
+	}
+
+	return resp
+}
+
+// MemoToPbFieldNames maps model.Memo field names to example.Memo field names.
+var MemoToPbFieldNames = map[string]string{
+	"ID":     "id",
+	"Note":   "note",
+	"Tags":   "tags",
+	"Author": "author",
+}
+
+// MemoToPbJSONNames maps model.Memo JSON field names to example.Memo JSON field names.
+var MemoToPbJSONNames = map[string]string{
+	"ID":     "id",
+	"Note":   "note",
+	"Tags":   "tags",
+	"Author": "author",
+}
+
+func PbToAuditPtr(src *example.AuditLog, opts ...TransformParam) *model.Audit {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToAudit(*src, opts...)
+	return &d
+}
+
+func PbToAuditPtrList(src []*example.AuditLog, opts ...TransformParam) []*model.Audit {
+	resp := make([]*model.Audit, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToAuditPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToAuditPtrVal(src *example.AuditLog, opts ...TransformParam) model.Audit {
+	if src == nil {
+		return model.Audit{}
+	}
+
+	return PbToAudit(*src, opts...)
+}
+
+func PbToAuditPtrValList(src []*example.AuditLog, opts ...TransformParam) []model.Audit {
+	resp := make([]model.Audit, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToAudit(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToAuditList is DEPRECATED. Use PbToAuditPtrValList instead.
+func PbToAuditList(src []*example.AuditLog, opts ...TransformParam) []model.Audit {
+	return PbToAuditPtrValList(src, opts...)
+}
+
+func PbToAudit(src example.AuditLog, opts ...TransformParam) model.Audit {
+	s := model.Audit{
+		Author: src.Author,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToAuditValPtr(src example.AuditLog, opts ...TransformParam) *model.Audit {
+	d := PbToAudit(src, opts...)
+	return &d
+}
+
+func PbToAuditValList(src []example.AuditLog, opts ...TransformParam) []model.Audit {
+	resp := make([]model.Audit, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToAudit(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToAuditValPtrList(src []example.AuditLog, opts ...TransformParam) []*model.Audit {
+	resp := make([]*model.Audit, len(src))
+
+	for i, s := range src {
+		g := PbToAudit(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToAuditFieldNames maps example.AuditLog field names to model.Audit field names.
+var PbToAuditFieldNames = map[string]string{
+	"author": "Author",
+}
+
+// PbToAuditJSONNames maps example.AuditLog JSON field names to model.Audit JSON field names.
+var PbToAuditJSONNames = map[string]string{
+	"author": "Author",
+}
+
+// PbToAuditSchemaHash is a hash of fields mapping between example.AuditLog and model.Audit.
+// It changes when mapped fields or their types are changed.
+const PbToAuditSchemaHash = "0b1d6b34b0d039da4e07834c02fd6a57ad0043d397a07988701fedc4fdd96170"
+
+func PbToMemoThreadPtr(ctx context.Context, src *example.MemoThread, opts ...TransformParam) *model.MemoThread {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToMemoThread(ctx, *src, opts...)
+	return &d
+}
+
+func PbToMemoThreadPtrList(ctx context.Context, src []*example.MemoThread, opts ...TransformParam) []*model.MemoThread {
+	resp := make([]*model.MemoThread, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMemoThreadPtr(ctx, s, opts...)
+	}
+
+	return resp
+}
+
+func PbToMemoThreadPtrVal(ctx context.Context, src *example.MemoThread, opts ...TransformParam) model.MemoThread {
+	if src == nil {
+		return model.MemoThread{}
+	}
+
+	return PbToMemoThread(ctx, *src, opts...)
+}
+
+func PbToMemoThreadPtrValList(ctx context.Context, src []*example.MemoThread, opts ...TransformParam) []model.MemoThread {
+	resp := make([]model.MemoThread, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToMemoThread(ctx, *s, opts...))
+	}
+
+	return resp
+}
+
+// PbToMemoThreadList is DEPRECATED. Use PbToMemoThreadPtrValList instead.
+func PbToMemoThreadList(ctx context.Context, src []*example.MemoThread, opts ...TransformParam) []model.MemoThread {
+	return PbToMemoThreadPtrValList(ctx, src, opts...)
+}
+
+func PbToMemoThread(ctx context.Context, src example.MemoThread, opts ...TransformParam) model.MemoThread {
+	s := model.MemoThread{}
+
+	applyOptions(opts...)
+
+	s.Memos = PbToMemoPtrValList(ctx, src.Memos, opts...)
+
+	s.Pinned = PbToMemoPtr(ctx, src.Pinned, opts...)
+
+	return s
+}
+
+func PbToMemoThreadValPtr(ctx context.Context, src example.MemoThread, opts ...TransformParam) *model.MemoThread {
+	d := PbToMemoThread(ctx, src, opts...)
+	return &d
+}
+
+func PbToMemoThreadValList(ctx context.Context, src []example.MemoThread, opts ...TransformParam) []model.MemoThread {
+	resp := make([]model.MemoThread, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToMemoThread(ctx, s, opts...)
+	}
+
+	return resp
+}
+
+func PbToMemoThreadValPtrList(ctx context.Context, src []example.MemoThread, opts ...TransformParam) []*model.MemoThread {
+	resp := make([]*model.MemoThread, len(src))
+
+	for i, s := range src {
+		g := PbToMemoThread(ctx, s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToMemoThreadFieldNames maps example.MemoThread field names to model.MemoThread field names.
+var PbToMemoThreadFieldNames = map[string]string{
+	"memos":  "Memos",
+	"pinned": "Pinned",
+}
+
+// PbToMemoThreadJSONNames maps example.MemoThread JSON field names to model.MemoThread JSON field names.
+var PbToMemoThreadJSONNames = map[string]string{
+	"memos":  "Memos",
+	"pinned": "Pinned",
+}
+
+// PbToMemoThreadSchemaHash is a hash of fields mapping between example.MemoThread and model.MemoThread.
+// It changes when mapped fields or their types are changed.
+const PbToMemoThreadSchemaHash = "46d859e0abeed4eb78256e92c258ba048e1c3f5b2cbaf6af3a1e8f8047b269cd"
+
+func MemoThreadToPbPtr(ctx context.Context, src *model.MemoThread, opts ...TransformParam) *example.MemoThread {
+	if src == nil {
+		return nil
+	}
+
+	d := MemoThreadToPb(ctx, *src, opts...)
+	return &d
+}
+
+func MemoThreadToPbPtrList(ctx context.Context, src []*model.MemoThread, opts ...TransformParam) []*example.MemoThread {
+	resp := make([]*example.MemoThread, len(src))
+
+	for i, s := range src {
+		resp[i] = MemoThreadToPbPtr(ctx, s, opts...)
+	}
+
+	return resp
+}
+
+func MemoThreadToPbPtrVal(ctx context.Context, src *model.MemoThread, opts ...TransformParam) example.MemoThread {
+	if src == nil {
+		return example.MemoThread{}
+	}
+
+	return MemoThreadToPb(ctx, *src, opts...)
+}
+
+func MemoThreadToPbValPtrList(ctx context.Context, src []model.MemoThread, opts ...TransformParam) []*example.MemoThread {
+	resp := make([]*example.MemoThread, len(src))
+
+	for i, s := range src {
+		g := MemoThreadToPb(ctx, s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// MemoThreadToPbList is DEPRECATED. Use MemoThreadToPbValPtrList instead.
+func MemoThreadToPbList(ctx context.Context, src []model.MemoThread, opts ...TransformParam) []*example.MemoThread {
+	return MemoThreadToPbValPtrList(ctx, src, opts...)
+}
+
+func MemoThreadToPb(ctx context.Context, src model.MemoThread, opts ...TransformParam) example.MemoThread {
+	s := example.MemoThread{}
+
+	applyOptions(opts...)
+
+	s.Memos = MemoToPbValPtrList(ctx, src.Memos, opts...)
+
+	s.Pinned = MemoToPbPtr(ctx, src.Pinned, opts...)
+
+	return s
+}
+
+func MemoThreadToPbValPtr(ctx context.Context, src model.MemoThread, opts ...TransformParam) *example.MemoThread {
+	d := MemoThreadToPb(ctx, src, opts...)
+	return &d
+}
+
+func MemoThreadToPbValList(ctx context.Context, src []model.MemoThread, opts ...TransformParam) []example.MemoThread {
+	resp := make([]example.MemoThread, len(src))
+
+	for i, s := range src {
+		resp[i] = MemoThreadToPb(ctx, s, opts...)
+	}
+
+	return resp
+}
+
+func MemoThreadToPbPtrValList(ctx context.Context, src []*model.MemoThread, opts ...TransformParam) []example.MemoThread {
+	resp := make([]example.MemoThread, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, MemoThreadToPb(ctx, *s, opts...))
+	}
+
+	return resp
+}
+
+// MemoThreadToPbFieldNames maps model.MemoThread field names to example.MemoThread field names.
+var MemoThreadToPbFieldNames = map[string]string{
+	"Memos":  "memos",
+	"Pinned": "pinned",
+}
+
+// MemoThreadToPbJSONNames maps model.MemoThread JSON field names to example.MemoThread JSON field names.
+var MemoThreadToPbJSONNames = map[string]string{
+	"Memos":  "memos",
+	"Pinned": "pinned",
+}
+
+func PbToCardPaymentPtr(src *example.Card, opts ...TransformParam) *model.CardPayment {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToCardPayment(*src, opts...)
+	return &d
+}
+
+func PbToCardPaymentPtrList(src []*example.Card, opts ...TransformParam) []*model.CardPayment {
+	resp := make([]*model.CardPayment, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToCardPaymentPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToCardPaymentPtrVal(src *example.Card, opts ...TransformParam) model.CardPayment {
+	if src == nil {
+		return model.CardPayment{}
+	}
+
+	return PbToCardPayment(*src, opts...)
+}
+
+func PbToCardPaymentPtrValList(src []*example.Card, opts ...TransformParam) []model.CardPayment {
+	resp := make([]model.CardPayment, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToCardPayment(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToCardPaymentList is DEPRECATED. Use PbToCardPaymentPtrValList instead.
+func PbToCardPaymentList(src []*example.Card, opts ...TransformParam) []model.CardPayment {
+	return PbToCardPaymentPtrValList(src, opts...)
+}
+
+func PbToCardPayment(src example.Card, opts ...TransformParam) model.CardPayment {
+	s := model.CardPayment{
+		Number: src.Number,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToCardPaymentValPtr(src example.Card, opts ...TransformParam) *model.CardPayment {
+	d := PbToCardPayment(src, opts...)
+	return &d
+}
+
+func PbToCardPaymentValList(src []example.Card, opts ...TransformParam) []model.CardPayment {
+	resp := make([]model.CardPayment, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToCardPayment(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToCardPaymentValPtrList(src []example.Card, opts ...TransformParam) []*model.CardPayment {
+	resp := make([]*model.CardPayment, len(src))
+
+	for i, s := range src {
+		g := PbToCardPayment(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToCardPaymentFieldNames maps example.Card field names to model.CardPayment field names.
+var PbToCardPaymentFieldNames = map[string]string{
+	"number": "Number",
+}
+
+// PbToCardPaymentJSONNames maps example.Card JSON field names to model.CardPayment JSON field names.
+var PbToCardPaymentJSONNames = map[string]string{
+	"number": "Number",
+}
+
+// PbToCardPaymentSchemaHash is a hash of fields mapping between example.Card and model.CardPayment.
+// It changes when mapped fields or their types are changed.
+const PbToCardPaymentSchemaHash = "f459731b254c1ab1f5038fffa4ab367a5f9533f1eef8d90f5505064770978da2"
+
+func CardPaymentToPbPtr(src *model.CardPayment, opts ...TransformParam) *example.Card {
+	if src == nil {
+		return nil
+	}
+
+	d := CardPaymentToPb(*src, opts...)
+	return &d
+}
+
+func CardPaymentToPbPtrList(src []*model.CardPayment, opts ...TransformParam) []*example.Card {
+	resp := make([]*example.Card, len(src))
+
+	for i, s := range src {
+		resp[i] = CardPaymentToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func CardPaymentToPbPtrVal(src *model.CardPayment, opts ...TransformParam) example.Card {
+	if src == nil {
+		return example.Card{}
+	}
+
+	return CardPaymentToPb(*src, opts...)
+}
+
+func CardPaymentToPbValPtrList(src []model.CardPayment, opts ...TransformParam) []*example.Card {
+	resp := make([]*example.Card, len(src))
+
+	for i, s := range src {
+		g := CardPaymentToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// CardPaymentToPbList is DEPRECATED. Use CardPaymentToPbValPtrList instead.
+func CardPaymentToPbList(src []model.CardPayment, opts ...TransformParam) []*example.Card {
+	return CardPaymentToPbValPtrList(src, opts...)
+}
+
+func CardPaymentToPb(src model.CardPayment, opts ...TransformParam) example.Card {
+	s := example.Card{
+		Number: src.Number,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func CardPaymentToPbValPtr(src model.CardPayment, opts ...TransformParam) *example.Card {
+	d := CardPaymentToPb(src, opts...)
+	return &d
+}
+
+func CardPaymentToPbValList(src []model.CardPayment, opts ...TransformParam) []example.Card {
+	resp := make([]example.Card, len(src))
+
+	for i, s := range src {
+		resp[i] = CardPaymentToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func CardPaymentToPbPtrValList(src []*model.CardPayment, opts ...TransformParam) []example.Card {
+	resp := make([]example.Card, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, CardPaymentToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// CardPaymentToPbFieldNames maps model.CardPayment field names to example.Card field names.
+var CardPaymentToPbFieldNames = map[string]string{
+	"Number": "number",
+}
+
+// CardPaymentToPbJSONNames maps model.CardPayment JSON field names to example.Card JSON field names.
+var CardPaymentToPbJSONNames = map[string]string{
+	"Number": "number",
+}
+
+func PbToPaymentPtr(src *example.Payment, opts ...TransformParam) *model.Payment {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToPayment(*src, opts...)
+	return &d
+}
+
+func PbToPaymentPtrList(src []*example.Payment, opts ...TransformParam) []*model.Payment {
+	resp := make([]*model.Payment, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToPaymentPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToPaymentPtrVal(src *example.Payment, opts ...TransformParam) model.Payment {
+	if src == nil {
+		return model.Payment{}
+	}
+
+	return PbToPayment(*src, opts...)
+}
+
+func PbToPaymentPtrValList(src []*example.Payment, opts ...TransformParam) []model.Payment {
+	resp := make([]model.Payment, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToPayment(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToPaymentList is DEPRECATED. Use PbToPaymentPtrValList instead.
+func PbToPaymentList(src []*example.Payment, opts ...TransformParam) []model.Payment {
+	return PbToPaymentPtrValList(src, opts...)
+}
+
+func PbToPayment(src example.Payment, opts ...TransformParam) model.Payment {
+	s := model.Payment{
+		ID: src.Id,
+	}
+
+	applyOptions(opts...)
+
+	switch v := src.Method.(type) {
+	case *example.Payment_Card:
+		s.Method = PbToCardPaymentPtr(v.Card, opts...)
+	case *example.Payment_Coupon:
+		s.Method = model.Coupon(v.Coupon)
+	}
+
+	switch src.Receipt.(type) {
+	case *example.Payment_Email:
+		s.Email = src.GetEmail()
+	case *example.Payment_Phone:
+		s.Phone = src.GetPhone()
+	}
+
+	return s
+}
+
+func PbToPaymentValPtr(src example.Payment, opts ...TransformParam) *model.Payment {
+	d := PbToPayment(src, opts...)
+	return &d
+}
+
+func PbToPaymentValList(src []example.Payment, opts ...TransformParam) []model.Payment {
+	resp := make([]model.Payment, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToPayment(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToPaymentValPtrList(src []example.Payment, opts ...TransformParam) []*model.Payment {
+	resp := make([]*model.Payment, len(src))
+
+	for i, s := range src {
+		g := PbToPayment(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToPaymentFieldNames maps example.Payment field names to model.Payment field names.
+var PbToPaymentFieldNames = map[string]string{
+	"id":     "ID",
+	"card":   "Method",
+	"coupon": "Method",
+	"email":  "Email",
+	"phone":  "Phone",
+}
+
+// PbToPaymentJSONNames maps example.Payment JSON field names to model.Payment JSON field names.
+var PbToPaymentJSONNames = map[string]string{
+	"id":     "ID",
+	"card":   "Method",
+	"coupon": "Method",
+	"email":  "Email",
+	"phone":  "Phone",
+}
+
+// PbToPaymentSchemaHash is a hash of fields mapping between example.Payment and model.Payment.
+// It changes when mapped fields or their types are changed.
+const PbToPaymentSchemaHash = "fedc88e9655d12698b02cad11dda2bc0c697cbce3274f2e3d0ba46b71d573b46"
+
+func PaymentToPbPtr(src *model.Payment, opts ...TransformParam) *example.Payment {
+	if src == nil {
+		return nil
+	}
+
+	d := PaymentToPb(*src, opts...)
+	return &d
+}
+
+func PaymentToPbPtrList(src []*model.Payment, opts ...TransformParam) []*example.Payment {
+	resp := make([]*example.Payment, len(src))
+
+	for i, s := range src {
+		resp[i] = PaymentToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PaymentToPbPtrVal(src *model.Payment, opts ...TransformParam) example.Payment {
+	if src == nil {
+		return example.Payment{}
+	}
+
+	return PaymentToPb(*src, opts...)
+}
+
+func PaymentToPbValPtrList(src []model.Payment, opts ...TransformParam) []*example.Payment {
+	resp := make([]*example.Payment, len(src))
+
+	for i, s := range src {
+		g := PaymentToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PaymentToPbList is DEPRECATED. Use PaymentToPbValPtrList instead.
+func PaymentToPbList(src []model.Payment, opts ...TransformParam) []*example.Payment {
+	return PaymentToPbValPtrList(src, opts...)
+}
+
+func PaymentToPb(src model.Payment, opts ...TransformParam) example.Payment {
+	s := example.Payment{
+		Id: src.ID,
+	}
+
+	applyOptions(opts...)
+
+	switch v := src.Method.(type) {
+	case *model.CardPayment:
+		s.Method = &example.Payment_Card{Card: CardPaymentToPbPtr(v, opts...)}
+	case model.Coupon:
+		s.Method = &example.Payment_Coupon{Coupon: string(v)}
+	}
+
+	switch {
+	case src.Email != "":
+		s.Receipt = &example.Payment_Email{Email: src.Email}
+	case src.Phone != "":
+		s.Receipt = &example.Payment_Phone{Phone: src.Phone}
+	}
+
+	return s
+}
+
+func PaymentToPbValPtr(src model.Payment, opts ...TransformParam) *example.Payment {
+	d := PaymentToPb(src, opts...)
+	return &d
+}
+
+func PaymentToPbValList(src []model.Payment, opts ...TransformParam) []example.Payment {
+	resp := make([]example.Payment, len(src))
+
+	for i, s := range src {
+		resp[i] = PaymentToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func PaymentToPbPtrValList(src []*model.Payment, opts ...TransformParam) []example.Payment {
+	resp := make([]example.Payment, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PaymentToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// PaymentToPbFieldNames maps model.Payment field names to example.Payment field names.
+var PaymentToPbFieldNames = map[string]string{
+	"ID":     "id",
+	"Method": "card",
+	"Email":  "email",
+	"Phone":  "phone",
+}
+
+// PaymentToPbJSONNames maps model.Payment JSON field names to example.Payment JSON field names.
+var PaymentToPbJSONNames = map[string]string{
+	"ID":     "id",
+	"Method": "card",
+	"Email":  "email",
+	"Phone":  "phone",
+}
+
+func PbToPayoutPtr(src *example.Payout, opts ...TransformParam) *model.Payout {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToPayout(*src, opts...)
+	return &d
+}
+
+func PbToPayoutPtrList(src []*example.Payout, opts ...TransformParam) []*model.Payout {
+	resp := make([]*model.Payout, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToPayoutPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToPayoutPtrVal(src *example.Payout, opts ...TransformParam) model.Payout {
+	if src == nil {
+		return model.Payout{}
+	}
+
+	return PbToPayout(*src, opts...)
+}
+
+func PbToPayoutPtrValList(src []*example.Payout, opts ...TransformParam) []model.Payout {
+	resp := make([]model.Payout, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToPayout(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToPayoutList is DEPRECATED. Use PbToPayoutPtrValList instead.
+func PbToPayoutList(src []*example.Payout, opts ...TransformParam) []model.Payout {
+	return PbToPayoutPtrValList(src, opts...)
+}
+
+func PbToPayout(src example.Payout, opts ...TransformParam) model.Payout {
+	s := model.Payout{
+		ID: src.Id,
+	}
+
+	applyOptions(opts...)
+
+	switch v := src.Target.(type) {
+	case *example.Payout_Card:
+		s.Target = model.PayoutTargetCard{Card: PbToCardPaymentPtr(v.Card, opts...)}
+	case *example.Payout_Account:
+		s.Target = model.PayoutTargetAccount{Account: v.Account}
+	}
+
+	return s
+}
+
+func PbToPayoutValPtr(src example.Payout, opts ...TransformParam) *model.Payout {
+	d := PbToPayout(src, opts...)
+	return &d
+}
+
+func PbToPayoutValList(src []example.Payout, opts ...TransformParam) []model.Payout {
+	resp := make([]model.Payout, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToPayout(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToPayoutValPtrList(src []example.Payout, opts ...TransformParam) []*model.Payout {
+	resp := make([]*model.Payout, len(src))
+
+	for i, s := range src {
+		g := PbToPayout(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToPayoutFieldNames maps example.Payout field names to model.Payout field names.
+var PbToPayoutFieldNames = map[string]string{
+	"id":      "ID",
+	"card":    "Target",
+	"account": "Target",
+}
+
+// PbToPayoutJSONNames maps example.Payout JSON field names to model.Payout JSON field names.
+var PbToPayoutJSONNames = map[string]string{
+	"id":      "ID",
+	"card":    "Target",
+	"account": "Target",
+}
+
+// PbToPayoutSchemaHash is a hash of fields mapping between example.Payout and model.Payout.
+// It changes when mapped fields or their types are changed.
+const PbToPayoutSchemaHash = "c9228d034f4a9e1a0f6fcb4a3c9174c8fcf92c55119461cbba503a99a39049ed"
+
+func PayoutToPbPtr(src *model.Payout, opts ...TransformParam) *example.Payout {
+	if src == nil {
+		return nil
+	}
+
+	d := PayoutToPb(*src, opts...)
+	return &d
+}
+
+func PayoutToPbPtrList(src []*model.Payout, opts ...TransformParam) []*example.Payout {
+	resp := make([]*example.Payout, len(src))
+
+	for i, s := range src {
+		resp[i] = PayoutToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PayoutToPbPtrVal(src *model.Payout, opts ...TransformParam) example.Payout {
+	if src == nil {
+		return example.Payout{}
+	}
+
+	return PayoutToPb(*src, opts...)
+}
+
+func PayoutToPbValPtrList(src []model.Payout, opts ...TransformParam) []*example.Payout {
+	resp := make([]*example.Payout, len(src))
+
+	for i, s := range src {
+		g := PayoutToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PayoutToPbList is DEPRECATED. Use PayoutToPbValPtrList instead.
+func PayoutToPbList(src []model.Payout, opts ...TransformParam) []*example.Payout {
+	return PayoutToPbValPtrList(src, opts...)
+}
+
+func PayoutToPb(src model.Payout, opts ...TransformParam) example.Payout {
+	s := example.Payout{
+		Id: src.ID,
+	}
+
+	applyOptions(opts...)
+
+	switch v := src.Target.(type) {
+	case model.PayoutTargetCard:
+		s.Target = &example.Payout_Card{Card: CardPaymentToPbPtr(v.Card, opts...)}
+	case model.PayoutTargetAccount:
+		s.Target = &example.Payout_Account{Account: v.Account}
+	}
+
+	return s
+}
+
+func PayoutToPbValPtr(src model.Payout, opts ...TransformParam) *example.Payout {
+	d := PayoutToPb(src, opts...)
+	return &d
+}
+
+func PayoutToPbValList(src []model.Payout, opts ...TransformParam) []example.Payout {
+	resp := make([]example.Payout, len(src))
+
+	for i, s := range src {
+		resp[i] = PayoutToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func PayoutToPbPtrValList(src []*model.Payout, opts ...TransformParam) []example.Payout {
+	resp := make([]example.Payout, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PayoutToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// PayoutToPbFieldNames maps model.Payout field names to example.Payout field names.
+var PayoutToPbFieldNames = map[string]string{
+	"ID":     "id",
+	"Target": "card",
+}
+
+// PayoutToPbJSONNames maps model.Payout JSON field names to example.Payout JSON field names.
+var PayoutToPbJSONNames = map[string]string{
+	"ID":     "id",
+	"Target": "card",
+}
+
+func PbToRetryPolicyPtr(src *example.RetryPolicy, opts ...TransformParam) *model.RetryPolicy {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToRetryPolicy(*src, opts...)
+	return &d
+}
+
+func PbToRetryPolicyPtrList(src []*example.RetryPolicy, opts ...TransformParam) []*model.RetryPolicy {
+	resp := make([]*model.RetryPolicy, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToRetryPolicyPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToRetryPolicyPtrVal(src *example.RetryPolicy, opts ...TransformParam) model.RetryPolicy {
+	if src == nil {
+		return model.RetryPolicy{}
+	}
+
+	return PbToRetryPolicy(*src, opts...)
+}
+
+func PbToRetryPolicyPtrValList(src []*example.RetryPolicy, opts ...TransformParam) []model.RetryPolicy {
+	resp := make([]model.RetryPolicy, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToRetryPolicy(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToRetryPolicyList is DEPRECATED. Use PbToRetryPolicyPtrValList instead.
+func PbToRetryPolicyList(src []*example.RetryPolicy, opts ...TransformParam) []model.RetryPolicy {
+	return PbToRetryPolicyPtrValList(src, opts...)
+}
+
+func PbToRetryPolicy(src example.RetryPolicy, opts ...TransformParam) model.RetryPolicy {
+	s := model.RetryPolicy{
+		Backoff: src.Backoff,
+		MaxWait: src.MaxWait,
+	}
+
+	applyOptions(opts...)
+
+	if src.Timeout != nil {
+		s.TimeoutMs = int64(*src.Timeout / time.Millisecond)
+	}
+
+	return s
+}
+
+func PbToRetryPolicyValPtr(src example.RetryPolicy, opts ...TransformParam) *model.RetryPolicy {
+	d := PbToRetryPolicy(src, opts...)
+	return &d
+}
+
+func PbToRetryPolicyValList(src []example.RetryPolicy, opts ...TransformParam) []model.RetryPolicy {
+	resp := make([]model.RetryPolicy, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToRetryPolicy(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToRetryPolicyValPtrList(src []example.RetryPolicy, opts ...TransformParam) []*model.RetryPolicy {
+	resp := make([]*model.RetryPolicy, len(src))
+
+	for i, s := range src {
+		g := PbToRetryPolicy(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToRetryPolicyFieldNames maps example.RetryPolicy field names to model.RetryPolicy field names.
+var PbToRetryPolicyFieldNames = map[string]string{
+	"backoff":  "Backoff",
+	"max_wait": "MaxWait",
+	"timeout":  "TimeoutMs",
+}
+
+// PbToRetryPolicyJSONNames maps example.RetryPolicy JSON field names to model.RetryPolicy JSON field names.
+var PbToRetryPolicyJSONNames = map[string]string{
+	"backoff": "Backoff",
+	"maxWait": "MaxWait",
+	"timeout": "TimeoutMs",
+}
+
+// PbToRetryPolicySchemaHash is a hash of fields mapping between example.RetryPolicy and model.RetryPolicy.
+// It changes when mapped fields or their types are changed.
+const PbToRetryPolicySchemaHash = "8294fdf691f04a746548d503d0700bf04e0dc9f8bd6690bc83db39ee12033cda"
+
+func RetryPolicyToPbPtr(src *model.RetryPolicy, opts ...TransformParam) *example.RetryPolicy {
+	if src == nil {
+		return nil
+	}
+
+	d := RetryPolicyToPb(*src, opts...)
+	return &d
+}
+
+func RetryPolicyToPbPtrList(src []*model.RetryPolicy, opts ...TransformParam) []*example.RetryPolicy {
+	resp := make([]*example.RetryPolicy, len(src))
+
+	for i, s := range src {
+		resp[i] = RetryPolicyToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func RetryPolicyToPbPtrVal(src *model.RetryPolicy, opts ...TransformParam) example.RetryPolicy {
+	if src == nil {
+		return example.RetryPolicy{}
+	}
+
+	return RetryPolicyToPb(*src, opts...)
+}
+
+func RetryPolicyToPbValPtrList(src []model.RetryPolicy, opts ...TransformParam) []*example.RetryPolicy {
+	resp := make([]*example.RetryPolicy, len(src))
+
+	for i, s := range src {
+		g := RetryPolicyToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// RetryPolicyToPbList is DEPRECATED. Use RetryPolicyToPbValPtrList instead.
+func RetryPolicyToPbList(src []model.RetryPolicy, opts ...TransformParam) []*example.RetryPolicy {
+	return RetryPolicyToPbValPtrList(src, opts...)
+}
+
+func RetryPolicyToPb(src model.RetryPolicy, opts ...TransformParam) example.RetryPolicy {
+	s := example.RetryPolicy{
+		Backoff: src.Backoff,
+		MaxWait: src.MaxWait,
+	}
+
+	applyOptions(opts...)
+
+	if src.TimeoutMs != 0 {
+		d := time.Duration(src.TimeoutMs) * time.Millisecond
+		s.Timeout = &d
+	}
+
+	return s
+}
+
+func RetryPolicyToPbValPtr(src model.RetryPolicy, opts ...TransformParam) *example.RetryPolicy {
+	d := RetryPolicyToPb(src, opts...)
+	return &d
+}
+
+func RetryPolicyToPbValList(src []model.RetryPolicy, opts ...TransformParam) []example.RetryPolicy {
+	resp := make([]example.RetryPolicy, len(src))
+
+	for i, s := range src {
+		resp[i] = RetryPolicyToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func RetryPolicyToPbPtrValList(src []*model.RetryPolicy, opts ...TransformParam) []example.RetryPolicy {
+	resp := make([]example.RetryPolicy, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, RetryPolicyToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// RetryPolicyToPbFieldNames maps model.RetryPolicy field names to example.RetryPolicy field names.
+var RetryPolicyToPbFieldNames = map[string]string{
+	"Backoff":   "backoff",
+	"MaxWait":   "max_wait",
+	"TimeoutMs": "timeout",
+}
+
+// RetryPolicyToPbJSONNames maps model.RetryPolicy JSON field names to example.RetryPolicy JSON field names.
+var RetryPolicyToPbJSONNames = map[string]string{
+	"Backoff":   "backoff",
+	"MaxWait":   "maxWait",
+	"TimeoutMs": "timeout",
+}
+
+func PbToDevicePtr(src *example.Device, opts ...TransformParam) (*model.Device, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToDevice(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToDevicePtrList(src []*example.Device, opts ...TransformParam) ([]*model.Device, error) {
+	resp := make([]*model.Device, len(src))
+
+	for i, s := range src {
+		d, err := PbToDevicePtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToDevicePtrVal(src *example.Device, opts ...TransformParam) (model.Device, error) {
+	if src == nil {
+		return model.Device{}, nil
+	}
+
+	return PbToDevice(*src, opts...)
+}
+
+func PbToDevicePtrValList(src []*example.Device, opts ...TransformParam) ([]model.Device, error) {
+	resp := make([]model.Device, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := PbToDevice(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// PbToDeviceList is DEPRECATED. Use PbToDevicePtrValList instead.
+func PbToDeviceList(src []*example.Device, opts ...TransformParam) ([]model.Device, error) {
+	return PbToDevicePtrValList(src, opts...)
+}
+
+func PbToDevice(src example.Device, opts ...TransformParam) (model.Device, error) {
+	s := model.Device{
+		ID: src.Id,
+	}
+
+	applyOptions(opts...)
+
+	s.Attributes = StructToMap(src.Attributes)
+
+	vConfig, err := StructToJSON(src.Config)
+	if err != nil {
+		return model.Device{}, fmt.Errorf("field Config: %w", err)
+	}
+	s.Config = vConfig
+
+	return s, nil
+}
+
+func PbToDeviceValPtr(src example.Device, opts ...TransformParam) (*model.Device, error) {
+	d, err := PbToDevice(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToDeviceValList(src []example.Device, opts ...TransformParam) ([]model.Device, error) {
+	resp := make([]model.Device, len(src))
+
+	for i, s := range src {
+		d, err := PbToDevice(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToDeviceValPtrList(src []example.Device, opts ...TransformParam) ([]*model.Device, error) {
+	resp := make([]*model.Device, len(src))
+
+	for i, s := range src {
+		g, err := PbToDevice(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// PbToDeviceFieldNames maps example.Device field names to model.Device field names.
+var PbToDeviceFieldNames = map[string]string{
+	"id":         "ID",
+	"attributes": "Attributes",
+	"config":     "Config",
+}
+
+// PbToDeviceJSONNames maps example.Device JSON field names to model.Device JSON field names.
+var PbToDeviceJSONNames = map[string]string{
+	"id":         "ID",
+	"attributes": "Attributes",
+	"config":     "Config",
+}
+
+// PbToDeviceSchemaHash is a hash of fields mapping between example.Device and model.Device.
+// It changes when mapped fields or their types are changed.
+const PbToDeviceSchemaHash = "30e359f502a8d82be42d16792844613a72be0594792faa059888f6c5b0797be3"
+
+func DeviceToPbPtr(src *model.Device, opts ...TransformParam) (*example.Device, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := DeviceToPb(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func DeviceToPbPtrList(src []*model.Device, opts ...TransformParam) ([]*example.Device, error) {
+	resp := make([]*example.Device, len(src))
+
+	for i, s := range src {
+		d, err := DeviceToPbPtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func DeviceToPbPtrVal(src *model.Device, opts ...TransformParam) (example.Device, error) {
+	if src == nil {
+		return example.Device{}, nil
+	}
+
+	return DeviceToPb(*src, opts...)
+}
+
+func DeviceToPbValPtrList(src []model.Device, opts ...TransformParam) ([]*example.Device, error) {
+	resp := make([]*example.Device, len(src))
+
+	for i, s := range src {
+		g, err := DeviceToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// DeviceToPbList is DEPRECATED. Use DeviceToPbValPtrList instead.
+func DeviceToPbList(src []model.Device, opts ...TransformParam) ([]*example.Device, error) {
+	return DeviceToPbValPtrList(src, opts...)
+}
+
+func DeviceToPb(src model.Device, opts ...TransformParam) (example.Device, error) {
+	s := example.Device{
+		Id: src.ID,
+	}
+
+	applyOptions(opts...)
+
+	vAttributes, err := MapToStruct(src.Attributes)
+	if err != nil {
+		return example.Device{}, fmt.Errorf("field Attributes: %w", err)
+	}
+	s.Attributes = vAttributes
+
+	vConfig, err := JSONToStruct(src.Config)
+	if err != nil {
+		return example.Device{}, fmt.Errorf("field Config: %w", err)
+	}
+	s.Config = vConfig
+
+	return s, nil
+}
+
+func DeviceToPbValPtr(src model.Device, opts ...TransformParam) (*example.Device, error) {
+	d, err := DeviceToPb(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func DeviceToPbValList(src []model.Device, opts ...TransformParam) ([]example.Device, error) {
+	resp := make([]example.Device, len(src))
+
+	for i, s := range src {
+		d, err := DeviceToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func DeviceToPbPtrValList(src []*model.Device, opts ...TransformParam) ([]example.Device, error) {
+	resp := make([]example.Device, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := DeviceToPb(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// DeviceToPbFieldNames maps model.Device field names to example.Device field names.
+var DeviceToPbFieldNames = map[string]string{
+	"ID":         "id",
+	"Attributes": "attributes",
+	"Config":     "config",
+}
+
+// DeviceToPbJSONNames maps model.Device JSON field names to example.Device JSON field names.
+var DeviceToPbJSONNames = map[string]string{
+	"ID":         "id",
+	"Attributes": "attributes",
+	"Config":     "config",
+}
+
+func PbToAttachmentPtr(src *example.Attachment, opts ...TransformParam) (*model.Attachment, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToAttachment(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToAttachmentPtrList(src []*example.Attachment, opts ...TransformParam) ([]*model.Attachment, error) {
+	resp := make([]*model.Attachment, len(src))
+
+	for i, s := range src {
+		d, err := PbToAttachmentPtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToAttachmentPtrVal(src *example.Attachment, opts ...TransformParam) (model.Attachment, error) {
+	if src == nil {
+		return model.Attachment{}, nil
+	}
+
+	return PbToAttachment(*src, opts...)
+}
+
+func PbToAttachmentPtrValList(src []*example.Attachment, opts ...TransformParam) ([]model.Attachment, error) {
+	resp := make([]model.Attachment, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := PbToAttachment(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// PbToAttachmentList is DEPRECATED. Use PbToAttachmentPtrValList instead.
+func PbToAttachmentList(src []*example.Attachment, opts ...TransformParam) ([]model.Attachment, error) {
+	return PbToAttachmentPtrValList(src, opts...)
+}
+
+func PbToAttachment(src example.Attachment, opts ...TransformParam) (model.Attachment, error) {
+	s := model.Attachment{
+		Content: src.Content,
+		Caption: string(src.Caption),
+	}
+
+	applyOptions(opts...)
+
+	if len(src.Checksum) > 0 {
+		vChecksum, err := billing.ParseChecksum(src.Checksum)
+		if err != nil {
+			return model.Attachment{}, fmt.Errorf("field Checksum: %w", err)
+		}
+		s.Checksum = vChecksum
+	}
+
+	if len(src.Id) > 0 {
+		vID, err := uuid.Parse(src.Id)
+		if err != nil {
+			return model.Attachment{}, fmt.Errorf("field Id: %w", err)
+		}
+		s.ID = vID
+	}
+
+	if len(src.OwnerId) > 0 {
+		vOwnerID, err := uuid.FromBytes(src.OwnerId)
+		if err != nil {
+			return model.Attachment{}, fmt.Errorf("field OwnerId: %w", err)
+		}
+		s.OwnerID = &vOwnerID
+	}
+
+	return s, nil
+}
+
+func PbToAttachmentValPtr(src example.Attachment, opts ...TransformParam) (*model.Attachment, error) {
+	d, err := PbToAttachment(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToAttachmentValList(src []example.Attachment, opts ...TransformParam) ([]model.Attachment, error) {
+	resp := make([]model.Attachment, len(src))
+
+	for i, s := range src {
+		d, err := PbToAttachment(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToAttachmentValPtrList(src []example.Attachment, opts ...TransformParam) ([]*model.Attachment, error) {
+	resp := make([]*model.Attachment, len(src))
+
+	for i, s := range src {
+		g, err := PbToAttachment(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// PbToAttachmentFieldNames maps example.Attachment field names to model.Attachment field names.
+var PbToAttachmentFieldNames = map[string]string{
+	"content":  "Content",
+	"caption":  "Caption",
+	"checksum": "Checksum",
+	"id":       "ID",
+	"owner_id": "OwnerID",
+}
+
+// PbToAttachmentJSONNames maps example.Attachment JSON field names to model.Attachment JSON field names.
+var PbToAttachmentJSONNames = map[string]string{
+	"content":  "Content",
+	"caption":  "Caption",
+	"checksum": "Checksum",
+	"id":       "ID",
+	"ownerId":  "OwnerID",
+}
+
+// PbToAttachmentSchemaHash is a hash of fields mapping between example.Attachment and model.Attachment.
+// It changes when mapped fields or their types are changed.
+const PbToAttachmentSchemaHash = "3e1b2dd59bc61be523ff3dc2a35a9e61ef4982ec61bf5a68063600d5cb3f9f33"
+
+func AttachmentToPbPtr(src *model.Attachment, opts ...TransformParam) (*example.Attachment, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := AttachmentToPb(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func AttachmentToPbPtrList(src []*model.Attachment, opts ...TransformParam) ([]*example.Attachment, error) {
+	resp := make([]*example.Attachment, len(src))
+
+	for i, s := range src {
+		d, err := AttachmentToPbPtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func AttachmentToPbPtrVal(src *model.Attachment, opts ...TransformParam) (example.Attachment, error) {
+	if src == nil {
+		return example.Attachment{}, nil
+	}
+
+	return AttachmentToPb(*src, opts...)
+}
+
+func AttachmentToPbValPtrList(src []model.Attachment, opts ...TransformParam) ([]*example.Attachment, error) {
+	resp := make([]*example.Attachment, len(src))
+
+	for i, s := range src {
+		g, err := AttachmentToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// AttachmentToPbList is DEPRECATED. Use AttachmentToPbValPtrList instead.
+func AttachmentToPbList(src []model.Attachment, opts ...TransformParam) ([]*example.Attachment, error) {
+	return AttachmentToPbValPtrList(src, opts...)
+}
+
+func AttachmentToPb(src model.Attachment, opts ...TransformParam) (example.Attachment, error) {
+	s := example.Attachment{
+		Content: src.Content,
+		Caption: []byte(src.Caption),
+	}
+
+	applyOptions(opts...)
+
+	s.Checksum = billing.Checksum.Bytes(src.Checksum)
+
+	if src.ID != uuid.Nil {
+		s.Id = src.ID.String()
+	}
+
+	if src.OwnerID != nil {
+		s.OwnerId = src.OwnerID[:]
+	}
+
+	return s, nil
+}
+
+func AttachmentToPbValPtr(src model.Attachment, opts ...TransformParam) (*example.Attachment, error) {
+	d, err := AttachmentToPb(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func AttachmentToPbValList(src []model.Attachment, opts ...TransformParam) ([]example.Attachment, error) {
+	resp := make([]example.Attachment, len(src))
+
+	for i, s := range src {
+		d, err := AttachmentToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func AttachmentToPbPtrValList(src []*model.Attachment, opts ...TransformParam) ([]example.Attachment, error) {
+	resp := make([]example.Attachment, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := AttachmentToPb(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// AttachmentToPbFieldNames maps model.Attachment field names to example.Attachment field names.
+var AttachmentToPbFieldNames = map[string]string{
+	"Content":  "content",
+	"Caption":  "caption",
+	"Checksum": "checksum",
+	"ID":       "id",
+	"OwnerID":  "owner_id",
+}
+
+// AttachmentToPbJSONNames maps model.Attachment JSON field names to example.Attachment JSON field names.
+var AttachmentToPbJSONNames = map[string]string{
+	"Content":  "content",
+	"Caption":  "caption",
+	"Checksum": "checksum",
+	"ID":       "id",
+	"OwnerID":  "ownerId",
+}
+
+func PbToQuotePtr(src *example.Quote, opts ...TransformParam) (*model.Quote, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := PbToQuote(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToQuotePtrList(src []*example.Quote, opts ...TransformParam) ([]*model.Quote, error) {
+	resp := make([]*model.Quote, len(src))
+
+	for i, s := range src {
+		d, err := PbToQuotePtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToQuotePtrVal(src *example.Quote, opts ...TransformParam) (model.Quote, error) {
+	if src == nil {
+		return model.Quote{}, nil
+	}
+
+	return PbToQuote(*src, opts...)
+}
+
+func PbToQuotePtrValList(src []*example.Quote, opts ...TransformParam) ([]model.Quote, error) {
+	resp := make([]model.Quote, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := PbToQuote(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// PbToQuoteList is DEPRECATED. Use PbToQuotePtrValList instead.
+func PbToQuoteList(src []*example.Quote, opts ...TransformParam) ([]model.Quote, error) {
+	return PbToQuotePtrValList(src, opts...)
+}
+
+func PbToQuote(src example.Quote, opts ...TransformParam) (model.Quote, error) {
+	s := model.Quote{}
+
+	applyOptions(opts...)
+
+	if len(src.Price) > 0 {
+		vPrice, err := decimal.NewFromString(src.Price)
+		if err != nil {
+			return model.Quote{}, fmt.Errorf("field Price: %w", err)
+		}
+		s.Price = vPrice
+	}
+
+	s.Discount = decimal.New(src.DiscountCents, -2)
+
+	if src.Total != nil {
+		s.Total = decimal.New(src.Total.Units, 0).Add(decimal.New(int64(src.Total.Nanos), -9))
+		s.Currency = billing.Currency(src.Total.CurrencyCode)
+	}
+
+	return s, nil
+}
+
+func PbToQuoteValPtr(src example.Quote, opts ...TransformParam) (*model.Quote, error) {
+	d, err := PbToQuote(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func PbToQuoteValList(src []example.Quote, opts ...TransformParam) ([]model.Quote, error) {
+	resp := make([]model.Quote, len(src))
+
+	for i, s := range src {
+		d, err := PbToQuote(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func PbToQuoteValPtrList(src []example.Quote, opts ...TransformParam) ([]*model.Quote, error) {
+	resp := make([]*model.Quote, len(src))
+
+	for i, s := range src {
+		g, err := PbToQuote(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// PbToQuoteFieldNames maps example.Quote field names to model.Quote field names.
+var PbToQuoteFieldNames = map[string]string{
+	"price":          "Price",
+	"discount_cents": "Discount",
+	"total":          "Total",
+}
+
+// PbToQuoteJSONNames maps example.Quote JSON field names to model.Quote JSON field names.
+var PbToQuoteJSONNames = map[string]string{
+	"price":         "Price",
+	"discountCents": "Discount",
+	"total":         "Total",
+}
+
+// PbToQuoteSchemaHash is a hash of fields mapping between example.Quote and model.Quote.
+// It changes when mapped fields or their types are changed.
+const PbToQuoteSchemaHash = "13ce1e9a3742e3bc0895b48f96887426a9cfbc640ddd2c78203fe26ae6514592"
+
+func QuoteToPbPtr(src *model.Quote, opts ...TransformParam) (*example.Quote, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := QuoteToPb(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func QuoteToPbPtrList(src []*model.Quote, opts ...TransformParam) ([]*example.Quote, error) {
+	resp := make([]*example.Quote, len(src))
+
+	for i, s := range src {
+		d, err := QuoteToPbPtr(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func QuoteToPbPtrVal(src *model.Quote, opts ...TransformParam) (example.Quote, error) {
+	if src == nil {
+		return example.Quote{}, nil
+	}
+
+	return QuoteToPb(*src, opts...)
+}
+
+func QuoteToPbValPtrList(src []model.Quote, opts ...TransformParam) ([]*example.Quote, error) {
+	resp := make([]*example.Quote, len(src))
+
+	for i, s := range src {
+		g, err := QuoteToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}
+
+// QuoteToPbList is DEPRECATED. Use QuoteToPbValPtrList instead.
+func QuoteToPbList(src []model.Quote, opts ...TransformParam) ([]*example.Quote, error) {
+	return QuoteToPbValPtrList(src, opts...)
+}
+
+func QuoteToPb(src model.Quote, opts ...TransformParam) (example.Quote, error) {
+	s := example.Quote{}
+
+	applyOptions(opts...)
+
+	s.Price = src.Price.String()
+
+	s.DiscountCents = src.Discount.Shift(2).RoundBank(0).IntPart()
+
+	vTotal := src.Total.Round(9)
+	s.Total = &_type.Money{CurrencyCode: string(src.Currency), Units: vTotal.IntPart(), Nanos: int32(vTotal.Sub(decimal.New(vTotal.IntPart(), 0)).Shift(9).IntPart())}
+
+	return s, nil
+}
+
+func QuoteToPbValPtr(src model.Quote, opts ...TransformParam) (*example.Quote, error) {
+	d, err := QuoteToPb(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func QuoteToPbValList(src []model.Quote, opts ...TransformParam) ([]example.Quote, error) {
+	resp := make([]example.Quote, len(src))
+
+	for i, s := range src {
+		d, err := QuoteToPb(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
+}
+
+func QuoteToPbPtrValList(src []*model.Quote, opts ...TransformParam) ([]example.Quote, error) {
+	resp := make([]example.Quote, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := QuoteToPb(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}
+
+// QuoteToPbFieldNames maps model.Quote field names to example.Quote field names.
+var QuoteToPbFieldNames = map[string]string{
+	"Price":    "price",
+	"Discount": "discount_cents",
+	"Total":    "total",
+}
+
+// QuoteToPbJSONNames maps model.Quote JSON field names to example.Quote JSON field names.
+var QuoteToPbJSONNames = map[string]string{
+	"Price":    "price",
+	"Discount": "discountCents",
+	"Total":    "total",
+}
+
+func PbToContactPtr(src *example.Contact, opts ...TransformParam) *model.Contact {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToContact(*src, opts...)
+	return &d
+}
+
+func PbToContactPtrList(src []*example.Contact, opts ...TransformParam) []*model.Contact {
+	resp := make([]*model.Contact, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToContactPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToContactPtrVal(src *example.Contact, opts ...TransformParam) model.Contact {
+	if src == nil {
+		return model.Contact{}
+	}
+
+	return PbToContact(*src, opts...)
+}
+
+func PbToContactPtrValList(src []*example.Contact, opts ...TransformParam) []model.Contact {
+	resp := make([]model.Contact, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToContact(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToContactList is DEPRECATED. Use PbToContactPtrValList instead.
+func PbToContactList(src []*example.Contact, opts ...TransformParam) []model.Contact {
+	return PbToContactPtrValList(src, opts...)
+}
+
+func PbToContact(src example.Contact, opts ...TransformParam) model.Contact {
+	s := model.Contact{}
+
+	applyOptions(opts...)
+
+	s.Nickname = sql.NullString{String: src.Nickname, Valid: true}
+
+	if src.Email != nil {
+		s.Email = sql.NullString{String: src.Email.Value, Valid: true}
+	}
+
+	if src.Age != nil {
+		s.Age = sql.NullInt64{Int64: int64(src.Age.Value), Valid: true}
+	}
+
+	if src.VerifiedAt != nil {
+		s.VerifiedAt = sql.NullTime{Time: time.Unix(src.VerifiedAt.Seconds, int64(src.VerifiedAt.Nanos)).UTC(), Valid: true}
+	}
+
+	return s
+}
+
+func PbToContactValPtr(src example.Contact, opts ...TransformParam) *model.Contact {
+	d := PbToContact(src, opts...)
+	return &d
+}
+
+func PbToContactValList(src []example.Contact, opts ...TransformParam) []model.Contact {
+	resp := make([]model.Contact, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToContact(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToContactValPtrList(src []example.Contact, opts ...TransformParam) []*model.Contact {
+	resp := make([]*model.Contact, len(src))
+
+	for i, s := range src {
+		g := PbToContact(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToContactFieldNames maps example.Contact field names to model.Contact field names.
+var PbToContactFieldNames = map[string]string{
+	"nickname":    "Nickname",
+	"email":       "Email",
+	"age":         "Age",
+	"verified_at": "VerifiedAt",
+}
+
+// PbToContactJSONNames maps example.Contact JSON field names to model.Contact JSON field names.
+var PbToContactJSONNames = map[string]string{
+	"nickname":   "Nickname",
+	"email":      "Email",
+	"age":        "Age",
+	"verifiedAt": "VerifiedAt",
+}
+
+// PbToContactSchemaHash is a hash of fields mapping between example.Contact and model.Contact.
+// It changes when mapped fields or their types are changed.
+const PbToContactSchemaHash = "28aaacda8900ac4dd150f53dd2696fb2bb513370bae099686cb5987f12c8f25c"
+
+func ContactToPbPtr(src *model.Contact, opts ...TransformParam) *example.Contact {
+	if src == nil {
+		return nil
+	}
+
+	d := ContactToPb(*src, opts...)
+	return &d
+}
+
+func ContactToPbPtrList(src []*model.Contact, opts ...TransformParam) []*example.Contact {
+	resp := make([]*example.Contact, len(src))
+
+	for i, s := range src {
+		resp[i] = ContactToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func ContactToPbPtrVal(src *model.Contact, opts ...TransformParam) example.Contact {
+	if src == nil {
+		return example.Contact{}
+	}
+
+	return ContactToPb(*src, opts...)
+}
+
+func ContactToPbValPtrList(src []model.Contact, opts ...TransformParam) []*example.Contact {
+	resp := make([]*example.Contact, len(src))
+
+	for i, s := range src {
+		g := ContactToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// ContactToPbList is DEPRECATED. Use ContactToPbValPtrList instead.
+func ContactToPbList(src []model.Contact, opts ...TransformParam) []*example.Contact {
+	return ContactToPbValPtrList(src, opts...)
+}
+
+func ContactToPb(src model.Contact, opts ...TransformParam) example.Contact {
+	s := example.Contact{}
+
+	applyOptions(opts...)
+
+	s.Nickname = src.Nickname.String
+
+	if src.Email.Valid {
+		s.Email = &types.StringValue{Value: src.Email.String}
+	}
+
+	if src.Age.Valid {
+		s.Age = &types.Int32Value{Value: int32(src.Age.Int64)}
+	}
+
+	if src.VerifiedAt.Valid {
+		s.VerifiedAt = &types.Timestamp{Seconds: src.VerifiedAt.Time.Unix(), Nanos: int32(src.VerifiedAt.Time.Nanosecond())}
+	}
+
+	return s
+}
+
+func ContactToPbValPtr(src model.Contact, opts ...TransformParam) *example.Contact {
+	d := ContactToPb(src, opts...)
+	return &d
+}
+
+func ContactToPbValList(src []model.Contact, opts ...TransformParam) []example.Contact {
+	resp := make([]example.Contact, len(src))
+
+	for i, s := range src {
+		resp[i] = ContactToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func ContactToPbPtrValList(src []*model.Contact, opts ...TransformParam) []example.Contact {
+	resp := make([]example.Contact, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, ContactToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// ContactToPbFieldNames maps model.Contact field names to example.Contact field names.
+var ContactToPbFieldNames = map[string]string{
+	"Nickname":   "nickname",
+	"Email":      "email",
+	"Age":        "age",
+	"VerifiedAt": "verified_at",
+}
+
+// ContactToPbJSONNames maps model.Contact JSON field names to example.Contact JSON field names.
+var ContactToPbJSONNames = map[string]string{
+	"Nickname":   "nickname",
+	"Email":      "email",
+	"Age":        "age",
+	"VerifiedAt": "verifiedAt",
+}
+
+func PbToTeamPtr(src *example.Team, opts ...TransformParam) *model.Team {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToTeam(*src, opts...)
+	return &d
+}
+
+func PbToTeamPtrList(src []*example.Team, opts ...TransformParam) []*model.Team {
+	resp := make([]*model.Team, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToTeamPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToTeamPtrVal(src *example.Team, opts ...TransformParam) model.Team {
+	if src == nil {
+		return model.Team{}
+	}
+
+	return PbToTeam(*src, opts...)
+}
+
+func PbToTeamPtrValList(src []*example.Team, opts ...TransformParam) []model.Team {
+	resp := make([]model.Team, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToTeam(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToTeamList is DEPRECATED. Use PbToTeamPtrValList instead.
+func PbToTeamList(src []*example.Team, opts ...TransformParam) []model.Team {
+	return PbToTeamPtrValList(src, opts...)
+}
+
+func PbToTeam(src example.Team, opts ...TransformParam) model.Team {
+	s := model.Team{
+		ID:   src.Id,
+		Name: src.Name,
+	}
+
+	applyOptions(opts...)
+
+	if src.Lead != nil {
+		s.Edges.Lead = PbToContactPtr(src.Lead, opts...)
+	}
+
+	if src.Members != nil {
+		s.Edges.Members = PbToContactPtrList(src.Members, opts...)
+	}
+
+	return s
+}
+
+func PbToTeamValPtr(src example.Team, opts ...TransformParam) *model.Team {
+	d := PbToTeam(src, opts...)
+	return &d
+}
+
+func PbToTeamValList(src []example.Team, opts ...TransformParam) []model.Team {
+	resp := make([]model.Team, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToTeam(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToTeamValPtrList(src []example.Team, opts ...TransformParam) []*model.Team {
+	resp := make([]*model.Team, len(src))
+
+	for i, s := range src {
+		g := PbToTeam(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToTeamFieldNames maps example.Team field names to model.Team field names.
+var PbToTeamFieldNames = map[string]string{
+	"id":      "ID",
+	"name":    "Name",
+	"lead":    "Edges.Lead",
+	"members": "Edges.Members",
+}
+
+// PbToTeamJSONNames maps example.Team JSON field names to model.Team JSON field names.
+var PbToTeamJSONNames = map[string]string{
+	"id":      "ID",
+	"name":    "Name",
+	"lead":    "Lead",
+	"members": "Members",
+}
+
+// PbToTeamSchemaHash is a hash of fields mapping between example.Team and model.Team.
+// It changes when mapped fields or their types are changed.
+const PbToTeamSchemaHash = "a26b060f6b5177588900ae39052178ebe61b512ba05bd2957cf0837a217cb666"
+
+func TeamToPbPtr(src *model.Team, opts ...TransformParam) *example.Team {
+	if src == nil {
+		return nil
+	}
+
+	d := TeamToPb(*src, opts...)
+	return &d
+}
+
+func TeamToPbPtrList(src []*model.Team, opts ...TransformParam) []*example.Team {
+	resp := make([]*example.Team, len(src))
+
+	for i, s := range src {
+		resp[i] = TeamToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func TeamToPbPtrVal(src *model.Team, opts ...TransformParam) example.Team {
+	if src == nil {
+		return example.Team{}
+	}
+
+	return TeamToPb(*src, opts...)
+}
+
+func TeamToPbValPtrList(src []model.Team, opts ...TransformParam) []*example.Team {
+	resp := make([]*example.Team, len(src))
+
+	for i, s := range src {
+		g := TeamToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// TeamToPbList is DEPRECATED. Use TeamToPbValPtrList instead.
+func TeamToPbList(src []model.Team, opts ...TransformParam) []*example.Team {
+	return TeamToPbValPtrList(src, opts...)
+}
+
+func TeamToPb(src model.Team, opts ...TransformParam) example.Team {
+	s := example.Team{
+		Id:   src.ID,
+		Name: src.Name,
+	}
+
+	applyOptions(opts...)
+
+	if src.Edges.Lead != nil {
+		s.Lead = ContactToPbPtr(src.Edges.Lead, opts...)
+	}
+
+	if src.Edges.Members != nil {
+		s.Members = ContactToPbPtrList(src.Edges.Members, opts...)
+	}
+
+	return s
+}
+
+func TeamToPbValPtr(src model.Team, opts ...TransformParam) *example.Team {
+	d := TeamToPb(src, opts...)
+	return &d
+}
+
+func TeamToPbValList(src []model.Team, opts ...TransformParam) []example.Team {
+	resp := make([]example.Team, len(src))
+
+	for i, s := range src {
+		resp[i] = TeamToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func TeamToPbPtrValList(src []*model.Team, opts ...TransformParam) []example.Team {
+	resp := make([]example.Team, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, TeamToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// TeamToPbFieldNames maps model.Team field names to example.Team field names.
+var TeamToPbFieldNames = map[string]string{
+	"ID":            "id",
+	"Name":          "name",
+	"Edges.Lead":    "lead",
+	"Edges.Members": "members",
+}
+
+// TeamToPbJSONNames maps model.Team JSON field names to example.Team JSON field names.
+var TeamToPbJSONNames = map[string]string{
+	"ID":      "id",
+	"Name":    "name",
+	"Lead":    "lead",
+	"Members": "members",
+}
+
+func PbToV2SizePtr(src *example.ProductSize, opts ...TransformParam) *v2.Size {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToV2Size(*src, opts...)
+	return &d
+}
+
+func PbToV2SizePtrList(src []*example.ProductSize, opts ...TransformParam) []*v2.Size {
+	resp := make([]*v2.Size, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToV2SizePtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToV2SizePtrVal(src *example.ProductSize, opts ...TransformParam) v2.Size {
+	if src == nil {
+		return v2.Size{}
+	}
+
+	return PbToV2Size(*src, opts...)
+}
+
+func PbToV2SizePtrValList(src []*example.ProductSize, opts ...TransformParam) []v2.Size {
+	resp := make([]v2.Size, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToV2Size(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToV2SizeList is DEPRECATED. Use PbToV2SizePtrValList instead.
+func PbToV2SizeList(src []*example.ProductSize, opts ...TransformParam) []v2.Size {
+	return PbToV2SizePtrValList(src, opts...)
+}
+
+func PbToV2Size(src example.ProductSize, opts ...TransformParam) v2.Size {
+	s := v2.Size{
+		Width:  src.Width,
+		Height: src.Height,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToV2SizeValPtr(src example.ProductSize, opts ...TransformParam) *v2.Size {
+	d := PbToV2Size(src, opts...)
+	return &d
+}
+
+func PbToV2SizeValList(src []example.ProductSize, opts ...TransformParam) []v2.Size {
+	resp := make([]v2.Size, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToV2Size(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToV2SizeValPtrList(src []example.ProductSize, opts ...TransformParam) []*v2.Size {
+	resp := make([]*v2.Size, len(src))
+
+	for i, s := range src {
+		g := PbToV2Size(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToV2SizeFieldNames maps example.ProductSize field names to v2.Size field names.
+var PbToV2SizeFieldNames = map[string]string{
+	"width":  "Width",
+	"height": "Height",
+}
+
+// PbToV2SizeJSONNames maps example.ProductSize JSON field names to v2.Size JSON field names.
+var PbToV2SizeJSONNames = map[string]string{
+	"width":  "width",
+	"height": "height",
+}
+
+// PbToV2SizeSchemaHash is a hash of fields mapping between example.ProductSize and v2.Size.
+// It changes when mapped fields or their types are changed.
+const PbToV2SizeSchemaHash = "807500eb5c4e090121f6b96c91a5138c8741a238d3488b7db6201e25182194e2"
+
+func V2SizeToPbPtr(src *v2.Size, opts ...TransformParam) *example.ProductSize {
+	if src == nil {
+		return nil
+	}
+
+	d := V2SizeToPb(*src, opts...)
+	return &d
+}
+
+func V2SizeToPbPtrList(src []*v2.Size, opts ...TransformParam) []*example.ProductSize {
+	resp := make([]*example.ProductSize, len(src))
+
+	for i, s := range src {
+		resp[i] = V2SizeToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func V2SizeToPbPtrVal(src *v2.Size, opts ...TransformParam) example.ProductSize {
+	if src == nil {
+		return example.ProductSize{}
+	}
+
+	return V2SizeToPb(*src, opts...)
+}
+
+func V2SizeToPbValPtrList(src []v2.Size, opts ...TransformParam) []*example.ProductSize {
+	resp := make([]*example.ProductSize, len(src))
+
+	for i, s := range src {
+		g := V2SizeToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// V2SizeToPbList is DEPRECATED. Use V2SizeToPbValPtrList instead.
+func V2SizeToPbList(src []v2.Size, opts ...TransformParam) []*example.ProductSize {
+	return V2SizeToPbValPtrList(src, opts...)
+}
+
+func V2SizeToPb(src v2.Size, opts ...TransformParam) example.ProductSize {
+	s := example.ProductSize{
+		Width:  src.Width,
+		Height: src.Height,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func V2SizeToPbValPtr(src v2.Size, opts ...TransformParam) *example.ProductSize {
+	d := V2SizeToPb(src, opts...)
+	return &d
+}
+
+func V2SizeToPbValList(src []v2.Size, opts ...TransformParam) []example.ProductSize {
+	resp := make([]example.ProductSize, len(src))
+
+	for i, s := range src {
+		resp[i] = V2SizeToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func V2SizeToPbPtrValList(src []*v2.Size, opts ...TransformParam) []example.ProductSize {
+	resp := make([]example.ProductSize, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, V2SizeToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// V2SizeToPbFieldNames maps v2.Size field names to example.ProductSize field names.
+var V2SizeToPbFieldNames = map[string]string{
+	"Width":  "width",
+	"Height": "height",
+}
+
+// V2SizeToPbJSONNames maps v2.Size JSON field names to example.ProductSize JSON field names.
+var V2SizeToPbJSONNames = map[string]string{
+	"width":  "width",
+	"height": "height",
+}
+
+func PbToV2ProductPtr(src *example.CatalogProduct, opts ...TransformParam) *v2.Product {
+	if src == nil {
+		return nil
+	}
+
+	d := PbToV2Product(*src, opts...)
+	return &d
+}
+
+func PbToV2ProductPtrList(src []*example.CatalogProduct, opts ...TransformParam) []*v2.Product {
+	resp := make([]*v2.Product, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToV2ProductPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToV2ProductPtrVal(src *example.CatalogProduct, opts ...TransformParam) v2.Product {
+	if src == nil {
+		return v2.Product{}
+	}
+
+	return PbToV2Product(*src, opts...)
+}
+
+func PbToV2ProductPtrValList(src []*example.CatalogProduct, opts ...TransformParam) []v2.Product {
+	resp := make([]v2.Product, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, PbToV2Product(*s, opts...))
+	}
+
+	return resp
+}
+
+// PbToV2ProductList is DEPRECATED. Use PbToV2ProductPtrValList instead.
+func PbToV2ProductList(src []*example.CatalogProduct, opts ...TransformParam) []v2.Product {
+	return PbToV2ProductPtrValList(src, opts...)
+}
+
+func PbToV2Product(src example.CatalogProduct, opts ...TransformParam) v2.Product {
+	s := v2.Product{
+		Id:           src.Id,
+		DisplayName:  src.Title,
+		Price:        src.Price,
+		Tags:         src.Tags,
+		Dimensions:   PbToV2SizePtr(src.Dimensions, opts...),
+		Availability: PbToV2ProductAvailabilityEnum(src.Availability),
+		ReleasedAt:   src.ReleasedAt,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func PbToV2ProductValPtr(src example.CatalogProduct, opts ...TransformParam) *v2.Product {
+	d := PbToV2Product(src, opts...)
+	return &d
+}
+
+func PbToV2ProductValList(src []example.CatalogProduct, opts ...TransformParam) []v2.Product {
+	resp := make([]v2.Product, len(src))
+
+	for i, s := range src {
+		resp[i] = PbToV2Product(s, opts...)
+	}
+
+	return resp
+}
+
+func PbToV2ProductValPtrList(src []example.CatalogProduct, opts ...TransformParam) []*v2.Product {
+	resp := make([]*v2.Product, len(src))
+
+	for i, s := range src {
+		g := PbToV2Product(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// PbToV2ProductFieldNames maps example.CatalogProduct field names to v2.Product field names.
+var PbToV2ProductFieldNames = map[string]string{
+	"id":           "Id",
+	"title":        "DisplayName",
+	"price":        "Price",
+	"tags":         "Tags",
+	"dimensions":   "Dimensions",
+	"availability": "Availability",
+	"released_at":  "ReleasedAt",
+}
+
+// PbToV2ProductJSONNames maps example.CatalogProduct JSON field names to v2.Product JSON field names.
+var PbToV2ProductJSONNames = map[string]string{
+	"id":           "id",
+	"title":        "display_name",
+	"price":        "price",
+	"tags":         "tags",
+	"dimensions":   "dimensions",
+	"availability": "availability",
+	"releasedAt":   "released_at",
+}
+
+// PbToV2ProductAvailabilityEnum transforms example.StockStatus into v2.Availability by (transformer.enum_mapping) option of field Availability,
+// unmapped values become zero value.
+func PbToV2ProductAvailabilityEnum(v example.StockStatus) (d v2.Availability) {
+	switch v {
+	case example.StockStatus_STOCK_STATUS_UNKNOWN:
+		return v2.Availability_AVAILABILITY_UNKNOWN
+	case example.StockStatus_IN_STOCK:
+		return v2.Availability_IN_STOCK
+	case example.StockStatus_SOLD_OUT:
+		return v2.Availability_SOLD_OUT
+	}
+
+	return d
+}
+
+// PbToV2ProductSchemaHash is a hash of fields mapping between example.CatalogProduct and v2.Product.
+// It changes when mapped fields or their types are changed.
+const PbToV2ProductSchemaHash = "0d95cbb65a16a7453acda8800fdef2a87c3fbd85c90f86631c3f648bb05651cb"
+
+func V2ProductToPbPtr(src *v2.Product, opts ...TransformParam) *example.CatalogProduct {
+	if src == nil {
+		return nil
+	}
+
+	d := V2ProductToPb(*src, opts...)
+	return &d
+}
+
+func V2ProductToPbPtrList(src []*v2.Product, opts ...TransformParam) []*example.CatalogProduct {
+	resp := make([]*example.CatalogProduct, len(src))
+
+	for i, s := range src {
+		resp[i] = V2ProductToPbPtr(s, opts...)
+	}
+
+	return resp
+}
+
+func V2ProductToPbPtrVal(src *v2.Product, opts ...TransformParam) example.CatalogProduct {
+	if src == nil {
+		return example.CatalogProduct{}
+	}
+
+	return V2ProductToPb(*src, opts...)
+}
+
+func V2ProductToPbValPtrList(src []v2.Product, opts ...TransformParam) []*example.CatalogProduct {
+	resp := make([]*example.CatalogProduct, len(src))
+
+	for i, s := range src {
+		g := V2ProductToPb(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}
+
+// V2ProductToPbList is DEPRECATED. Use V2ProductToPbValPtrList instead.
+func V2ProductToPbList(src []v2.Product, opts ...TransformParam) []*example.CatalogProduct {
+	return V2ProductToPbValPtrList(src, opts...)
+}
+
+func V2ProductToPb(src v2.Product, opts ...TransformParam) example.CatalogProduct {
+	s := example.CatalogProduct{
+		Id:           src.Id,
+		Title:        src.DisplayName,
+		Price:        src.Price,
+		Tags:         src.Tags,
+		Dimensions:   V2SizeToPbPtr(src.Dimensions, opts...),
+		Availability: V2ProductAvailabilityEnumToPb(src.Availability),
+		ReleasedAt:   src.ReleasedAt,
+	}
+
+	applyOptions(opts...)
+
+	return s
+}
+
+func V2ProductToPbValPtr(src v2.Product, opts ...TransformParam) *example.CatalogProduct {
+	d := V2ProductToPb(src, opts...)
+	return &d
+}
+
+func V2ProductToPbValList(src []v2.Product, opts ...TransformParam) []example.CatalogProduct {
+	resp := make([]example.CatalogProduct, len(src))
+
+	for i, s := range src {
+		resp[i] = V2ProductToPb(s, opts...)
+	}
+
+	return resp
+}
+
+func V2ProductToPbPtrValList(src []*v2.Product, opts ...TransformParam) []example.CatalogProduct {
+	resp := make([]example.CatalogProduct, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, V2ProductToPb(*s, opts...))
+	}
+
+	return resp
+}
+
+// V2ProductToPbFieldNames maps v2.Product field names to example.CatalogProduct field names.
+var V2ProductToPbFieldNames = map[string]string{
+	"Id":           "id",
+	"DisplayName":  "title",
+	"Price":        "price",
+	"Tags":         "tags",
+	"Dimensions":   "dimensions",
+	"Availability": "availability",
+	"ReleasedAt":   "released_at",
+}
+
+// V2ProductToPbJSONNames maps v2.Product JSON field names to example.CatalogProduct JSON field names.
+var V2ProductToPbJSONNames = map[string]string{
+	"id":           "id",
+	"display_name": "title",
+	"price":        "price",
+	"tags":         "tags",
+	"dimensions":   "dimensions",
+	"availability": "availability",
+	"released_at":  "releasedAt",
+}
+
+// V2ProductAvailabilityEnumToPb transforms v2.Availability into example.StockStatus by (transformer.enum_mapping) option of field Availability,
+// unmapped values become zero value.
+func V2ProductAvailabilityEnumToPb(v v2.Availability) (d example.StockStatus) {
+	switch v {
+	case v2.Availability_AVAILABILITY_UNKNOWN:
+		return example.StockStatus_STOCK_STATUS_UNKNOWN
+	case v2.Availability_IN_STOCK:
+		return example.StockStatus_IN_STOCK
+	case v2.Availability_SOLD_OUT:
+		return example.StockStatus_SOLD_OUT
+	}
+
+	return d
+}
+
+type OneofTheDecl interface {
+	GetStringValue() string
+	GetInt64Value() int64
+}
+
+func TheOneToString(src OneofTheDecl) string {
+	if s := src.GetStringValue(); s != "" {
+		return s
+	}
+
+	if i := src.GetInt64Value(); i != 0 {
+		return strconv.FormatInt(i, 10)
+	}
+
+	return "<nil>"
+}
+
+func StringToTheOne(s string, dst *example.TheOne, v string) {
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || v == "v2" {
+		dst.TheDecl = &example.TheOne_StringValue{StringValue: s}
+		return
+	}
+
+	dst.TheDecl = &example.TheOne_Int64Value{Int64Value: i}
+	return
+}
+
+// MergeCustomerPatch sets fields of dst which are present in patch, nil fields of
+// patch are not present and don't change dst.
+func MergeCustomerPatch(dst *model.Customer, patch *example.CustomerPatch, opts ...TransformParam) {
+	if patch == nil {
+		return
+	}
+	if patch.Name != nil {
+		dst.Name = patch.Name.Value
+	}
+	if patch.DefaultAddress != nil {
+		dst.DefaultAddress = PbToAddressPtr(patch.DefaultAddress, opts...)
+	}
+	if patch.BillingAddress != nil {
+		dst.BillingAddress = PbToAddress(*patch.BillingAddress, opts...)
+	}
+}
+
+// MergeCustomerPatchChanges sets fields of dst which are present in patch, like
+// MergeCustomerPatch, and returns names of dst fields which values are changed,
+// e.g. for audit logs.
+func MergeCustomerPatchChanges(dst *model.Customer, patch *example.CustomerPatch, opts ...TransformParam) []string {
+	if patch == nil {
+		return nil
+	}
+
+	var changed []string
+	if patch.Name != nil {
+		v := patch.Name.Value
+		if dst.Name != v {
+			dst.Name = v
+			changed = append(changed, "Name")
+		}
+	}
+	if patch.DefaultAddress != nil {
+		v := PbToAddressPtr(patch.DefaultAddress, opts...)
+		if !reflect.DeepEqual(dst.DefaultAddress, v) {
+			dst.DefaultAddress = v
+			changed = append(changed, "DefaultAddress")
+		}
+	}
+	if patch.BillingAddress != nil {
+		v := PbToAddress(*patch.BillingAddress, opts...)
+		if !reflect.DeepEqual(dst.BillingAddress, v) {
+			dst.BillingAddress = v
+			changed = append(changed, "BillingAddress")
+		}
+	}
+
+	return changed
+}
+
+// method OrderService.WatchOrders: client adapter does not support streaming methods
+
+// OrderServiceModelClient calls OrderService methods with models, requests and
+// responses are transformed by generated transformers.
+type OrderServiceModelClient struct {
+	client example.OrderServiceClient
+}
+
+// NewOrderServiceModelClient returns adapter of example.OrderServiceClient.
+func NewOrderServiceModelClient(client example.OrderServiceClient) *OrderServiceModelClient {
+	return &OrderServiceModelClient{client: client}
+}
+
+// CreateOrder transforms req into proto message, calls OrderService.CreateOrder and
+// transforms response into model.
+func (c *OrderServiceModelClient) CreateOrder(ctx context.Context, req model.Order, opts ...grpc.CallOption) (*model.Order, error) {
+	resp, err := c.client.CreateOrder(ctx, OrderToPbValPtr(req), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return PbToOrderPtr(resp), nil
 }
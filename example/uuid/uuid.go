@@ -0,0 +1,47 @@
+// Package uuid is a minimal stand-in of github.com/google/uuid which is used
+// by models of example, see option transformer.uuid.
+package uuid
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// UUID is a 128 bit universally unique identifier.
+type UUID [16]byte
+
+// Nil is an empty UUID, all zeros.
+var Nil UUID
+
+// Parse decodes s into UUID, s must be in form
+// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
+func Parse(s string) (UUID, error) {
+	var u UUID
+	if len(s) != 36 || strings.Count(s, "-") != 4 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("invalid UUID %q", s)
+	}
+
+	if _, err := hex.Decode(u[:], []byte(strings.Replace(s, "-", "", -1))); err != nil {
+		return u, fmt.Errorf("invalid UUID %q: %w", s, err)
+	}
+
+	return u, nil
+}
+
+// FromBytes returns UUID of b, b must be 16 bytes long.
+func FromBytes(b []byte) (UUID, error) {
+	var u UUID
+	if len(b) != len(u) {
+		return u, fmt.Errorf("invalid UUID length %d", len(b))
+	}
+	copy(u[:], b)
+
+	return u, nil
+}
+
+// String returns UUID u in form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
+func (u UUID) String() string {
+	h := hex.EncodeToString(u[:])
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
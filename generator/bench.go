@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// benchmarks is true if benchmarks of transformers are generated, see
+// SetBenchmarks.
+var benchmarks bool
+
+// benchFiles holds content of benchmarks by output paths of generated files,
+// see Benchmarks.
+var benchFiles = map[string]string{}
+
+// SetBenchmarks turns on benchmarks: each generated file gets a _bench_test.go
+// file with benchmarks of transformers of messages, which report ns/op and
+// allocations, so regressions of generated code can be tracked.
+func SetBenchmarks(on bool) {
+	benchmarks = on
+}
+
+// Benchmarks returns path and content of benchmark file of generated file
+// path, empty strings are returned if benchmarks are turned off or file has
+// no messages which are transformed into models.
+func Benchmarks(path string) (string, string) {
+	content, ok := benchFiles[path]
+	if !ok {
+		return "", ""
+	}
+
+	return strings.TrimSuffix(path, ".go") + "_bench_test.go", content
+}
+
+// withGraphs sets values of fields of tests which are other generated
+// messages of the same file: sub messages get values of their own tests,
+// repeated fields get two such sub messages. Only nullable fields, i.e.
+// pointers to sub messages, are set, sub messages are set one level deep.
+// Sub messages with skipped tests are left nil, see withSkips.
+func withGraphs(tests []roundTripTest) []roundTripTest {
+	byName := map[string]roundTripTest{}
+	for _, rt := range tests {
+		if _, ok := byName[rt.full]; !ok {
+			byName[rt.full] = rt
+		}
+	}
+
+	out := make([]roundTripTest, 0, len(tests))
+	for _, rt := range tests {
+		fields := map[string]Field{}
+		for _, f := range rt.Fields {
+			fields[f.ProtoOrigName] = f
+		}
+
+		rt.Graph = nil
+		for _, fdp := range rt.desc.GetField() {
+			sub, ok := byName[fdp.GetTypeName()]
+			f, mapped := fields[fdp.GetName()]
+			switch {
+			case !ok, !mapped, sub.Skip != "", fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, fdp.OneofIndex != nil:
+				continue
+			case f.SkipPbToGo, f.SkipGoToPb, f.Dep != nil, !extractNullOption(fdp):
+				continue
+			}
+
+			typ, lit := subMessageType(sub), subMessageLiteral(sub)
+			v := "&" + typ + lit
+			if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+				v = "[]*" + typ + "{" + lit + ", " + lit + "}"
+			}
+			rt.Graph = append(rt.Graph, roundTripValue{Name: f.ProtoName, Value: v})
+		}
+
+		out = append(out, rt)
+	}
+
+	return out
+}
+
+// subMessageType returns proto structure type of test rt, e.g. pb.Address.
+func subMessageType(rt roundTripTest) string {
+	if rt.SrcPref == "" {
+		return rt.Src
+	}
+
+	return rt.SrcPref + "." + rt.Src
+}
+
+// subMessageLiteral returns composite literal of proto structure of test rt
+// without type, e.g. {Id: 1, City: "city"}.
+func subMessageLiteral(rt roundTripTest) string {
+	values := make([]string, 0, len(rt.Values))
+	for _, v := range rt.Values {
+		values = append(values, v.Name+": "+v.Value)
+	}
+
+	return "{" + strings.Join(values, ", ") + "}"
+}
@@ -0,0 +1,178 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Benchmarks", func() {
+
+	Describe("withGraphs", func() {
+
+		It("sets sub messages of the same file", func() {
+			typMsg := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+			address := roundTripTest{
+				Data:   Data{Src: "Address", SrcPref: "pb"},
+				Values: []roundTripValue{{Name: "Id", Value: "1"}, {Name: "City", Value: `"city"`}},
+				desc:   &descriptorpb.DescriptorProto{Name: sp("Address")},
+				full:   ".pb.Address",
+			}
+			customer := roundTripTest{
+				Data: Data{Src: "Customer", SrcPref: "pb", Fields: []Field{
+					{Name: "Address", ProtoName: "Address", ProtoOrigName: "address"},
+					{Name: "Addresses", ProtoName: "Addresses", ProtoOrigName: "addresses"},
+					{Name: "Billing", ProtoName: "Billing", ProtoOrigName: "billing", SkipGoToPb: true},
+				}},
+				desc: &descriptorpb.DescriptorProto{Name: sp("Customer"), Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("address"), Type: &typMsg, TypeName: sp(".pb.Address")},
+					{Name: sp("addresses"), Type: &typMsg, TypeName: sp(".pb.Address"), Label: &typRepeated},
+					{Name: sp("billing"), Type: &typMsg, TypeName: sp(".pb.Address")},
+					{Name: sp("unmapped"), Type: &typMsg, TypeName: sp(".pb.Address")},
+					{Name: sp("external"), Type: &typMsg, TypeName: sp(".other.Address")},
+				}},
+				full: ".pb.Customer",
+			}
+
+			tests := withGraphs([]roundTripTest{address, customer})
+			Expect(tests).To(HaveLen(2))
+			Expect(tests[0].Graph).To(BeEmpty())
+			Expect(tests[1].Graph).To(Equal([]roundTripValue{
+				{Name: "Address", Value: `&pb.Address{Id: 1, City: "city"}`},
+				{Name: "Addresses", Value: `[]*pb.Address{{Id: 1, City: "city"}, {Id: 1, City: "city"}}`},
+			}))
+		})
+
+		It("skips non-nullable sub messages", func() {
+			typMsg := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+			fdp := &descriptorpb.FieldDescriptorProto{Name: sp("address"), Type: &typMsg, TypeName: sp(".pb.Address"), Options: &descriptorpb.FieldOptions{}}
+			proto.SetExtension(fdp.Options, gogoproto.E_Nullable, false)
+
+			tests := withGraphs([]roundTripTest{
+				{Data: Data{Src: "Address"}, desc: &descriptorpb.DescriptorProto{}, full: ".pb.Address"},
+				{
+					Data: Data{Src: "Customer", Fields: []Field{{Name: "Address", ProtoName: "Address", ProtoOrigName: "address"}}},
+					desc: &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{fdp}},
+					full: ".pb.Customer",
+				},
+			})
+			Expect(tests[1].Graph).To(BeEmpty())
+		})
+
+		It("skips sub messages with skipped tests", func() {
+			typMsg := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+			tests := withGraphs([]roundTripTest{
+				{Data: Data{Src: "Refund"}, desc: &descriptorpb.DescriptorProto{}, full: ".pb.Refund", Skip: "helpers"},
+				{
+					Data: Data{Src: "Batch", Fields: []Field{{Name: "Refunds", ProtoName: "Refunds", ProtoOrigName: "refunds"}}},
+					desc: &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("refunds"), Type: &typMsg, TypeName: sp(".pb.Refund"), Label: &typRepeated},
+					}},
+					full: ".pb.Batch",
+				},
+			})
+			Expect(tests[1].Graph).To(BeEmpty())
+		})
+	})
+
+	It("returns nothing for files which are not processed", func() {
+		path, content := Benchmarks("order_transformer.go")
+		Expect(path).To(BeEmpty())
+		Expect(content).To(BeEmpty())
+	})
+
+	Describe("bench template", func() {
+
+		It("measures transformers of message, list and graph", func() {
+			w := &bytes.Buffer{}
+			Expect(benchT.Execute(w, []roundTripTest{{
+				Data:   Data{Src: "Customer", SrcPref: "pb", SrcFn: "Pb", Dst: "Customer", DstFn: "Customer", NoReverse: true},
+				Values: []roundTripValue{{Name: "Id", Value: "1"}},
+				Graph:  []roundTripValue{{Name: "Address", Value: "&pb.Address{Id: 1}"}},
+			}})).To(Succeed())
+
+			Expect(w.String()).To(Equal(`
+
+// BenchmarkPbToCustomer measures transformation of messages into models.
+func BenchmarkPbToCustomer(b *testing.B) {
+	src := &pb.Customer{
+		Id: 1,
+	}
+	list := make([]*pb.Customer, 100)
+	for i := range list {
+		list[i] = src
+	}
+	graph := &pb.Customer{
+		Id: 1,
+		Address: &pb.Address{Id: 1},
+	}
+	var sink interface{}
+
+	b.Run("message", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = PbToCustomerPtr(src)
+		}
+	})
+
+	b.Run("list", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = PbToCustomerPtrList(list)
+		}
+	})
+
+	b.Run("graph", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = PbToCustomerPtr(graph)
+		}
+	})
+
+	_ = sink
+}
+`))
+		})
+
+		It("checks errors of transformers", func() {
+			w := &bytes.Buffer{}
+			Expect(benchT.Execute(w, []roundTripTest{{
+				Data: Data{Src: "Customer", SrcPref: "pb", SrcFn: "Pb", Dst: "Customer", DstFn: "Customer", WithErrors: true, WithContext: true},
+			}})).To(Succeed())
+
+			Expect(w.String()).To(ContainSubstring(`
+	m, err := PbToCustomerPtr(ctx, src)
+	if err != nil {
+		b.Fatal(err)
+	}
+	ms, err := PbToCustomerPtrList(ctx, list)
+	if err != nil {
+		b.Fatal(err)
+	}
+`))
+			Expect(w.String()).To(ContainSubstring(`
+			v, err := CustomerToPbPtr(ctx, m)
+			if err != nil {
+				b.Fatal(err)
+			}
+			sink = v
+`))
+		})
+
+		It("skips benchmarks with reason", func() {
+			w := &bytes.Buffer{}
+			Expect(benchT.Execute(w, []roundTripTest{{
+				Data: Data{Src: "Refund", SrcPref: "pb", SrcFn: "Pb", Dst: "Refund", DstFn: "Refund"},
+				Skip: "field Currency is transformed by helper or custom functions",
+			}})).To(Succeed())
+
+			Expect(w.String()).To(ContainSubstring("func BenchmarkPbToRefund(b *testing.B) {\n\tb.Skip(\"field Currency is transformed by helper or custom functions\")\n}\n"))
+			Expect(w.String()).To(ContainSubstring("func BenchmarkRefundToPb(b *testing.B) {\n\tb.Skip(\"field Currency is transformed by helper or custom functions\")\n}\n"))
+			Expect(w.String()).NotTo(ContainSubstring("b.Run("))
+		})
+	})
+})
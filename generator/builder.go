@@ -0,0 +1,27 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+)
+
+// builderFields returns model fields which can be set by builder methods of
+// messages with transformer.go_builder option. Fields transformed by Converter
+// dependencies are omitted, builder uses package-level transformers. Model
+// fields of several oneof cases are set once. Types declared in model package
+// are prefixed by modelPackage.
+func builderFields(fields []Field, s source.Structure, modelPackage string) []modelField {
+	bf := []modelField{}
+	seen := map[string]bool{}
+
+	for _, f := range flatFields(fields) {
+		gf, ok := s[f.Name]
+		if !ok || f.Dep != nil || seen[f.Name] {
+			continue
+		}
+		seen[f.Name] = true
+
+		bf = append(bf, modelField{Name: f.Name, Type: qualifiedGoType(gf, modelPackage)})
+	}
+
+	return bf
+}
@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Builder", func() {
+
+	Describe("builderFields", func() {
+
+		It("returns model fields with qualified types", func() {
+			s := source.Structure{
+				"ID":      {Type: "int64"},
+				"Address": {Type: "Address", IsPointer: true},
+				"Tags":    {Type: "string", IsSlice: true},
+			}
+			fields := []Field{
+				{Name: "ID"},
+				{Name: "Address"},
+				{Name: "Unknown"},
+				{ProtoName: "Meta", EmbeddedFields: []Field{{Name: "Tags"}}},
+			}
+
+			Expect(builderFields(fields, s, "model")).To(Equal([]modelField{
+				{Name: "ID", Type: "int64"},
+				{Name: "Address", Type: "*model.Address"},
+				{Name: "Tags", Type: "[]string"},
+			}))
+		})
+	})
+
+	Describe("builder template", func() {
+
+		It("adds With method per field", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			err = t.ExecuteTemplate(w, "builder", Data{
+				Src:        "Order",
+				SrcPref:    "pb",
+				SrcFn:      "Pb",
+				SrcPointer: "*",
+				Dst:        "Order",
+				DstPref:    "model",
+				DstFn:      "Order",
+				Builder:    []modelField{{Name: "ID", Type: "int64"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(w.String()).To(ContainSubstring(`func (b *OrderPbBuilder) WithID(v int64) *OrderPbBuilder {
+	b.sets = append(b.sets, func(m *model.Order) { m.ID = v })
+	return b
+}`))
+			Expect(w.String()).To(ContainSubstring(`func (b *OrderPbBuilder) Build(opts ...TransformParam) *pb.Order {`))
+			Expect(w.String()).To(ContainSubstring(`return OrderToPbPtr(&m, opts...)`))
+		})
+	})
+})
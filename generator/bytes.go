@@ -0,0 +1,42 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// processBytesField returns *Field for singular bytes field fdp. Model fields
+// of []byte type are assigned as is, string fields are converted, other types
+// require functions of transformer.bytes_converter option.
+func processBytesField(fdp *descriptorpb.FieldDescriptorProto, pname, gname string, gf source.FieldInfo) (*Field, error) {
+	if conv, _ := getStringOption(fdp.Options, options.E_BytesConverter); conv != "" {
+		p2g, g2p := conv, ""
+		if i := strings.Index(conv, ","); i >= 0 {
+			p2g, g2p = strings.TrimSpace(conv[:i]), strings.TrimSpace(conv[i+1:])
+		}
+
+		return &Field{
+			Name:      gname,
+			ProtoName: pname,
+			Wrapper: &Elem{
+				Kind:      elemBytes,
+				ProtoToGo: p2g,
+				GoToProto: g2p,
+			},
+		}, nil
+	}
+
+	switch {
+	case gf.IsPointer || gf.Key != "":
+	case gf.IsSlice && gf.Type == "byte":
+		return &Field{Name: gname, ProtoName: pname}, nil
+	case !gf.IsSlice && gf.Type == "string":
+		return &Field{Name: gname, ProtoName: pname, ProtoToGoType: "string", GoToProtoType: "[]byte"}, nil
+	}
+
+	return nil, newLoggableError("field %s: bytes field can't be transformed into model field of type %s", gname, gf.GoType()).
+		withHint(`set (%s) option with functions which transform bytes into model type and back, e.g. "uuid.FromBytes,uuid.UUID.Bytes"`, options.E_BytesConverter.Name)
+}
@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Bytes fields", func() {
+
+	typBytes := descriptorpb.FieldDescriptorProto_TYPE_BYTES
+
+	field := func() *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{Name: sp("id"), Type: &typBytes, Options: &descriptorpb.FieldOptions{}}
+	}
+
+	It("assigns bytes to []byte model fields", func() {
+		f, err := processBytesField(field(), "Id", "ID", source.FieldInfo{Type: "byte", IsSlice: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f).To(Equal(&Field{Name: "ID", ProtoName: "Id"}))
+	})
+
+	It("converts bytes to string model fields", func() {
+		f, err := processBytesField(field(), "Id", "ID", source.FieldInfo{Type: "string"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f).To(Equal(&Field{Name: "ID", ProtoName: "Id", ProtoToGoType: "string", GoToProtoType: "[]byte"}))
+	})
+
+	It("calls functions of bytes_converter option", func() {
+		fdp := field()
+		proto.SetExtension(fdp.Options, options.E_BytesConverter, "uuid.FromBytes, uuid.UUID.Bytes")
+
+		f, err := processBytesField(fdp, "Id", "ID", source.FieldInfo{Type: "uuid.UUID"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{Kind: elemBytes, ProtoToGo: "uuid.FromBytes", GoToProto: "uuid.UUID.Bytes"}))
+	})
+
+	It("skips reverse transformation if bytes_converter has one function", func() {
+		fdp := field()
+		proto.SetExtension(fdp.Options, options.E_BytesConverter, "uuid.FromBytes")
+
+		f, err := processBytesField(fdp, "Id", "ID", source.FieldInfo{Type: "uuid.UUID"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{Kind: elemBytes, ProtoToGo: "uuid.FromBytes"}))
+	})
+
+	It("returns error with hint for other model types", func() {
+		_, err := processBytesField(field(), "Id", "ID", source.FieldInfo{Type: "uuid.UUID"})
+		Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		Expect(err.Error()).To(HavePrefix("field ID: bytes field can't be transformed into model field of type uuid.UUID; hint: set (transformer.bytes_converter)"))
+	})
+})
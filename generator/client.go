@@ -0,0 +1,137 @@
+package generator
+
+import (
+	"io"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// clientMethod is a unary method of client adapter.
+type clientMethod struct {
+	// Method name, e.g. GetProduct.
+	Name string
+	// Model names of request and response messages, e.g. GetProductRequest.
+	Request  string
+	Response string
+	// Names of request transformer into message and response transformer into
+	// model without suffixes, e.g. GetProductRequestToPb and PbToProduct.
+	RequestFunc  string
+	ResponseFunc string
+	// If true, transformers of request and response messages return error,
+	// see transformer.with_errors.
+	RequestErrors  bool
+	ResponseErrors bool
+	// If true, transformers of request and response messages accept context,
+	// see transformer.with_context.
+	RequestContext  bool
+	ResponseContext bool
+}
+
+// clientAdapter contains data for client adapter of one service.
+type clientAdapter struct {
+	// Service name, e.g. ProductService.
+	Service string
+	// Package names of proto structures and models.
+	ProtoPackage string
+	ModelPackage string
+	Methods      []clientMethod
+}
+
+// clientAdapters returns client adapters of services of file f which have
+// transformer.go_client_adapter option. Request and response messages should
+// have go_struct option, methods which can not be adapted are reported into w
+// as comments. Adapters transform models into messages, so nothing is returned
+// if disableReverse is true.
+func clientAdapters(w io.Writer, f *descriptorpb.FileDescriptorProto, messages MessageOptionList, protoPackage, modelPackage string, disableReverse bool) []clientAdapter {
+	var adapters []clientAdapter
+
+	for _, svc := range f.GetService() {
+		if !extractClientAdapterOption(svc.GetOptions()) {
+			continue
+		}
+
+		if disableReverse {
+			p(w, "// service %q: client adapter is not generated, reverse functions are disabled\n", svc.GetName())
+			continue
+		}
+
+		ca := clientAdapter{
+			Service:      strcase.ToCamel(svc.GetName()),
+			ProtoPackage: protoPackage,
+			ModelPackage: modelPackage,
+		}
+
+		for _, m := range svc.GetMethod() {
+			if m.GetClientStreaming() || m.GetServerStreaming() {
+				p(w, "// method %s.%s: client adapter does not support streaming methods\n", svc.GetName(), m.GetName())
+				continue
+			}
+
+			req, ok := adapterModel(w, svc, m, m.GetInputType(), messages)
+			if !ok {
+				continue
+			}
+
+			resp, ok := adapterModel(w, svc, m, m.GetOutputType(), messages)
+			if !ok {
+				continue
+			}
+
+			reqOpts, respOpts := req.Descriptor().GetOptions(), resp.Descriptor().GetOptions()
+			if d := extractDirectionOption(reqOpts); d == options.Direction_PB_TO_GO {
+				p(w, "// method %s.%s: client adapter method is not generated, request message %s has (%s) = %s option\n",
+					svc.GetName(), m.GetName(), req.Full(), options.E_Direction.Name, d)
+				continue
+			}
+			if d := extractDirectionOption(respOpts); d == options.Direction_GO_TO_PB {
+				p(w, "// method %s.%s: client adapter method is not generated, response message %s has (%s) = %s option\n",
+					svc.GetName(), m.GetName(), resp.Full(), options.E_Direction.Name, d)
+				continue
+			}
+
+			_, reqFunc := transformerNames(req)
+			respFunc, _ := transformerNames(resp)
+
+			ca.Methods = append(ca.Methods, clientMethod{
+				Name:            strcase.ToCamel(m.GetName()),
+				Request:         req.Target(),
+				Response:        resp.Target(),
+				RequestFunc:     reqFunc,
+				ResponseFunc:    respFunc,
+				RequestErrors:   extractWithErrorsOption(reqOpts),
+				ResponseErrors:  extractWithErrorsOption(respOpts),
+				RequestContext:  extractWithContextOption(reqOpts),
+				ResponseContext: extractWithContextOption(respOpts),
+			})
+		}
+
+		if len(ca.Methods) > 0 {
+			adapters = append(adapters, ca)
+		}
+	}
+
+	return adapters
+}
+
+// adapterModel returns options of message typ which is request or response of
+// method m of service svc. If message has no go_struct option, it's reported
+// into w and false is returned.
+func adapterModel(w io.Writer, svc *descriptorpb.ServiceDescriptorProto, m *descriptorpb.MethodDescriptorProto, typ string, messages MessageOptionList) (MessageOption, bool) {
+	mo, ok := messages[strings.TrimPrefix(typ, ".")]
+	if !ok || mo.Omitted() {
+		p(w, "// method %s.%s: client adapter method is not generated, message %s has no (%s) option\n",
+			svc.GetName(), m.GetName(), strings.TrimPrefix(typ, "."), options.E_GoStruct.Name)
+		return nil, false
+	}
+
+	if strings.Contains(mo.Target(), ".") {
+		p(w, "// method %s.%s: client adapter does not support model %s of another package\n",
+			svc.GetName(), m.GetName(), mo.Target())
+		return nil, false
+	}
+
+	return mo, true
+}
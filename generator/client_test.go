@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Client", func() {
+
+	var f *descriptorpb.FileDescriptorProto
+
+	messages := MessageOptionList{
+		"pkg.GetRequest":  messageOption{targetName: "ProductQuery"},
+		"pkg.Product":     messageOption{targetName: "Product"},
+		"pkg.Empty":       messageOption{},
+		"pkg.ProductList": messageOption{targetName: "ProductList"},
+	}
+
+	BeforeEach(func() {
+		f = &descriptorpb.FileDescriptorProto{
+			Service: []*descriptorpb.ServiceDescriptorProto{
+				{
+					Name:    sp("ProductService"),
+					Options: &descriptorpb.ServiceOptions{},
+					Method: []*descriptorpb.MethodDescriptorProto{
+						{Name: sp("GetProduct"), InputType: sp(".pkg.GetRequest"), OutputType: sp(".pkg.Product")},
+						{Name: sp("DeleteProduct"), InputType: sp(".pkg.GetRequest"), OutputType: sp(".pkg.Empty")},
+						{Name: sp("WatchProducts"), InputType: sp(".pkg.GetRequest"), OutputType: sp(".pkg.Product"), ServerStreaming: bp(true)},
+					},
+				},
+				{
+					Name: sp("InternalService"),
+					Method: []*descriptorpb.MethodDescriptorProto{
+						{Name: sp("GetProduct"), InputType: sp(".pkg.GetRequest"), OutputType: sp(".pkg.Product")},
+					},
+				},
+			},
+		}
+		proto.SetExtension(f.Service[0].Options, options.E_GoClientAdapter, true)
+	})
+
+	Describe("clientAdapters", func() {
+
+		It("returns adapters of services with go_client_adapter option", func() {
+			w := &bytes.Buffer{}
+
+			Expect(clientAdapters(w, f, messages, "pb", "model", false)).To(Equal([]clientAdapter{{
+				Service:      "ProductService",
+				ProtoPackage: "pb",
+				ModelPackage: "model",
+				Methods:      []clientMethod{{Name: "GetProduct", Request: "ProductQuery", Response: "Product", RequestFunc: "ProductQueryToPb", ResponseFunc: "PbToProduct"}},
+			}}))
+
+			Expect(w.String()).To(Equal(
+				"// method ProductService.DeleteProduct: client adapter method is not generated, message pkg.Empty has no (transformer.go_struct) option\n" +
+					"// method ProductService.WatchProducts: client adapter does not support streaming methods\n"))
+		})
+
+		It("returns nothing if reverse functions are disabled", func() {
+			w := &bytes.Buffer{}
+
+			Expect(clientAdapters(w, f, messages, "pb", "model", true)).To(BeEmpty())
+			Expect(w.String()).To(Equal("// service \"ProductService\": client adapter is not generated, reverse functions are disabled\n"))
+		})
+
+		It("skips methods which messages are not transformed in required direction", func() {
+			// directed returns message option with transformer.direction option.
+			directed := func(name, target string, dir options.Direction) messageOption {
+				desc := &descriptorpb.DescriptorProto{Options: &descriptorpb.MessageOptions{}}
+				proto.SetExtension(desc.Options, options.E_Direction, dir)
+				return messageOption{targetName: target, fullName: name, desc: desc}
+			}
+
+			directedMessages := MessageOptionList{
+				"pkg.GetRequest": directed("pkg.GetRequest", "ProductQuery", options.Direction_PB_TO_GO),
+				"pkg.Product":    directed("pkg.Product", "Product", options.Direction_GO_TO_PB),
+			}
+			f.Service[0].Method = f.Service[0].Method[:1]
+
+			w := &bytes.Buffer{}
+			Expect(clientAdapters(w, f, directedMessages, "pb", "model", false)).To(BeEmpty())
+			Expect(w.String()).To(Equal(
+				"// method ProductService.GetProduct: client adapter method is not generated, request message pkg.GetRequest has (transformer.direction) = PB_TO_GO option\n"))
+
+			directedMessages["pkg.GetRequest"] = directed("pkg.GetRequest", "ProductQuery", options.Direction_GO_TO_PB)
+			w.Reset()
+			Expect(clientAdapters(w, f, directedMessages, "pb", "model", false)).To(BeEmpty())
+			Expect(w.String()).To(Equal(
+				"// method ProductService.GetProduct: client adapter method is not generated, response message pkg.Product has (transformer.direction) = GO_TO_PB option\n"))
+		})
+	})
+
+	Describe("client template", func() {
+
+		It("renders adapter methods", func() {
+			w := &bytes.Buffer{}
+
+			Expect(clientT.Execute(w, clientAdapter{
+				Service:      "ProductService",
+				ProtoPackage: "pb",
+				ModelPackage: "model",
+				Methods:      []clientMethod{{Name: "GetProduct", Request: "ProductQuery", Response: "Product", RequestFunc: "ProductQueryToPb", ResponseFunc: "PbToProduct"}},
+			})).To(Succeed())
+
+			Expect(w.String()).To(Equal(`
+// ProductServiceModelClient calls ProductService methods with models, requests and
+// responses are transformed by generated transformers.
+type ProductServiceModelClient struct {
+	client pb.ProductServiceClient
+}
+
+// NewProductServiceModelClient returns adapter of pb.ProductServiceClient.
+func NewProductServiceModelClient(client pb.ProductServiceClient) *ProductServiceModelClient {
+	return &ProductServiceModelClient{client: client}
+}
+
+// GetProduct transforms req into proto message, calls ProductService.GetProduct and
+// transforms response into model.
+func (c *ProductServiceModelClient) GetProduct(ctx context.Context, req model.ProductQuery, opts ...grpc.CallOption) (*model.Product, error) {
+	resp, err := c.client.GetProduct(ctx, ProductQueryToPbValPtr(req), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return PbToProductPtr(resp), nil
+}
+`))
+		})
+
+		It("returns errors of transformers of messages with transformer.with_errors option", func() {
+			w := &bytes.Buffer{}
+
+			Expect(clientT.Execute(w, clientAdapter{
+				Service:      "ProductService",
+				ProtoPackage: "pb",
+				ModelPackage: "model",
+				Methods:      []clientMethod{{Name: "GetProduct", Request: "ProductQuery", Response: "Product", RequestFunc: "ProductQueryToPb", ResponseFunc: "PbToProduct", RequestErrors: true, ResponseErrors: true}},
+			})).To(Succeed())
+
+			Expect(w.String()).To(ContainSubstring(`
+	in, err := ProductQueryToPbValPtr(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.GetProduct(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return PbToProductPtr(resp)
+}
+`))
+		})
+
+		It("passes context to transformers of messages with transformer.with_context option", func() {
+			w := &bytes.Buffer{}
+
+			Expect(clientT.Execute(w, clientAdapter{
+				Service:      "ProductService",
+				ProtoPackage: "pb",
+				ModelPackage: "model",
+				Methods:      []clientMethod{{Name: "GetProduct", Request: "ProductQuery", Response: "Product", RequestFunc: "ProductQueryToPb", ResponseFunc: "PbToProduct", RequestContext: true, ResponseContext: true}},
+			})).To(Succeed())
+
+			Expect(w.String()).To(ContainSubstring(`
+	resp, err := c.client.GetProduct(ctx, ProductQueryToPbValPtr(ctx, req), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return PbToProductPtr(ctx, resp), nil
+}
+`))
+		})
+	})
+})
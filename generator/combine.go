@@ -0,0 +1,214 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PackageWriter combines generated files of each output directory, i.e. of
+// each Go package, into one file named after the package, e.g.
+// transform/transform_transformer.go. Files are kept in memory until Close,
+// then combined files are written into underlying FileWriter. Directories
+// with one generated file are written as is.
+type PackageWriter struct {
+	w     FileWriter
+	dirs  []string
+	files map[string][]generatedFile
+}
+
+// generatedFile is a file kept by PackageWriter.
+type generatedFile struct {
+	name    string
+	content string
+}
+
+// NewPackageWriter returns PackageWriter which writes combined files into w.
+func NewPackageWriter(w FileWriter) *PackageWriter {
+	return &PackageWriter{w: w, files: map[string][]generatedFile{}}
+}
+
+// WriteFile keeps generated Go file until Close, other files, e.g. coverage
+// report or tests, are written into underlying FileWriter as is.
+func (pw *PackageWriter) WriteFile(name, content string) error {
+	if path.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+		return pw.w.WriteFile(name, content)
+	}
+
+	dir := path.Dir(name)
+	if _, ok := pw.files[dir]; !ok {
+		pw.dirs = append(pw.dirs, dir)
+	}
+
+	pw.files[dir] = append(pw.files[dir], generatedFile{name: name, content: content})
+
+	return nil
+}
+
+// Close writes combined files of all directories in order of their first
+// files and closes underlying FileWriter.
+func (pw *PackageWriter) Close() error {
+	for _, dir := range pw.dirs {
+		files := pw.files[dir]
+		if len(files) == 1 {
+			if err := pw.w.WriteFile(files[0].name, files[0].content); err != nil {
+				return err
+			}
+			continue
+		}
+
+		pkg, content, err := combineFiles(files)
+		if err != nil {
+			return fmt.Errorf("%s: %s", dir, err)
+		}
+
+		if err := pw.w.WriteFile(path.Join(dir, pkg+"_transformer.go"), content); err != nil {
+			return err
+		}
+	}
+
+	return pw.w.Close()
+}
+
+// combineFiles returns package name and content of file with code of all
+// files. Imports are deduplicated, declarations with the same source, such as
+// helpers, are written once. Header of the first file is kept, headers of
+// other files add lines which are not in the header yet, e.g. their source
+// file names.
+func combineFiles(files []generatedFile) (string, string, error) {
+	fset := token.NewFileSet()
+
+	header := &bytes.Buffer{}
+	headerLines := map[string]bool{}
+	body := &bytes.Buffer{}
+	decls := map[string]bool{}
+	imports := map[string]string{}
+	names := map[string]string{}
+	pkg := ""
+
+	for _, gf := range files {
+		src := []byte(gf.content)
+		f, err := parser.ParseFile(fset, gf.name, src, parser.ParseComments)
+		if err != nil {
+			return "", "", err
+		}
+
+		if pkg == "" {
+			pkg = f.Name.Name
+		} else if f.Name.Name != pkg {
+			return "", "", fmt.Errorf("%s is in package %s, other files are in package %s", gf.name, f.Name.Name, pkg)
+		}
+
+		offset := func(p token.Pos) int { return fset.Position(p).Offset }
+
+		first := header.Len() == 0
+		for _, line := range strings.Split(strings.TrimSpace(string(src[:offset(f.Package)])), "\n") {
+			if !first && (line == "" || headerLines[line]) {
+				continue
+			}
+			headerLines[line] = true
+			header.WriteString(line + "\n")
+		}
+
+		for _, is := range f.Imports {
+			p, _ := strconv.Unquote(is.Path.Value)
+			name := path.Base(p)
+			if is.Name != nil {
+				name = is.Name.Name
+			}
+			if prev, ok := names[name]; ok && prev != p && name != "_" {
+				return "", "", fmt.Errorf("%s imports %s as %s, another file imports %s with the same name", gf.name, p, name, prev)
+			}
+			names[name] = p
+
+			if is.Name != nil {
+				imports[p] = is.Name.Name
+			} else if _, ok := imports[p]; !ok {
+				imports[p] = ""
+			}
+		}
+
+		end := offset(f.Name.End())
+		for _, d := range f.Decls {
+			if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+				end = offset(gd.End())
+				continue
+			}
+
+			decl := string(src[offset(d.Pos()):offset(d.End())])
+			if !isInit(d) && decls[decl] {
+				end = offset(d.End())
+				continue
+			}
+			decls[decl] = true
+
+			body.Write(src[end:offset(d.End())])
+			end = offset(d.End())
+		}
+		body.Write(src[end:])
+	}
+
+	out := &bytes.Buffer{}
+	if bytes.TrimSpace(header.Bytes()) != nil {
+		out.Write(header.Bytes())
+		out.WriteString("\n")
+	}
+	fmt.Fprintf(out, "package %s\n", pkg)
+
+	writeGroupedImports(out, imports)
+	out.Write(body.Bytes())
+
+	content, err := format.Source(out.Bytes())
+	if err != nil {
+		return "", "", err
+	}
+
+	return pkg, string(content), nil
+}
+
+// isInit returns true if d is an init function, such functions can be
+// declared several times.
+func isInit(d ast.Decl) bool {
+	fd, ok := d.(*ast.FuncDecl)
+	return ok && fd.Recv == nil && fd.Name.Name == "init"
+}
+
+// writeGroupedImports writes import declaration of imports, which maps import
+// paths to names. Standard library packages are grouped before other packages
+// like goimports does.
+func writeGroupedImports(w *bytes.Buffer, imports map[string]string) {
+	if len(imports) == 0 {
+		return
+	}
+
+	std, other := []string{}, []string{}
+	for p, name := range imports {
+		spec := strings.TrimSpace(name + " " + strconv.Quote(p))
+		if strings.Contains(strings.SplitN(p, "/", 2)[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+
+	fmt.Fprintln(w, "\nimport (")
+	for _, s := range std {
+		fmt.Fprintf(w, "\t%s\n", s)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		fmt.Fprintln(w)
+	}
+	for _, s := range other {
+		fmt.Fprintf(w, "\t%s\n", s)
+	}
+	fmt.Fprintln(w, ")")
+}
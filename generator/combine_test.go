@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PackageWriter", func() {
+
+	var (
+		buf *bytes.Buffer
+		pw  *PackageWriter
+	)
+
+	BeforeEach(func() {
+		buf = &bytes.Buffer{}
+		pw = NewPackageWriter(NewStreamWriter(buf))
+	})
+
+	It("combines files of the same directory", func() {
+		Expect(pw.WriteFile("transform/product_transformer.go", `// Code generated. DO NOT EDIT.
+// source file: product.proto
+
+package transform
+
+import (
+	"fmt"
+
+	"github.com/acme/model"
+)
+
+// PbToProduct transforms product.
+func PbToProduct() model.Product { fmt.Println(); return model.Product{} }
+
+func init() {}
+`)).To(Succeed())
+		Expect(pw.WriteFile("model/product_sum_types.go", "package model\n\ntype Kind int\n")).To(Succeed())
+		Expect(pw.WriteFile("transform/order_transformer.go", `// Code generated. DO NOT EDIT.
+// source file: order.proto
+
+package transform
+
+import (
+	"github.com/acme/model"
+	"strings"
+)
+
+// message "Empty" has no option "transformer.go_struct", skipped...
+func PbToOrder() model.Order { return model.Order{Name: strings.ToUpper("")} }
+
+func init() {}
+`)).To(Succeed())
+		Expect(pw.WriteFile("transform/options.go", `// Code generated. DO NOT EDIT.
+
+package transform
+
+type TransformParam func()
+`)).To(Succeed())
+		Expect(buf.Len()).To(BeZero())
+
+		Expect(pw.Close()).To(Succeed())
+		Expect(buf.String()).To(Equal(`// >>> file: transform/transform_transformer.go
+// Code generated. DO NOT EDIT.
+// source file: product.proto
+// source file: order.proto
+
+package transform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/acme/model"
+)
+
+// PbToProduct transforms product.
+func PbToProduct() model.Product { fmt.Println(); return model.Product{} }
+
+func init() {}
+
+// message "Empty" has no option "transformer.go_struct", skipped...
+func PbToOrder() model.Order { return model.Order{Name: strings.ToUpper("")} }
+
+func init() {}
+
+type TransformParam func()
+// <<< file: transform/transform_transformer.go
+// >>> file: model/product_sum_types.go
+package model
+
+type Kind int
+// <<< file: model/product_sum_types.go
+`))
+	})
+
+	It("writes identical declarations once", func() {
+		Expect(pw.WriteFile("transform/a.go", "package transform\n\nvar _ = 1\n\nfunc helper() {}\n")).To(Succeed())
+		Expect(pw.WriteFile("transform/b.go", "package transform\n\nfunc helper() {}\n")).To(Succeed())
+		Expect(pw.Close()).To(Succeed())
+
+		Expect(buf.String()).To(ContainSubstring("package transform\n\nvar _ = 1\n\nfunc helper() {}\n// <<<"))
+	})
+
+	It("writes files other than Go files as is", func() {
+		Expect(pw.WriteFile("transform/a.go", "package transform\n")).To(Succeed())
+		Expect(pw.WriteFile("transform/coverage.json", "{}\n")).To(Succeed())
+		Expect(pw.WriteFile("transform/b.go", "package transform\n")).To(Succeed())
+		Expect(pw.Close()).To(Succeed())
+
+		Expect(buf.String()).To(HavePrefix("// >>> file: transform/coverage.json\n{}\n// <<< file: transform/coverage.json\n"))
+		Expect(buf.String()).To(ContainSubstring("// >>> file: transform/transform_transformer.go\n"))
+	})
+
+	It("writes test files as is", func() {
+		Expect(pw.WriteFile("transform/a.go", "package transform\n")).To(Succeed())
+		Expect(pw.WriteFile("transform/a_test.go", "package transform\n\nimport \"testing\"\n")).To(Succeed())
+		Expect(pw.WriteFile("transform/b.go", "package transform\n")).To(Succeed())
+		Expect(pw.Close()).To(Succeed())
+
+		Expect(buf.String()).To(HavePrefix("// >>> file: transform/a_test.go\npackage transform\n\nimport \"testing\"\n// <<< file: transform/a_test.go\n"))
+		Expect(buf.String()).To(ContainSubstring("// >>> file: transform/transform_transformer.go\n"))
+	})
+
+	It("returns an error if files import different packages with the same name", func() {
+		Expect(pw.WriteFile("transform/a.go", "package transform\n\nimport pb \"github.com/acme/a\"\n\nvar _ pb.A\n")).To(Succeed())
+		Expect(pw.WriteFile("transform/b.go", "package transform\n\nimport pb \"github.com/acme/b\"\n\nvar _ pb.B\n")).To(Succeed())
+
+		Expect(pw.Close()).To(MatchError("transform: transform/b.go imports github.com/acme/b as pb, another file imports github.com/acme/a with the same name"))
+	})
+
+	It("returns an error if files are in different packages", func() {
+		Expect(pw.WriteFile("transform/a.go", "package transform\n")).To(Succeed())
+		Expect(pw.WriteFile("transform/b.go", "package model\n")).To(Succeed())
+
+		Expect(pw.Close()).To(MatchError("transform: transform/b.go is in package model, other files are in package transform"))
+	})
+})
@@ -0,0 +1,314 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Precedence rules of field options, from highest to lowest:
+//
+//  1. transformer.skip, all other options of the field are ignored;
+//  2. transformer.embedded, options which describe transformation of the field
+//     itself (map_to, custom, unwrap_list, ordered_map) are ignored;
+//  3. transformer.custom_pb_to_go and transformer.custom_go_to_pb, other
+//     options which describe transformation of the field are ignored;
+//  4. explicit options (map_to, custom) take precedence over matching of
+//     proto and model fields by name and type;
+//  5. transformer.ordered_map takes precedence over transformer.unwrap_list.
+
+// fieldTarget describes proto field which is transformed into model field.
+type fieldTarget struct {
+	// Proto field name.
+	name string
+	// If true, model field is pointed by transformer.map_to option.
+	explicit bool
+}
+
+// hasOption returns true if options m contain option opt.
+func hasOption(m proto.Message, opt protoreflect.ExtensionType) bool {
+	if m == nil {
+		return false
+	}
+
+	return proto.HasExtension(m, opt)
+}
+
+// ignoredOptions returns names of options opts which are set for field fdp.
+func ignoredOptions(fdp *descriptorpb.FieldDescriptorProto, opts ...protoreflect.ExtensionType) []string {
+	names := []string{}
+	for _, opt := range opts {
+		if hasOption(fdp.Options, opt) {
+			names = append(names, "("+optionName(opt)+")")
+		}
+	}
+
+	return names
+}
+
+// optionConflicts returns descriptions of options of field fdp which
+// contradict each other or are overridden by options with higher precedence.
+// Model field type gtype is used to detect custom transformers of fields
+// which could be transformed by generated ones.
+func optionConflicts(fdp *descriptorpb.FieldDescriptorProto, subMessages MessageOptionList, gtype string) []string {
+	conflicts := []string{}
+	name := fdp.GetName()
+
+	if extractSkipOption(fdp.Options) {
+		if ignored := ignoredOptions(fdp, options.E_MapTo, options.E_MapAs, options.E_Custom,
+			options.E_Embedded, options.E_EmbeddedPrefix, options.E_UnwrapList, options.E_OrderedMap,
+			options.E_ConverterMethod, options.E_ConverterReverseMethod, options.E_Sensitive, options.E_ModelPointer, options.E_EnumMapping,
+			options.E_CustomPbToGo, options.E_CustomGoToPb, options.E_CustomWithError, options.E_DurationAs, options.E_StructAsJson,
+			options.E_BytesConverter, options.E_Uuid,
+			options.E_Decimal, options.E_DecimalScale, options.E_DecimalRounding, options.E_CurrencyField,
+			options.E_HelperSignature, options.E_TargetField, options.E_OneofCase); len(ignored) > 0 {
+			conflicts = append(conflicts, fmt.Sprintf("field %s: (%s) takes precedence, options %s are ignored",
+				name, options.E_Skip.Name, strings.Join(ignored, ", ")))
+		}
+		return conflicts
+	}
+
+	if extractEmbeddedOption(fdp.Options) {
+		if ignored := ignoredOptions(fdp, options.E_MapTo, options.E_Custom, options.E_UnwrapList, options.E_OrderedMap,
+			options.E_ConverterMethod, options.E_ConverterReverseMethod, options.E_ModelPointer,
+			options.E_CustomPbToGo, options.E_CustomGoToPb, options.E_CustomWithError, options.E_BytesConverter, options.E_Uuid,
+			options.E_Decimal, options.E_DecimalScale, options.E_DecimalRounding, options.E_CurrencyField,
+			options.E_HelperSignature, options.E_TargetField, options.E_OneofCase); len(ignored) > 0 {
+			conflicts = append(conflicts, fmt.Sprintf("field %s: (%s) takes precedence, options %s are ignored",
+				name, options.E_Embedded.Name, strings.Join(ignored, ", ")))
+		}
+		return conflicts
+	}
+
+	if custom := ignoredOptions(fdp, options.E_CustomPbToGo, options.E_CustomGoToPb); len(custom) > 0 {
+		if ignored := ignoredOptions(fdp, options.E_Custom, options.E_UnwrapList, options.E_OrderedMap,
+			options.E_ConverterMethod, options.E_ConverterReverseMethod, options.E_ModelPointer,
+			options.E_UseStdTime, options.E_EnumMapping, options.E_DurationAs, options.E_StructAsJson, options.E_BytesConverter, options.E_Uuid,
+			options.E_Decimal, options.E_DecimalScale, options.E_DecimalRounding, options.E_CurrencyField,
+			options.E_HelperSignature, options.E_OneofCase); len(ignored) > 0 {
+			conflicts = append(conflicts, fmt.Sprintf("field %s: %s take precedence, options %s are ignored",
+				name, strings.Join(custom, ", "), strings.Join(ignored, ", ")))
+		}
+		return conflicts
+	}
+
+	if getBoolOption(fdp.Options, options.E_CustomWithError) {
+		conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored without (%s) or (%s)",
+			name, options.E_CustomWithError.Name, options.E_CustomPbToGo.Name, options.E_CustomGoToPb.Name))
+	}
+
+	if hasOption(fdp.Options, options.E_EnumMapping) && fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_ENUM {
+		conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored for non-enum fields",
+			name, options.E_EnumMapping.Name))
+	}
+
+	if hasOption(fdp.Options, options.E_DurationAs) && (fdp.GetTypeName() != ".google.protobuf.Duration" ||
+		fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED) {
+		conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored for fields other than singular google.protobuf.Duration",
+			name, options.E_DurationAs.Name))
+	}
+
+	if hasOption(fdp.Options, options.E_StructAsJson) && fdp.GetTypeName() != googleProtobufStruct {
+		conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored for fields other than google.protobuf.Struct",
+			name, options.E_StructAsJson.Name))
+	}
+
+	if hasOption(fdp.Options, options.E_BytesConverter) && (fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_BYTES ||
+		fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED) {
+		conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored for fields other than singular bytes",
+			name, options.E_BytesConverter.Name))
+	}
+
+	if getBoolOption(fdp.Options, options.E_Uuid) {
+		switch t := fdp.GetType(); {
+		case fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED ||
+			t != descriptorpb.FieldDescriptorProto_TYPE_STRING && t != descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+			conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored for fields other than singular string and bytes",
+				name, options.E_Uuid.Name))
+		case hasOption(fdp.Options, options.E_BytesConverter):
+			conflicts = append(conflicts, fmt.Sprintf("field %s: (%s) takes precedence, option (%s) is ignored",
+				name, options.E_BytesConverter.Name, options.E_Uuid.Name))
+		}
+	}
+
+	if !getBoolOption(fdp.Options, options.E_Decimal) {
+		for _, opt := range []protoreflect.ExtensionType{options.E_DecimalScale, options.E_DecimalRounding, options.E_CurrencyField} {
+			if hasOption(fdp.Options, opt) {
+				conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored without (%s) = true",
+					name, optionName(opt), options.E_Decimal.Name))
+			}
+		}
+	} else {
+		if hasOption(fdp.Options, options.E_DecimalScale) && fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_INT64 {
+			conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored for fields other than int64",
+				name, options.E_DecimalScale.Name))
+		}
+		if hasOption(fdp.Options, options.E_CurrencyField) && fdp.GetTypeName() != googleTypeMoney {
+			conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored for fields other than google.type.Money",
+				name, options.E_CurrencyField.Name))
+		}
+	}
+
+	if hasOption(fdp.Options, options.E_EmbeddedPrefix) {
+		conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored without (%s) = true",
+			name, options.E_EmbeddedPrefix.Name, options.E_Embedded.Name))
+	}
+
+	var mo MessageOption
+	if tn := fdp.GetTypeName(); tn != "" {
+		mo = subMessages[strings.TrimPrefix(tn, ".")]
+	}
+	isMap := mo != nil && mo.Descriptor().GetOptions().GetMapEntry()
+
+	for _, opt := range []protoreflect.ExtensionType{options.E_OrderedMap, options.E_UnwrapList} {
+		if getBoolOption(fdp.Options, opt) && !isMap {
+			conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored for non-map fields",
+				name, optionName(opt)))
+		}
+	}
+
+	if isMap && extractOrderedMapOption(fdp.Options) && extractUnwrapListOption(fdp.Options) {
+		conflicts = append(conflicts, fmt.Sprintf("field %s: (%s) takes precedence, option (%s) is ignored",
+			name, options.E_OrderedMap.Name, options.E_UnwrapList.Name))
+	}
+
+	if !getBoolOption(fdp.Options, options.E_Custom) {
+		return conflicts
+	}
+
+	switch {
+	case isMap:
+		conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) is ignored for map fields",
+			name, options.E_Custom.Name))
+	case mo != nil && !mo.Omitted() && gtype != "" && lastName(gtype) == lastName(mo.Target()):
+		// model field has the same type as go_struct of sub message, generated
+		// transformer could be used.
+		conflicts = append(conflicts, fmt.Sprintf("field %s: option (%s) takes precedence over generated transformer of %s, remove the option to use it",
+			name, options.E_Custom.Name, mo.Target()))
+	}
+
+	return conflicts
+}
+
+// fieldTargets returns proto fields of message msg by names of model fields
+// they are transformed into. Skipped and embedded fields are omitted. If two
+// fields point the same model field by transformer.map_to option, error is
+// returned, because there is no rule which one should be used.
+func fieldTargets(msg *descriptorpb.DescriptorProto) (map[string]fieldTarget, error) {
+	targets := map[string]fieldTarget{}
+
+	for _, fdp := range msg.GetField() {
+		if extractSkipOption(fdp.Options) || extractEmbeddedOption(fdp.Options) {
+			continue
+		}
+
+		gname, explicit := modelFieldName(fdp)
+		t := fieldTarget{name: fdp.GetName(), explicit: explicit}
+
+		prev, ok := targets[gname]
+		switch {
+		case !ok, !prev.explicit && t.explicit:
+			targets[gname] = t
+		case prev.explicit && t.explicit:
+			return nil, fmt.Errorf("fields %s and %s are both mapped into model field %s with option (%s)",
+				prev.name, t.name, gname, options.E_MapTo.Name)
+		}
+	}
+
+	return targets, nil
+}
+
+// modelFieldName returns name of model field which proto field fdp is
+// transformed into. Explicit flag is true if name is set by transformer.map_to
+// option.
+func modelFieldName(fdp *descriptorpb.FieldDescriptorProto) (string, bool) {
+	mapTo, _ := getStringOption(fdp.Options, options.E_MapTo)
+	mapAs, _ := getStringOption(fdp.Options, options.E_MapAs)
+	_, gname := prepareFieldNames(fdp.GetName(), mapAs, mapTo)
+
+	return gname, mapTo != ""
+}
+
+// targetConflict returns loggable error if model field, which proto field fdp
+// is matched with by name, is pointed by transformer.map_to option
+// of another field. Explicit option takes precedence, so fdp is skipped.
+func targetConflict(fdp *descriptorpb.FieldDescriptorProto, targets map[string]fieldTarget) error {
+	gname, _ := modelFieldName(fdp)
+	t, ok := targets[gname]
+	if !ok || !t.explicit || t.name == fdp.GetName() {
+		return nil
+	}
+
+	return newLoggableError("field %s: model field %s is pointed by option (%s) of field %s, explicit option takes precedence over matching by name",
+		fdp.GetName(), gname, options.E_MapTo.Name, t.name).
+		withHint("use (%s) option to transform field %s into another model field or skip it with (%s) = true",
+			options.E_MapTo.Name, fdp.GetName(), options.E_Skip.Name)
+}
+
+// directionConflict returns an error if field fdp of message with
+// transformer.direction option dir refers to sub message which transformers
+// are not generated in the direction required by the message. Directions of
+// transformer.skip_direction option of the field are not required. Values of
+// map fields are checked as well.
+func directionConflict(fdp *descriptorpb.FieldDescriptorProto, subMessages MessageOptionList, dir options.Direction) error {
+	if extractSkipOption(fdp.Options) || extractEmbeddedOption(fdp.Options) ||
+		len(ignoredOptions(fdp, options.E_CustomPbToGo, options.E_CustomGoToPb)) > 0 {
+		return nil
+	}
+
+	mo := subMessages[strings.TrimPrefix(fdp.GetTypeName(), ".")]
+	if mo != nil && mo.Descriptor().GetOptions().GetMapEntry() {
+		var value MessageOption
+		for _, ef := range mo.Descriptor().GetField() {
+			if ef.GetName() == "value" {
+				value = subMessages[strings.TrimPrefix(ef.GetTypeName(), ".")]
+			}
+		}
+		mo = value
+	}
+	if mo == nil || mo.Omitted() {
+		return nil
+	}
+
+	switch skip := extractSkipDirectionOption(fdp.Options); {
+	case skip == options.Direction_BOTH:
+	case dir == options.Direction_BOTH:
+		dir = oppositeDirection(skip)
+	case dir == skip:
+		return nil
+	}
+
+	sub := extractDirectionOption(mo.Descriptor().GetOptions())
+	if sub == options.Direction_BOTH || sub == dir {
+		return nil
+	}
+
+	if dir == options.Direction_BOTH {
+		return newLoggableError("field skipped: %s, transformers of message %s are generated in direction %s only",
+			fdp.GetName(), mo.Full(), sub).
+			withHint("set (%s) = %s option of message of field %s, skip the field with (%s) = true or (%s) = %s",
+				options.E_Direction.Name, options.Direction_BOTH, fdp.GetName(), options.E_Skip.Name,
+				options.E_SkipDirection.Name, oppositeDirection(sub))
+	}
+
+	return newLoggableError("field skipped: %s, transformers of message %s are generated in direction %s only",
+		fdp.GetName(), mo.Full(), sub).
+		withHint("set (%s) = %s option of message of field %s or skip the field with (%s) = true",
+			options.E_Direction.Name, options.Direction_BOTH, fdp.GetName(), options.E_Skip.Name)
+}
+
+// oppositeDirection returns direction which is opposite to d, BOTH for BOTH.
+func oppositeDirection(d options.Direction) options.Direction {
+	switch d {
+	case options.Direction_PB_TO_GO:
+		return options.Direction_GO_TO_PB
+	case options.Direction_GO_TO_PB:
+		return options.Direction_PB_TO_GO
+	}
+
+	return d
+}
@@ -0,0 +1,284 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Conflict", func() {
+
+	// field returns string proto field with options.
+	field := func(name string, opts map[protoreflect.ExtensionType]interface{}) *descriptorpb.FieldDescriptorProto {
+		fdp := &descriptorpb.FieldDescriptorProto{Name: sp(name), Type: &typString, Options: &descriptorpb.FieldOptions{}}
+		for ext, v := range opts {
+			proto.SetExtension(fdp.Options, ext, v)
+		}
+		return fdp
+	}
+
+	subMessages := MessageOptionList{
+		"pkg.Address": messageOption{targetName: "Address", desc: &descriptorpb.DescriptorProto{}},
+		"pkg.Entry": messageOption{desc: &descriptorpb.DescriptorProto{
+			Options: &descriptorpb.MessageOptions{MapEntry: bp(true)},
+		}},
+	}
+
+	millis := options.DurationAs_MILLISECONDS
+	typBytes := descriptorpb.FieldDescriptorProto_TYPE_BYTES
+
+	DescribeTable("optionConflicts",
+		func(fdp *descriptorpb.FieldDescriptorProto, gtype string, expected []string) {
+			Expect(optionConflicts(fdp, subMessages, gtype)).To(Equal(expected))
+		},
+
+		Entry("no options", field("name", nil), "string", []string{}),
+
+		Entry("skip with other options", field("name", map[protoreflect.ExtensionType]interface{}{
+			options.E_Skip:  true,
+			options.E_MapTo: "Title",
+		}), "string", []string{
+			"field name: (transformer.skip) takes precedence, options (transformer.map_to) are ignored",
+		}),
+
+		Entry("skip with signature, target and oneof case options", field("name", map[protoreflect.ExtensionType]interface{}{
+			options.E_Skip:            true,
+			options.E_HelperSignature: "context,error",
+			options.E_TargetField:     "display_name",
+			options.E_OneofCase:       "Coupon",
+		}), "string", []string{
+			"field name: (transformer.skip) takes precedence, options (transformer.helper_signature), (transformer.target_field), (transformer.oneof_case) are ignored",
+		}),
+
+		Entry("embedded with helper_signature", field("address", map[protoreflect.ExtensionType]interface{}{
+			options.E_Embedded:        true,
+			options.E_HelperSignature: "pointer_arg",
+		}), "", []string{
+			"field address: (transformer.embedded) takes precedence, options (transformer.helper_signature) are ignored",
+		}),
+
+		Entry("embedded with custom", field("address", map[protoreflect.ExtensionType]interface{}{
+			options.E_Embedded: true,
+			options.E_Custom:   true,
+		}), "", []string{
+			"field address: (transformer.embedded) takes precedence, options (transformer.custom) are ignored",
+		}),
+
+		Entry("embedded_prefix without embedded", field("address", map[protoreflect.ExtensionType]interface{}{
+			options.E_EmbeddedPrefix: "Address",
+		}), "", []string{
+			"field address: option (transformer.embedded_prefix) is ignored without (transformer.embedded) = true",
+		}),
+
+		Entry("enum_mapping for non-enum field", field("name", map[protoreflect.ExtensionType]interface{}{
+			options.E_EnumMapping: "PAID=StatePaid",
+		}), "string", []string{
+			"field name: option (transformer.enum_mapping) is ignored for non-enum fields",
+		}),
+
+		Entry("duration_as for non-duration field", field("timeout", map[protoreflect.ExtensionType]interface{}{
+			options.E_DurationAs: millis,
+		}), "int64", []string{
+			"field timeout: option (transformer.duration_as) is ignored for fields other than singular google.protobuf.Duration",
+		}),
+
+		Entry("struct_as_json for non-struct field", field("config", map[protoreflect.ExtensionType]interface{}{
+			options.E_StructAsJson: true,
+		}), "string", []string{
+			"field config: option (transformer.struct_as_json) is ignored for fields other than google.protobuf.Struct",
+		}),
+
+		Entry("bytes_converter for non-bytes field", field("id", map[protoreflect.ExtensionType]interface{}{
+			options.E_BytesConverter: "uuid.FromBytes,uuid.UUID.Bytes",
+		}), "string", []string{
+			"field id: option (transformer.bytes_converter) is ignored for fields other than singular bytes",
+		}),
+
+		Entry("uuid for non-string field", func() *descriptorpb.FieldDescriptorProto {
+			fdp := field("count", map[protoreflect.ExtensionType]interface{}{options.E_Uuid: true})
+			fdp.Type = &typInt64
+			return fdp
+		}(), "int64", []string{
+			"field count: option (transformer.uuid) is ignored for fields other than singular string and bytes",
+		}),
+
+		Entry("uuid with bytes_converter", func() *descriptorpb.FieldDescriptorProto {
+			fdp := field("id", map[protoreflect.ExtensionType]interface{}{
+				options.E_Uuid:           true,
+				options.E_BytesConverter: "uuid.FromBytes",
+			})
+			fdp.Type = &typBytes
+			return fdp
+		}(), "uuid.UUID", []string{
+			"field id: (transformer.bytes_converter) takes precedence, option (transformer.uuid) is ignored",
+		}),
+
+		Entry("decimal_scale without decimal", field("price", map[protoreflect.ExtensionType]interface{}{
+			options.E_DecimalScale: int32(2),
+		}), "int64", []string{
+			"field price: option (transformer.decimal_scale) is ignored without (transformer.decimal) = true",
+		}),
+
+		Entry("decimal parameters of other field types", field("price", map[protoreflect.ExtensionType]interface{}{
+			options.E_Decimal:       true,
+			options.E_DecimalScale:  int32(2),
+			options.E_CurrencyField: "Currency",
+		}), "decimal.Decimal", []string{
+			"field price: option (transformer.decimal_scale) is ignored for fields other than int64",
+			"field price: option (transformer.currency_field) is ignored for fields other than google.type.Money",
+		}),
+
+		Entry("custom functions with other options", field("price", map[protoreflect.ExtensionType]interface{}{
+			options.E_CustomPbToGo:    "money.FromCents",
+			options.E_ConverterMethod: "CurrencyResolver.ToMinorUnits",
+		}), "decimal.Decimal", []string{
+			"field price: (transformer.custom_pb_to_go) take precedence, options (transformer.converter_method) are ignored",
+		}),
+
+		Entry("custom functions with helper_signature", field("price", map[protoreflect.ExtensionType]interface{}{
+			options.E_CustomGoToPb:    "money.ToCents",
+			options.E_HelperSignature: "context,error",
+		}), "decimal.Decimal", []string{
+			"field price: (transformer.custom_go_to_pb) take precedence, options (transformer.helper_signature) are ignored",
+		}),
+
+		Entry("custom_with_error without functions", field("price", map[protoreflect.ExtensionType]interface{}{
+			options.E_CustomWithError: true,
+		}), "decimal.Decimal", []string{
+			"field price: option (transformer.custom_with_error) is ignored without (transformer.custom_pb_to_go) or (transformer.custom_go_to_pb)",
+		}),
+
+		Entry("unwrap_list for non-map field", field("name", map[protoreflect.ExtensionType]interface{}{
+			options.E_UnwrapList: true,
+		}), "string", []string{
+			"field name: option (transformer.unwrap_list) is ignored for non-map fields",
+		}),
+
+		Entry("ordered_map for non-map field", field("name", map[protoreflect.ExtensionType]interface{}{
+			options.E_OrderedMap: true,
+		}), "string", []string{
+			"field name: option (transformer.ordered_map) is ignored for non-map fields",
+		}),
+
+		Entry("ordered_map with unwrap_list", func() *descriptorpb.FieldDescriptorProto {
+			fdp := field("labels", map[protoreflect.ExtensionType]interface{}{
+				options.E_OrderedMap: true,
+				options.E_UnwrapList: true,
+			})
+			fdp.Type, fdp.TypeName, fdp.Label = &typMessage, sp(".pkg.Entry"), &typRepeated
+			return fdp
+		}(), "", []string{
+			"field labels: (transformer.ordered_map) takes precedence, option (transformer.unwrap_list) is ignored",
+		}),
+
+		Entry("custom for map field", func() *descriptorpb.FieldDescriptorProto {
+			fdp := field("labels", map[protoreflect.ExtensionType]interface{}{options.E_Custom: true})
+			fdp.Type, fdp.TypeName, fdp.Label = &typMessage, sp(".pkg.Entry"), &typRepeated
+			return fdp
+		}(), "", []string{
+			"field labels: option (transformer.custom) is ignored for map fields",
+		}),
+
+		Entry("custom for field of generated transformer type", func() *descriptorpb.FieldDescriptorProto {
+			fdp := field("address", map[protoreflect.ExtensionType]interface{}{options.E_Custom: true})
+			fdp.Type, fdp.TypeName = &typMessage, sp(".pkg.Address")
+			return fdp
+		}(), "Address", []string{
+			"field address: option (transformer.custom) takes precedence over generated transformer of Address, remove the option to use it",
+		}),
+
+		Entry("custom for field of another type", func() *descriptorpb.FieldDescriptorProto {
+			fdp := field("address", map[protoreflect.ExtensionType]interface{}{options.E_Custom: true})
+			fdp.Type, fdp.TypeName = &typMessage, sp(".pkg.Address")
+			return fdp
+		}(), "Location", []string{}),
+	)
+
+	Describe("fieldTargets", func() {
+
+		It("prefers explicit map_to option", func() {
+			msg := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{
+				field("title", nil),
+				field("name", map[protoreflect.ExtensionType]interface{}{options.E_MapTo: "Title"}),
+				field("ignored", map[protoreflect.ExtensionType]interface{}{options.E_Skip: true}),
+			}}
+
+			targets, err := fieldTargets(msg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(targets).To(Equal(map[string]fieldTarget{
+				"Title": {name: "name", explicit: true},
+			}))
+
+			Expect(targetConflict(msg.Field[0], targets)).To(MatchError(
+				"field title: model field Title is pointed by option (transformer.map_to) of field name, explicit option takes precedence over matching by name; " +
+					"hint: use (transformer.map_to) option to transform field title into another model field or skip it with (transformer.skip) = true"))
+			Expect(targetConflict(msg.Field[1], targets)).To(Succeed())
+		})
+
+		It("returns an error if fields are mapped into the same model field", func() {
+			msg := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{
+				field("first", map[protoreflect.ExtensionType]interface{}{options.E_MapTo: "Title"}),
+				field("second", map[protoreflect.ExtensionType]interface{}{options.E_MapTo: "Title"}),
+			}}
+
+			_, err := fieldTargets(msg)
+			Expect(err).To(MatchError("fields first and second are both mapped into model field Title with option (transformer.map_to)"))
+		})
+	})
+
+	Describe("directionConflict", func() {
+
+		// directed returns message options with transformer.direction option.
+		directed := func(dir options.Direction, fields ...*descriptorpb.FieldDescriptorProto) *descriptorpb.DescriptorProto {
+			desc := &descriptorpb.DescriptorProto{Field: fields, Options: &descriptorpb.MessageOptions{}}
+			proto.SetExtension(desc.Options, options.E_Direction, dir)
+			return desc
+		}
+
+		// skipIn returns field options with transformer.skip_direction option.
+		skipIn := func(dir options.Direction) map[protoreflect.ExtensionType]interface{} {
+			return map[protoreflect.ExtensionType]interface{}{options.E_SkipDirection: dir}
+		}
+
+		messages := MessageOptionList{
+			"pkg.Address": messageOption{targetName: "Address", fullName: "pkg.Address", desc: directed(options.Direction_PB_TO_GO)},
+			"pkg.Phone":   messageOption{targetName: "Phone", fullName: "pkg.Phone", desc: &descriptorpb.DescriptorProto{}},
+			"pkg.Entry": messageOption{desc: &descriptorpb.DescriptorProto{
+				Field:   []*descriptorpb.FieldDescriptorProto{{Name: sp("key")}, {Name: sp("value"), TypeName: sp(".pkg.Address")}},
+				Options: &descriptorpb.MessageOptions{MapEntry: bp(true)},
+			}},
+		}
+
+		// typed returns message field of type typ with options.
+		typed := func(typ string, opts map[protoreflect.ExtensionType]interface{}) *descriptorpb.FieldDescriptorProto {
+			fdp := field("address", opts)
+			fdp.TypeName = sp(typ)
+			return fdp
+		}
+
+		It("returns an error if sub message is not transformed in required direction", func() {
+			Expect(directionConflict(typed(".pkg.Address", nil), messages, options.Direction_BOTH)).To(MatchError(
+				"field skipped: address, transformers of message pkg.Address are generated in direction PB_TO_GO only; " +
+					"hint: set (transformer.direction) = BOTH option of message of field address, skip the field with (transformer.skip) = true or (transformer.skip_direction) = GO_TO_PB"))
+			Expect(directionConflict(typed(".pkg.Address", nil), messages, options.Direction_GO_TO_PB)).To(MatchError(
+				"field skipped: address, transformers of message pkg.Address are generated in direction PB_TO_GO only; " +
+					"hint: set (transformer.direction) = BOTH option of message of field address or skip the field with (transformer.skip) = true"))
+			Expect(directionConflict(typed(".pkg.Address", skipIn(options.Direction_PB_TO_GO)), messages, options.Direction_BOTH)).To(HaveOccurred())
+			Expect(directionConflict(typed(".pkg.Address", nil), messages, options.Direction_GO_TO_PB)).To(HaveOccurred())
+			Expect(directionConflict(typed(".pkg.Entry", nil), messages, options.Direction_BOTH)).To(HaveOccurred())
+		})
+
+		It("returns nothing if sub message is transformed in required direction", func() {
+			Expect(directionConflict(typed(".pkg.Address", nil), messages, options.Direction_PB_TO_GO)).To(Succeed())
+			Expect(directionConflict(typed(".pkg.Phone", nil), messages, options.Direction_GO_TO_PB)).To(Succeed())
+			Expect(directionConflict(typed(".pkg.Address", map[protoreflect.ExtensionType]interface{}{options.E_Skip: true}), messages, options.Direction_BOTH)).To(Succeed())
+			Expect(directionConflict(typed(".pkg.Address", skipIn(options.Direction_GO_TO_PB)), messages, options.Direction_BOTH)).To(Succeed())
+			Expect(directionConflict(typed(".pkg.Address", skipIn(options.Direction_GO_TO_PB)), messages, options.Direction_GO_TO_PB)).To(Succeed())
+			Expect(directionConflict(field("name", nil), messages, options.Direction_BOTH)).To(Succeed())
+		})
+	})
+})
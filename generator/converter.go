@@ -0,0 +1,177 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// converterMode is true if transformers are added as methods of Converter
+// structure, see SetConverter.
+var converterMode bool
+
+// SetConverter turns on converter mode: transformers of messages are added as
+// methods of Converter structure too, see ConverterHelpers.
+func SetConverter(on bool) {
+	converterMode = on
+}
+
+// converterDeps contains signatures of methods by names of dependency
+// interfaces and methods. Methods are collected from processed files and
+// declared by ConverterHelpers.
+var converterDeps = map[string]map[string]string{}
+
+// converterIface is a dependency interface held by Converter.
+type converterIface struct {
+	Name string
+	// Method declarations, e.g. ToMinorUnits(v string) int64.
+	Methods []string
+}
+
+// dependencyMethod returns interface and method names from option opt of
+// field fdp, which is in format Interface.Method. Empty names are returned if
+// option is not set.
+func dependencyMethod(fdp *descriptorpb.FieldDescriptorProto, opt protoreflect.ExtensionType) (string, string, error) {
+	v, err := getStringOption(fdp.Options, opt)
+	if err != nil || v == "" {
+		return "", "", nil
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 2 || !token.IsIdentifier(parts[0]) || !token.IsIdentifier(parts[1]) || !token.IsExported(parts[0]) {
+		return "", "", fmt.Errorf("field %s: option (%s) should be in format Interface.Method with exported interface name, got %q",
+			fdp.GetName(), optionName(opt), v)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// processDepField returns field which is transformed by methods of Converter
+// dependency, nil is returned if field fdp has neither
+// transformer.converter_method nor transformer.converter_reverse_method
+// option. Only scalar proto fields are supported.
+func processDepField(fdp *descriptorpb.FieldDescriptorProto, pname, gname string) (*Field, error) {
+	iface, method, err := dependencyMethod(fdp, options.E_ConverterMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	riface, rmethod, err := dependencyMethod(fdp, options.E_ConverterReverseMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	if method == "" && rmethod == "" {
+		return nil, nil
+	}
+
+	if iface == "" {
+		iface = riface
+	}
+
+	if riface != "" && riface != iface {
+		return nil, fmt.Errorf("field %s: options (%s) and (%s) should point methods of the same interface, got %s and %s",
+			fdp.GetName(), options.E_ConverterMethod.Name, options.E_ConverterReverseMethod.Name, iface, riface)
+	}
+
+	t, ok := types[fdp.GetType()]
+	if !ok || fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil, newLoggableError("field %s: option (%s) is supported for singular scalar fields only", gname, options.E_ConverterMethod.Name).
+			withHint("use (transformer.custom) option for field %s", fdp.GetName())
+	}
+
+	pt := t.pbType
+	if pt == "" {
+		pt = t.goType
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		ProtoType: pt,
+		Dep: &Dep{
+			Iface:         iface,
+			Method:        method,
+			ReverseMethod: rmethod,
+			ProtoType:     pt,
+		},
+	}, nil
+}
+
+// registerDeps registers methods of dependency interfaces used by fields and
+// returns the rest of fields. Types of model s are prefixed by modelPackage.
+// Fields transformed by dependencies are not generated without converter
+// mode, they are reported into w and returned fields don't contain them.
+func registerDeps(w io.Writer, fields []Field, s source.Structure, modelPackage string, converter bool) ([]Field, error) {
+	out := make([]Field, 0, len(fields))
+
+	for _, f := range fields {
+		d := f.Dep
+		if d == nil {
+			out = append(out, f)
+			continue
+		}
+
+		if !converter {
+			p(w, "// field %s: option (%s) requires converter mode; hint: run generator with converter=true parameter\n",
+				f.Name, options.E_ConverterMethod.Name)
+			continue
+		}
+
+		gt := qualifiedGoType(s[f.Name], modelPackage)
+		sigs := map[string]string{}
+		if d.Method != "" {
+			sigs[d.Method] = fmt.Sprintf("%s(v %s) %s", d.Method, d.ProtoType, gt)
+		}
+		if d.ReverseMethod != "" {
+			sigs[d.ReverseMethod] = fmt.Sprintf("%s(v %s) %s", d.ReverseMethod, gt, d.ProtoType)
+		}
+
+		if converterDeps[d.Iface] == nil {
+			converterDeps[d.Iface] = map[string]string{}
+		}
+
+		for m, sig := range sigs {
+			if prev, ok := converterDeps[d.Iface][m]; ok && prev != sig {
+				return nil, fmt.Errorf("field %s: method %s.%s is declared as %s and %s by different fields", f.Name, d.Iface, m, prev, sig)
+			}
+			converterDeps[d.Iface][m] = sig
+		}
+
+		out = append(out, f)
+	}
+
+	return out, nil
+}
+
+// ConverterHelpers returns file content with Converter structure, transformers
+// of messages are added as its methods in converter mode. Dependency
+// interfaces registered by processed files are declared too.
+func ConverterHelpers(packageName string) (string, error) {
+	ifaces := make([]converterIface, 0, len(converterDeps))
+	for name, methods := range converterDeps {
+		ci := converterIface{Name: name}
+		for _, sig := range methods {
+			ci.Methods = append(ci.Methods, sig)
+		}
+		sort.Strings(ci.Methods)
+		ifaces = append(ifaces, ci)
+	}
+	sort.Slice(ifaces, func(i, j int) bool { return ifaces[i].Name < ifaces[j].Name })
+
+	w := output()
+	fmt.Fprintln(w, "\npackage", packageName)
+
+	if err := converterHelpersT.Execute(w, ifaces); err != nil {
+		return "", err
+	}
+
+	return w.String(), nil
+}
@@ -0,0 +1,198 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Converter", func() {
+
+	AfterEach(func() {
+		converterDeps = map[string]map[string]string{}
+	})
+
+	// depField returns string field with converter method options.
+	depField := func(method, reverse string) *descriptorpb.FieldDescriptorProto {
+		fdp := &descriptorpb.FieldDescriptorProto{Name: sp("price"), Type: &typString, Options: &descriptorpb.FieldOptions{}}
+		if method != "" {
+			proto.SetExtension(fdp.Options, options.E_ConverterMethod, method)
+		}
+		if reverse != "" {
+			proto.SetExtension(fdp.Options, options.E_ConverterReverseMethod, reverse)
+		}
+		return fdp
+	}
+
+	Describe("processDepField", func() {
+
+		It("returns field transformed by dependency methods", func() {
+			f, err := processDepField(depField("CurrencyResolver.ToMinorUnits", "CurrencyResolver.FromMinorUnits"), "Price", "Price")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(Equal(&Field{
+				Name:      "Price",
+				ProtoName: "Price",
+				ProtoType: "string",
+				Dep: &Dep{
+					Iface:         "CurrencyResolver",
+					Method:        "ToMinorUnits",
+					ReverseMethod: "FromMinorUnits",
+					ProtoType:     "string",
+				},
+			}))
+		})
+
+		It("returns nil for fields without options", func() {
+			f, err := processDepField(depField("", ""), "Price", "Price")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(BeNil())
+		})
+
+		It("returns an error for invalid method", func() {
+			_, err := processDepField(depField("ToMinorUnits", ""), "Price", "Price")
+			Expect(err).To(MatchError(`field price: option (transformer.converter_method) should be in format Interface.Method with exported interface name, got "ToMinorUnits"`))
+		})
+
+		It("returns an error for methods of different interfaces", func() {
+			_, err := processDepField(depField("CurrencyResolver.ToMinorUnits", "Locale.FromMinorUnits"), "Price", "Price")
+			Expect(err).To(MatchError("field price: options (transformer.converter_method) and (transformer.converter_reverse_method) should point methods of the same interface, got CurrencyResolver and Locale"))
+		})
+
+		It("returns loggable error for repeated fields", func() {
+			fdp := depField("CurrencyResolver.ToMinorUnits", "")
+			fdp.Label = &typRepeated
+			_, err := processDepField(fdp, "Price", "Price")
+			Expect(err).To(MatchError("field Price: option (transformer.converter_method) is supported for singular scalar fields only; hint: use (transformer.custom) option for field price"))
+		})
+	})
+
+	Describe("registerDeps", func() {
+		s := source.Structure{"Price": {Type: "Money"}, "ID": {Type: "int64"}}
+		fields := []Field{
+			{Name: "ID"},
+			{Name: "Price", Dep: &Dep{Iface: "CurrencyResolver", Method: "ToMinorUnits", ReverseMethod: "FromMinorUnits", ProtoType: "string"}},
+		}
+
+		It("registers dependency methods", func() {
+			out, err := registerDeps(nil, fields, s, "model", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(fields))
+			Expect(converterDeps).To(Equal(map[string]map[string]string{
+				"CurrencyResolver": {
+					"ToMinorUnits":   "ToMinorUnits(v string) model.Money",
+					"FromMinorUnits": "FromMinorUnits(v model.Money) string",
+				},
+			}))
+		})
+
+		It("omits fields without converter mode", func() {
+			w := &bytes.Buffer{}
+			out, err := registerDeps(w, fields, s, "model", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(fields[:1]))
+			Expect(w.String()).To(Equal("// field Price: option (transformer.converter_method) requires converter mode; hint: run generator with converter=true parameter\n"))
+		})
+
+		It("returns an error if method has different signatures", func() {
+			_, err := registerDeps(nil, fields, s, "model", true)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = registerDeps(nil, fields, source.Structure{"Price": {Type: "int64"}}, "model", true)
+			Expect(err).To(MatchError(ContainSubstring("method CurrencyResolver.")))
+			Expect(err).To(MatchError(ContainSubstring("by different fields")))
+		})
+	})
+
+	Describe("ConverterHelpers", func() {
+
+		It("returns Converter structure", func() {
+			content, err := ConverterHelpers("transform")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("\npackage transform\n"))
+			Expect(content).To(ContainSubstring("type Converter struct {"))
+			Expect(content).To(ContainSubstring("func NewConverter(opts ...TransformParam) *Converter {"))
+			Expect(content).NotTo(ContainSubstring("time"))
+		})
+
+		It("declares dependency interfaces", func() {
+			converterDeps["CurrencyResolver"] = map[string]string{
+				"ToMinorUnits":   "ToMinorUnits(v string) model.Money",
+				"FromMinorUnits": "FromMinorUnits(v model.Money) string",
+			}
+
+			content, err := ConverterHelpers("transform")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring(`	// CurrencyResolver transforms fields with (transformer.converter_method) options.
+	CurrencyResolver CurrencyResolver
+}`))
+			Expect(content).To(ContainSubstring(`type CurrencyResolver interface {
+	FromMinorUnits(v model.Money) string
+	ToMinorUnits(v string) model.Money
+}`))
+		})
+	})
+
+	Describe("converter template", func() {
+		d := Data{
+			Src:        "Order",
+			SrcPref:    "pb",
+			SrcFn:      "Pb",
+			SrcPointer: "*",
+			Dst:        "Order",
+			DstPref:    "model",
+			DstFn:      "Order",
+			Converter:  true,
+			Fields: []Field{
+				{Name: "ID", ProtoName: "Id"},
+				{Name: "Price", ProtoName: "Price", Dep: &Dep{Iface: "CurrencyResolver", Method: "ToMinorUnits", ReverseMethod: "FromMinorUnits"}},
+				{Name: "Total", ProtoName: "Total", Dep: &Dep{Iface: "CurrencyResolver", Method: "ToMinorUnits"}},
+			},
+		}
+
+		It("adds transformers as converter methods", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "converter", d)).To(Succeed())
+			Expect(w.String()).To(Equal(`// PbToOrder transforms pb.Order into model.Order with package-level transformer and converter options, fields of converter dependencies are set by their methods.
+func (c *Converter) PbToOrder(src pb.Order, opts ...TransformParam) model.Order {
+	dst := PbToOrder(src, c.params(opts)...)
+	dst.Price = c.CurrencyResolver.ToMinorUnits(src.Price)
+	dst.Total = c.CurrencyResolver.ToMinorUnits(src.Total)
+
+	return dst
+}
+
+// PbToOrderPtr transforms pb.Order pointer into model.Order pointer with converter options and dependencies.
+func (c *Converter) PbToOrderPtr(src *pb.Order, opts ...TransformParam) *model.Order {
+	if src == nil {
+		return nil
+	}
+
+	dst := c.PbToOrder(*src, opts...)
+	return &dst
+}`))
+		})
+
+		It("adds reverse transformers", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "converter", d.reverse())).To(Succeed())
+			Expect(w.String()).To(ContainSubstring(`func (c *Converter) OrderToPb(src model.Order, opts ...TransformParam) pb.Order {
+	dst := OrderToPb(src, c.params(opts)...)
+	dst.Price = c.CurrencyResolver.FromMinorUnits(src.Price)
+
+	return dst
+}`))
+			Expect(w.String()).To(ContainSubstring("func (c *Converter) OrderToPbPtr(src *model.Order, opts ...TransformParam) *pb.Order {"))
+		})
+	})
+})
@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+)
+
+// strictMode is true if generation fails on fields which are not mapped,
+// see SetStrict.
+var strictMode bool
+
+// modelFirst is true if model structures are the source of truth, see
+// SetModelFirst.
+var modelFirst bool
+
+// coverage holds mapping coverage of messages processed by ProcessFile in
+// order of processing, see CoverageReport.
+var coverage []messageCoverage
+
+// unmappedField is a proto field which is not transformed into model field,
+// reason is the comment added to generated file.
+type unmappedField struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// fieldReport holds fields of processed message which are not transformed
+// and problems of fields in order of fields: conflicting options and reasons
+// of unmapped fields.
+type fieldReport struct {
+	unmapped []unmappedField
+	issues   []fieldIssue
+}
+
+// fieldIssue is a problem desc of field name with index in message.
+type fieldIssue struct {
+	index    int
+	name     string
+	desc     string
+	unmapped bool
+}
+
+// unmappedIssues returns issues of fields which are not transformed.
+func (fr fieldReport) unmappedIssues() []fieldIssue {
+	out := []fieldIssue{}
+	for _, fi := range fr.issues {
+		if fi.unmapped {
+			out = append(out, fi)
+		}
+	}
+
+	return out
+}
+
+// messageCoverage describes how fields of proto message are mapped to fields
+// of model structure.
+type messageCoverage struct {
+	File    string `json:"file"`
+	Message string `json:"message"`
+	Model   string `json:"model"`
+	// Number of fields which are transformed.
+	Mapped int `json:"mapped"`
+	// Fields which are not transformed by accident, fields skipped
+	// explicitly with transformer.skip option or //transformer:skip directive
+	// are not listed.
+	UnmappedProtoFields []unmappedField `json:"unmapped_proto_fields"`
+	UnmappedModelFields []string        `json:"unmapped_model_fields"`
+}
+
+// SetStrict turns on strict mode: generation fails if proto fields are not
+// transformed into model fields, e.g. because of a type mismatch, or if
+// exported model fields are not covered by proto messages, the same way as
+// model-first parameter. Fields skipped explicitly are not reported.
+func SetStrict(on bool) {
+	strictMode = on
+}
+
+// SetModelFirst turns on model-first mode: model structures are the source of
+// truth and generation fails with the list of exported model fields which are
+// not covered by proto messages.
+func SetModelFirst(on bool) {
+	modelFirst = on
+}
+
+// CoverageReport returns JSON report with unmapped fields of every message
+// with go_struct option of processed files, e.g. for CI gating. Total number
+// of unmapped fields is reported in the "unmapped" field.
+func CoverageReport() (string, error) {
+	report := struct {
+		Unmapped int               `json:"unmapped"`
+		Messages []messageCoverage `json:"messages"`
+	}{Messages: []messageCoverage{}}
+
+	for _, mc := range coverage {
+		report.Unmapped += len(mc.UnmappedProtoFields) + len(mc.UnmappedModelFields)
+		report.Messages = append(report.Messages, mc)
+	}
+
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(b) + "\n", nil
+}
+
+// unmappedError returns an error of file name in strict mode if proto fields
+// of its messages are not transformed, lines are diagnostics of such fields.
+func unmappedError(name string, lines []string) error {
+	if !strictMode || len(lines) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s: proto fields are not transformed into model fields in strict mode; hint: fix the fields or skip them with (%s) = true\n\t%s",
+		name, options.E_Skip.Name, strings.Join(lines, "\n\t"))
+}
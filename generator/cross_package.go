@@ -0,0 +1,153 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// transformerPackage is Go package which transformers of .proto file are
+// generated into.
+type transformerPackage struct {
+	// Key identifies package: import path or output directory if import path
+	// is unknown.
+	key string
+	// Import path, empty if it's unknown, e.g. in source_relative mode.
+	importPath string
+	name       string
+}
+
+// newTransformerPackage returns package of transformers of .proto file name
+// with go_package import path ip and transformer.go_transformer_package option
+// value opt. Without option transformers are generated into package pkg of
+// output directory, pn is directory of use-package-in-path parameter, see
+// transformerDir.
+func newTransformerPackage(name, ip, opt, pkg, pn string) transformerPackage {
+	if opt != "" {
+		ip, n := opt, path.Base(opt)
+		if i := strings.Index(opt, ";"); i >= 0 {
+			ip, n = opt[:i], opt[i+1:]
+		}
+
+		return transformerPackage{key: ip, importPath: ip, name: n}
+	}
+
+	dir := transformerDir(name, ip, pn)
+	if sourceRelative {
+		return transformerPackage{key: dir, name: pkg}
+	}
+
+	return transformerPackage{key: dir, importPath: dir, name: pkg}
+}
+
+// crossPackageMessages returns messages with qualified names of transformers
+// of messages which fields of file messages msgs refer to and which are
+// generated into other Go packages, e.g. billingtransform.PbToAddress for
+// message of another directory. Import specs of such packages are returned
+// too. Transformers of file f are generated into package pkg, pn is directory
+// of use-package-in-path parameter.
+func crossPackageMessages(
+	f *descriptorpb.FileDescriptorProto,
+	msgs []fileMessage,
+	messages MessageOptionList,
+	pkg, pn string,
+) (MessageOptionList, []string, error) {
+
+	opt, _ := getStringOption(f.Options, options.E_GoTransformerPackage)
+	_, ip := goPackage(f.GetOptions())
+	current := newTransformerPackage(f.GetName(), ip, opt, pkg, pn)
+
+	var qualified MessageOptionList
+	specs := []string{}
+	aliases := map[string]string{}
+	done := map[string]bool{}
+
+	for _, typeName := range referredMessages(f, msgs) {
+		full := strings.TrimPrefix(typeName, ".")
+		mo, ok := messages[full].(messageOption)
+		if !ok || done[full] || mo.Omitted() || mo.fileName == "" || mo.fileName == f.GetName() {
+			continue
+		}
+
+		other := newTransformerPackage(mo.fileName, mo.goPackage, mo.transformerPackage, pkg, pn)
+		if other.key == current.key {
+			continue
+		}
+
+		if other.importPath == "" {
+			return nil, nil, fmt.Errorf("%s: transformers of message %s are generated into directory %s of file %s, import path of the package is unknown; hint: set (%s) option of file %s",
+				f.GetName(), full, other.key, mo.fileName, options.E_GoTransformerPackage.Name, mo.fileName)
+		}
+
+		alias := packageAlias(other, current.name)
+		if ip, ok := aliases[alias]; ok && ip != other.importPath {
+			return nil, nil, fmt.Errorf("%s: transformers of packages %s and %s are called with the same name %s; hint: set package names with (%s) option",
+				f.GetName(), ip, other.importPath, alias, options.E_GoTransformerPackage.Name)
+		}
+		aliases[alias] = other.importPath
+
+		if qualified == nil {
+			qualified = make(MessageOptionList, len(messages))
+			for k, v := range messages {
+				qualified[k] = v
+			}
+		}
+
+		p2g, g2p := transformerNames(mo)
+		mo.pbToGo, mo.goToPb = alias+"."+p2g, alias+"."+g2p
+		qualified[full] = mo
+		done[full] = true
+		specs = append(specs, alias+" "+strconv.Quote(other.importPath))
+	}
+
+	if qualified == nil {
+		return messages, nil, nil
+	}
+
+	return qualified, specs, nil
+}
+
+// referredMessages returns type names of messages which fields of file
+// messages msgs and methods of services of file f refer to.
+func referredMessages(f *descriptorpb.FileDescriptorProto, msgs []fileMessage) []string {
+	names := []string{}
+	for _, fm := range msgs {
+		for _, fdp := range fm.desc.GetField() {
+			if fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+				names = append(names, fdp.GetTypeName())
+			}
+		}
+	}
+
+	for _, sd := range f.GetService() {
+		for _, md := range sd.GetMethod() {
+			names = append(names, md.GetInputType(), md.GetOutputType())
+		}
+	}
+
+	return names
+}
+
+// packageAlias returns name which transformers of package p are called with
+// from package pkg. Packages with the same name as pkg are prefixed with the
+// parent directory of import path, e.g. billingtransform for
+// github.com/acme/billing/transform.
+func packageAlias(p transformerPackage, pkg string) string {
+	if p.name != pkg {
+		return p.name
+	}
+
+	prefix := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, path.Base(path.Dir(p.importPath)))
+
+	return prefix + p.name
+}
@@ -0,0 +1,97 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("CrossPackage", func() {
+
+	var (
+		f        *descriptorpb.FileDescriptorProto
+		msgs     []fileMessage
+		messages MessageOptionList
+	)
+
+	BeforeEach(func() {
+		f = &descriptorpb.FileDescriptorProto{Name: sp("order/order.proto"), Options: &descriptorpb.FileOptions{}}
+		msgs = []fileMessage{{name: "Order", desc: &descriptorpb.DescriptorProto{
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: sp("address"), Type: &typMessage, TypeName: sp(".billing.Address")},
+				{Name: sp("billing_address"), Type: &typMessage, TypeName: sp(".billing.Address")},
+				{Name: sp("item"), Type: &typMessage, TypeName: sp(".svc.Item")},
+			},
+		}}}
+		messages = MessageOptionList{
+			"billing.Address": messageOption{targetName: "billing.Address", fileName: "billing/address.proto"},
+			"svc.Item":        messageOption{targetName: "Item", fileName: "order/item.proto"},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(SetPaths("", "")).To(Succeed())
+	})
+
+	It("qualifies transformers of messages of other directories", func() {
+		mol, specs, err := crossPackageMessages(f, msgs, messages, "transform", "transform")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(specs).To(Equal([]string{`billingtransform "billing/transform"`}))
+
+		p2g, g2p := transformerNames(mol["billing.Address"])
+		Expect(p2g).To(Equal("billingtransform.PbToBillingAddress"))
+		Expect(g2p).To(Equal("billingtransform.BillingAddressToPb"))
+
+		p2g, _ = transformerNames(mol["svc.Item"])
+		Expect(p2g).To(Equal("PbToItem"))
+
+		p2g, _ = transformerNames(messages["billing.Address"])
+		Expect(p2g).To(Equal("PbToBillingAddress"))
+	})
+
+	It("uses go_package import paths of files in import mode", func() {
+		f.Options.GoPackage = sp("github.com/acme/order;order")
+		messages["billing.Address"] = messageOption{targetName: "billing.Address", fileName: "billing/address.proto", goPackage: "github.com/acme/billing"}
+		messages["svc.Item"] = messageOption{targetName: "Item", fileName: "order/item.proto", goPackage: "github.com/acme/order"}
+
+		mol, specs, err := crossPackageMessages(f, msgs, messages, "transform", "transform")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(specs).To(Equal([]string{`billingtransform "github.com/acme/billing/transform"`}))
+
+		p2g, _ := transformerNames(mol["billing.Address"])
+		Expect(p2g).To(Equal("billingtransform.PbToBillingAddress"))
+	})
+
+	It("uses package of go_transformer_package option", func() {
+		messages["billing.Address"] = messageOption{targetName: "billing.Address", fileName: "billing/address.proto", transformerPackage: "github.com/acme/billing/conv;billingconv"}
+
+		mol, specs, err := crossPackageMessages(f, msgs, messages, "transform", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(specs).To(Equal([]string{`billingconv "github.com/acme/billing/conv"`}))
+
+		p2g, _ := transformerNames(mol["billing.Address"])
+		Expect(p2g).To(Equal("billingconv.PbToBillingAddress"))
+	})
+
+	It("compares package of go_transformer_package option with packages of other files", func() {
+		proto.SetExtension(f.Options, options.E_GoTransformerPackage, "billing/transform")
+
+		mol, specs, err := crossPackageMessages(f, msgs, messages, "transform", "transform")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(specs).To(Equal([]string{`ordertransform "order/transform"`}))
+		Expect(mol["billing.Address"]).To(Equal(messages["billing.Address"]))
+
+		p2g, _ := transformerNames(mol["svc.Item"])
+		Expect(p2g).To(Equal("ordertransform.PbToItem"))
+	})
+
+	It("returns an error if import path of package is unknown in source_relative mode", func() {
+		Expect(SetPaths("source_relative", "")).To(Succeed())
+
+		_, _, err := crossPackageMessages(f, msgs, messages, "transform", "transform")
+		Expect(err).To(MatchError("order/order.proto: transformers of message billing.Address are generated into directory billing of file billing/address.proto, import path of the package is unknown; " +
+			"hint: set (transformer.go_transformer_package) option of file billing/address.proto"))
+	})
+})
@@ -0,0 +1,30 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// processCustomFuncField returns *Field for field fdp which is transformed by
+// functions of transformer.custom_pb_to_go and transformer.custom_go_to_pb
+// options. Functions are called verbatim, generator doesn't check types of
+// proto and model fields. Nil is returned if neither option is set.
+func processCustomFuncField(fdp *descriptorpb.FieldDescriptorProto, pname, gname string) (*Field, error) {
+	p2g, _ := getStringOption(fdp.Options, options.E_CustomPbToGo)
+	g2p, _ := getStringOption(fdp.Options, options.E_CustomGoToPb)
+
+	if p2g == "" && g2p == "" {
+		return nil, nil
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Wrapper: &Elem{
+			Kind:      elemCustom,
+			ProtoToGo: p2g,
+			GoToProto: g2p,
+			WithError: getBoolOption(fdp.Options, options.E_CustomWithError),
+		},
+	}, nil
+}
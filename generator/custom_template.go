@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// customTemplates contains templates of custom template directory, see
+// SetTemplateDir.
+var customTemplates *template.Template
+
+// SetTemplateDir adds templates of .tmpl files of directory dir into
+// "messages" template set, which transformers of messages are generated with.
+// Templates are executed with the same data and functions as built-in ones.
+// File content is a template named after the file, e.g. patch.tmpl, files can
+// also define templates with define actions. Templates with names of built-in
+// templates, e.g. "messages", "ptr2ptr" or "patch", replace them, other
+// templates extend the set. Template "custom" is executed after transformers
+// of every message, it's empty by default. Empty dir restores built-in
+// templates.
+func SetTemplateDir(dir string) error {
+	if dir == "" {
+		customTemplates = nil
+		return nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return pkgerrors.Wrap(err, "custom template dir")
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("custom template dir: %s has no .tmpl files", dir)
+	}
+
+	t := template.New("custom-template-dir").Funcs(funcMap)
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return pkgerrors.Wrap(err, "custom template dir")
+		}
+
+		if _, err := t.New(strings.TrimSuffix(filepath.Base(f), ".tmpl")).Parse(string(b)); err != nil {
+			return pkgerrors.Wrap(err, "custom template dir")
+		}
+	}
+
+	prev := customTemplates
+	customTemplates = t
+
+	// check that templates can be added into the set, so generation never
+	// fails on it later.
+	if _, err := templateWithHelpers("messages"); err != nil {
+		customTemplates = prev
+		return pkgerrors.Wrap(err, "custom template dir")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetTemplateDir", func() {
+
+	var dir string
+
+	d := Data{Src: "Product", SrcPref: "pb", SrcFn: "Pb", SrcPointer: "*", Dst: "Product", DstPref: "model", DstFn: "Product", NoReverse: true}
+
+	write := func(name, content string) {
+		Expect(ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)).To(Succeed())
+	}
+
+	render := func(d Data) string {
+		w := &bytes.Buffer{}
+		Expect(execMessageTemplate(w, d)).To(Succeed())
+		return w.String()
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "templates")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(SetTemplateDir("")).To(Succeed())
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("replaces built-in templates by templates named after files", func() {
+		builtin := render(d)
+		write("ptr2ptr.tmpl", `// {{ template "FuncName" . }}Ptr is replaced.`)
+		Expect(SetTemplateDir(dir)).To(Succeed())
+
+		out := render(d)
+		Expect(out).To(HavePrefix("// PbToProductPtr is replaced.\n"))
+		Expect(out).NotTo(Equal(builtin))
+	})
+
+	It("extends template set with custom template", func() {
+		write("logging.tmpl", `{{ define "custom" }}{{ if not .Swapped }}
+// {{ template "FuncName" . }} is generated with {{ template "logged" . }}.{{ end }}{{ end }}
+{{ define "logged" }}logging{{ end }}`)
+		Expect(SetTemplateDir(dir)).To(Succeed())
+
+		Expect(render(d)).To(HaveSuffix("\n// PbToProduct is generated with logging.\n\n"))
+	})
+
+	It("restores built-in templates for empty dir", func() {
+		builtin := render(d)
+		write("messages.tmpl", `replaced`)
+		Expect(SetTemplateDir(dir)).To(Succeed())
+		Expect(render(d)).To(Equal("replaced"))
+
+		Expect(SetTemplateDir("")).To(Succeed())
+		Expect(render(d)).To(Equal(builtin))
+	})
+
+	It("returns an error if directory has no templates", func() {
+		Expect(SetTemplateDir(dir)).To(MatchError("custom template dir: " + dir + " has no .tmpl files"))
+	})
+
+	It("returns an error if template can't be parsed", func() {
+		write("patch.tmpl", `{{ if .Patch }}`)
+		Expect(SetTemplateDir(dir)).To(MatchError(ContainSubstring("custom template dir: template: patch:1: unexpected EOF")))
+	})
+})
@@ -0,0 +1,43 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Custom functions", func() {
+
+	field := func() *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{Name: sp("price"), Type: &typInt64, Options: &descriptorpb.FieldOptions{}}
+	}
+
+	It("returns nil for fields without custom functions", func() {
+		Expect(processCustomFuncField(field(), "Price", "Price")).To(BeNil())
+	})
+
+	It("calls functions of options verbatim", func() {
+		fdp := field()
+		proto.SetExtension(fdp.Options, options.E_CustomPbToGo, "money.FromCents")
+		proto.SetExtension(fdp.Options, options.E_CustomGoToPb, "money.ToCents")
+		proto.SetExtension(fdp.Options, options.E_CustomWithError, true)
+
+		f, err := processCustomFuncField(fdp, "Price", "Price")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{Kind: elemCustom, ProtoToGo: "money.FromCents", GoToProto: "money.ToCents", WithError: true}))
+	})
+
+	It("takes precedence over matching by type", func() {
+		fdp := field()
+		proto.SetExtension(fdp.Options, options.E_CustomPbToGo, "money.FromCents")
+		s := source.Structure{"Price": source.FieldInfo{Type: "decimal.Decimal"}}
+
+		f, err := processField(nil, fdp, MessageOptionList{}, s, policies{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper.Kind).To(Equal(elemCustom))
+		Expect(f.ProtoToGoType).To(BeEmpty())
+	})
+})
@@ -0,0 +1,176 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"path/filepath"
+	"sort"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var (
+	// fallbackPackage is a name of package which transformers are generated
+	// into if requested package would create an import cycle. Empty name
+	// means requested package name with "transform" suffix, e.g.
+	// modeltransform.
+	fallbackPackage string
+
+	// cycleNote explains why transformers are generated into fallback
+	// package, it's added to generated files, see ResolvePackage. Empty if
+	// requested package is used.
+	cycleNote string
+)
+
+// SetFallbackPackage sets name of package which is used instead of package
+// parameter if generated transformers would be in the same package as models,
+// proto structures or helper functions they import.
+func SetFallbackPackage(name string) error {
+	if name != "" && !token.IsIdentifier(name) {
+		return fmt.Errorf("fallback package: %q is not a valid package name", name)
+	}
+
+	fallbackPackage = name
+
+	return nil
+}
+
+// ResolvePackage replaces package name pkg with fallback package, see
+// SetFallbackPackage, if transformers of any file of request req would be
+// generated into Go package which they import. It's called once before files
+// are processed, so transformers of all files and helper files are generated
+// into the same package. Packages are compared by import paths, so cycles are
+// found in import mode or for files with transformer.go_transformer_package
+// option only, since import path of output directory of source_relative mode
+// is unknown.
+func ResolvePackage(req *pluginpb.CodeGeneratorRequest, pkg *string, helperPackageName, helperPackagePath string, usePackageInPath bool) error {
+	cycleNote = ""
+
+	for _, f := range req.ProtoFile {
+		if !fileFilter.match(f.GetName()) || !hasModels(f.Options) {
+			continue
+		}
+
+		cycle, err := fileImportCycle(f, *pkg, helperPackageName, helperPackagePath, usePackageInPath)
+		if err != nil {
+			return err
+		}
+		if cycle == "" {
+			continue
+		}
+
+		if !usePackageInPath {
+			return fmt.Errorf("%s: transformers of package %s can not be generated: %s; hint: set use-package-in-path=true, so transformers are generated into fallback package directory, or set (%s) option",
+				f.GetName(), *pkg, cycle, options.E_GoTransformerPackage.Name)
+		}
+
+		fallback := cycleFallback(*pkg)
+		cycleNote = fmt.Sprintf("Transformers are generated into package %s instead of %s to avoid import cycle: %s.", fallback, *pkg, cycle)
+		*pkg = fallback
+
+		return nil
+	}
+
+	return nil
+}
+
+// hasModels returns true if file options fo point source of models, i.e.
+// transformers of the file are generated.
+func hasModels(fo *descriptorpb.FileOptions) bool {
+	for _, opt := range modelsOptions {
+		if hasOption(fo, opt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fileImportCycle returns description of import cycle which appears if
+// transformers of file f are generated into package pkg, see importCycle.
+// Files with transformer.go_transformer_package option are not generated into
+// pkg and files without known import path of transformers are not checked.
+func fileImportCycle(f *descriptorpb.FileDescriptorProto, pkg, helperPackageName, helperPackagePath string, usePackageInPath bool) (string, error) {
+	if opt, _ := getStringOption(f.Options, options.E_GoTransformerPackage); opt != "" {
+		return "", nil
+	}
+
+	pn := ""
+	if usePackageInPath {
+		pn = pkg
+	}
+
+	_, pbPath := goPackage(f.GetOptions())
+	tp := newTransformerPackage(f.GetName(), pbPath, "", pkg, pn)
+	if tp.importPath == "" {
+		return "", nil
+	}
+
+	hp, err := helperPackages(f.Options, helperPackageName, helperPackagePath)
+	if err != nil {
+		return "", err
+	}
+
+	return importCycle(tp.importPath, modelsImportPath(f.Options), pbPath, hp), nil
+}
+
+// modelsImportPath returns import path of Go package of models of file options
+// fo, empty string means that import path is unknown.
+func modelsImportPath(fo *descriptorpb.FileOptions) string {
+	if dir, err := getStringOption(fo, options.E_GoModelsDir); err == nil && dir != "" {
+		_, ip, err := source.PackageOf(filepath.Clean(dir), ".")
+		if err != nil {
+			return ""
+		}
+		return ip
+	}
+
+	paths, _ := modelsPaths(fo)
+	_, ip := modelsPackage(fo, paths)
+
+	return ip
+}
+
+// importCycle returns description of import cycle which appears if
+// transformers are generated into package of import path ip, empty string
+// means there is no cycle. Transformers import model package of import path
+// modelPath, proto package of import path protoPath and helper packages hp, so
+// ip can not be one of them. Empty import paths are unknown and are not
+// compared.
+func importCycle(ip, modelPath, protoPath string, hp map[string]helperPackage) string {
+	switch ip {
+	case "":
+		return ""
+	case modelPath:
+		return fmt.Sprintf("transformers import models of package %s", modelPath)
+	case protoPath:
+		return fmt.Sprintf("transformers import proto structures of package %s", protoPath)
+	}
+
+	families := []string{}
+	for family, p := range hp {
+		if p.path == ip {
+			families = append(families, family)
+		}
+	}
+
+	if len(families) > 0 {
+		sort.Strings(families)
+		return fmt.Sprintf("transformers import %s helper functions of package %s", families[0], hp[families[0]].path)
+	}
+
+	return ""
+}
+
+// cycleFallback returns name of package which transformers are generated into
+// instead of package pkg, which would create an import cycle.
+func cycleFallback(pkg string) string {
+	if fallbackPackage != "" {
+		return fallbackPackage
+	}
+
+	return pkg + "transform"
+}
@@ -0,0 +1,118 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var _ = Describe("Cycle", func() {
+
+	hp := map[string]helperPackage{
+		helperTime:   {name: "timeconv", path: "github.com/org/timeconv"},
+		helperScalar: {name: "helpers", path: "github.com/org/helpers"},
+	}
+
+	DescribeTable("importCycle",
+		func(ip, expected string) {
+			Expect(importCycle(ip, "github.com/org/model", "github.com/org/pb", hp)).To(Equal(expected))
+		},
+
+		Entry("no cycle", "github.com/org/transform", ""),
+		Entry("unknown import path", "", ""),
+		Entry("package named like model package", "github.com/org/api/model", ""),
+		Entry("model package", "github.com/org/model", "transformers import models of package github.com/org/model"),
+		Entry("proto package", "github.com/org/pb", "transformers import proto structures of package github.com/org/pb"),
+		Entry("helper package", "github.com/org/timeconv", "transformers import time helper functions of package github.com/org/timeconv"),
+	)
+
+	Describe("ResolvePackage", func() {
+
+		// file returns .proto file with models of testdata package and
+		// go_package option gp.
+		file := func(name, gp string) *descriptorpb.FileDescriptorProto {
+			f := &descriptorpb.FileDescriptorProto{Name: sp(name), Package: sp("api"), Options: &descriptorpb.FileOptions{GoPackage: sp(gp)}}
+			proto.SetExtension(f.Options, options.E_GoModelsFilePath, "testdata/model.go")
+			return f
+		}
+
+		AfterEach(func() {
+			cycleNote = ""
+		})
+
+		It("generates all files into fallback package if any of them creates an import cycle", func() {
+			req := &pluginpb.CodeGeneratorRequest{ProtoFile: []*descriptorpb.FileDescriptorProto{
+				file("api/user.proto", "github.com/ZacxDev/protoc-gen-struct-transformer/api;api"),
+				file("generator/product.proto", "github.com/ZacxDev/protoc-gen-struct-transformer/generator;pb"),
+			}}
+			pkg := "testdata"
+
+			Expect(ResolvePackage(req, &pkg, "", "", true)).To(Succeed())
+			Expect(pkg).To(Equal("testdatatransform"))
+			Expect(cycleNote).To(Equal("Transformers are generated into package testdatatransform instead of testdata to avoid import cycle: transformers import models of package github.com/ZacxDev/protoc-gen-struct-transformer/generator/testdata."))
+
+			for _, f := range req.ProtoFile {
+				path, _, err := ProcessFile(f, &pkg, sp(""), sp(""), MessageOptionList{}, false, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(path).To(HaveSuffix("/testdatatransform/" + strings.TrimSuffix(filepath.Base(f.GetName()), ".proto") + "_transformer.go"))
+			}
+		})
+
+		It("keeps package named like imported packages of other import paths", func() {
+			req := &pluginpb.CodeGeneratorRequest{ProtoFile: []*descriptorpb.FileDescriptorProto{
+				file("api/user.proto", "github.com/acme/api/model;model"),
+			}}
+			pkg := "model"
+
+			Expect(ResolvePackage(req, &pkg, "", "", true)).To(Succeed())
+			Expect(pkg).To(Equal("model"))
+			Expect(cycleNote).To(BeEmpty())
+		})
+
+		It("finds cycles with proto package of the same import path", func() {
+			req := &pluginpb.CodeGeneratorRequest{ProtoFile: []*descriptorpb.FileDescriptorProto{
+				file("api/user.proto", "github.com/acme/api;api"),
+			}}
+			pkg := "transform"
+
+			Expect(ResolvePackage(req, &pkg, "", "", false)).To(MatchError(ContainSubstring("api/user.proto: transformers of package transform can not be generated: transformers import proto structures of package github.com/acme/api; hint: set use-package-in-path=true")))
+		})
+
+		It("skips files without models", func() {
+			req := &pluginpb.CodeGeneratorRequest{ProtoFile: []*descriptorpb.FileDescriptorProto{
+				{Name: sp("api/user.proto"), Options: &descriptorpb.FileOptions{GoPackage: sp("github.com/acme/api;api")}},
+			}}
+			pkg := "transform"
+
+			Expect(ResolvePackage(req, &pkg, "", "", false)).To(Succeed())
+			Expect(pkg).To(Equal("transform"))
+		})
+	})
+
+	Describe("cycleFallback", func() {
+
+		AfterEach(func() {
+			Expect(SetFallbackPackage("")).To(Succeed())
+		})
+
+		It("adds suffix to package name by default", func() {
+			Expect(cycleFallback("model")).To(Equal("modeltransform"))
+		})
+
+		It("returns package set by SetFallbackPackage", func() {
+			Expect(SetFallbackPackage("converters")).To(Succeed())
+			Expect(cycleFallback("model")).To(Equal("converters"))
+		})
+
+		It("does not accept invalid package names", func() {
+			Expect(SetFallbackPackage("model-transform")).To(MatchError(`fallback package: "model-transform" is not a valid package name`))
+		})
+	})
+})
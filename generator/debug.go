@@ -0,0 +1,198 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// debugFile is true if debug information is written into sidecar files, see
+// SetDebugFile.
+var debugFile bool
+
+// debugReports holds debug information of processed files by output paths of
+// generated files, see DebugFile.
+var debugReports = map[string]debugReport{}
+
+// elemKindNames are names of element-wise transformations which are done by
+// generated code without functions.
+var elemKindNames = map[elemKind]string{
+	elemWrapper:  "wrapper",
+	elemValue:    "assign",
+	elemFunc:     "func",
+	elemList:     "list",
+	elemPairs:    "pairs",
+	elemEnum:     "enum",
+	elemTime:     "time",
+	elemDuration: "duration",
+	elemMessage:  "message",
+	elemOptional: "optional",
+	elemCustom:   "custom",
+	elemStruct:   "struct",
+	elemBytes:    "bytes",
+	elemUUID:     "uuid",
+	elemDecimal:  "decimal",
+	elemNull:     "null",
+}
+
+// debugReport describes how transformers of .proto file are generated.
+type debugReport struct {
+	File         string         `json:"file"`
+	Package      string         `json:"package"`
+	ProtoPackage string         `json:"proto_package"`
+	ModelPackage string         `json:"model_package"`
+	Messages     []debugMessage `json:"messages"`
+}
+
+// debugMessage describes transformers of proto message.
+type debugMessage struct {
+	Message string            `json:"message"`
+	Model   string            `json:"model"`
+	PbToGo  string            `json:"pb_to_go"`
+	GoToPb  string            `json:"go_to_pb,omitempty"`
+	Options map[string]string `json:"options,omitempty"`
+	Fields  []debugField      `json:"fields"`
+	// Fields which are not transformed, see messageCoverage.
+	Unmapped []unmappedField `json:"unmapped,omitempty"`
+}
+
+// debugField describes transformation of proto field into model field, e.g.
+// functions or kinds of element-wise transformations in both directions.
+type debugField struct {
+	Proto     string            `json:"proto"`
+	Model     string            `json:"model"`
+	ProtoType string            `json:"proto_type"`
+	PbToGo    string            `json:"pb_to_go"`
+	GoToPb    string            `json:"go_to_pb"`
+	Options   map[string]string `json:"options,omitempty"`
+}
+
+// SetDebugFile turns on debug files: resolved options, matched fields and
+// functions which transform them are written as JSON next to each generated
+// file, e.g. product_transformer.debug.json, so generated code is kept clean.
+func SetDebugFile(on bool) {
+	debugFile = on
+}
+
+// DebugFile returns path and content of debug file of generated file path,
+// empty strings are returned if debug files are turned off or file has no
+// debug information.
+func DebugFile(path string) (string, string, error) {
+	dr, ok := debugReports[path]
+	if !ok {
+		return "", "", nil
+	}
+
+	b, err := json.MarshalIndent(dr, "", "  ")
+	if err != nil {
+		return "", "", err
+	}
+
+	return strings.TrimSuffix(path, ".go") + ".debug.json", string(b) + "\n", nil
+}
+
+// newDebugMessage returns debug information of message m with full name,
+// which is transformed into model by functions p2g and g2p, g2p is empty if
+// reverse functions are not generated.
+func newDebugMessage(m *descriptorpb.DescriptorProto, full, model, p2g, g2p string, fields []Field, unmapped []unmappedField) debugMessage {
+	dm := debugMessage{
+		Message:  full,
+		Model:    model,
+		PbToGo:   p2g,
+		GoToPb:   g2p,
+		Options:  optionValues(m.GetOptions()),
+		Fields:   []debugField{},
+		Unmapped: unmapped,
+	}
+
+	byName := map[string]*descriptorpb.FieldDescriptorProto{}
+	for _, fdp := range m.GetField() {
+		byName[fdp.GetName()] = fdp
+	}
+
+	for _, f := range fields {
+		df := debugField{Proto: f.ProtoOrigName, Model: f.Name}
+		df.PbToGo, df.GoToPb = fieldConverters(f)
+		if fdp, ok := byName[f.ProtoOrigName]; ok {
+			df.ProtoType = protoTypeName(fdp)
+			df.Options = optionValues(fdp.GetOptions())
+		}
+		dm.Fields = append(dm.Fields, df)
+	}
+
+	return dm
+}
+
+// protoTypeName returns type of field fdp as it's declared in .proto file,
+// e.g. int64 or svc.Address, repeated fields are prefixed with repeated.
+func protoTypeName(fdp *descriptorpb.FieldDescriptorProto) string {
+	name := strings.ToLower(strings.TrimPrefix(fdp.GetType().String(), "TYPE_"))
+	if tn := fdp.GetTypeName(); tn != "" {
+		name = strings.TrimPrefix(tn, ".")
+	}
+
+	if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return "repeated " + name
+	}
+
+	return name
+}
+
+// fieldConverters returns functions or kinds of transformations of field f
+// in both directions, e.g. StringToNullsString and NullsStringToString or
+// assign if field is assigned as is.
+func fieldConverters(f Field) (string, string) {
+	switch {
+	case f.Dep != nil:
+		return f.Dep.Iface + "." + f.Dep.Method, f.Dep.Iface + "." + f.Dep.ReverseMethod
+	case f.Enum != nil && len(f.Enum.Mapping) > 0:
+		return f.Enum.mappingFunc(false), f.Enum.mappingFunc(true)
+	case f.Enum != nil:
+		return "enum", "enum"
+	case len(f.EmbeddedFields) > 0:
+		return "embedded", "embedded"
+	case f.Elem != nil:
+		return elemConverters(*f.Elem)
+	case f.Wrapper != nil:
+		return elemConverters(*f.Wrapper)
+	case f.ProtoToGoType == "" && f.GoToProtoType == "":
+		return "assign", "assign"
+	}
+
+	return f.ProtoToGoType, f.GoToProtoType
+}
+
+// elemConverters returns functions of element-wise transformation e or its
+// kind if elements are transformed by generated code.
+func elemConverters(e Elem) (string, string) {
+	if e.ProtoToGo != "" || e.GoToProto != "" {
+		return e.ProtoToGo, e.GoToProto
+	}
+
+	return elemKindNames[e.Kind], elemKindNames[e.Kind]
+}
+
+// optionValues returns values of extensions of options m by extension names,
+// e.g. "transformer.go_struct": "Product", nil if no extensions are set.
+func optionValues(m proto.Message) map[string]string {
+	if m == nil || reflect.ValueOf(m).IsNil() {
+		return nil
+	}
+
+	values := map[string]string{}
+	proto.RangeExtensions(m, func(xt protoreflect.ExtensionType, v interface{}) bool {
+		values[optionName(xt)] = fmt.Sprint(v)
+		return true
+	})
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return values
+}
@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Debug", func() {
+
+	Describe("fieldConverters", func() {
+
+		It("returns functions of field", func() {
+			p2g, g2p := fieldConverters(Field{ProtoToGoType: "helpers.StringToNullsString", GoToProtoType: "helpers.NullsStringToString"})
+			Expect(p2g).To(Equal("helpers.StringToNullsString"))
+			Expect(g2p).To(Equal("helpers.NullsStringToString"))
+		})
+
+		It("returns assign for fields without functions", func() {
+			p2g, g2p := fieldConverters(Field{})
+			Expect(p2g).To(Equal("assign"))
+			Expect(g2p).To(Equal("assign"))
+		})
+
+		It("returns kind of element-wise transformation", func() {
+			p2g, g2p := fieldConverters(Field{ProtoToGoType: "int", Elem: &Elem{Kind: elemTime}})
+			Expect(p2g).To(Equal("time"))
+			Expect(g2p).To(Equal("time"))
+
+			p2g, g2p = fieldConverters(Field{Elem: &Elem{Kind: elemMessage, ProtoToGo: "PbToAddressPtrVal", GoToProto: "AddressToPbValPtr"}})
+			Expect(p2g).To(Equal("PbToAddressPtrVal"))
+			Expect(g2p).To(Equal("AddressToPbValPtr"))
+		})
+
+		It("returns methods of converter dependency", func() {
+			p2g, g2p := fieldConverters(Field{Dep: &Dep{Iface: "PriceConverter", Method: "PriceToModel", ReverseMethod: "PriceToPb"}})
+			Expect(p2g).To(Equal("PriceConverter.PriceToModel"))
+			Expect(g2p).To(Equal("PriceConverter.PriceToPb"))
+		})
+
+		It("returns mapping functions of enums", func() {
+			p2g, g2p := fieldConverters(Field{Enum: &Enum{Func: "OrderStatus", Mapping: []EnumValue{{}}}})
+			Expect(p2g).To(Equal("PbToOrderStatusEnum"))
+			Expect(g2p).To(Equal("OrderStatusEnumToPb"))
+		})
+	})
+
+	Describe("optionValues", func() {
+
+		It("returns values of extensions by names", func() {
+			fo := &descriptorpb.FieldOptions{}
+			dir := options.Direction_GO_TO_PB
+			proto.SetExtension(fo, options.E_MapTo, "Total")
+			proto.SetExtension(fo, options.E_SkipDirection, dir)
+
+			Expect(optionValues(fo)).To(Equal(map[string]string{
+				"transformer.map_to":         "Total",
+				"transformer.skip_direction": "GO_TO_PB",
+			}))
+		})
+
+		It("returns nil without extensions", func() {
+			Expect(optionValues(&descriptorpb.FieldOptions{})).To(BeNil())
+			Expect(optionValues((*descriptorpb.FieldOptions)(nil))).To(BeNil())
+		})
+	})
+
+	Describe("protoTypeName", func() {
+
+		It("returns type of field as it's declared", func() {
+			Expect(protoTypeName(&descriptorpb.FieldDescriptorProto{Type: &typInt64})).To(Equal("int64"))
+			Expect(protoTypeName(&descriptorpb.FieldDescriptorProto{Type: &typMessage, TypeName: sp(".svc.Address"), Label: &typRepeated})).To(Equal("repeated svc.Address"))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// googleTypeMoney is a FQTN of google.type.Money message.
+const googleTypeMoney = ".google.type.Money"
+
+// processDecimalField returns *Field for string, int64 or google.type.Money
+// field fdp with transformer.decimal option, which is transformed into model
+// field gf of type decimal.Decimal. Currency code of google.type.Money field
+// is transformed into model field of transformer.currency_field option, which
+// is looked up in goStructFields.
+func processDecimalField(fdp *descriptorpb.FieldDescriptorProto, pname, gname string, goStructFields source.Structure, gf source.FieldInfo) (*Field, error) {
+	pt := ""
+	switch {
+	case fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED:
+	case fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		pt = "string"
+	case fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_INT64:
+		pt = "int64"
+	case fdp.GetTypeName() == googleTypeMoney:
+		pt = "money"
+	}
+
+	if pt == "" {
+		return nil, newLoggableError("field %s: option (%s) is supported for singular string, int64 and google.type.Money fields only",
+			gname, options.E_Decimal.Name).
+			withHint("remove the option or transform the field with (transformer.custom_pb_to_go) and (transformer.custom_go_to_pb) functions")
+	}
+
+	if gf.IsPointer || gf.IsSlice || gf.Key != "" || !strings.Contains(gf.Type, ".") {
+		return nil, newLoggableError("field %s: option (%s) requires model field of type decimal.Decimal, got %s",
+			gname, options.E_Decimal.Name, gf.GoType()).
+			withHint("change type of model field or remove the option")
+	}
+
+	scale, rounding := extractDecimalOptions(fdp.Options)
+	e := &Elem{
+		Kind:           elemDecimal,
+		ProtoType:      pt,
+		GoType:         gf.Type,
+		ProtoIsPointer: pt == "money" && extractNullOption(fdp),
+		Scale:          scale,
+		Rounding:       rounding,
+	}
+
+	if cf, _ := getStringOption(fdp.Options, options.E_CurrencyField); cf != "" && pt == "money" {
+		fi, ok := goStructFields[cf]
+		if !ok || fi.IsPointer || fi.IsSlice || fi.Key != "" {
+			return nil, newLoggableError("field %s: model field %s of option (%s) is not found or is not a string",
+				gname, cf, options.E_CurrencyField.Name).
+				withHint("add string model field %s or fix the option", cf)
+		}
+		e.Currency, e.CurrencyType = cf, fi.Type
+	}
+
+	return &Field{Name: gname, ProtoName: pname, Wrapper: e}, nil
+}
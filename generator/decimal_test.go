@@ -0,0 +1,92 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Decimal fields", func() {
+
+	field := func(typ *descriptorpb.FieldDescriptorProto_Type, opts map[protoreflect.ExtensionType]interface{}) *descriptorpb.FieldDescriptorProto {
+		fdp := &descriptorpb.FieldDescriptorProto{Name: sp("price"), Type: typ, Options: &descriptorpb.FieldOptions{}}
+		proto.SetExtension(fdp.Options, options.E_Decimal, true)
+		for ext, v := range opts {
+			proto.SetExtension(fdp.Options, ext, v)
+		}
+		return fdp
+	}
+
+	money := func(opts map[protoreflect.ExtensionType]interface{}) *descriptorpb.FieldDescriptorProto {
+		fdp := field(&typMessage, opts)
+		fdp.TypeName = sp(googleTypeMoney)
+		return fdp
+	}
+
+	dec := source.FieldInfo{Type: "decimal.Decimal"}
+
+	It("transforms string fields", func() {
+		f, err := processDecimalField(field(&typString, nil), "Price", "Price", nil, dec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f).To(Equal(&Field{Name: "Price", ProtoName: "Price", Wrapper: &Elem{
+			Kind: elemDecimal, ProtoType: "string", GoType: "decimal.Decimal", Rounding: "Round",
+		}}))
+	})
+
+	It("transforms int64 fields with scale and rounding", func() {
+		even := options.Rounding_HALF_EVEN
+		scale := int32(2)
+		fdp := field(&typInt64, map[protoreflect.ExtensionType]interface{}{
+			options.E_DecimalScale:    scale,
+			options.E_DecimalRounding: even,
+		})
+
+		f, err := processDecimalField(fdp, "Price", "Price", nil, dec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{Kind: elemDecimal, ProtoType: "int64", GoType: "decimal.Decimal", Scale: 2, Rounding: "RoundBank"}))
+	})
+
+	It("transforms currency code of google.type.Money fields", func() {
+		fdp := money(map[protoreflect.ExtensionType]interface{}{options.E_CurrencyField: "Currency"})
+		s := source.Structure{"Currency": {Type: "billing.Currency"}}
+
+		f, err := processDecimalField(fdp, "Total", "Total", s, dec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{
+			Kind: elemDecimal, ProtoType: "money", GoType: "decimal.Decimal", ProtoIsPointer: true, Rounding: "Round",
+			Currency: "Currency", CurrencyType: "billing.Currency",
+		}))
+	})
+
+	It("returns error with hint if currency field is not found", func() {
+		fdp := money(map[protoreflect.ExtensionType]interface{}{options.E_CurrencyField: "Currency"})
+
+		_, err := processDecimalField(fdp, "Total", "Total", source.Structure{}, dec)
+		Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		Expect(err.Error()).To(Equal("field Total: model field Currency of option (transformer.currency_field) is not found or is not a string; hint: add string model field Currency or fix the option"))
+	})
+
+	It("returns error for unsupported proto types", func() {
+		_, err := processDecimalField(field(&typMessage, nil), "Price", "Price", nil, dec)
+		Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		Expect(err.Error()).To(HavePrefix("field Price: option (transformer.decimal) is supported for singular string, int64 and google.type.Money fields only"))
+	})
+
+	It("returns error for unsupported model types", func() {
+		_, err := processDecimalField(field(&typString, nil), "Price", "Price", nil, source.FieldInfo{Type: "float64"})
+		Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		Expect(err.Error()).To(HavePrefix("field Price: option (transformer.decimal) requires model field of type decimal.Decimal, got float64"))
+	})
+
+	It("takes precedence over matching by type", func() {
+		s := source.Structure{"Price": {Type: "decimal.Decimal"}}
+
+		f, err := processField(nil, field(&typString, nil), MessageOptionList{}, s, policies{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper.Kind).To(Equal(elemDecimal))
+	})
+})
@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// withDirectives returns shallow copy of message msg where fields pointed by
+// //transformer:from directives of model structure s get transformer.map_to
+// option with name of model field. Fields with their own map_to option are not
+// changed, proto options take precedence over model directives. Directives
+// which point unknown proto fields are reported into w.
+func withDirectives(w io.Writer, msg *descriptorpb.DescriptorProto, s source.Structure) (*descriptorpb.DescriptorProto, error) {
+	from := map[string]string{}
+
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		pf := s[name].From
+		if pf == "" {
+			continue
+		}
+
+		if prev, ok := from[pf]; ok {
+			return nil, fmt.Errorf("model fields %s and %s are both transformed from field %s with //transformer:from directive", prev, name, pf)
+		}
+		from[pf] = name
+	}
+
+	if len(from) == 0 {
+		return msg, nil
+	}
+
+	// Messages of google.golang.org/protobuf can't be copied by value, so
+	// fields of message are copied one by one.
+	out := &descriptorpb.DescriptorProto{
+		Name:           msg.Name,
+		Field:          make([]*descriptorpb.FieldDescriptorProto, len(msg.Field)),
+		Extension:      msg.Extension,
+		NestedType:     msg.NestedType,
+		EnumType:       msg.EnumType,
+		ExtensionRange: msg.ExtensionRange,
+		OneofDecl:      msg.OneofDecl,
+		Options:        msg.Options,
+		ReservedRange:  msg.ReservedRange,
+		ReservedName:   msg.ReservedName,
+	}
+
+	for i, fdp := range msg.Field {
+		out.Field[i] = fdp
+
+		gname, ok := from[fdp.GetName()]
+		if !ok {
+			continue
+		}
+		delete(from, fdp.GetName())
+
+		if hasOption(fdp.Options, options.E_MapTo) {
+			p(w, "// conflict: field %s: option (%s) takes precedence over //transformer:from directive of model field %s\n",
+				fdp.GetName(), options.E_MapTo.Name, gname)
+			continue
+		}
+
+		f := proto.Clone(fdp).(*descriptorpb.FieldDescriptorProto)
+		if f.Options == nil {
+			f.Options = &descriptorpb.FieldOptions{}
+		}
+		proto.SetExtension(f.Options, options.E_MapTo, gname)
+		out.Field[i] = f
+	}
+
+	for _, name := range names {
+		if pf := s[name].From; pf != "" {
+			if _, ok := from[pf]; ok {
+				p(w, "// model field %s: field %s of //transformer:from directive not found in message %s\n", name, pf, msg.GetName())
+			}
+		}
+	}
+
+	return out, nil
+}
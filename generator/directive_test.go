@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Directive", func() {
+
+	Describe("withDirectives", func() {
+
+		var msg *descriptorpb.DescriptorProto
+
+		BeforeEach(func() {
+			msg = &descriptorpb.DescriptorProto{
+				Name: sp("Product"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("legacy_code"), Type: &typString},
+					{Name: sp("title"), Type: &typString, Options: &descriptorpb.FieldOptions{}},
+				},
+			}
+			proto.SetExtension(msg.Field[1].Options, options.E_MapTo, "Name")
+		})
+
+		It("sets map_to option of fields pointed by from directive", func() {
+			w := &bytes.Buffer{}
+			got, err := withDirectives(w, msg, source.Structure{
+				"Code":    {Type: "string", From: "legacy_code"},
+				"Caption": {Type: "string", From: "title"},
+				"Unknown": {Type: "string", From: "unknown"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getStringOption(got.Field[0].Options, options.E_MapTo)).To(Equal("Code"))
+			Expect(getStringOption(got.Field[1].Options, options.E_MapTo)).To(Equal("Name"))
+			Expect(msg.Field[0].Options).To(BeNil(), "original message is not changed")
+
+			Expect(w.String()).To(Equal(
+				"// conflict: field title: option (transformer.map_to) takes precedence over //transformer:from directive of model field Caption\n" +
+					"// model field Unknown: field unknown of //transformer:from directive not found in message Product\n"))
+		})
+
+		It("returns the same message without directives", func() {
+			got, err := withDirectives(nil, msg, source.Structure{"Code": {Type: "string"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(BeIdenticalTo(msg))
+		})
+
+		It("returns an error if model fields are transformed from the same field", func() {
+			_, err := withDirectives(nil, msg, source.Structure{
+				"Code":  {Type: "string", From: "legacy_code"},
+				"Code2": {Type: "string", From: "legacy_code"},
+			})
+			Expect(err).To(MatchError("model fields Code and Code2 are both transformed from field legacy_code with //transformer:from directive"))
+		})
+	})
+
+	It("skips fields of model fields with skip directive", func() {
+		fdp := &descriptorpb.FieldDescriptorProto{Name: sp("cache"), Type: &typString}
+
+		_, err := processField(nil, fdp, nil, source.Structure{"Cache": {Type: "string", Skip: true}}, policies{})
+		Expect(err).To(MatchError("field skipped: cache, model field Cache has //transformer:skip directive"))
+	})
+})
@@ -0,0 +1,18 @@
+package generator
+
+import "github.com/ZacxDev/protoc-gen-struct-transformer/source"
+
+// entEdgesField is a name of field of ent entities which holds their edges.
+const entEdgesField = "Edges"
+
+// entEntity returns true if model structure s is ent entity, i.e. edges are
+// added into s, see source.PromoteEntEdges.
+func entEntity(s source.Structure) bool {
+	for _, fi := range s {
+		if fi.Edge {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,173 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+)
+
+// EnumValue is a pair of proto enum value and model constant, see
+// transformer.enum_mapping.
+type EnumValue struct {
+	// Name of proto enum value, e.g. STATUS_ACTIVE.
+	Proto string
+	// Name of model constant, e.g. StatusActive or billing.StatusActive.
+	Model string
+}
+
+// parseEnumMapping parses value of transformer.enum_mapping option of field
+// gname, e.g. "STATUS_ACTIVE=StatusActive,STATUS_BLOCKED=StatusBlocked".
+func parseEnumMapping(gname, value string) ([]EnumValue, error) {
+	mapping := []EnumValue{}
+	seen := map[string]bool{}
+
+	for _, pair := range strings.Split(value, ",") {
+		kv := strings.Split(strings.TrimSpace(pair), "=")
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" || strings.TrimSpace(kv[1]) == "" {
+			return nil, newLoggableError("field %s: option (%s) has invalid pair %q", gname, options.E_EnumMapping.Name, pair).
+				withHint("use comma-separated pairs of enum value and model constant, e.g. STATUS_ACTIVE=StatusActive")
+		}
+
+		v := EnumValue{Proto: strings.TrimSpace(kv[0]), Model: strings.TrimSpace(kv[1])}
+		if seen[v.Proto] {
+			return nil, newLoggableError("field %s: option (%s) maps enum value %s twice", gname, options.E_EnumMapping.Name, v.Proto).
+				withHint("remove one of %s pairs", v.Proto)
+		}
+		seen[v.Proto] = true
+
+		mapping = append(mapping, v)
+	}
+
+	return mapping, nil
+}
+
+// processMappedEnumField returns *Field created out of enum field of type typ
+// with transformer.enum_mapping option. Model field may have any type which
+// constants of mapping belong to, repeated fields are transformed into
+// slices element-wise.
+func processMappedEnumField(pname, gname, typ string, gf source.FieldInfo, mapping []EnumValue, repeated bool) (*Field, error) {
+	if gf.IsPointer || gf.Key != "" || gf.IsSlice != repeated {
+		want := "value"
+		if repeated {
+			want = "slice"
+		}
+		return nil, newLoggableError("field %s: enum %s with option (%s) can be transformed into %s of model type only, got %s",
+			gname, strings.TrimPrefix(typ, "."), options.E_EnumMapping.Name, want, gf).
+			withHint("change type of model field %s or remove the option", gname)
+	}
+
+	e := &Enum{
+		ProtoType: enumGoType(typ),
+		GoType:    gf.Type,
+		Mapping:   mapping,
+	}
+
+	if !repeated {
+		return &Field{Name: gname, ProtoName: pname, Enum: e}, nil
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Elem: &Elem{
+			Kind:      elemEnum,
+			ProtoType: e.ProtoType,
+			GoType:    e.GoType,
+			Enum:      e,
+		},
+	}, nil
+}
+
+// enumMappingFuncs sets base names of mapping functions of enum fields with
+// transformer.enum_mapping option, names consist of model name fn and field
+// name, e.g. OrderStatus for PbToOrderStatusEnum and OrderStatusEnumToPb.
+func enumMappingFuncs(fields []Field, fn string) {
+	for _, f := range fields {
+		enumMappingFuncs(f.EmbeddedFields, fn)
+
+		e := f.Enum
+		if f.Elem != nil {
+			e = f.Elem.Enum
+		}
+
+		if e != nil && len(e.Mapping) > 0 {
+			e.Func = fn + f.Name
+		}
+	}
+}
+
+// qualify returns name n of model package pkg with package prefix, names of
+// other packages and basic types are returned as is.
+func qualify(n, pkg string) string {
+	if _, basic := basicTypes[n]; basic || strings.Contains(n, ".") || pkg == "" {
+		return n
+	}
+
+	return pkg + "." + n
+}
+
+// enumValuePrefix returns prefix of Go constants of enum values for Go type
+// t of proto enum: nested enums, e.g. Order_Status, use parent message name,
+// top level ones use enum name.
+func enumValuePrefix(t string) string {
+	if i := strings.LastIndex(t, "_"); i > 0 {
+		return t[:i]
+	}
+
+	return t
+}
+
+// formatEnumMappings returns mapping functions of enum fields with
+// transformer.enum_mapping option for transformation direction of d.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatEnumMappings(d Data) string {
+	funcs := []string{}
+
+	for _, f := range flatFields(d.Fields) {
+		e := f.Enum
+		if f.Elem != nil {
+			e = f.Elem.Enum
+		}
+
+		if e == nil || len(e.Mapping) == 0 {
+			continue
+		}
+
+		protoPkg, modelPkg := d.SrcPref, d.DstPref
+		if d.Swapped {
+			protoPkg, modelPkg = modelPkg, protoPkg
+		}
+
+		pt := qualify(e.ProtoType, protoPkg)
+		gt := qualify(e.GoType, modelPkg)
+		prefix := qualify(enumValuePrefix(e.ProtoType), protoPkg)
+
+		cases := []string{}
+		for _, v := range e.Mapping {
+			pv, gv := prefix+"_"+v.Proto, qualify(v.Model, modelPkg)
+			if d.Swapped {
+				pv, gv = gv, pv
+			}
+			cases = append(cases, fmt.Sprintf("\tcase %s:\n\t\treturn %s\n", pv, gv))
+		}
+
+		name, src, dst := e.mappingFunc(false), pt, gt
+		if d.Swapped {
+			name, src, dst = e.mappingFunc(true), gt, pt
+		}
+
+		funcs = append(funcs, fmt.Sprintf(`// %[1]s transforms %[2]s into %[3]s by (%[4]s) option of field %[5]s,
+// unmapped values become zero value.
+func %[1]s(v %[2]s) (d %[3]s) {
+	switch v {
+%[6]s	}
+
+	return d
+}`, name, src, dst, options.E_EnumMapping.Name, f.Name, strings.Join(cases, "")))
+	}
+
+	return strings.Join(funcs, "\n\n")
+}
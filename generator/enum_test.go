@@ -0,0 +1,118 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Enum mapping", func() {
+
+	Describe("parseEnumMapping", func() {
+
+		It("returns pairs of enum values and model constants", func() {
+			got, err := parseEnumMapping("State", "PAID=StatePaid, SHIPPED = billing.StateShipped")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(Equal([]EnumValue{
+				{Proto: "PAID", Model: "StatePaid"},
+				{Proto: "SHIPPED", Model: "billing.StateShipped"},
+			}))
+		})
+
+		DescribeTable("returns loggable error",
+			func(value, msg string) {
+				_, err := parseEnumMapping("State", value)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Pair without constant", "PAID=", `field State: option (transformer.enum_mapping) has invalid pair "PAID="; `+
+				"hint: use comma-separated pairs of enum value and model constant, e.g. STATUS_ACTIVE=StatusActive"),
+			Entry("Value mapped twice", "PAID=StatePaid,PAID=StateShipped", "field State: option (transformer.enum_mapping) maps enum value PAID twice; "+
+				"hint: remove one of PAID pairs"),
+		)
+	})
+
+	Describe("processMappedEnumField", func() {
+		mapping := []EnumValue{{Proto: "PAID", Model: "StatePaid"}}
+
+		It("returns Field with mapping", func() {
+			got, err := processMappedEnumField("State", "State", ".pkg.Order.Status", source.FieldInfo{Type: "State"}, mapping, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(Equal(&Field{Name: "State", ProtoName: "State", Enum: &Enum{
+				ProtoType: "Order_Status", GoType: "State", Mapping: mapping,
+			}}))
+		})
+
+		It("returns Field with element-wise mapping of repeated field", func() {
+			got, err := processMappedEnumField("States", "States", ".pkg.Order.Status", source.FieldInfo{Type: "State", IsSlice: true}, mapping, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Elem).To(Equal(&Elem{Kind: elemEnum, ProtoType: "Order_Status", GoType: "State", Enum: &Enum{
+				ProtoType: "Order_Status", GoType: "State", Mapping: mapping,
+			}}))
+		})
+
+		It("returns loggable error for pointer model field", func() {
+			_, err := processMappedEnumField("State", "State", ".pkg.Order.Status", source.FieldInfo{Type: "State", IsPointer: true}, mapping, false)
+			Expect(err).To(MatchError("field State: enum pkg.Order.Status with option (transformer.enum_mapping) can be transformed into value of model type only, got *State; " +
+				"hint: change type of model field State or remove the option"))
+		})
+	})
+
+	Describe("formatEnumMappings", func() {
+
+		fields := func() []Field {
+			fields := []Field{
+				{Name: "ID", ProtoName: "Id"},
+				{Name: "State", ProtoName: "State", Enum: &Enum{
+					ProtoType: "Order_Status", GoType: "State",
+					Mapping: []EnumValue{{Proto: "PAID", Model: "StatePaid"}, {Proto: "SHIPPED", Model: "StateShipped"}},
+				}},
+			}
+			enumMappingFuncs(fields, "Order")
+			return fields
+		}
+
+		It("names mapping functions by model and field", func() {
+			f := fields()[1]
+			Expect(f.Enum.Func).To(Equal("OrderState"))
+			Expect(f.Enum.convert("src.State", false, "model")).To(Equal("PbToOrderStateEnum(src.State)"))
+			Expect(f.Enum.convert("src.State", true, "pb")).To(Equal("OrderStateEnumToPb(src.State)"))
+		})
+
+		It("returns switch-based functions for both directions", func() {
+			d := Data{SrcPref: "pb", DstPref: "model", Fields: fields()}
+
+			Expect(formatEnumMappings(d)).To(Equal(`// PbToOrderStateEnum transforms pb.Order_Status into model.State by (transformer.enum_mapping) option of field State,
+// unmapped values become zero value.
+func PbToOrderStateEnum(v pb.Order_Status) (d model.State) {
+	switch v {
+	case pb.Order_PAID:
+		return model.StatePaid
+	case pb.Order_SHIPPED:
+		return model.StateShipped
+	}
+
+	return d
+}`))
+
+			Expect(formatEnumMappings(d.reverse())).To(Equal(`// OrderStateEnumToPb transforms model.State into pb.Order_Status by (transformer.enum_mapping) option of field State,
+// unmapped values become zero value.
+func OrderStateEnumToPb(v model.State) (d pb.Order_Status) {
+	switch v {
+	case model.StatePaid:
+		return pb.Order_PAID
+	case model.StateShipped:
+		return pb.Order_SHIPPED
+	}
+
+	return d
+}`))
+		})
+
+		It("returns empty string for messages without mapped enums", func() {
+			Expect(formatEnumMappings(Data{Fields: []Field{{Name: "ID"}}})).To(BeEmpty())
+		})
+	})
+})
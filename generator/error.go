@@ -16,8 +16,9 @@ var (
 	// }
 	ErrNilOptions = errors.New("options are nil")
 
-	// ErrFileSkipped is returned when .proto file has not go_models_file_path
-	// option.
+	// ErrFileSkipped is returned when .proto file has none of
+	// go_models_file_path, go_models_dir and go_models_import_path options or
+	// it's skipped by file filter, see SetFileFilter.
 	ErrFileSkipped = errors.New("files was skipped")
 )
 
@@ -38,6 +39,8 @@ func (e errOptionNotExists) Error() string {
 // comment if happened.
 type loggableError struct {
 	message string
+	// Suggested fix, such as option which allows to transform field.
+	hint string
 }
 
 // newLoggableError initializes error which will be added to output file.
@@ -45,7 +48,16 @@ func newLoggableError(format string, args ...interface{}) loggableError {
 	return loggableError{message: fmt.Sprintf(format, args...)}
 }
 
+// withHint returns a copy of error with suggested fix.
+func (e loggableError) withHint(format string, args ...interface{}) loggableError {
+	e.hint = fmt.Sprintf(format, args...)
+	return e
+}
+
 // Error is an error interface implementation.
 func (e loggableError) Error() string {
-	return e.message
+	if e.hint == "" {
+		return e.message
+	}
+	return e.message + "; hint: " + e.hint
 }
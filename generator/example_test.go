@@ -0,0 +1,100 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	// required by generated round-trip and fuzz tests of example.
+	_ "github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var _ = Describe("Example", func() {
+
+	// generate runs plugin with parameters param on example/message.proto,
+	// descriptors of which are read from testdata/example.protoset, see
+	// re-generate-example target of Makefile.
+	generate := func(root, param string) []*pluginpb.CodeGeneratorResponse_File {
+		data, err := ioutil.ReadFile("testdata/example.protoset")
+		Expect(err).NotTo(HaveOccurred())
+
+		set := &descriptorpb.FileDescriptorSet{}
+		Expect(proto.Unmarshal(data, set)).To(Succeed())
+
+		req, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+			FileToGenerate: []string{"example/message.proto"},
+			Parameter:      proto.String(param),
+			ProtoFile:      set.File,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Model files are parsed relative to working directory, so plugin
+		// is run from the root of module like protoc in Makefile.
+		stderr := &bytes.Buffer{}
+		cmd := exec.Command("go", "run", ".")
+		cmd.Dir, cmd.Stdin, cmd.Stderr = root, bytes.NewReader(req), stderr
+		out, err := cmd.Output()
+		Expect(err).NotTo(HaveOccurred(), stderr.String())
+
+		resp := &pluginpb.CodeGeneratorResponse{}
+		Expect(proto.Unmarshal(out, resp)).To(Succeed())
+		Expect(resp.GetError()).To(BeEmpty())
+
+		return resp.File
+	}
+
+	It("passes generated round-trip tests, fuzz tests and benchmarks", func() {
+		if testing.Short() {
+			Skip("generated tests are built with go test")
+		}
+
+		root, err := filepath.Abs("..")
+		Expect(err).NotTo(HaveOccurred())
+
+		files := generate(root, "package=transform,debug=false,helper-package=helpers,goimports=true,round-trip-tests=true,fuzz-tests=true,benchmarks=true")
+
+		// Generated files replace files of example in build of go test, so
+		// example isn't changed.
+		dir, err := ioutil.TempDir("", "example")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		overlay := map[string]map[string]string{"Replace": {}}
+		tests := 0
+		for _, f := range files {
+			if !strings.HasSuffix(f.GetName(), ".go") {
+				continue
+			}
+			if strings.HasSuffix(f.GetName(), "_test.go") {
+				tests++
+			}
+
+			path := filepath.Join(dir, f.GetName())
+			Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(path, []byte(f.GetContent()), 0644)).To(Succeed())
+			overlay["Replace"][filepath.Join(root, f.GetName())] = path
+		}
+		Expect(tests).NotTo(BeZero())
+
+		data, err := json.Marshal(overlay)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "overlay.json"), data, 0644)).To(Succeed())
+
+		// Each benchmark is run once, so failures of benchmarks are found
+		// without measurements.
+		cmd := exec.Command("go", "test", "-count", "1", "-bench", ".", "-benchtime", "1x", "-overlay", filepath.Join(dir, "overlay.json"), "./example/transform/")
+		cmd.Dir = root
+		out, err := cmd.CombinedOutput()
+		Expect(err).NotTo(HaveOccurred(), string(out))
+	})
+})
@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"github.com/iancoleman/strcase"
+)
+
+// externalStructures adds structures of model packages other than package of
+// models files paths into structs. Such structures are pointed by go_struct
+// options with package name, e.g. "billing.Address", of messages msgs, they
+// are added with the same names. Packages are resolved by imports of models
+// files and loaded with go/packages. Import specs of the packages are returned.
+// Structures which are in structs already, e.g. of target messages, are
+// skipped.
+func externalStructures(paths []string, msgs []fileMessage, structs source.StructureList) ([]string, error) {
+	names := map[string]struct{}{}
+	for _, fm := range msgs {
+		if sn, err := extractStructNameOption(fm.desc); err == nil && strings.Contains(sn, ".") && structs[sn] == nil {
+			names[sn[:strings.LastIndex(sn, ".")]] = struct{}{}
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	imports := map[string]string{}
+	for _, path := range paths {
+		im, err := source.Imports(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, ip := range im {
+			if _, ok := imports[name]; !ok {
+				imports[name] = ip
+			}
+		}
+	}
+
+	pkgs := make([]string, 0, len(names))
+	for name := range names {
+		pkgs = append(pkgs, name)
+	}
+	sort.Strings(pkgs)
+
+	specs := []string{}
+	for _, name := range pkgs {
+		ip, ok := imports[name]
+		if !ok {
+			return nil, fmt.Errorf("option (%s): package %s is not imported by models file %s; hint: use the package in models file or set (%s) to structure of models file",
+				options.E_GoStruct.Name, name, strings.Join(paths, ", "), options.E_GoStruct.Name)
+		}
+
+		sl, err := source.ParsePackage(filepath.Dir(paths[0]), ip)
+		if err != nil {
+			return nil, err
+		}
+
+		for sn, s := range sl {
+			structs[name+"."+sn] = s
+		}
+		specs = append(specs, fmt.Sprintf("%s %q", name, ip))
+	}
+
+	return specs, nil
+}
+
+// splitTarget returns package and structure name of go_struct option value
+// target, package is modelPackage if target has no package name.
+func splitTarget(target, modelPackage string) (string, string) {
+	if i := strings.LastIndex(target, "."); i >= 0 {
+		return target[:i], target[i+1:]
+	}
+
+	return modelPackage, target
+}
+
+// targetFuncName returns part of transformer function names for go_struct
+// option value target, e.g. BillingAddress for billing.Address of another
+// model package. Structures of models file are used as is.
+func targetFuncName(target string) string {
+	if !strings.Contains(target, ".") {
+		return target
+	}
+
+	return strcase.ToCamel(target)
+}
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("External", func() {
+
+	message := func(name, goStruct string) fileMessage {
+		desc := &descriptorpb.DescriptorProto{Name: sp(name), Options: &descriptorpb.MessageOptions{}}
+		proto.SetExtension(desc.Options, options.E_GoStruct, goStruct)
+		return fileMessage{name: name, desc: desc}
+	}
+
+	Describe("externalStructures", func() {
+
+		const modelsFile = "../example/model/model.go"
+
+		It("adds structures of other model packages", func() {
+			structs := source.StructureList{}
+
+			specs, err := externalStructures([]string{modelsFile}, []fileMessage{
+				message("PostalAddress", "billing.Address"),
+				message("Customer", "Customer"),
+			}, structs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(specs).To(Equal([]string{`billing "github.com/ZacxDev/protoc-gen-struct-transformer/example/billing"`}))
+			Expect(structs).To(HaveKey("billing.Address"))
+		})
+
+		It("does nothing if structures of models file are used", func() {
+			specs, err := externalStructures([]string{modelsFile}, []fileMessage{message("Customer", "Customer")}, source.StructureList{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(specs).To(BeEmpty())
+		})
+
+		It("returns an error if package is not imported by models file", func() {
+			_, err := externalStructures([]string{modelsFile}, []fileMessage{message("Money", "money.Amount")}, source.StructureList{})
+			Expect(err).To(MatchError("option (transformer.go_struct): package money is not imported by models file ../example/model/model.go; " +
+				"hint: use the package in models file or set (transformer.go_struct) to structure of models file"))
+		})
+	})
+
+	DescribeTable("splitTarget",
+		func(target, pkg, name string) {
+			p, n := splitTarget(target, "model")
+			Expect(p).To(Equal(pkg))
+			Expect(n).To(Equal(name))
+		},
+
+		Entry("Structure of models file", "Address", "model", "Address"),
+		Entry("Structure of another package", "billing.Address", "billing", "Address"),
+	)
+
+	DescribeTable("targetFuncName",
+		func(target, expected string) {
+			Expect(targetFuncName(target)).To(Equal(expected))
+		},
+
+		Entry("Structure of models file", "Address", "Address"),
+		Entry("Structure of another package", "billing.Address", "BillingAddress"),
+	)
+})
@@ -4,13 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
+	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
 	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"github.com/iancoleman/strcase"
 	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // lastName splits string by "." and returns last part.
@@ -20,14 +23,73 @@ func lastName(s string) string {
 }
 
 // wktgoogleProtobufTimestamp returns *Field created out of
-// google.protobuf.Timestamp protobuf field.
-func wktgoogleProtobufTimestamp(pname, gname string, gf source.FieldInfo, pnullable bool) *Field {
+// google.protobuf.Timestamp protobuf field. If stdtime is false, proto field
+// is a google.protobuf wrapper structure, see transformer.timestamps_as.
+func wktgoogleProtobufTimestamp(pname, gname string, gf source.FieldInfo, pnullable, stdtime bool) *Field {
+	if !stdtime {
+		return wktgoogleProtobufTime(pname, gname, "Timestamp", "", gf, pnullable)
+	}
+	return wktgoogleProtobufTime(pname, gname, "Time", "time.Time", gf, pnullable)
+}
+
+// wktgoogleProtobufDuration returns *Field created out of
+// google.protobuf.Duration protobuf field. If stdtime is false, proto field is
+// a google.protobuf wrapper structure, see transformer.timestamps_as.
+func wktgoogleProtobufDuration(pname, gname string, gf source.FieldInfo, pnullable, stdtime bool) *Field {
+	if !stdtime {
+		return wktgoogleProtobufTime(pname, gname, "Duration", "", gf, pnullable)
+	}
+	return wktgoogleProtobufTime(pname, gname, "Duration", "time.Duration", gf, pnullable)
+}
+
+// stdTimeNames contains base names of helper functions for standard Go types
+// of model fields, which are used when proto field is not transformed into
+// standard Go type, e.g. TimestampPtrToTime.
+var stdTimeNames = map[string]string{
+	"time.Time":     "Time",
+	"time.Duration": "StdDuration",
+}
+
+// timeHelperName returns base name of helper function for model field type t.
+func timeHelperName(t string) string {
+	if n, ok := stdTimeNames[t]; ok {
+		return n
+	}
+
+	return strcase.ToCamel(strings.Replace(t, ".", "", -1))
+}
+
+// wktgoogleProtobufTime returns *Field for Timestamp or Duration field. Proto
+// field is expected to be of standard Go type std (gogoproto.stdtime or
+// gogoproto.stdduration), helper functions with base name p are used if Go
+// field has another type. Empty std means proto field is a structure and
+// helper functions are always used.
+func wktgoogleProtobufTime(pname, gname, p, std string, gf source.FieldInfo, pnullable bool) *Field {
+	// pointer and value of standard type are transformed into each other
+	// with zero value instead of nil.
+	if std != "" && gf.Type == std && gf.IsPointer != pnullable && !gf.IsSlice && gf.Key == "" {
+		return &Field{
+			Name:      gname,
+			ProtoName: pname,
+			Wrapper: &Elem{
+				Kind:           elemValue,
+				ProtoType:      std,
+				GoType:         std,
+				ProtoIsPointer: pnullable,
+				GoIsPointer:    gf.IsPointer,
+			},
+		}
+	}
+
 	p2g := ""
 	g2p := ""
+	helper := ""
 
-	if gf.Type != "time.Time" {
-		g := strcase.ToCamel(strings.Replace(gf.Type, ".", "", -1))
-		p := "Time"
+	if gf.Type != std {
+		g := timeHelperName(gf.Type)
+		if std != "" {
+			g = strcase.ToCamel(strings.Replace(gf.Type, ".", "", -1))
+		}
 
 		if pnullable {
 			p += "Ptr"
@@ -39,6 +101,7 @@ func wktgoogleProtobufTimestamp(pname, gname string, gf source.FieldInfo, pnulla
 
 		p2g = fmt.Sprintf("%sTo%s", p, g)
 		g2p = fmt.Sprintf("%sTo%s", g, p)
+		helper = helperTime
 	}
 
 	return &Field{
@@ -47,7 +110,99 @@ func wktgoogleProtobufTimestamp(pname, gname string, gf source.FieldInfo, pnulla
 		ProtoToGoType: p2g,
 		GoToProtoType: g2p,
 		UsePackage:    p2g != "",
+		Helper:        helper,
+	}
+}
+
+// wktStdTime returns *Field for google.protobuf.Timestamp structure which is
+// transformed into time.Time or *time.Time model field gf by generated code,
+// see transformer.use_std_time.
+func wktStdTime(pname, gname string, gf source.FieldInfo, pnullable bool) (*Field, error) {
+	if gf.Type != "time.Time" || gf.IsSlice || gf.Key != "" {
+		return nil, newLoggableError("field %s: option (%s) requires model field of type time.Time or *time.Time, got %s",
+			gname, options.E_UseStdTime.Name, gf).
+			withHint("change type of model field %s to time.Time or remove the option to use helper functions", gname)
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Wrapper: &Elem{
+			Kind:           elemTime,
+			ProtoType:      "Timestamp",
+			GoType:         "time.Time",
+			ProtoIsPointer: pnullable,
+			GoIsPointer:    gf.IsPointer,
+		},
+	}, nil
+}
+
+// durationUnits contains units of integer model fields of
+// google.protobuf.Duration fields by transformer.duration_as option.
+var durationUnits = map[options.DurationAs]string{
+	options.DurationAs_NANOSECONDS:  "time.Nanosecond",
+	options.DurationAs_MILLISECONDS: "time.Millisecond",
+}
+
+// wktDuration returns *Field for google.protobuf.Duration field which is
+// transformed into model field gf by generated code: Duration structure into
+// time.Duration or *time.Duration and any duration into integer number of
+// units of transformer.duration_as option as. If stdtime is true, proto field
+// is time.Duration. Nil is returned if field is transformed by helper
+// functions or assigned directly.
+func wktDuration(pname, gname string, gf source.FieldInfo, pnullable, stdtime bool, as options.DurationAs) (*Field, error) {
+	unit := durationUnits[as]
+	if unit == "" && (stdtime || gf.Type != "time.Duration" || gf.IsSlice || gf.Key != "") {
+		return nil, nil
+	}
+
+	if unit != "" && (!numericTypes[gf.Type] || strings.HasPrefix(gf.Type, "float") || gf.IsSlice || gf.Key != "") {
+		return nil, newLoggableError("field %s: option (%s) = %s requires model field of integer type, got %s",
+			gname, options.E_DurationAs.Name, as, gf).
+			withHint("change type of model field %s to int64 or remove the option", gname)
+	}
+
+	pt := "Duration"
+	if stdtime {
+		pt = "time.Duration"
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Wrapper: &Elem{
+			Kind:           elemDuration,
+			ProtoType:      pt,
+			GoType:         gf.Type,
+			ProtoIsPointer: pnullable,
+			GoIsPointer:    gf.IsPointer,
+			Unit:           unit,
+		},
+	}, nil
+}
+
+// checkModelTimestamp returns loggable error if time.Time model field gf of
+// Timestamp field fdp doesn't match transformer.model_timestamps policy pol.
+// Fields with transformer.model_pointer option are not checked.
+func checkModelTimestamp(fdp *descriptorpb.FieldDescriptorProto, gname string, gf source.FieldInfo, pol options.ModelPointer) error {
+	if pol == options.ModelPointer_DETECT_POINTER || gf.Type != "time.Time" || gf.IsSlice || gf.Key != "" ||
+		extractModelPointerOption(fdp.Options) != options.ModelPointer_DETECT_POINTER {
+		return nil
+	}
+
+	ptr := pol == options.ModelPointer_MODEL_POINTER
+	if gf.IsPointer == ptr {
+		return nil
 	}
+
+	want := "time.Time"
+	if ptr {
+		want = "*time.Time"
+	}
+
+	return newLoggableError("field %s: timestamp is transformed into %s by (%s) = %s policy, got %s",
+		gname, want, options.E_ModelTimestamps.Name, pol, gf).
+		withHint("change type of model field %s to %s or set (%s) option of field %s", gname, want, options.E_ModelPointer.Name, fdp.GetName())
 }
 
 // wktgoogleProtobufString returns *Field created out of
@@ -62,9 +217,540 @@ func wktgoogleProtobufString(pname, gname, ftype string) *Field {
 		ProtoToGoType: fmt.Sprintf("%sTo%s", p, g),
 		GoToProtoType: fmt.Sprintf("%sTo%s", g, p),
 		UsePackage:    true,
+		Helper:        helperWrappers,
 	}
 }
 
+// wktWrapper returns *Field created out of field of google.protobuf wrapper
+// type typ, which is transformed into pointer or value of wrapped Go type
+// according to transformer.wrappers_as policy pol.
+func wktWrapper(pname, gname, typ string, gf source.FieldInfo, pnullable bool, pol options.WrappersAs) (*Field, error) {
+	vt := wrappers[typ]
+	ptr := pol == options.WrappersAs_POINTER
+
+	star := ""
+	if ptr {
+		star = "*"
+	}
+
+	if gf.Type != vt || gf.IsSlice || gf.Key != "" {
+		return nil, newLoggableError("field %s: %s can be transformed into %s%s only, got %s", gname, typ[1:], star, vt, gf).
+			withHint("change type of model field %s to %s%s or skip the field with (transformer.skip) = true", gname, star, vt)
+	}
+
+	if gf.IsPointer != ptr {
+		other := options.WrappersAs_POINTER
+		if ptr {
+			other = options.WrappersAs_VALUE
+		}
+		return nil, newLoggableError("field %s: %s is transformed into %s%s by (%s) = %s policy, got %s",
+			gname, typ[1:], star, vt, options.E_WrappersAs.Name, pol, gf).
+			withHint("change type of model field %s to %s%s or set (%s) = %s", gname, star, vt, options.E_WrappersAs.Name, other)
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Wrapper: &Elem{
+			Kind:           elemWrapper,
+			ProtoType:      lastName(typ),
+			GoType:         vt,
+			ProtoIsPointer: pnullable,
+			GoIsPointer:    ptr,
+		},
+	}, nil
+}
+
+// wrappersPolicy returns policy which field of google.protobuf wrapper type
+// typ is transformed with. Without explicit policy pol wrapper is transformed
+// into model field gf directly if it has wrapped type: pointer fields get nil
+// handling, e.g. Int64Value <-> *int64, and value fields get zero values.
+// StringValue fields of other types, including string, keep using helper
+// functions like StringValueToString.
+func wrappersPolicy(typ string, gf source.FieldInfo, pol options.WrappersAs) options.WrappersAs {
+	if pol != options.WrappersAs_WRAPPERS_AS_HELPERS || gf.Type != wrappers[typ] || gf.IsSlice || gf.Key != "" {
+		return pol
+	}
+
+	switch {
+	case gf.IsPointer:
+		return options.WrappersAs_POINTER
+	case typ != ".google.protobuf.StringValue":
+		return options.WrappersAs_VALUE
+	}
+
+	return pol
+}
+
+// wktgoogleRpcStatus returns *Field created out of google.rpc.Status field.
+// Status is transformed into model field of type error with StatusToError and
+// ErrorToStatus functions, see StatusHelpers.
+func wktgoogleRpcStatus(pname, gname string, gf source.FieldInfo, pnullable bool) (*Field, error) {
+	if gf.Type != "error" || gf.IsPointer {
+		return nil, newLoggableError("field %s: google.rpc.Status can be transformed into error only, got %s", gname, gf).
+			withHint("change type of model field %s to error or skip the field with (transformer.skip) = true", gname)
+	}
+
+	if !pnullable {
+		return nil, newLoggableError("field %s: google.rpc.Status must be nullable", gname).
+			withHint("remove (gogoproto.nullable) = false option")
+	}
+
+	return &Field{
+		Name:          gname,
+		ProtoName:     pname,
+		ProtoToGoType: "StatusToError",
+		GoToProtoType: "ErrorToStatus",
+	}, nil
+}
+
+// wktgoogleProtobufValue returns *Field created out of google.protobuf.Value or
+// google.protobuf.ListValue field of type typ. Values are transformed into
+// interface{} and lists into []interface{} with functions from ValueHelpers.
+func wktgoogleProtobufValue(pname, gname, typ string, gf source.FieldInfo, pnullable bool) (*Field, error) {
+	list := typ == googleProtobufListValue
+
+	p2g, g2p, want := "ValueToInterface", "InterfaceToValue", "interface{}"
+	if list {
+		p2g, g2p, want = "ListValueToSlice", "SliceToListValue", "[]interface{}"
+	}
+
+	if (gf.Type != "interface{}" && gf.Type != "any") || gf.IsPointer || gf.IsSlice != list || gf.Key != "" {
+		return nil, newLoggableError("field %s: %s can be transformed into %s only, got %s", gname, typ[1:], want, gf.GoType()).
+			withHint("change type of model field %s to %s or skip the field with (transformer.skip) = true", gname, want)
+	}
+
+	if !pnullable {
+		return nil, newLoggableError("field %s: %s must be nullable", gname, typ[1:]).
+			withHint("remove (gogoproto.nullable) = false option")
+	}
+
+	return &Field{
+		Name:          gname,
+		ProtoName:     pname,
+		ProtoToGoType: p2g,
+		GoToProtoType: g2p,
+	}, nil
+}
+
+// jsonTypes contains model types of google.protobuf.Struct fields with
+// transformer.struct_as_json option. Resolved json.RawMessage is an alias of
+// jsontext.Value in recent Go versions.
+var jsonTypes = map[string]bool{"json.RawMessage": true, "jsontext.Value": true}
+
+// wktgoogleProtobufStruct returns *Field created out of google.protobuf.Struct
+// field. Structure is transformed into map[string]interface{} or, if asJSON is
+// true, into json.RawMessage with functions from ValueHelpers, see
+// transformer.struct_as_json.
+func wktgoogleProtobufStruct(pname, gname string, gf source.FieldInfo, pnullable, asJSON bool) (*Field, error) {
+	p2g, g2p, want := "StructToMap", "MapToStruct", "map[string]interface{}"
+	ok := gf.Key == "string" && (gf.Type == "interface{}" || gf.Type == "any") && !gf.IsPointer && !gf.IsSlice
+	if asJSON {
+		p2g, g2p, want = "StructToJSON", "JSONToStruct", "json.RawMessage"
+		ok = gf.Key == "" && !gf.IsPointer && (jsonTypes[gf.Type] && !gf.IsSlice || gf.IsSlice && (gf.Type == "byte" || gf.Type == "uint8"))
+	}
+
+	if !ok {
+		return nil, newLoggableError("field %s: google.protobuf.Struct can be transformed into %s only, got %s", gname, want, gf.GoType()).
+			withHint("change type of model field %s to %s or skip the field with (transformer.skip) = true", gname, want)
+	}
+
+	if !pnullable {
+		return nil, newLoggableError("field %s: google.protobuf.Struct must be nullable", gname).
+			withHint("remove (gogoproto.nullable) = false option")
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Wrapper: &Elem{
+			Kind:      elemStruct,
+			ProtoType: "Struct",
+			GoType:    want,
+			ProtoToGo: p2g,
+			GoToProto: g2p,
+			WithError: asJSON,
+		},
+	}, nil
+}
+
+// wktRepeated returns *Field created out of repeated field of google.protobuf
+// well-known type, such as repeated google.protobuf.StringValue or repeated
+// google.protobuf.Timestamp. Such fields are transformed element by element
+// into slice of values or pointers. Flag stdtime is described in wktElem.
+func wktRepeated(pname, gname, typ string, gf source.FieldInfo, pnullable, stdtime bool) (*Field, error) {
+	e, err := wktElem("[]", gname, typ, gf, pnullable, stdtime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Elem:      e,
+	}, nil
+}
+
+// processMapField returns *Field created out of map field with values of
+// google.protobuf well-known type, e.g. map<string, google.protobuf.Timestamp>.
+// Map key of Go structure field must have the same type as proto map key.
+// Flag stdtime is described in wktElem.
+func processMapField(pname, gname string, entry *descriptorpb.DescriptorProto, subMessages MessageOptionList, gf source.FieldInfo, pnullable, stdtime bool) (*Field, error) {
+	val, err := mapValueField(gname, entry, gf)
+	if err != nil {
+		return nil, err
+	}
+
+	if t, ok := types[val.GetType()]; ok {
+		return processScalarMapField(pname, gname, t, gf)
+	}
+
+	vt := val.GetTypeName()
+	if !isElemWKT(vt) {
+		mo, ok := subMessages[strings.TrimPrefix(vt, ".")]
+		if ok && !mo.Omitted() && mo.Descriptor() != nil && !mo.Descriptor().GetOptions().GetMapEntry() {
+			return processMessageMapField(pname, gname, vt, mo, gf, pnullable)
+		}
+
+		e := newLoggableError("field %s: map values of type %s are not supported", gname, strings.TrimPrefix(vt, "."))
+		if ok && isListWrapper(mo.Descriptor()) {
+			return nil, e.withHint("%s is a list wrapper, use (transformer.unwrap_list) = true to transform it into map[%s][]T", strings.TrimPrefix(vt, "."), gf.Key)
+		}
+		return nil, e.withHint("skip the field with (transformer.skip) = true and transform it manually")
+	}
+
+	e, err := wktElem(fmt.Sprintf("map[%s]", gf.Key), gname, vt, gf, pnullable, stdtime)
+	if err != nil {
+		return nil, err
+	}
+	e.MapKey = gf.Key
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Elem:      e,
+	}, nil
+}
+
+// processScalarMapField returns *Field created out of map field with scalar
+// values of type t, values are copied into model map of the same value type.
+func processScalarMapField(pname, gname string, t typeRel, gf source.FieldInfo) (*Field, error) {
+	vt := t.pbType
+	if vt == "" {
+		vt = t.goType
+	}
+
+	if gf.Type != vt || gf.IsPointer || gf.IsSlice {
+		return nil, newLoggableError("field %s: map values of type %s can not be transformed into %s", gname, vt, gf).
+			withHint("change value type of model field %s to %s", gname, vt)
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Elem: &Elem{
+			Kind:      elemValue,
+			ProtoType: vt,
+			GoType:    vt,
+			MapKey:    gf.Key,
+		},
+	}, nil
+}
+
+// processMessageMapField returns *Field created out of map field with values
+// of message type vt, which has go_struct option mo. Values are transformed
+// with transformers of the message, e.g. map[string]*pb.Address is
+// transformed into map[string]model.Address with PbToAddressPtrVal. Flag
+// pnullable is false if proto map values are not pointers
+// (gogoproto.nullable = false).
+func processMessageMapField(pname, gname, vt string, mo MessageOption, gf source.FieldInfo, pnullable bool) (*Field, error) {
+	if err := withErrorsMapValues(gname, mo); err != nil {
+		return nil, err
+	}
+
+	if gf.IsSlice || (lastName(gf.Type) != lastName(mo.Target()) && gf.Type != mo.Target()) {
+		return nil, newLoggableError("field %s: map values of type %s can be transformed into map[%s]%s or map[%s]*%s only, got %s",
+			gname, strings.TrimPrefix(vt, "."), gf.Key, mo.Target(), gf.Key, mo.Target(), gf.GoType()).
+			withHint("change type of model field %s to map[%s]%s", gname, gf.Key, mo.Target())
+	}
+
+	var p2g, g2p string
+
+	switch {
+	case pnullable && gf.IsPointer:
+		p2g, g2p = "Ptr", "Ptr"
+	case pnullable && !gf.IsPointer:
+		p2g, g2p = "PtrVal", "ValPtr"
+	case !pnullable && gf.IsPointer:
+		p2g, g2p = "ValPtr", "PtrVal"
+	}
+
+	pb2go, go2pb := transformerNames(mo)
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Elem: &Elem{
+			Kind:           elemMessage,
+			ProtoType:      mo.GoName(),
+			GoType:         gf.Type,
+			ProtoIsPointer: pnullable,
+			GoIsPointer:    gf.IsPointer,
+			ProtoToGo:      pb2go + p2g,
+			GoToProto:      go2pb + g2p,
+			MapKey:         gf.Key,
+		},
+	}, nil
+}
+
+// withErrorsMapValues returns loggable error if map values of message mo
+// would be transformed by transformers with transformer.with_errors or
+// transformer.with_context options. Map values are transformed in
+// expressions, which can't return error or get context.
+func withErrorsMapValues(gname string, mo MessageOption) error {
+	opt := options.E_WithErrors
+	switch mopts := mo.Descriptor().GetOptions(); {
+	case extractWithErrorsOption(mopts):
+	case extractWithContextOption(mopts):
+		opt = options.E_WithContext
+	default:
+		return nil
+	}
+
+	return newLoggableError("field %s: map values of message %s with option (%s) are not supported", gname, mo.Full(), opt.Name).
+		withHint("skip the field with (transformer.skip) = true and transform it manually")
+}
+
+// mapValueField checks that map entry key can be transformed into key of Go
+// map field gf and returns field descriptor of map value.
+func mapValueField(gname string, entry *descriptorpb.DescriptorProto, gf source.FieldInfo) (*descriptorpb.FieldDescriptorProto, error) {
+	if len(entry.Field) != 2 {
+		return nil, fmt.Errorf("field %s: map entry %s must have two fields", gname, entry.GetName())
+	}
+
+	if gf.Key == "" {
+		return nil, newLoggableError("field %s: map can be transformed into Go map only, got %s", gname, gf).
+			withHint("change type of model field %s to map", gname)
+	}
+
+	key, val := entry.Field[0], entry.Field[1]
+
+	t := types[key.GetType()]
+	kt := t.pbType
+	if kt == "" {
+		kt = t.goType
+	}
+
+	if kt != gf.Key {
+		return nil, newLoggableError("field %s: map key of type %s can not be transformed into %s", gname, key.GetType(), gf.Key).
+			withHint("change key type of model field %s to %s", gname, kt)
+	}
+
+	return val, nil
+}
+
+// isListWrapper returns true if message has single repeated field of message
+// type, such messages are used as a workaround for map<K, repeated V>.
+func isListWrapper(msg *descriptorpb.DescriptorProto) bool {
+	fields := msg.GetField()
+
+	return len(fields) == 1 &&
+		fields[0].GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED &&
+		fields[0].GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+}
+
+// processListMapField returns *Field created out of map field with values of
+// list wrapper message, i.e. message with a single repeated field of message
+// type, such as
+//
+//	message AddressList { repeated Address items = 1; }
+//	map<string, AddressList> addresses = 1 [(transformer.unwrap_list) = true];
+//
+// Such fields are transformed into Go maps of slices, e.g.
+// map[string][]model.Address, wrapper message has no model.
+func processListMapField(pname, gname string, entry *descriptorpb.DescriptorProto, subMessages MessageOptionList, gf source.FieldInfo, pnullable bool) (*Field, error) {
+	val, err := mapValueField(gname, entry, gf)
+	if err != nil {
+		return nil, err
+	}
+
+	vt := strings.TrimPrefix(val.GetTypeName(), ".")
+
+	wrapper, ok := subMessages[vt]
+	if !ok || wrapper.Descriptor() == nil {
+		return nil, newLoggableError("field %s: list wrapper message %s not found", gname, vt)
+	}
+
+	if !isListWrapper(wrapper.Descriptor()) {
+		return nil, newLoggableError("field %s: message %s is not a list wrapper, it must have one repeated field of message type", gname, vt).
+			withHint("remove (transformer.unwrap_list) option")
+	}
+
+	items := wrapper.Descriptor().GetField()[0]
+	it := strings.TrimPrefix(items.GetTypeName(), ".")
+
+	mo, ok := subMessages[it]
+	if !ok || mo.Omitted() {
+		return nil, newLoggableError("field %s: message %s has no option %s", gname, it, options.E_GoStruct.Name).
+			withHint("add option (%s) with model name to message %s", options.E_GoStruct.Name, it)
+	}
+
+	if err := withErrorsMapValues(gname, mo); err != nil {
+		return nil, err
+	}
+
+	if !gf.IsSlice || (lastName(gf.Type) != mo.Target() && gf.Type != mo.Target()) {
+		return nil, newLoggableError("field %s: map values of type %s can be transformed into map[%s][]%s or map[%s][]*%s only, got %s",
+			gname, vt, gf.Key, mo.Target(), gf.Key, mo.Target(), gf.GoType()).
+			withHint("change type of model field %s to map[%s][]%s", gname, gf.Key, mo.Target())
+	}
+
+	var p2g, g2p string
+
+	iptr := extractNullOption(items)
+	switch {
+	case iptr && gf.IsPointer:
+		p2g, g2p = "Ptr", "Ptr"
+	case !iptr && !gf.IsPointer:
+		p2g, g2p = "Val", "Val"
+	case iptr && !gf.IsPointer:
+		p2g, g2p = "PtrVal", "ValPtr"
+	default:
+		p2g, g2p = "ValPtr", "PtrVal"
+	}
+
+	elem := "[]" + gf.String()
+	pb2go, go2pb := transformerNames(mo)
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Elem: &Elem{
+			Kind:           elemList,
+			ProtoType:      wrapper.GoName(),
+			GoType:         elem,
+			ProtoIsPointer: pnullable,
+			ProtoToGo:      pb2go + p2g + "List",
+			GoToProto:      go2pb + g2p + "List",
+			MapKey:         gf.Key,
+			Items:          strcase.ToCamel(items.GetName()),
+		},
+	}, nil
+}
+
+// processOrderedMapField returns *Field created out of map field with
+// transformer.ordered_map option. Such fields are transformed into slice of
+// model pairs, e.g. []model.LabelPair, pair structure must have Key and Value
+// fields of the same types as proto map key and value. Only maps with scalar
+// values are supported.
+func processOrderedMapField(pname, gname string, entry *descriptorpb.DescriptorProto, gf source.FieldInfo) (*Field, error) {
+	if len(entry.Field) != 2 {
+		return nil, fmt.Errorf("field %s: map entry %s must have two fields", gname, entry.GetName())
+	}
+
+	if !gf.IsSlice || gf.Key != "" {
+		return nil, newLoggableError("field %s: map with option (%s) can be transformed into slice of pairs only, got %s",
+			gname, options.E_OrderedMap.Name, gf.GoType()).
+			withHint("change type of model field %s to slice of structures with Key and Value fields", gname)
+	}
+
+	key, val := entry.Field[0], entry.Field[1]
+
+	kt := types[key.GetType()]
+	if kt.goType == "" || key.GetType() == descriptorpb.FieldDescriptorProto_TYPE_BOOL {
+		return nil, newLoggableError("field %s: ordered map keys of type %s are not supported", gname, key.GetType()).
+			withHint("remove (%s) option", options.E_OrderedMap.Name)
+	}
+
+	vt, ok := types[val.GetType()]
+	if !ok {
+		return nil, newLoggableError("field %s: ordered map values of type %s are not supported", gname, val.GetType()).
+			withHint("remove (%s) option or skip the field with (transformer.skip) = true", options.E_OrderedMap.Name)
+	}
+
+	k, v := kt.pbType, vt.pbType
+	if k == "" {
+		k = kt.goType
+	}
+	if v == "" {
+		v = vt.goType
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Elem: &Elem{
+			Kind:        elemPairs,
+			ProtoType:   v,
+			GoType:      gf.Type,
+			GoIsPointer: gf.IsPointer,
+			MapKey:      k,
+		},
+	}, nil
+}
+
+// wktElem returns *Elem for elements of collection (slice or map) of
+// google.protobuf well-known type typ. Collection contains Go type prefix,
+// such as "[]" or "map[string]", and is used for error messages only. If
+// stdtime is false, Timestamp and Duration elements are google.protobuf
+// wrapper structures and always transformed with helper functions.
+func wktElem(collection, gname, typ string, gf source.FieldInfo, pnullable, stdtime bool) (*Elem, error) {
+	if vt, ok := wrappers[typ]; ok {
+		if gf.Type != vt {
+			return nil, newLoggableError("field %s: %s elements can be transformed into %s%s or %s*%s only, got %s%s",
+				gname, typ[1:], collection, vt, collection, vt, collection, gf).
+				withHint("change element type of model field %s to %s or skip the field with (transformer.skip) = true", gname, vt)
+		}
+
+		return &Elem{
+			Kind:           elemWrapper,
+			ProtoType:      lastName(typ),
+			GoType:         gf.Type,
+			ProtoIsPointer: pnullable,
+			GoIsPointer:    gf.IsPointer,
+		}, nil
+	}
+
+	if !stdtime {
+		p := lastName(typ)
+		g := timeHelperName(gf.Type)
+
+		return &Elem{
+			Kind:           elemFunc,
+			ProtoType:      p,
+			GoType:         gf.Type,
+			ProtoIsPointer: pnullable,
+			GoIsPointer:    gf.IsPointer,
+			ProtoToGo:      fmt.Sprintf("%sTo%s", p, g),
+			GoToProto:      fmt.Sprintf("%sTo%s", g, p),
+			UsePackage:     true,
+			Helper:         helperTime,
+		}, nil
+	}
+
+	std := stdTypes[typ]
+	e := &Elem{
+		Kind:           elemValue,
+		ProtoType:      std,
+		GoType:         gf.Type,
+		ProtoIsPointer: pnullable,
+		GoIsPointer:    gf.IsPointer,
+	}
+
+	if gf.Type != std {
+		p := strcase.ToCamel(lastName(std))
+		g := strcase.ToCamel(strings.Replace(gf.Type, ".", "", -1))
+
+		e.Kind = elemFunc
+		e.ProtoToGo = fmt.Sprintf("%sTo%s", p, g)
+		e.GoToProto = fmt.Sprintf("%sTo%s", g, p)
+		e.UsePackage = true
+		e.Helper = helperTime
+	}
+
+	return e, nil
+}
+
 // processSubMessage processes sub messages of current message. Sub message is
 // a message type which is used as field type.
 //
@@ -74,7 +760,7 @@ func wktgoogleProtobufString(pname, gname, ftype string) *Field {
 // message A {}
 // message B { A a_field = 1; }
 func processSubMessage(w io.Writer,
-	fdp *descriptor.FieldDescriptorProto,
+	fdp *descriptorpb.FieldDescriptorProto,
 	pname, gname, pbtype string,
 	mo MessageOption,
 	goStructFields source.Structure,
@@ -116,10 +802,16 @@ func processSubMessage(w io.Writer,
 		pbtype = fmt.Sprintf("Pb%s", strcase.ToCamel(ptype))
 	}
 
-	if l := fdp.Label; l != nil && *l == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+	if l := fdp.Label; l != nil && *l == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
 		tpl += "List"
 		if g, ok := goStructFields[gname]; ok {
-			pb = strcase.ToCamel(g.Type)
+			t := g.Type
+			// structures of other model packages are transformed by
+			// functions with package name, e.g. PbToBillingAddressList.
+			if mo == nil || t != mo.Target() {
+				t = lastName(t)
+			}
+			pb = strcase.ToCamel(t)
 		}
 	}
 
@@ -139,6 +831,17 @@ func processSubMessage(w io.Writer,
 	p2g = fmt.Sprintf(tpl, pbtype, pb)
 	g2p = fmt.Sprintf(tpl, pb, pbtype)
 
+	// messages of files with transformer.func_name_format_* options have
+	// custom transformer names.
+	if mo != nil && !customTransformer && mo.OneofDecl() == "" && !extractEmbedOption(fdp.Options) {
+		if n, r := mo.FuncNames(); n != "" {
+			p2g, g2p = n, r
+			if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+				p2g, g2p = p2g+"List", g2p+"List"
+			}
+		}
+	}
+
 	f := &Field{
 		Name:           strcase.ToCamel(fname),
 		ProtoName:      strcase.ToCamel(*fdp.Name),
@@ -157,6 +860,8 @@ func processSubMessage(w io.Writer,
 		if !customTransformer {
 			// OneofDecl is used for the BoldCommerce-specific implementation of OneOf for the migration from Int64ToString
 			f.OneofDecl = mo.OneofDecl()
+			f.WithError = f.OneofDecl == "" && extractWithErrorsOption(mo.Descriptor().GetOptions())
+			f.WithContext = f.OneofDecl == "" && extractWithContextOption(mo.Descriptor().GetOptions())
 		}
 	}
 
@@ -165,7 +870,7 @@ func processSubMessage(w io.Writer,
 
 // processSimpleField processes fields of basic types such as int, string and
 // so on.
-func processSimpleField(w io.Writer, pname, gname string, ftype *descriptor.FieldDescriptorProto_Type, sf source.FieldInfo) (*Field, error) {
+func processSimpleField(w io.Writer, pname, gname string, ftype *descriptorpb.FieldDescriptorProto_Type, sf source.FieldInfo) (*Field, error) {
 
 	sf.Type = strcase.ToCamel(strings.Replace(sf.Type, ".", "", -1)) // pkg.Type => PkgType
 	t := types[*ftype]
@@ -198,6 +903,7 @@ func processSimpleField(w io.Writer, pname, gname string, ftype *descriptor.Fiel
 		f.ProtoToGoType = fmt.Sprintf("%sTo%s", strcase.ToCamel(p), sf.Type)
 		f.GoToProtoType = fmt.Sprintf("%sTo%s", sf.Type, strcase.ToCamel(p))
 		f.UsePackage = true
+		f.Helper = helperScalar
 
 	case sft != tpb:
 		p(w, "// sft: %s, tpb: %s\n", sft, tpb)
@@ -216,9 +922,10 @@ func processSimpleField(w io.Writer, pname, gname string, ftype *descriptor.Fiel
 // processField returns filled Field struct for template.
 func processField(
 	w io.Writer,
-	fdp *descriptor.FieldDescriptorProto,
+	fdp *descriptorpb.FieldDescriptorProto,
 	subMessages MessageOptionList,
 	goStructFields source.Structure,
+	pol policies,
 ) (*Field, error) {
 	// If field has transformer.skip == true, it will be not processed.
 	if skip := extractSkipOption(fdp.Options); skip {
@@ -240,13 +947,38 @@ func processField(
 
 	// check if field exists in destination/Go structure.
 	gf, ok := goStructFields[gname]
+	if !ok && mapTo == "" && pol.modelStyle == options.ModelStyle_SQLC {
+		// sqlc keeps initialisms of column names, e.g. UserID for user_id.
+		if name := similarField(gname, goStructFields); name != "" {
+			gname = name
+			gf, ok = goStructFields[gname]
+		}
+	}
 	if !ok {
 		// do not check for embedded fields.
 		if isEmbed := extractEmbedOption(fdp.Options); !isEmbed {
-			return nil, pkgerrors.Wrap(errors.New("field not found in destination structure"), gname)
+			if mapTo != "" {
+				return nil, pkgerrors.Wrap(fmt.Errorf("model field of option (%s) of field %s not found in destination structure; hint: %s",
+					options.E_MapTo.Name, *fdp.Name, mapToHint(*fdp.Name, gname, goStructFields)), gname)
+			}
+			return nil, pkgerrors.Wrap(fmt.Errorf("field not found in destination structure; hint: %s", notFoundHint(gname, goStructFields)), gname)
 		}
 	}
 
+	if gf.Skip {
+		return nil, newLoggableError("field skipped: %s, model field %s has //transformer:skip directive", *fdp.Name, gname)
+	}
+
+	if gf.Promoted != "" && mapTo == "" && !pol.flattenEmbedded && !gormModelField(gf, pol) {
+		return nil, newLoggableError("field skipped: %s, model field %s is promoted from embedded structure %s", *fdp.Name, gname, gf.Promoted).
+			withHint("set (%s) = true option of the message or (%s) = %q option of the field", options.E_FlattenEmbedded.Name, options.E_MapTo.Name, gname)
+	}
+
+	if mp := extractModelPointerOption(fdp.Options); ok && mp != options.ModelPointer_DETECT_POINTER {
+		gf.IsPointer = mp == options.ModelPointer_MODEL_POINTER
+		goStructFields = withField(goStructFields, gname, gf)
+	}
+
 	p(w, "\n\n// ===============================\n")
 	if oi := fdp.OneofIndex; oi != nil {
 		p(w, "// fdp.OneofIndex: %#v\n\n", *oi)
@@ -260,15 +992,227 @@ func processField(
 	}
 	p(w, "// fdp.Name: %q, mapAs: %q, mapTo: %q\n", *fdp.Name, mapAs, mapTo)
 
+	f, err := processCustomFuncField(fdp, pname, gname)
+	if err == nil && f == nil {
+		f, err = processDepField(fdp, pname, gname)
+	}
+	if err == nil && f == nil {
+		f, err = processFieldType(w, fdp, pname, gname, subMessages, goStructFields, gf, pol)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := withHelperSignature(f, fdp); err != nil {
+		return nil, err
+	}
+
+	f.ProtoOrigName = *fdp.Name
+	f.ProtoJSONName = protoJSONName(fdp)
+	f.GoJSONName = goJSONName(f.Name, gf.Tag)
+	f.Signature = fieldSignature(f.ProtoOrigName, fdp, f.Name, gf)
+	f.Promoted = gf.Promoted != ""
+	if gf.Edge {
+		f.Name, f.Edge = entEdgesField+"."+f.Name, true
+	}
+
+	return f, nil
+}
+
+// withField returns copy of Go structure s where field name is replaced with
+// gf, s itself is not changed.
+func withField(s source.Structure, name string, gf source.FieldInfo) source.Structure {
+	c := make(source.Structure, len(s))
+	for k, v := range s {
+		c[k] = v
+	}
+	c[name] = gf
+
+	return c
+}
+
+// notFoundHint returns suggested fix for proto field which has no model field
+// gname in Go structure s: a model field with similar name, which can be
+// pointed by transformer.map_to option, or transformer.skip option.
+func notFoundHint(gname string, s source.Structure) string {
+	if _, ok := s["unsupported_map_type_"+gname]; ok {
+		return fmt.Sprintf("model field %s has unsupported map type, skip the field with (transformer.skip) = true", gname)
+	}
+
+	if name := similarField(gname, s); name != "" {
+		return fmt.Sprintf("use (transformer.map_to) = %q if proto field should be transformed into model field %s", name, name)
+	}
+
+	return fmt.Sprintf("add field %s to model, use (transformer.map_to) option if model field has another name or skip the field with (transformer.skip) = true", gname)
+}
+
+// mapToHint returns suggested fix for transformer.map_to option of proto
+// field pname which points model field gname missing in Go structure s.
+func mapToHint(pname, gname string, s source.Structure) string {
+	if _, ok := s["unsupported_map_type_"+gname]; ok {
+		return fmt.Sprintf("model field %s has unsupported map type, skip the field with (transformer.skip) = true", gname)
+	}
+
+	if name := similarField(gname, s); name != "" {
+		return fmt.Sprintf("use (transformer.map_to) = %q, model field names are case-sensitive", name)
+	}
+
+	return fmt.Sprintf("add field %s to model or point existing model field with (transformer.map_to) option of field %s", gname, pname)
+}
+
+// similarField returns name of field of Go structure s which differs from
+// gname by case and underscores only, empty string if there is no such field.
+func similarField(gname string, s source.Structure) string {
+	norm := func(n string) string {
+		return strings.ToLower(strings.Replace(n, "_", "", -1))
+	}
+
+	similar := []string{}
+	for name := range s {
+		if norm(name) == norm(gname) {
+			similar = append(similar, name)
+		}
+	}
+	sort.Strings(similar)
+
+	if len(similar) > 0 {
+		return similar[0]
+	}
+
+	return ""
+}
+
+// fieldSignature returns string which describes mapping between proto and Go
+// fields: names and types of both fields.
+func fieldSignature(pname string, fdp *descriptorpb.FieldDescriptorProto, gname string, gf source.FieldInfo) string {
+	gt := gf.GoType()
+
+	pt := fdp.GetType().String()
+	if tn := fdp.GetTypeName(); tn != "" {
+		pt = tn
+	}
+
+	return fmt.Sprintf("%s %s %s => %s %s", pname, fdp.GetLabel(), pt, gname, gt)
+}
+
+// protoJSONName returns JSON name of proto field. Usually protoc fills
+// json_name up, otherwise name is calculated the same way as protoc does:
+// underscores are removed and following letters are capitalized.
+func protoJSONName(fdp *descriptorpb.FieldDescriptorProto) string {
+	if jn := fdp.GetJsonName(); jn != "" {
+		return jn
+	}
+
+	var (
+		b     strings.Builder
+		upper bool
+	)
+
+	for _, r := range fdp.GetName() {
+		if r == '_' {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// goJSONName returns name which is used by encoding/json package for
+// structure field: name from json tag or field name itself if tag has no name.
+// For ignored fields (json:"-") it returns "-".
+func goJSONName(name, tag string) string {
+	jn := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+	if jn == "" {
+		return name
+	}
+
+	return jn
+}
+
+// processFieldType chooses appropriate processing function based on proto
+// field type and file-level policies pol.
+func processFieldType(
+	w io.Writer,
+	fdp *descriptorpb.FieldDescriptorProto,
+	pname, gname string,
+	subMessages MessageOptionList,
+	goStructFields source.Structure,
+	gf source.FieldInfo,
+	pol policies,
+) (*Field, error) {
+	stdtime := pol.timestamps == options.TimestampsAs_TIME
+
+	if getBoolOption(fdp.Options, options.E_Decimal) {
+		return processDecimalField(fdp, pname, gname, goStructFields, gf)
+	}
+
+	if f, err := processNullField(fdp, pname, gname, gf); f != nil || err != nil {
+		return f, err
+	}
+
 	// Process subMessages. For details see comments for the TypeName.
-	if typ := fdp.TypeName; *fdp.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE && typ != nil {
+	if typ := fdp.TypeName; *fdp.Type == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE && typ != nil {
 		t := *typ
+
+		if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+			if mo, ok := subMessages[t[1:]]; ok && mo.Descriptor().GetOptions().GetMapEntry() {
+				if extractOrderedMapOption(fdp.Options) {
+					return processOrderedMapField(pname, gname, mo.Descriptor(), gf)
+				}
+				if extractUnwrapListOption(fdp.Options) {
+					return processListMapField(pname, gname, mo.Descriptor(), subMessages, gf, extractNullOption(fdp))
+				}
+				return processMapField(pname, gname, mo.Descriptor(), subMessages, gf, extractNullOption(fdp), stdtime)
+			}
+
+			if isElemWKT(t) {
+				return wktRepeated(pname, gname, t, gf, extractNullOption(fdp), stdtime)
+			}
+		}
+
+		if _, ok := wrappers[t]; ok {
+			if wp := wrappersPolicy(t, gf, pol.wrappers); wp != options.WrappersAs_WRAPPERS_AS_HELPERS {
+				return wktWrapper(pname, gname, t, gf, extractNullOption(fdp), wp)
+			}
+		}
+
 		switch t {
 		case ".google.protobuf.Timestamp":
+			if err := checkModelTimestamp(fdp, gname, gf, pol.modelTimestamps); err != nil {
+				return nil, err
+			}
 			isNullable := extractNullOption(fdp)
-			return wktgoogleProtobufTimestamp(pname, gname, gf, isNullable), nil
+			if (extractUseStdTimeOption(fdp.Options) || sqlcTime(gf, pol)) && !extractStdTimeOption(fdp) {
+				return wktStdTime(pname, gname, gf, isNullable)
+			}
+			return wktgoogleProtobufTimestamp(pname, gname, gf, isNullable, stdtime), nil
+		case ".google.protobuf.Duration":
+			isNullable := extractNullOption(fdp)
+			if f, err := wktDuration(pname, gname, gf, isNullable, stdtime, extractDurationAsOption(fdp.Options)); f != nil || err != nil {
+				return f, err
+			}
+			return wktgoogleProtobufDuration(pname, gname, gf, isNullable, stdtime), nil
 		case ".google.protobuf.StringValue":
 			return wktgoogleProtobufString(pname, gname, gf.Type), nil
+		case googleRpcStatus:
+			return wktgoogleRpcStatus(pname, gname, gf, extractNullOption(fdp))
+		case googleProtobufValue, googleProtobufListValue:
+			if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+				break
+			}
+			return wktgoogleProtobufValue(pname, gname, t, gf, extractNullOption(fdp))
+		case googleProtobufStruct:
+			if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+				break
+			}
+			return wktgoogleProtobufStruct(pname, gname, gf, extractNullOption(fdp), extractStructAsJSONOption(fdp.Options))
 		}
 
 		// if the field has the custom=true - the custom transformer will be used for this field
@@ -280,9 +1224,298 @@ func processField(
 		return processSubMessage(w, fdp, pname, gname, t, mo, goStructFields, customTransformer)
 	}
 
+	if proto3Optional(fdp) && fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_BYTES {
+		t, ok := types[fdp.GetType()]
+		if !ok {
+			return nil, newLoggableError("field %s: optional fields of type %s are not supported", gname,
+				strings.ToLower(strings.TrimPrefix(fdp.GetType().String(), "TYPE_"))).
+				withHint("skip the field with (transformer.skip) = true and transform it manually")
+		}
+		return processOptionalField(pname, gname, t, gf)
+	}
+
+	if typ, custom := extractGoTypeOption(fdp); typ != "" {
+		if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+			return nil, newLoggableError("field %s: repeated fields with gogoproto.customtype or gogoproto.casttype are not supported", gname).
+				withHint("skip the field with (transformer.skip) = true and transform it manually")
+		}
+		return processGoTypeField(pname, gname, typ, custom, gf, custom && extractNullOption(fdp)), nil
+	}
+
+	if fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_ENUM {
+		mapping, err := extractEnumMappingOption(fdp.Options, gname)
+		if err != nil {
+			return nil, err
+		}
+		if mapping == nil {
+			mapping = targetEnumMapping(fdp.GetTypeName(), gf)
+		}
+		if mapping != nil {
+			return processMappedEnumField(pname, gname, fdp.GetTypeName(), gf, mapping,
+				fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED)
+		}
+
+		if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+			return processRepeatedEnumField(pname, gname, fdp.GetTypeName(), gf, pol.enums)
+		}
+		return processEnumField(pname, gname, fdp.GetTypeName(), gf, pol.enums)
+	}
+
+	if f, err := processUUIDField(fdp, pname, gname, gf); f != nil || err != nil {
+		return f, err
+	}
+
+	if fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_BYTES && fdp.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return processBytesField(fdp, pname, gname, gf)
+	}
+
 	return processSimpleField(w, pname, gname, fdp.Type, gf)
 }
 
+// basicTypes contains Go types which can be converted into each other by
+// type conversion, e.g. int64(v).
+var basicTypes = map[string]struct{}{
+	"int": {}, "int8": {}, "int16": {}, "int32": {}, "int64": {},
+	"uint": {}, "uint8": {}, "uint16": {}, "uint32": {}, "uint64": {},
+	"float32": {}, "float64": {}, "string": {}, "bool": {},
+}
+
+// processGoTypeField processes fields with gogoproto.customtype or
+// gogoproto.casttype options, i.e. fields where proto struct field has type
+// typ instead of type of proto scalar. Cast types are converted into basic Go
+// types directly, in other cases helper functions are used.
+func processGoTypeField(pname, gname, typ string, custom bool, gf source.FieldInfo, pnullable bool) *Field {
+	f := &Field{
+		Name:           gname,
+		ProtoName:      pname,
+		ProtoIsPointer: pnullable,
+		GoIsPointer:    gf.IsPointer,
+	}
+
+	_, basic := basicTypes[gf.Type]
+	// types of model package are declared without package name.
+	same := gf.Type == typ || gf.Type == lastName(typ)
+
+	switch {
+	case same && pnullable == gf.IsPointer: // equal types
+
+	case !custom && basic && !gf.IsPointer:
+		f.ProtoToGoType = gf.Type
+		f.GoToProtoType = typ
+
+	default:
+		p := strcase.ToCamel(lastName(typ))
+		g := strcase.ToCamel(strings.Replace(gf.Type, ".", "", -1))
+
+		f.ProtoToGoType = fmt.Sprintf("%sTo%s", p, g)
+		f.GoToProtoType = fmt.Sprintf("%sTo%s", g, p)
+		f.UsePackage = true
+		f.Helper = helperCustom
+	}
+
+	return f
+}
+
+// integerTypes contains Go integer types which proto enums can be converted
+// into.
+var integerTypes = map[string]struct{}{
+	"int": {}, "int8": {}, "int16": {}, "int32": {}, "int64": {},
+	"uint": {}, "uint8": {}, "uint16": {}, "uint32": {}, "uint64": {},
+}
+
+// enumGoType returns name of Go type generated for proto enum typ without
+// package name, e.g. Order_Status for .pkg.Order.Status. Proto packages are
+// expected to be lower case, as style guide suggests, so the type name starts
+// with the first capitalized part.
+func enumGoType(typ string) string {
+	parts := strings.Split(strings.TrimPrefix(typ, "."), ".")
+
+	start := len(parts) - 1
+	for i, part := range parts {
+		if part != "" && unicode.IsUpper([]rune(part)[0]) {
+			start = i
+			break
+		}
+	}
+
+	names := make([]string, 0, len(parts)-start)
+	for _, part := range parts[start:] {
+		names = append(names, strcase.ToCamel(part))
+	}
+
+	return strings.Join(names, "_")
+}
+
+// processEnumField returns *Field created out of enum field of type typ. Enum
+// is transformed into value name or number according to transformer.enums_as
+// policy pol. By default, the representation is chosen by model field type:
+// string fields get names, integer fields get numbers.
+func processEnumField(pname, gname, typ string, gf source.FieldInfo, pol options.EnumsAs) (*Field, error) {
+	name := strings.TrimPrefix(typ, ".")
+
+	if gf.IsPointer || gf.IsSlice || gf.Key != "" {
+		return nil, newLoggableError("field %s: enum %s can be transformed into value of string or integer type only, got %s", gname, name, gf).
+			withHint("change type of model field %s to string or integer type", gname)
+	}
+
+	_, integer := integerTypes[gf.Type]
+	// types of model package, e.g. type Status string, are converted like
+	// their underlying types, which are known with explicit policy only.
+	_, basic := basicTypes[gf.Type]
+
+	asString := false
+	switch {
+	case pol == options.EnumsAs_STRING && (gf.Type == "string" || !basic):
+		asString = true
+	case pol == options.EnumsAs_NUMBER && (integer || !basic):
+	case pol == options.EnumsAs_ENUMS_AS_MODEL_TYPE && gf.Type == "string":
+		asString = true
+	case pol == options.EnumsAs_ENUMS_AS_MODEL_TYPE && integer:
+	case pol == options.EnumsAs_ENUMS_AS_MODEL_TYPE:
+		return nil, newLoggableError("field %s: enum %s can be transformed into string or integer types only, got %s", gname, name, gf).
+			withHint("set (%s) = STRING or (%s) = NUMBER if model field %s has such underlying type", options.E_EnumsAs.Name, options.E_EnumsAs.Name, gname)
+	default:
+		return nil, newLoggableError("field %s: enum %s can not be transformed into %s by (%s) = %s policy", gname, name, gf, options.E_EnumsAs.Name, pol).
+			withHint("change type of model field %s or skip the field with (transformer.skip) = true", gname)
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Enum: &Enum{
+			ProtoType: enumGoType(typ),
+			GoType:    gf.Type,
+			AsString:  asString,
+		},
+	}, nil
+}
+
+// processRepeatedEnumField returns *Field created out of repeated enum field
+// of type typ. Model field must be a slice, its elements are transformed like
+// single enum fields according to transformer.enums_as policy pol.
+func processRepeatedEnumField(pname, gname, typ string, gf source.FieldInfo, pol options.EnumsAs) (*Field, error) {
+	if !gf.IsSlice || gf.IsPointer || gf.Key != "" {
+		return nil, newLoggableError("field %s: repeated enum %s can be transformed into slice of string or integer type only, got %s", gname, strings.TrimPrefix(typ, "."), gf).
+			withHint("change type of model field %s to slice of string or integer type", gname)
+	}
+
+	elem := gf
+	elem.IsSlice = false
+
+	f, err := processEnumField(pname, gname, typ, elem, pol)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Elem: &Elem{
+			Kind:      elemEnum,
+			ProtoType: f.Enum.ProtoType,
+			GoType:    f.Enum.GoType,
+			Enum:      f.Enum,
+		},
+	}, nil
+}
+
+// processEmbeddedField returns Field with set of fields of embedded sub
+// message. Sub message fields are matched with parent Go structure fields
+// considering transformer.embedded_prefix option.
+func processEmbeddedField(
+	w io.Writer,
+	fdp *descriptorpb.FieldDescriptorProto,
+	subMessages MessageOptionList,
+	goStructFields source.Structure,
+	pol policies,
+) (*Field, error) {
+	typ := fdp.GetTypeName()
+	if fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || typ == "" {
+		return nil, fmt.Errorf("field %q is not a message, it can not be embedded", fdp.GetName())
+	}
+
+	if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil, fmt.Errorf("repeated field %q can not be embedded", fdp.GetName())
+	}
+
+	// Submessage has a name like ".package.type", 1: removes first ".".
+	mo, ok := subMessages[typ[1:]]
+	if !ok || mo.Descriptor() == nil {
+		return nil, fmt.Errorf("embedded message %q not found", typ)
+	}
+
+	prefix, err := getStringOption(fdp.Options, options.E_EmbeddedPrefix)
+	if _, ok := err.(errOptionNotExists); err != nil && err != ErrNilOptions && !ok {
+		return nil, pkgerrors.Wrap(err, "embedded_prefix option")
+	}
+
+	// Go structure fields without prefix, they are matched with sub message
+	// fields.
+	unprefixed := source.Structure{}
+	for name, fi := range goStructFields {
+		if strings.HasPrefix(name, prefix) {
+			unprefixed[strings.TrimPrefix(name, prefix)] = fi
+		}
+	}
+
+	mapAs, err := getStringOption(fdp.Options, options.E_MapAs)
+	if _, ok := err.(errOptionNotExists); err != nil && err != ErrNilOptions && !ok {
+		return nil, pkgerrors.Wrap(err, "mapAs option")
+	}
+
+	pname, _ := prepareFieldNames(fdp.GetName(), mapAs, "")
+
+	f := &Field{
+		ProtoName:      pname,
+		ProtoOrigName:  fdp.GetName(),
+		ProtoJSONName:  protoJSONName(fdp),
+		ProtoType:      strcase.ToCamel(lastName(typ)),
+		ProtoIsPointer: extractNullOption(fdp),
+	}
+
+	sub, err := withDirectives(w, mo.Descriptor(), unprefixed)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, fdp.GetName())
+	}
+
+	for _, sf := range sub.Field {
+		ef, err := processField(w, sf, subMessages, unprefixed, pol)
+		if err == nil && ef.Wrapper != nil && ef.Wrapper.Kind == elemCustom {
+			err = newLoggableError("field %s: fields of embedded messages can not be transformed by (%s) and (%s) options", sf.GetName(), options.E_CustomPbToGo.Name, options.E_CustomGoToPb.Name).
+				withHint("transform field %s of message %s manually", sf.GetName(), strings.TrimPrefix(typ, "."))
+		}
+		if err == nil && ef.Wrapper != nil && ef.Wrapper.Kind == elemOptional {
+			err = newLoggableError("field %s: optional fields of embedded messages are not supported", sf.GetName()).
+				withHint("transform field %s of message %s manually", sf.GetName(), strings.TrimPrefix(typ, "."))
+		}
+		if err == nil && ef.Wrapper != nil {
+			err = newLoggableError("field %s: fields of embedded messages can not be transformed by (%s) policy", sf.GetName(), options.E_WrappersAs.Name).
+				withHint("transform field %s of message %s manually", sf.GetName(), strings.TrimPrefix(typ, "."))
+		}
+		if err == nil && ef.Dep != nil {
+			err = newLoggableError("field %s: fields of embedded messages can not be transformed by (%s) option", sf.GetName(), options.E_ConverterMethod.Name).
+				withHint("transform field %s of message %s manually", sf.GetName(), strings.TrimPrefix(typ, "."))
+		}
+		if err != nil {
+			if e, ok := err.(loggableError); ok {
+				p(w, "// %s\n", e)
+				continue
+			}
+			return nil, pkgerrors.Wrap(err, fdp.GetName())
+		}
+
+		ef.Name = prefix + ef.Name
+		ef.ProtoOrigName = f.ProtoOrigName + "." + ef.ProtoOrigName
+		ef.ProtoJSONName = f.ProtoJSONName + "." + ef.ProtoJSONName
+		ef.GoJSONName = goJSONName(ef.Name, goStructFields[ef.Name].Tag)
+		ef.Signature = fieldSignature(ef.ProtoOrigName, sf, ef.Name, goStructFields[ef.Name])
+
+		f.EmbeddedFields = append(f.EmbeddedFields, *ef)
+	}
+
+	return f, nil
+}
+
 // abbreviationUpper checks a incoming string for equality and suffixes, if it
 // exists it will be converted to uppercase.
 // For instance, identifier fields in models often have a name like SomeID, with
@@ -4,14 +4,16 @@ import (
 	"errors"
 
 	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
 	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var _ = Describe("Field", func() {
@@ -49,19 +51,38 @@ var _ = Describe("Field", func() {
 				DescribeTable("check Field stuct",
 
 					func(pname, gname, typ string, gp, pnullable bool, expected Field) {
-						got := wktgoogleProtobufTimestamp(pname, gname, source.FieldInfo{Type: typ, IsPointer: gp}, pnullable)
+						got := wktgoogleProtobufTimestamp(pname, gname, source.FieldInfo{Type: typ, IsPointer: gp}, pnullable, true)
 
 						Expect(*got).To(MatchAllFields(Fields{
 							"Name":           Equal(expected.Name),
 							"ProtoName":      Equal(expected.ProtoName),
+							"ProtoOrigName":  Equal(expected.ProtoOrigName),
+							"ProtoJSONName":  Equal(expected.ProtoJSONName),
+							"GoJSONName":     Equal(expected.GoJSONName),
 							"ProtoToGoType":  Equal(expected.ProtoToGoType),
 							"GoToProtoType":  Equal(expected.GoToProtoType),
 							"ProtoType":      Equal(expected.ProtoType),
 							"GoIsPointer":    Equal(expected.GoIsPointer),
 							"ProtoIsPointer": Equal(expected.ProtoIsPointer),
 							"UsePackage":     Equal(expected.UsePackage),
+							"Helper":         Equal(expected.Helper),
 							"OneofDecl":      Equal(expected.OneofDecl),
 							"Opts":           Equal(expected.Opts),
+							"EmbeddedFields": Equal(expected.EmbeddedFields),
+							"Elem":           Equal(expected.Elem),
+							"Wrapper":        Equal(expected.Wrapper),
+							"Enum":           Equal(expected.Enum),
+							"Dep":            Equal(expected.Dep),
+							"Signature":      Equal(expected.Signature),
+							"WithError":      Equal(expected.WithError),
+							"EmptySlice":     Equal(expected.EmptySlice),
+							"WithContext":    Equal(expected.WithContext),
+							"Promoted":       Equal(expected.Promoted),
+							"Edge":           Equal(expected.Edge),
+							"HelperCall":     Equal(expected.HelperCall),
+							"Case":           Equal(expected.Case),
+							"SkipPbToGo":     Equal(expected.SkipPbToGo),
+							"SkipGoToPb":     Equal(expected.SkipGoToPb),
 						}))
 					},
 
@@ -71,6 +92,7 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "TimeToAnyGoType",
 						GoToProtoType: "AnyGoTypeToTime",
 						UsePackage:    true,
+						Helper:        helperTime,
 					}),
 					Entry("String", "protoName", "name", "string", false, false, Field{
 						Name:          "name",
@@ -78,6 +100,7 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "TimeToString",
 						GoToProtoType: "StringToTime",
 						UsePackage:    true,
+						Helper:        helperTime,
 					}),
 					Entry("Time", "protoName", "name", "time.Time", false, false, Field{
 						Name:          "name",
@@ -86,8 +109,63 @@ var _ = Describe("Field", func() {
 						GoToProtoType: "",
 						UsePackage:    false,
 					}),
+					Entry("Time to pointer", "protoName", "name", "time.Time", true, false, Field{
+						Name:      "name",
+						ProtoName: "protoName",
+						Wrapper:   &Elem{Kind: elemValue, ProtoType: "time.Time", GoType: "time.Time", GoIsPointer: true},
+					}),
 				)
 			})
+
+			DescribeTable("checkModelTimestamp",
+				func(gf source.FieldInfo, pol, field options.ModelPointer, msg string) {
+					fdp := &descriptorpb.FieldDescriptorProto{Name: sp("created"), Options: &descriptorpb.FieldOptions{}}
+					if field != options.ModelPointer_DETECT_POINTER {
+						proto.SetExtension(fdp.Options, options.E_ModelPointer, field)
+					}
+
+					err := checkModelTimestamp(fdp, "Created", gf, pol)
+					if msg == "" {
+						Expect(err).NotTo(HaveOccurred())
+						return
+					}
+					Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+					Expect(err).To(MatchError(msg))
+				},
+
+				Entry("No policy", source.FieldInfo{Type: "time.Time", IsPointer: true},
+					options.ModelPointer_DETECT_POINTER, options.ModelPointer_DETECT_POINTER, ""),
+				Entry("Pointer by policy", source.FieldInfo{Type: "time.Time", IsPointer: true},
+					options.ModelPointer_MODEL_POINTER, options.ModelPointer_DETECT_POINTER, ""),
+				Entry("Other model types", source.FieldInfo{Type: "nulls.Time"},
+					options.ModelPointer_MODEL_POINTER, options.ModelPointer_DETECT_POINTER, ""),
+				Entry("Field option overrides policy", source.FieldInfo{Type: "time.Time"},
+					options.ModelPointer_MODEL_POINTER, options.ModelPointer_MODEL_VALUE, ""),
+				Entry("Value instead of pointer", source.FieldInfo{Type: "time.Time"},
+					options.ModelPointer_MODEL_POINTER, options.ModelPointer_DETECT_POINTER,
+					"field Created: timestamp is transformed into *time.Time by (transformer.model_timestamps) = MODEL_POINTER policy, got time.Time; "+
+						"hint: change type of model field Created to *time.Time or set (transformer.model_pointer) option of field created"),
+			)
+
+			DescribeTable("wktStdTime",
+				func(gf source.FieldInfo, pnullable bool, expected *Elem) {
+					got, err := wktStdTime("Created", "Created", gf, pnullable)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(got).To(Equal(&Field{Name: "Created", ProtoName: "Created", Wrapper: expected}))
+				},
+
+				Entry("Value", source.FieldInfo{Type: "time.Time"}, true,
+					&Elem{Kind: elemTime, ProtoType: "Timestamp", GoType: "time.Time", ProtoIsPointer: true}),
+				Entry("Pointer", source.FieldInfo{Type: "time.Time", IsPointer: true}, true,
+					&Elem{Kind: elemTime, ProtoType: "Timestamp", GoType: "time.Time", ProtoIsPointer: true, GoIsPointer: true}),
+			)
+
+			It("wktStdTime returns loggable error for other model types", func() {
+				_, err := wktStdTime("Created", "Created", source.FieldInfo{Type: "nulls.Time"}, true)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError("field Created: option (transformer.use_std_time) requires model field of type time.Time or *time.Time, got nulls.Time; " +
+					"hint: change type of model field Created to time.Time or remove the option to use helper functions"))
+			})
 		})
 
 		Describe("google.Protobuf.StringValue", func() {
@@ -102,14 +180,33 @@ var _ = Describe("Field", func() {
 						Expect(*got).To(MatchAllFields(Fields{
 							"Name":           Equal(expected.Name),
 							"ProtoName":      Equal(expected.ProtoName),
+							"ProtoOrigName":  Equal(expected.ProtoOrigName),
+							"ProtoJSONName":  Equal(expected.ProtoJSONName),
+							"GoJSONName":     Equal(expected.GoJSONName),
 							"ProtoToGoType":  Equal(expected.ProtoToGoType),
 							"GoToProtoType":  Equal(expected.GoToProtoType),
 							"ProtoType":      Equal(expected.ProtoType),
 							"GoIsPointer":    Equal(expected.GoIsPointer),
 							"ProtoIsPointer": Equal(expected.ProtoIsPointer),
 							"UsePackage":     Equal(expected.UsePackage),
+							"Helper":         Equal(expected.Helper),
 							"OneofDecl":      Equal(expected.OneofDecl),
 							"Opts":           Equal(expected.Opts),
+							"EmbeddedFields": Equal(expected.EmbeddedFields),
+							"Elem":           Equal(expected.Elem),
+							"Wrapper":        Equal(expected.Wrapper),
+							"Enum":           Equal(expected.Enum),
+							"Dep":            Equal(expected.Dep),
+							"Signature":      Equal(expected.Signature),
+							"WithError":      Equal(expected.WithError),
+							"EmptySlice":     Equal(expected.EmptySlice),
+							"WithContext":    Equal(expected.WithContext),
+							"Promoted":       Equal(expected.Promoted),
+							"Edge":           Equal(expected.Edge),
+							"HelperCall":     Equal(expected.HelperCall),
+							"Case":           Equal(expected.Case),
+							"SkipPbToGo":     Equal(expected.SkipPbToGo),
+							"SkipGoToPb":     Equal(expected.SkipGoToPb),
 						}))
 					},
 
@@ -119,6 +216,7 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "StringValueToAnyGoType",
 						GoToProtoType: "AnyGoTypeToStringValue",
 						UsePackage:    true,
+						Helper:        helperWrappers,
 					}),
 					Entry("String", "protoName", "name", "int64", Field{
 						Name:          "name",
@@ -126,6 +224,7 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "StringValueToInt64",
 						GoToProtoType: "Int64ToStringValue",
 						UsePackage:    true,
+						Helper:        helperWrappers,
 					}),
 					Entry("pkg.Type", "protoName", "name", "pkg.Type", Field{
 						Name:          "name",
@@ -133,41 +232,671 @@ var _ = Describe("Field", func() {
 						ProtoToGoType: "StringValueToPkgType",
 						GoToProtoType: "PkgTypeToStringValue",
 						UsePackage:    true,
+						Helper:        helperWrappers,
 					}),
 				)
 			})
 		})
 	})
 
+	Describe("google.rpc.Status", func() {
+
+		It("returns Field for error model field", func() {
+			got, err := wktgoogleRpcStatus("Result", "Result", source.FieldInfo{Type: "error"}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*got).To(Equal(Field{
+				Name:          "Result",
+				ProtoName:     "Result",
+				ProtoToGoType: "StatusToError",
+				GoToProtoType: "ErrorToStatus",
+			}))
+		})
+
+		DescribeTable("returns loggable error",
+			func(gf source.FieldInfo, pnullable bool, msg string) {
+				_, err := wktgoogleRpcStatus("Result", "Result", gf, pnullable)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Non-error model field", source.FieldInfo{Type: "string"}, true,
+				"field Result: google.rpc.Status can be transformed into error only, got string; hint: change type of model field Result to error or skip the field with (transformer.skip) = true"),
+			Entry("Non-nullable status", source.FieldInfo{Type: "error"}, false,
+				"field Result: google.rpc.Status must be nullable; hint: remove (gogoproto.nullable) = false option"),
+		)
+	})
+
+	Describe("google.protobuf.Value", func() {
+
+		DescribeTable("check Field struct",
+			func(typ string, gf source.FieldInfo, p2g, g2p string) {
+				got, err := wktgoogleProtobufValue("Data", "Data", typ, gf, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*got).To(Equal(Field{Name: "Data", ProtoName: "Data", ProtoToGoType: p2g, GoToProtoType: g2p}))
+			},
+
+			Entry("Value", ".google.protobuf.Value", source.FieldInfo{Type: "interface{}"}, "ValueToInterface", "InterfaceToValue"),
+			Entry("Value to any", ".google.protobuf.Value", source.FieldInfo{Type: "any"}, "ValueToInterface", "InterfaceToValue"),
+			Entry("ListValue", ".google.protobuf.ListValue", source.FieldInfo{Type: "interface{}", IsSlice: true}, "ListValueToSlice", "SliceToListValue"),
+		)
+
+		DescribeTable("returns loggable error",
+			func(typ string, gf source.FieldInfo, pnullable bool, msg string) {
+				_, err := wktgoogleProtobufValue("Data", "Data", typ, gf, pnullable)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Value into slice", ".google.protobuf.Value", source.FieldInfo{Type: "interface{}", IsSlice: true}, true,
+				"field Data: google.protobuf.Value can be transformed into interface{} only, got []interface{}; "+
+					"hint: change type of model field Data to interface{} or skip the field with (transformer.skip) = true"),
+			Entry("ListValue into string", ".google.protobuf.ListValue", source.FieldInfo{Type: "string"}, true,
+				"field Data: google.protobuf.ListValue can be transformed into []interface{} only, got string; "+
+					"hint: change type of model field Data to []interface{} or skip the field with (transformer.skip) = true"),
+			Entry("Non-nullable value", ".google.protobuf.Value", source.FieldInfo{Type: "interface{}"}, false,
+				"field Data: google.protobuf.Value must be nullable; hint: remove (gogoproto.nullable) = false option"),
+		)
+	})
+
+	Describe("google.protobuf.Struct", func() {
+
+		DescribeTable("check Field struct",
+			func(gf source.FieldInfo, asJSON bool, expected *Elem) {
+				got, err := wktgoogleProtobufStruct("Meta", "Meta", gf, true, asJSON)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(got).To(Equal(&Field{Name: "Meta", ProtoName: "Meta", Wrapper: expected}))
+			},
+
+			Entry("Map", source.FieldInfo{Type: "interface{}", Key: "string"}, false,
+				&Elem{Kind: elemStruct, ProtoType: "Struct", GoType: "map[string]interface{}", ProtoToGo: "StructToMap", GoToProto: "MapToStruct"}),
+			Entry("Map of any", source.FieldInfo{Type: "any", Key: "string"}, false,
+				&Elem{Kind: elemStruct, ProtoType: "Struct", GoType: "map[string]interface{}", ProtoToGo: "StructToMap", GoToProto: "MapToStruct"}),
+			Entry("JSON", source.FieldInfo{Type: "json.RawMessage"}, true,
+				&Elem{Kind: elemStruct, ProtoType: "Struct", GoType: "json.RawMessage", ProtoToGo: "StructToJSON", GoToProto: "JSONToStruct", WithError: true}),
+			Entry("Resolved JSON", source.FieldInfo{Type: "byte", IsSlice: true}, true,
+				&Elem{Kind: elemStruct, ProtoType: "Struct", GoType: "json.RawMessage", ProtoToGo: "StructToJSON", GoToProto: "JSONToStruct", WithError: true}),
+		)
+
+		DescribeTable("returns loggable error",
+			func(gf source.FieldInfo, pnullable, asJSON bool, msg string) {
+				_, err := wktgoogleProtobufStruct("Meta", "Meta", gf, pnullable, asJSON)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Struct into JSON without option", source.FieldInfo{Type: "json.RawMessage"}, true, false,
+				"field Meta: google.protobuf.Struct can be transformed into map[string]interface{} only, got json.RawMessage; "+
+					"hint: change type of model field Meta to map[string]interface{} or skip the field with (transformer.skip) = true"),
+			Entry("Struct into map with option", source.FieldInfo{Type: "interface{}", Key: "string"}, true, true,
+				"field Meta: google.protobuf.Struct can be transformed into json.RawMessage only, got map[string]interface{}; "+
+					"hint: change type of model field Meta to json.RawMessage or skip the field with (transformer.skip) = true"),
+			Entry("Non-nullable struct", source.FieldInfo{Type: "interface{}", Key: "string"}, false, false,
+				"field Meta: google.protobuf.Struct must be nullable; hint: remove (gogoproto.nullable) = false option"),
+		)
+	})
+
+	Describe("Repeated well-known types", func() {
+
+		DescribeTable("check Field struct",
+			func(typ string, gf source.FieldInfo, pnullable bool, expected *Elem) {
+				got, err := wktRepeated("ProtoName", "Name", typ, gf, pnullable, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(got.Name).To(Equal("Name"))
+				Expect(got.ProtoName).To(Equal("ProtoName"))
+				Expect(got.Elem).To(Equal(expected))
+			},
+
+			Entry("StringValue to []string", ".google.protobuf.StringValue", source.FieldInfo{Type: "string"}, true,
+				&Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string", ProtoIsPointer: true}),
+			Entry("Int64Value to []*int64", ".google.protobuf.Int64Value", source.FieldInfo{Type: "int64", IsPointer: true}, true,
+				&Elem{Kind: elemWrapper, ProtoType: "Int64Value", GoType: "int64", ProtoIsPointer: true, GoIsPointer: true}),
+			Entry("Non-nullable BoolValue", ".google.protobuf.BoolValue", source.FieldInfo{Type: "bool"}, false,
+				&Elem{Kind: elemWrapper, ProtoType: "BoolValue", GoType: "bool"}),
+			Entry("Timestamp to []time.Time", ".google.protobuf.Timestamp", source.FieldInfo{Type: "time.Time"}, true,
+				&Elem{Kind: elemValue, ProtoType: "time.Time", GoType: "time.Time", ProtoIsPointer: true}),
+			Entry("Timestamp to []*nulls.Time", ".google.protobuf.Timestamp", source.FieldInfo{Type: "nulls.Time", IsPointer: true}, false,
+				&Elem{Kind: elemFunc, ProtoType: "time.Time", GoType: "nulls.Time", GoIsPointer: true,
+					ProtoToGo: "TimeToNullsTime", GoToProto: "NullsTimeToTime", UsePackage: true, Helper: helperTime}),
+			Entry("Duration to []int64", ".google.protobuf.Duration", source.FieldInfo{Type: "int64"}, false,
+				&Elem{Kind: elemFunc, ProtoType: "time.Duration", GoType: "int64",
+					ProtoToGo: "DurationToInt64", GoToProto: "Int64ToDuration", UsePackage: true, Helper: helperTime}),
+		)
+
+		It("returns loggable error for mismatched types", func() {
+			_, err := wktRepeated("ProtoName", "Name", ".google.protobuf.StringValue", source.FieldInfo{Type: "int"}, true, true)
+			Expect(err).To(MatchError(newLoggableError("field Name: google.protobuf.StringValue elements can be transformed into []string or []*string only, got []int").
+				withHint("change element type of model field Name to string or skip the field with (transformer.skip) = true")))
+		})
+	})
+
+	Describe("Timestamp structures", func() {
+
+		DescribeTable("check Field struct",
+			func(gf source.FieldInfo, pnullable bool, p2g, g2p string) {
+				got := wktgoogleProtobufTimestamp("Created", "Created", gf, pnullable, false)
+				Expect(got).To(Equal(&Field{Name: "Created", ProtoName: "Created",
+					ProtoToGoType: p2g, GoToProtoType: g2p, UsePackage: true, Helper: helperTime}))
+			},
+
+			Entry("Pointer to time.Time", source.FieldInfo{Type: "time.Time"}, true, "TimestampPtrToTime", "TimeToTimestampPtr"),
+			Entry("Value to *time.Time", source.FieldInfo{Type: "time.Time", IsPointer: true}, false, "TimestampToTimePtr", "TimePtrToTimestamp"),
+			Entry("Pointer to nulls.Time", source.FieldInfo{Type: "nulls.Time"}, true, "TimestampPtrToNullsTime", "NullsTimeToTimestampPtr"),
+		)
+
+		It("returns Field for Duration", func() {
+			got := wktgoogleProtobufDuration("Timeout", "Timeout", source.FieldInfo{Type: "time.Duration"}, true, false)
+			Expect(got.ProtoToGoType).To(Equal("DurationPtrToStdDuration"))
+			Expect(got.GoToProtoType).To(Equal("StdDurationToDurationPtr"))
+		})
+
+		DescribeTable("wktDuration",
+			func(gf source.FieldInfo, stdtime bool, as options.DurationAs, expected *Elem) {
+				got, err := wktDuration("Timeout", "Timeout", gf, true, stdtime, as)
+				Expect(err).NotTo(HaveOccurred())
+				if expected == nil {
+					Expect(got).To(BeNil())
+					return
+				}
+				Expect(got).To(Equal(&Field{Name: "Timeout", ProtoName: "Timeout", Wrapper: expected}))
+			},
+
+			Entry("Structure to time.Duration", source.FieldInfo{Type: "time.Duration", IsPointer: true}, false, options.DurationAs_DURATION_AS_MODEL_TYPE,
+				&Elem{Kind: elemDuration, ProtoType: "Duration", GoType: "time.Duration", ProtoIsPointer: true, GoIsPointer: true}),
+			Entry("Standard type to milliseconds", source.FieldInfo{Type: "int64"}, true, options.DurationAs_MILLISECONDS,
+				&Elem{Kind: elemDuration, ProtoType: "time.Duration", GoType: "int64", ProtoIsPointer: true, Unit: "time.Millisecond"}),
+			Entry("Standard type to time.Duration", source.FieldInfo{Type: "time.Duration"}, true, options.DurationAs_DURATION_AS_MODEL_TYPE, nil),
+			Entry("Structure to other type", source.FieldInfo{Type: "nulls.Duration"}, false, options.DurationAs_DURATION_AS_MODEL_TYPE, nil),
+		)
+
+		It("wktDuration returns loggable error for non-integer model types of units", func() {
+			_, err := wktDuration("Timeout", "Timeout", source.FieldInfo{Type: "float64"}, true, true, options.DurationAs_NANOSECONDS)
+			Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+			Expect(err).To(MatchError("field Timeout: option (transformer.duration_as) = NANOSECONDS requires model field of integer type, got float64; " +
+				"hint: change type of model field Timeout to int64 or remove the option"))
+		})
+
+		It("returns Elem for repeated Timestamp", func() {
+			got, err := wktRepeated("Times", "Times", ".google.protobuf.Timestamp", source.FieldInfo{Type: "time.Time"}, true, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Elem).To(Equal(&Elem{Kind: elemFunc, ProtoType: "Timestamp", GoType: "time.Time", ProtoIsPointer: true,
+				ProtoToGo: "TimestampToTime", GoToProto: "TimeToTimestamp", UsePackage: true, Helper: helperTime}))
+		})
+	})
+
+	Describe("Wrapper fields", func() {
+
+		DescribeTable("check Field struct",
+			func(gf source.FieldInfo, pnullable bool, pol options.WrappersAs, expected *Elem) {
+				got, err := wktWrapper("Limit", "Limit", ".google.protobuf.Int64Value", gf, pnullable, pol)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(got).To(Equal(&Field{Name: "Limit", ProtoName: "Limit", Wrapper: expected}))
+			},
+
+			Entry("Pointer", source.FieldInfo{Type: "int64", IsPointer: true}, true, options.WrappersAs_POINTER,
+				&Elem{Kind: elemWrapper, ProtoType: "Int64Value", GoType: "int64", ProtoIsPointer: true, GoIsPointer: true}),
+			Entry("Value", source.FieldInfo{Type: "int64"}, false, options.WrappersAs_VALUE,
+				&Elem{Kind: elemWrapper, ProtoType: "Int64Value", GoType: "int64"}),
+		)
+
+		DescribeTable("returns loggable error",
+			func(gf source.FieldInfo, pol options.WrappersAs, msg string) {
+				_, err := wktWrapper("Limit", "Limit", ".google.protobuf.Int64Value", gf, true, pol)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Types mismatch", source.FieldInfo{Type: "string", IsPointer: true}, options.WrappersAs_POINTER,
+				"field Limit: google.protobuf.Int64Value can be transformed into *int64 only, got *string; "+
+					"hint: change type of model field Limit to *int64 or skip the field with (transformer.skip) = true"),
+			Entry("Value for pointer policy", source.FieldInfo{Type: "int64"}, options.WrappersAs_POINTER,
+				"field Limit: google.protobuf.Int64Value is transformed into *int64 by (transformer.wrappers_as) = POINTER policy, got int64; "+
+					"hint: change type of model field Limit to *int64 or set (transformer.wrappers_as) = VALUE"),
+			Entry("Pointer for value policy", source.FieldInfo{Type: "int64", IsPointer: true}, options.WrappersAs_VALUE,
+				"field Limit: google.protobuf.Int64Value is transformed into int64 by (transformer.wrappers_as) = VALUE policy, got *int64; "+
+					"hint: change type of model field Limit to int64 or set (transformer.wrappers_as) = POINTER"),
+		)
+
+		DescribeTable("wrappersPolicy",
+			func(typ string, gf source.FieldInfo, pol, expected options.WrappersAs) {
+				Expect(wrappersPolicy(typ, gf, pol)).To(Equal(expected))
+			},
+
+			Entry("Pointer model field", ".google.protobuf.Int64Value", source.FieldInfo{Type: "int64", IsPointer: true},
+				options.WrappersAs_WRAPPERS_AS_HELPERS, options.WrappersAs_POINTER),
+			Entry("Value model field", ".google.protobuf.BoolValue", source.FieldInfo{Type: "bool"},
+				options.WrappersAs_WRAPPERS_AS_HELPERS, options.WrappersAs_VALUE),
+			Entry("Pointer string field", ".google.protobuf.StringValue", source.FieldInfo{Type: "string", IsPointer: true},
+				options.WrappersAs_WRAPPERS_AS_HELPERS, options.WrappersAs_POINTER),
+			Entry("String field uses helpers", ".google.protobuf.StringValue", source.FieldInfo{Type: "string"},
+				options.WrappersAs_WRAPPERS_AS_HELPERS, options.WrappersAs_WRAPPERS_AS_HELPERS),
+			Entry("Another type uses helpers", ".google.protobuf.StringValue", source.FieldInfo{Type: "NullString", IsPointer: true},
+				options.WrappersAs_WRAPPERS_AS_HELPERS, options.WrappersAs_WRAPPERS_AS_HELPERS),
+			Entry("Slice uses helpers", ".google.protobuf.Int64Value", source.FieldInfo{Type: "int64", IsSlice: true},
+				options.WrappersAs_WRAPPERS_AS_HELPERS, options.WrappersAs_WRAPPERS_AS_HELPERS),
+			Entry("Explicit policy", ".google.protobuf.Int64Value", source.FieldInfo{Type: "int64", IsPointer: true},
+				options.WrappersAs_VALUE, options.WrappersAs_VALUE),
+		)
+	})
+
+	Describe("Enum fields", func() {
+
+		DescribeTable("enumGoType",
+			func(typ, expected string) {
+				Expect(enumGoType(typ)).To(Equal(expected))
+			},
+
+			Entry("Top-level enum", ".svc.example.Status", "Status"),
+			Entry("Nested enum", ".svc.example.Order.Status", "Order_Status"),
+			Entry("No package", ".Status", "Status"),
+		)
+
+		DescribeTable("check Field struct",
+			func(gf source.FieldInfo, pol options.EnumsAs, expected *Enum) {
+				got, err := processEnumField("State", "State", ".pkg.Order.State", gf, pol)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(got).To(Equal(&Field{Name: "State", ProtoName: "State", Enum: expected}))
+			},
+
+			Entry("String by model type", source.FieldInfo{Type: "string"}, options.EnumsAs_ENUMS_AS_MODEL_TYPE,
+				&Enum{ProtoType: "Order_State", GoType: "string", AsString: true}),
+			Entry("Number by model type", source.FieldInfo{Type: "int32"}, options.EnumsAs_ENUMS_AS_MODEL_TYPE,
+				&Enum{ProtoType: "Order_State", GoType: "int32"}),
+			Entry("Model type as string", source.FieldInfo{Type: "State"}, options.EnumsAs_STRING,
+				&Enum{ProtoType: "Order_State", GoType: "State", AsString: true}),
+			Entry("Model type as number", source.FieldInfo{Type: "State"}, options.EnumsAs_NUMBER,
+				&Enum{ProtoType: "Order_State", GoType: "State"}),
+		)
+
+		DescribeTable("returns loggable error",
+			func(gf source.FieldInfo, pol options.EnumsAs, msg string) {
+				_, err := processEnumField("State", "State", ".pkg.Order.State", gf, pol)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Pointer", source.FieldInfo{Type: "string", IsPointer: true}, options.EnumsAs_STRING,
+				"field State: enum pkg.Order.State can be transformed into value of string or integer type only, got *string; "+
+					"hint: change type of model field State to string or integer type"),
+			Entry("Unknown model type", source.FieldInfo{Type: "State"}, options.EnumsAs_ENUMS_AS_MODEL_TYPE,
+				"field State: enum pkg.Order.State can be transformed into string or integer types only, got State; "+
+					"hint: set (transformer.enums_as) = STRING or (transformer.enums_as) = NUMBER if model field State has such underlying type"),
+			Entry("Policy mismatch", source.FieldInfo{Type: "int64"}, options.EnumsAs_STRING,
+				"field State: enum pkg.Order.State can not be transformed into int64 by (transformer.enums_as) = STRING policy; "+
+					"hint: change type of model field State or skip the field with (transformer.skip) = true"),
+		)
+
+		It("transforms repeated enum element-wise", func() {
+			got, err := processRepeatedEnumField("States", "States", ".pkg.Order.State",
+				source.FieldInfo{Type: "State", IsSlice: true}, options.EnumsAs_NUMBER)
+			Expect(err).NotTo(HaveOccurred())
+
+			e := &Enum{ProtoType: "Order_State", GoType: "State"}
+			Expect(got).To(Equal(&Field{Name: "States", ProtoName: "States", Elem: &Elem{
+				Kind: elemEnum, ProtoType: "Order_State", GoType: "State", Enum: e,
+			}}))
+		})
+
+		DescribeTable("returns loggable error for repeated enum",
+			func(gf source.FieldInfo, msg string) {
+				_, err := processRepeatedEnumField("States", "States", ".pkg.Order.State", gf, options.EnumsAs_ENUMS_AS_MODEL_TYPE)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Not a slice", source.FieldInfo{Type: "string"},
+				"field States: repeated enum pkg.Order.State can be transformed into slice of string or integer type only, got string; "+
+					"hint: change type of model field States to slice of string or integer type"),
+			Entry("Unknown element type", source.FieldInfo{Type: "State", IsSlice: true},
+				"field States: enum pkg.Order.State can be transformed into string or integer types only, got State; "+
+					"hint: set (transformer.enums_as) = STRING or (transformer.enums_as) = NUMBER if model field States has such underlying type"),
+		)
+	})
+
+	Describe("Map fields", func() {
+
+		entry := func(key descriptorpb.FieldDescriptorProto_Type, val string) *descriptorpb.DescriptorProto {
+			return &descriptorpb.DescriptorProto{
+				Name: sp("TimesEntry"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("key"), Type: &key},
+					{Name: sp("value"), Type: &typMessage, TypeName: sp(val)},
+				},
+			}
+		}
+
+		It("returns Field for map of timestamps", func() {
+			got, err := processMapField("Times", "Times", entry(typString, ".google.protobuf.Timestamp"), nil,
+				source.FieldInfo{Type: "time.Time", Key: "string"}, false, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Name).To(Equal("Times"))
+			Expect(got.ProtoName).To(Equal("Times"))
+			Expect(got.Elem).To(Equal(&Elem{Kind: elemValue, ProtoType: "time.Time", GoType: "time.Time", MapKey: "string"}))
+		})
+
+		It("returns Field for map of wrappers", func() {
+			got, err := processMapField("Names", "Names", entry(typInt64, ".google.protobuf.StringValue"), nil,
+				source.FieldInfo{Type: "string", IsPointer: true, Key: "int64"}, true, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Elem).To(Equal(&Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string",
+				ProtoIsPointer: true, GoIsPointer: true, MapKey: "int64"}))
+		})
+
+		DescribeTable("returns loggable error",
+			func(e *descriptorpb.DescriptorProto, gf source.FieldInfo, msg string) {
+				_, err := processMapField("Times", "Times", e, nil, gf, true, true)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Go field is not a map", entry(typString, ".google.protobuf.Timestamp"),
+				source.FieldInfo{Type: "time.Time"},
+				"field Times: map can be transformed into Go map only, got time.Time; hint: change type of model field Times to map"),
+			Entry("Key types mismatch", entry(typString, ".google.protobuf.Timestamp"),
+				source.FieldInfo{Type: "time.Time", Key: "int"},
+				"field Times: map key of type TYPE_STRING can not be transformed into int; hint: change key type of model field Times to string"),
+			Entry("Unsupported value type", entry(typString, ".pkg.Custom"),
+				source.FieldInfo{Type: "Custom", Key: "string"},
+				"field Times: map values of type pkg.Custom are not supported; hint: skip the field with (transformer.skip) = true and transform it manually"),
+		)
+
+		It("returns Field for map of scalars", func() {
+			e := entry(typString, "")
+			e.Field[1] = &descriptorpb.FieldDescriptorProto{Name: sp("value"), Type: &typInt64}
+
+			got, err := processMapField("Counts", "Counts", e, nil, source.FieldInfo{Type: "int64", Key: "string"}, true, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Elem).To(Equal(&Elem{Kind: elemValue, ProtoType: "int64", GoType: "int64", MapKey: "string"}))
+
+			_, err = processMapField("Counts", "Counts", e, nil, source.FieldInfo{Type: "int", Key: "string"}, true, true)
+			Expect(err).To(MatchError(newLoggableError("field Counts: map values of type int64 can not be transformed into int").
+				withHint("change value type of model field Counts to int64")))
+		})
+
+		DescribeTable("returns Field for map of messages",
+			func(gf source.FieldInfo, pnullable bool, p2g, g2p string) {
+				messages := MessageOptionList{
+					"pkg.Address": messageOption{targetName: "Address", desc: &descriptorpb.DescriptorProto{Name: sp("Address")}},
+				}
+
+				got, err := processMapField("Addresses", "Addresses", entry(typString, ".pkg.Address"), messages, gf, pnullable, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(got.Elem).To(Equal(&Elem{Kind: elemMessage, ProtoType: "Address", GoType: "Address",
+					ProtoIsPointer: pnullable, GoIsPointer: gf.IsPointer, ProtoToGo: p2g, GoToProto: g2p, MapKey: "string"}))
+			},
+
+			Entry("Pointers to values", source.FieldInfo{Type: "Address", Key: "string"}, true, "PbToAddressPtrVal", "AddressToPbValPtr"),
+			Entry("Pointers to pointers", source.FieldInfo{Type: "Address", IsPointer: true, Key: "string"}, true, "PbToAddressPtr", "AddressToPbPtr"),
+			Entry("Values to values", source.FieldInfo{Type: "Address", Key: "string"}, false, "PbToAddress", "AddressToPb"),
+			Entry("Values to pointers", source.FieldInfo{Type: "Address", IsPointer: true, Key: "string"}, false, "PbToAddressValPtr", "AddressToPbPtrVal"),
+		)
+
+		It("uses Go names of nested messages for map values", func() {
+			messages := MessageOptionList{
+				"pkg.Order.Item": messageOption{targetName: "OrderItem", goName: "Order_Item", desc: &descriptorpb.DescriptorProto{Name: sp("Item")}},
+			}
+
+			got, err := processMapField("Items", "Items", entry(typString, ".pkg.Order.Item"), messages,
+				source.FieldInfo{Type: "OrderItem", Key: "string"}, true, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Elem.ProtoType).To(Equal("Order_Item"))
+			Expect(got.Elem.ProtoToGo).To(Equal("PbToOrderItemPtrVal"))
+		})
+
+		It("returns loggable error for map of messages with another model type", func() {
+			messages := MessageOptionList{
+				"pkg.Address": messageOption{targetName: "Address", desc: &descriptorpb.DescriptorProto{Name: sp("Address")}},
+			}
+
+			_, err := processMapField("Addresses", "Addresses", entry(typString, ".pkg.Address"), messages,
+				source.FieldInfo{Type: "Location", Key: "string"}, true, true)
+			Expect(err).To(MatchError(newLoggableError("field Addresses: map values of type pkg.Address can be transformed into map[string]Address or map[string]*Address only, got map[string]Location").
+				withHint("change type of model field Addresses to map[string]Address")))
+		})
+
+		It("returns loggable error for map of messages with transformer.with_errors option", func() {
+			desc := &descriptorpb.DescriptorProto{Name: sp("Address"), Options: &descriptorpb.MessageOptions{}}
+			proto.SetExtension(desc.Options, options.E_WithErrors, true)
+			messages := MessageOptionList{
+				"pkg.Address": messageOption{targetName: "Address", fullName: "pkg.Address", desc: desc},
+			}
+
+			_, err := processMapField("Addresses", "Addresses", entry(typString, ".pkg.Address"), messages,
+				source.FieldInfo{Type: "Address", Key: "string"}, true, true)
+			Expect(err).To(MatchError(newLoggableError("field Addresses: map values of message pkg.Address with option (transformer.with_errors) are not supported").
+				withHint("skip the field with (transformer.skip) = true and transform it manually")))
+		})
+
+		It("returns loggable error for map of messages with transformer.with_context option", func() {
+			desc := &descriptorpb.DescriptorProto{Name: sp("Address"), Options: &descriptorpb.MessageOptions{}}
+			proto.SetExtension(desc.Options, options.E_WithContext, true)
+			messages := MessageOptionList{
+				"pkg.Address": messageOption{targetName: "Address", fullName: "pkg.Address", desc: desc},
+			}
+
+			_, err := processMapField("Addresses", "Addresses", entry(typString, ".pkg.Address"), messages,
+				source.FieldInfo{Type: "Address", Key: "string"}, true, true)
+			Expect(err).To(MatchError(newLoggableError("field Addresses: map values of message pkg.Address with option (transformer.with_context) are not supported").
+				withHint("skip the field with (transformer.skip) = true and transform it manually")))
+		})
+
+		It("suggests unwrap_list option for list wrappers", func() {
+			messages := MessageOptionList{
+				"pkg.AddressList": messageOption{desc: &descriptorpb.DescriptorProto{
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("items"), Label: &typRepeated, Type: &typMessage, TypeName: sp(".pkg.Address")},
+					},
+				}},
+			}
+
+			_, err := processMapField("Addresses", "Addresses", entry(typString, ".pkg.AddressList"), messages,
+				source.FieldInfo{Type: "Address", IsSlice: true, Key: "string"}, true, true)
+			Expect(err).To(MatchError(newLoggableError("field Addresses: map values of type pkg.AddressList are not supported").
+				withHint("pkg.AddressList is a list wrapper, use (transformer.unwrap_list) = true to transform it into map[string][]T")))
+		})
+	})
+
+	Describe("Ordered map fields", func() {
+
+		entry := func(key, val descriptorpb.FieldDescriptorProto_Type) *descriptorpb.DescriptorProto {
+			return &descriptorpb.DescriptorProto{
+				Name: sp("LabelsEntry"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("key"), Type: &key},
+					{Name: sp("value"), Type: &val},
+				},
+			}
+		}
+
+		It("returns Field for slice of pairs", func() {
+			got, err := processOrderedMapField("Labels", "Labels", entry(typString, typInt64),
+				source.FieldInfo{Type: "LabelPair", IsPointer: true, IsSlice: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(Equal(&Field{Name: "Labels", ProtoName: "Labels", Elem: &Elem{
+				Kind: elemPairs, ProtoType: "int64", GoType: "LabelPair", GoIsPointer: true, MapKey: "string",
+			}}))
+		})
+
+		DescribeTable("returns loggable error",
+			func(e *descriptorpb.DescriptorProto, gf source.FieldInfo, msg string) {
+				_, err := processOrderedMapField("Labels", "Labels", e, gf)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Go field is a map", entry(typString, typString), source.FieldInfo{Type: "string", Key: "string"},
+				"field Labels: map with option (transformer.ordered_map) can be transformed into slice of pairs only, got map[string]string; "+
+					"hint: change type of model field Labels to slice of structures with Key and Value fields"),
+			Entry("Message values", entry(typString, typMessage), source.FieldInfo{Type: "LabelPair", IsSlice: true},
+				"field Labels: ordered map values of type TYPE_MESSAGE are not supported; "+
+					"hint: remove (transformer.ordered_map) option or skip the field with (transformer.skip) = true"),
+		)
+	})
+
+	Describe("List wrapper map fields", func() {
+
+		var (
+			entry = &descriptorpb.DescriptorProto{
+				Name: sp("AddressesEntry"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("key"), Type: &typString},
+					{Name: sp("value"), Type: &typMessage, TypeName: sp(".pkg.AddressList")},
+				},
+			}
+
+			messages = MessageOptionList{
+				"pkg.AddressList": messageOption{desc: &descriptorpb.DescriptorProto{
+					Name: sp("AddressList"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("items"), Label: &typRepeated, Type: &typMessage, TypeName: sp(".pkg.Address")},
+					},
+				}},
+				"pkg.Address": messageOption{targetName: "Address"},
+				"pkg.Scalars": messageOption{desc: &descriptorpb.DescriptorProto{
+					Name:  sp("Scalars"),
+					Field: []*descriptorpb.FieldDescriptorProto{{Name: sp("items"), Label: &typRepeated, Type: &typString}},
+				}},
+			}
+		)
+
+		DescribeTable("returns Field",
+			func(gf source.FieldInfo, p2g, g2p string) {
+				got, err := processListMapField("Addresses", "Addresses", entry, messages, gf, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(got).To(Equal(&Field{Name: "Addresses", ProtoName: "Addresses", Elem: &Elem{
+					Kind: elemList, ProtoType: "AddressList", GoType: "[]" + gf.String(), ProtoIsPointer: true,
+					ProtoToGo: p2g, GoToProto: g2p, MapKey: "string", Items: "Items",
+				}}))
+			},
+
+			Entry("Slice of values", source.FieldInfo{Type: "Address", IsSlice: true, Key: "string"},
+				"PbToAddressPtrValList", "AddressToPbValPtrList"),
+			Entry("Slice of pointers", source.FieldInfo{Type: "Address", IsPointer: true, IsSlice: true, Key: "string"},
+				"PbToAddressPtrList", "AddressToPbPtrList"),
+		)
+
+		It("transforms non-nullable elements into slice of pointers", func() {
+			ml := MessageOptionList{}
+			for k, v := range messages {
+				ml[k] = v
+			}
+			items := proto.Clone(messages["pkg.AddressList"].Descriptor()).(*descriptorpb.DescriptorProto)
+			items.Field[0].Options = &descriptorpb.FieldOptions{}
+			proto.SetExtension(items.Field[0].Options, gogoproto.E_Nullable, false)
+			ml["pkg.AddressList"] = messageOption{desc: items}
+
+			gf := source.FieldInfo{Type: "Address", IsPointer: true, IsSlice: true, Key: "string"}
+			got, err := processListMapField("Addresses", "Addresses", entry, ml, gf, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Elem.ProtoToGo).To(Equal("PbToAddressValPtrList"))
+			Expect(got.Elem.GoToProto).To(Equal("AddressToPbPtrValList"))
+		})
+
+		DescribeTable("returns loggable error",
+			func(typeName string, gf source.FieldInfo, msg string) {
+				e := proto.Clone(entry).(*descriptorpb.DescriptorProto)
+				e.Field[1].TypeName = sp(typeName)
+
+				_, err := processListMapField("Addresses", "Addresses", e, messages, gf, true)
+				Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+				Expect(err).To(MatchError(msg))
+			},
+
+			Entry("Unknown message", ".pkg.Unknown", source.FieldInfo{Type: "Address", IsSlice: true, Key: "string"},
+				"field Addresses: list wrapper message pkg.Unknown not found"),
+			Entry("Not a wrapper", ".pkg.Scalars", source.FieldInfo{Type: "Address", IsSlice: true, Key: "string"},
+				"field Addresses: message pkg.Scalars is not a list wrapper, it must have one repeated field of message type; hint: remove (transformer.unwrap_list) option"),
+			Entry("Go field is not a map of slices", ".pkg.AddressList", source.FieldInfo{Type: "Address", Key: "string"},
+				"field Addresses: map values of type pkg.AddressList can be transformed into map[string][]Address or map[string][]*Address only, got map[string]Address; hint: change type of model field Addresses to map[string][]Address"),
+		)
+	})
+
+	Describe("map_to option", func() {
+
+		mapped := func(name, mapTo string) *descriptorpb.FieldDescriptorProto {
+			fdp := &descriptorpb.FieldDescriptorProto{Name: sp(name), Type: &typInt64, Options: &descriptorpb.FieldOptions{}}
+			proto.SetExtension(fdp.Options, options.E_MapTo, mapTo)
+			return fdp
+		}
+
+		It("transforms proto field into pointed model field", func() {
+			got, err := processField(nil, mapped("user_id", "Int64Field"), subm, goStruct, policies{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Name).To(Equal("Int64Field"))
+			Expect(got.ProtoName).To(Equal("UserId"))
+		})
+
+		It("returns an error if pointed model field does not exist", func() {
+			_, err := processField(nil, mapped("user_id", "OwnerID"), subm, goStruct, policies{})
+			Expect(err).To(MatchError("OwnerID: model field of option (transformer.map_to) of field user_id not found in destination structure; " +
+				"hint: add field OwnerID to model or point existing model field with (transformer.map_to) option of field user_id"))
+
+			_, err = processField(nil, mapped("user_id", "Int64field"), subm, goStruct, policies{})
+			Expect(err).To(MatchError(`Int64field: model field of option (transformer.map_to) of field user_id not found in destination structure; ` +
+				`hint: use (transformer.map_to) = "Int64Field", model field names are case-sensitive`))
+		})
+	})
+
+	DescribeTable("notFoundHint",
+		func(gname string, expected string) {
+			s := source.Structure{
+				"Street_1":                    {Type: "string"},
+				"unsupported_map_type_Labels": {Type: "*ast.ArrayType"},
+			}
+			Expect(notFoundHint(gname, s)).To(Equal(expected))
+		},
+
+		Entry("Similar name", "Street1", `use (transformer.map_to) = "Street_1" if proto field should be transformed into model field Street_1`),
+		Entry("Unsupported model type", "Labels", "model field Labels has unsupported map type, skip the field with (transformer.skip) = true"),
+		Entry("No similar fields", "City", "add field City to model, use (transformer.map_to) option if model field has another name or skip the field with (transformer.skip) = true"),
+	)
+
 	Describe("ProcessSubMessages", func() {
 
 		var (
 			protoField         = "proto_field"
 			protoFieldTypeName = "CustomType"
 			goField            = "StringField"
-			labelRepeated      = descriptor.FieldDescriptorProto_LABEL_REPEATED
 		)
 
 		DescribeTable("check result",
-			func(fdp *descriptor.FieldDescriptorProto, pname, gname, pbType string, mo MessageOption, custom bool, expected *Field) {
+			func(fdp *descriptorpb.FieldDescriptorProto, pname, gname, pbType string, mo MessageOption, custom bool, expected *Field) {
 				got, err := processSubMessage(nil, fdp, pname, gname, pbType, mo, goStruct, custom)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(*got).To(MatchAllFields(Fields{
 					"Name":           Equal(expected.Name),
 					"ProtoName":      Equal(expected.ProtoName),
+					"ProtoOrigName":  Equal(expected.ProtoOrigName),
+					"ProtoJSONName":  Equal(expected.ProtoJSONName),
+					"GoJSONName":     Equal(expected.GoJSONName),
 					"ProtoToGoType":  Equal(expected.ProtoToGoType),
 					"GoToProtoType":  Equal(expected.GoToProtoType),
 					"ProtoType":      Equal(expected.ProtoType),
 					"GoIsPointer":    Equal(expected.GoIsPointer),
 					"ProtoIsPointer": Equal(expected.ProtoIsPointer),
 					"UsePackage":     Equal(expected.UsePackage),
+					"Helper":         Equal(expected.Helper),
 					"OneofDecl":      Equal(expected.OneofDecl),
 					"Opts":           Equal(expected.Opts),
+					"EmbeddedFields": Equal(expected.EmbeddedFields),
+					"Elem":           Equal(expected.Elem),
+					"Wrapper":        Equal(expected.Wrapper),
+					"Enum":           Equal(expected.Enum),
+					"Dep":            Equal(expected.Dep),
+					"Signature":      Equal(expected.Signature),
+					"WithError":      Equal(expected.WithError),
+					"EmptySlice":     Equal(expected.EmptySlice),
+					"WithContext":    Equal(expected.WithContext),
+					"Promoted":       Equal(expected.Promoted),
+					"Edge":           Equal(expected.Edge),
+					"HelperCall":     Equal(expected.HelperCall),
+					"Case":           Equal(expected.Case),
+					"SkipPbToGo":     Equal(expected.SkipPbToGo),
+					"SkipGoToPb":     Equal(expected.SkipGoToPb),
 				}))
 			},
 
-			Entry("Int64", &descriptor.FieldDescriptorProto{Name: &protoField}, protoField, goField, "int64", mo, false, &Field{
+			Entry("Int64", &descriptorpb.FieldDescriptorProto{Name: &protoField}, protoField, goField, "int64", mo, false, &Field{
 				Name:           "StringField",
 				ProtoName:      "ProtoField",
 				ProtoType:      "Pb",
@@ -180,7 +909,7 @@ var _ = Describe("Field", func() {
 				Opts:           ", opts...",
 			}),
 
-			Entry("Custom field", &descriptor.FieldDescriptorProto{Name: &protoField, TypeName: &protoFieldTypeName}, protoField, goField, "int64", mo, true, &Field{
+			Entry("Custom field", &descriptorpb.FieldDescriptorProto{Name: &protoField, TypeName: &protoFieldTypeName}, protoField, goField, "int64", mo, true, &Field{
 				Name:           "StringField",
 				ProtoName:      "ProtoField",
 				ProtoType:      "PbCustomType",
@@ -193,7 +922,7 @@ var _ = Describe("Field", func() {
 				Opts:           ", opts...",
 			}),
 
-			Entry("With messageOption and empty oneof", &descriptor.FieldDescriptorProto{Name: &protoField}, protoField, goField, "int64", mo, false, &Field{
+			Entry("With messageOption and empty oneof", &descriptorpb.FieldDescriptorProto{Name: &protoField}, protoField, goField, "int64", mo, false, &Field{
 				Name:           "StringField",
 				ProtoName:      "ProtoField",
 				ProtoType:      "Pb",
@@ -206,7 +935,7 @@ var _ = Describe("Field", func() {
 				Opts:           ", opts...",
 			}),
 
-			Entry("With messageOption and non-empty oneof", &descriptor.FieldDescriptorProto{Name: &protoField}, protoField, goField, "int64", moWithOneOf, false, &Field{
+			Entry("With messageOption and non-empty oneof", &descriptorpb.FieldDescriptorProto{Name: &protoField}, protoField, goField, "int64", moWithOneOf, false, &Field{
 				Name:           "StringField",
 				ProtoName:      "ProtoField",
 				ProtoType:      "int64",
@@ -220,7 +949,7 @@ var _ = Describe("Field", func() {
 			}),
 
 			Entry("With messageOption, empty oneof, and fqdn type name",
-				&descriptor.FieldDescriptorProto{
+				&descriptorpb.FieldDescriptorProto{
 					Name: &protoField,
 				},
 				protoField, goField, "full.type", moWithOneOf, false,
@@ -238,7 +967,7 @@ var _ = Describe("Field", func() {
 				}),
 
 			Entry("Repeated field",
-				&descriptor.FieldDescriptorProto{
+				&descriptorpb.FieldDescriptorProto{
 					Name:  &protoField,
 					Label: &labelRepeated,
 				},
@@ -257,7 +986,7 @@ var _ = Describe("Field", func() {
 				}),
 
 			Entry("Repeated field when name field found in target struct.",
-				&descriptor.FieldDescriptorProto{
+				&descriptorpb.FieldDescriptorProto{
 					Name:  &protoField,
 					Label: &labelRepeated,
 				},
@@ -275,32 +1004,75 @@ var _ = Describe("Field", func() {
 					Opts:           ", opts...",
 				}),
 		)
+
+		It("marks fields of messages with transformer.with_errors option", func() {
+			desc := &descriptorpb.DescriptorProto{Name: sp("Address"), Options: &descriptorpb.MessageOptions{}}
+			proto.SetExtension(desc.Options, options.E_WithErrors, true)
+			s := source.Structure{"Address": source.FieldInfo{Type: "Address", IsPointer: true}}
+
+			got, err := processSubMessage(nil, &descriptorpb.FieldDescriptorProto{Name: sp("address")}, "Address", "Address", "Address",
+				messageOption{targetName: "Address", desc: desc}, s, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.WithError).To(BeTrue())
+			Expect(got.ProtoToGoType).To(Equal("PbToAddress"))
+		})
+
+		It("marks fields of messages with transformer.with_context option", func() {
+			desc := &descriptorpb.DescriptorProto{Name: sp("Address"), Options: &descriptorpb.MessageOptions{}}
+			proto.SetExtension(desc.Options, options.E_WithContext, true)
+			s := source.Structure{"Address": source.FieldInfo{Type: "Address", IsPointer: true}}
+
+			got, err := processSubMessage(nil, &descriptorpb.FieldDescriptorProto{Name: sp("address")}, "Address", "Address", "Address",
+				messageOption{targetName: "Address", desc: desc}, s, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.WithContext).To(BeTrue())
+			Expect(got.WithError).To(BeFalse())
+		})
 	})
 
 	Describe("ProcessSimpleField", func() {
 
 		var (
-			pint32  = descriptor.FieldDescriptorProto_TYPE_INT32
-			pint64  = descriptor.FieldDescriptorProto_TYPE_INT64
-			pstring = descriptor.FieldDescriptorProto_TYPE_STRING
+			pint32  = descriptorpb.FieldDescriptorProto_TYPE_INT32
+			pint64  = descriptorpb.FieldDescriptorProto_TYPE_INT64
+			pstring = descriptorpb.FieldDescriptorProto_TYPE_STRING
 		)
 
 		DescribeTable("check result",
-			func(pname, gname string, ftype *descriptor.FieldDescriptorProto_Type, sf source.FieldInfo, expected *Field) {
+			func(pname, gname string, ftype *descriptorpb.FieldDescriptorProto_Type, sf source.FieldInfo, expected *Field) {
 				got, err := processSimpleField(nil, pname, gname, ftype, sf)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(*got).To(MatchAllFields(Fields{
 					"Name":           Equal(expected.Name),
 					"ProtoName":      Equal(expected.ProtoName),
+					"ProtoOrigName":  Equal(expected.ProtoOrigName),
+					"ProtoJSONName":  Equal(expected.ProtoJSONName),
+					"GoJSONName":     Equal(expected.GoJSONName),
 					"ProtoToGoType":  Equal(expected.ProtoToGoType),
 					"GoToProtoType":  Equal(expected.GoToProtoType),
 					"ProtoType":      Equal(expected.ProtoType),
 					"GoIsPointer":    Equal(expected.GoIsPointer),
 					"ProtoIsPointer": Equal(expected.ProtoIsPointer),
 					"UsePackage":     Equal(expected.UsePackage),
+					"Helper":         Equal(expected.Helper),
 					"OneofDecl":      Equal(expected.OneofDecl),
 					"Opts":           Equal(expected.Opts),
+					"EmbeddedFields": Equal(expected.EmbeddedFields),
+					"Elem":           Equal(expected.Elem),
+					"Wrapper":        Equal(expected.Wrapper),
+					"Enum":           Equal(expected.Enum),
+					"Dep":            Equal(expected.Dep),
+					"Signature":      Equal(expected.Signature),
+					"WithError":      Equal(expected.WithError),
+					"EmptySlice":     Equal(expected.EmptySlice),
+					"WithContext":    Equal(expected.WithContext),
+					"Promoted":       Equal(expected.Promoted),
+					"Edge":           Equal(expected.Edge),
+					"HelperCall":     Equal(expected.HelperCall),
+					"Case":           Equal(expected.Case),
+					"SkipPbToGo":     Equal(expected.SkipPbToGo),
+					"SkipGoToPb":     Equal(expected.SkipGoToPb),
 				}))
 
 			},
@@ -357,6 +1129,7 @@ var _ = Describe("Field", func() {
 					GoIsPointer:    false,
 					ProtoIsPointer: false,
 					UsePackage:     true,
+					Helper:         helperScalar,
 					OneofDecl:      "",
 					Opts:           "",
 				}),
@@ -371,6 +1144,7 @@ var _ = Describe("Field", func() {
 					GoIsPointer:    false,
 					ProtoIsPointer: false,
 					UsePackage:     true,
+					Helper:         helperScalar,
 					OneofDecl:      "",
 					Opts:           "",
 				}),
@@ -385,12 +1159,68 @@ var _ = Describe("Field", func() {
 					GoIsPointer:    false,
 					ProtoIsPointer: false,
 					UsePackage:     true,
+					Helper:         helperScalar,
 					OneofDecl:      "",
 					Opts:           "",
 				}),
 		)
 	})
 
+	Describe("processGoTypeField", func() {
+
+		DescribeTable("check Field struct",
+			func(typ string, custom bool, gf source.FieldInfo, pnullable bool, expected Field) {
+				got := processGoTypeField("ProtoName", "Name", typ, custom, gf, pnullable)
+				expected.Name, expected.ProtoName = "Name", "ProtoName"
+				Expect(*got).To(Equal(expected))
+			},
+
+			Entry("Cast type, equal types", "pkg.MyInt", false, source.FieldInfo{Type: "pkg.MyInt"}, false, Field{}),
+			Entry("Cast type of model package", "model.MyInt", false, source.FieldInfo{Type: "MyInt"}, false, Field{}),
+			Entry("Cast type to basic type", "pkg.MyInt", false, source.FieldInfo{Type: "int64"}, false, Field{
+				ProtoToGoType: "int64",
+				GoToProtoType: "pkg.MyInt",
+			}),
+			Entry("Cast type to struct", "pkg.MyInt", false, source.FieldInfo{Type: "nulls.Int"}, false, Field{
+				ProtoToGoType: "MyIntToNullsInt",
+				GoToProtoType: "NullsIntToMyInt",
+				UsePackage:    true,
+				Helper:        helperCustom,
+			}),
+			Entry("Custom type, equal types", "uuid.UUID", true, source.FieldInfo{Type: "uuid.UUID", IsPointer: true}, true, Field{
+				ProtoIsPointer: true,
+				GoIsPointer:    true,
+			}),
+			Entry("Nullable custom type to string", "uuid.UUID", true, source.FieldInfo{Type: "string"}, true, Field{
+				ProtoToGoType:  "UUIDToString",
+				GoToProtoType:  "StringToUUID",
+				ProtoIsPointer: true,
+				UsePackage:     true,
+				Helper:         helperCustom,
+			}),
+		)
+	})
+
+	Describe("extractGoTypeOption", func() {
+
+		DescribeTable("check returns",
+			func(ext protoreflect.ExtensionType, value, expected string, custom bool) {
+				fdp := &descriptorpb.FieldDescriptorProto{Options: &descriptorpb.FieldOptions{}}
+				if ext != nil {
+					proto.SetExtension(fdp.Options, ext, value)
+				}
+
+				typ, c := extractGoTypeOption(fdp)
+				Expect(typ).To(Equal(expected))
+				Expect(c).To(Equal(custom))
+			},
+
+			Entry("No options", nil, "", "", false),
+			Entry("Cast type", gogoproto.E_Casttype, "github.com/org/pkg.MyInt", "pkg.MyInt", false),
+			Entry("Custom type", gogoproto.E_Customtype, "github.com/google/uuid.UUID", "uuid.UUID", true),
+		)
+	})
+
 	Describe("prepareFieldNames", func() {
 
 		DescribeTable("parameter combinations",
@@ -412,18 +1242,218 @@ var _ = Describe("Field", func() {
 
 	})
 
-	Describe("processField", func() {
+	Describe("processEmbeddedField", func() {
+
+		var (
+			typString = descriptorpb.FieldDescriptorProto_TYPE_STRING
+			embedded  = messageOption{
+				desc: &descriptorpb.DescriptorProto{
+					Name: sp("Embedded"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("field"), Type: &typString, Options: &descriptorpb.FieldOptions{}},
+					},
+				},
+			}
+			messages = MessageOptionList{"pkg.Embedded": embedded}
+			fields   = source.Structure{
+				"PrefField": {Type: "string"},
+				"Field":     {Type: "string", Tag: `json:"field"`},
+			}
+		)
 
 		DescribeTable("check result",
-			func(f *descriptor.FieldDescriptorProto, skip, embed bool, expected *Field, expectedErr error) {
+			func(prefix string, expected *Field) {
+				fdp := &descriptorpb.FieldDescriptorProto{
+					Name:     sp("sub_message"),
+					Type:     &typMessage,
+					TypeName: sp(".pkg.Embedded"),
+					Options:  &descriptorpb.FieldOptions{},
+				}
 
-				err := proto.SetExtension(f.Options, options.E_Skip, bp(skip))
-				Expect(err).NotTo(HaveOccurred())
+				if prefix != "" {
+					proto.SetExtension(fdp.Options, options.E_EmbeddedPrefix, prefix)
+				}
 
-				err = proto.SetExtension(f.Options, options.E_Embed, bp(embed))
+				got, err := processEmbeddedField(nil, fdp, messages, fields, policies{})
 				Expect(err).NotTo(HaveOccurred())
+				Expect(got).To(Equal(expected))
+			},
+
+			Entry("Without prefix", "", &Field{
+				ProtoName:      "SubMessage",
+				ProtoOrigName:  "sub_message",
+				ProtoJSONName:  "subMessage",
+				ProtoType:      "Embedded",
+				ProtoIsPointer: true,
+				EmbeddedFields: []Field{
+					{Name: "Field", ProtoName: "Field", ProtoOrigName: "sub_message.field", ProtoJSONName: "subMessage.field", GoJSONName: "field",
+						Signature: "sub_message.field LABEL_OPTIONAL TYPE_STRING => Field string"},
+				},
+			}),
+
+			Entry("With prefix", "Pref", &Field{
+				ProtoName:      "SubMessage",
+				ProtoOrigName:  "sub_message",
+				ProtoJSONName:  "subMessage",
+				ProtoType:      "Embedded",
+				ProtoIsPointer: true,
+				EmbeddedFields: []Field{
+					{Name: "PrefField", ProtoName: "Field", ProtoOrigName: "sub_message.field", ProtoJSONName: "subMessage.field", GoJSONName: "PrefField",
+						Signature: "sub_message.field LABEL_OPTIONAL TYPE_STRING => PrefField string"},
+				},
+			}),
+		)
+
+		DescribeTable("check errors",
+			func(fdp *descriptorpb.FieldDescriptorProto, expected string) {
+				_, err := processEmbeddedField(nil, fdp, messages, fields, policies{})
+				Expect(err).To(MatchError(expected))
+			},
+
+			Entry("Not a message", &descriptorpb.FieldDescriptorProto{
+				Name: sp("scalar"),
+				Type: &typString,
+			}, `field "scalar" is not a message, it can not be embedded`),
+
+			Entry("Repeated message", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("list"),
+				Type:     &typMessage,
+				TypeName: sp(".pkg.Embedded"),
+				Label:    &labelRepeated,
+			}, `repeated field "list" can not be embedded`),
+
+			Entry("Unknown message", &descriptorpb.FieldDescriptorProto{
+				Name:     sp("unknown"),
+				Type:     &typMessage,
+				TypeName: sp(".pkg.Unknown"),
+			}, `embedded message ".pkg.Unknown" not found`),
+		)
+	})
+
+	Describe("protoJSONName", func() {
+
+		DescribeTable("check result",
+			func(fdp *descriptorpb.FieldDescriptorProto, expected string) {
+				Expect(protoJSONName(fdp)).To(Equal(expected))
+			},
+
+			Entry("json_name is set", &descriptorpb.FieldDescriptorProto{Name: sp("field_name"), JsonName: sp("customName")}, "customName"),
+			Entry("Snake case", &descriptorpb.FieldDescriptorProto{Name: sp("map_field_1")}, "mapField1"),
+			Entry("Single word", &descriptorpb.FieldDescriptorProto{Name: sp("id")}, "id"),
+			Entry("Capitalized", &descriptorpb.FieldDescriptorProto{Name: sp("ID")}, "ID"),
+		)
+	})
+
+	Describe("goJSONName", func() {
+
+		DescribeTable("check result",
+			func(name, tag, expected string) {
+				Expect(goJSONName(name, tag)).To(Equal(expected))
+			},
+
+			Entry("No tag", "FieldName", "", "FieldName"),
+			Entry("Tag without json", "FieldName", `db:"field_name"`, "FieldName"),
+			Entry("Json tag", "FieldName", `db:"field" json:"field_name"`, "field_name"),
+			Entry("Json tag with options", "FieldName", `json:"field_name,omitempty"`, "field_name"),
+			Entry("Json tag options only", "FieldName", `json:",omitempty"`, "FieldName"),
+			Entry("Ignored field", "FieldName", `json:"-"`, "-"),
+		)
+	})
+
+	Describe("model_pointer option", func() {
+
+		messages := MessageOptionList{
+			"pkg.Address": messageOption{targetName: "Address", desc: &descriptorpb.DescriptorProto{}},
+		}
+
+		field := func(mp options.ModelPointer) *descriptorpb.FieldDescriptorProto {
+			fdp := &descriptorpb.FieldDescriptorProto{Name: sp("address"), Type: &typMessage, TypeName: sp(".pkg.Address"), Options: &descriptorpb.FieldOptions{}}
+			proto.SetExtension(fdp.Options, options.E_ModelPointer, mp)
+			return fdp
+		}
+
+		It("treats model field as a pointer", func() {
+			// e.g. type AddressRef = *Address
+			s := source.Structure{"Address": {Type: "AddressRef"}}
+
+			f, err := processField(nil, field(options.ModelPointer_MODEL_POINTER), messages, s, policies{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.GoIsPointer).To(BeTrue())
+			Expect(f.Signature).To(HaveSuffix("=> Address *AddressRef"))
+			Expect(s["Address"].IsPointer).To(BeFalse(), "model structure is not changed")
+		})
+
+		It("treats model field as a value", func() {
+			s := source.Structure{"Address": {Type: "Address", IsPointer: true}}
+
+			f, err := processField(nil, field(options.ModelPointer_MODEL_VALUE), messages, s, policies{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.GoIsPointer).To(BeFalse())
+		})
+	})
+
+	Describe("promoted model fields", func() {
+
+		s := source.Structure{"ID": {Type: "int64", Promoted: "BaseModel"}}
+
+		field := func(mapTo string) *descriptorpb.FieldDescriptorProto {
+			fdp := &descriptorpb.FieldDescriptorProto{Name: sp("id"), Type: &typInt64, Options: &descriptorpb.FieldOptions{}}
+			if mapTo != "" {
+				proto.SetExtension(fdp.Options, options.E_MapTo, mapTo)
+			}
+			return fdp
+		}
+
+		It("skips promoted field without transformer.flatten_embedded option", func() {
+			_, err := processField(nil, field(""), nil, s, policies{})
+			Expect(err).To(MatchError(newLoggableError("field skipped: id, model field ID is promoted from embedded structure BaseModel").
+				withHint(`set (transformer.flatten_embedded) = true option of the message or (transformer.map_to) = "ID" option of the field`)))
+		})
+
+		It("matches promoted field of message with transformer.flatten_embedded option", func() {
+			f, err := processField(nil, field(""), nil, s, policies{flattenEmbedded: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Name).To(Equal("ID"))
+			Expect(f.Promoted).To(BeTrue())
+		})
+
+		It("matches promoted field pointed by transformer.map_to option", func() {
+			f, err := processField(nil, field("ID"), nil, s, policies{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Promoted).To(BeTrue())
+		})
+
+		It("matches field promoted from gorm.Model in GORM model style", func() {
+			s := source.Structure{"ID": {Type: "uint", Promoted: "Model"}}
+
+			f, err := processField(nil, field(""), nil, s, policies{modelStyle: options.ModelStyle_GORM})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Promoted).To(BeTrue())
+
+			_, err = processField(nil, field(""), nil, s, policies{})
+			Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		})
+
+		It("matches edge of ent entity with path to Edges field", func() {
+			s := source.Structure{"ID": {Type: "int64", Edge: true}}
+
+			f, err := processField(nil, field(""), nil, s, policies{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Name).To(Equal("Edges.ID"))
+			Expect(f.Edge).To(BeTrue())
+		})
+	})
+
+	Describe("processField", func() {
+
+		DescribeTable("check result",
+			func(f *descriptorpb.FieldDescriptorProto, skip, embed bool, expected *Field, expectedErr error) {
+
+				proto.SetExtension(f.Options, options.E_Skip, skip)
+
+				proto.SetExtension(f.Options, options.E_Embed, embed)
 
-				field, err := processField(nil, f, subm, goStruct)
+				field, err := processField(nil, f, subm, goStruct, policies{})
 				if expectedErr == nil {
 					Expect(err).NotTo(HaveOccurred())
 				} else {
@@ -435,26 +1465,48 @@ var _ = Describe("Field", func() {
 					Expect(*field).To(MatchAllFields(Fields{
 						"Name":           Equal(expected.Name),
 						"ProtoName":      Equal(expected.ProtoName),
+						"ProtoOrigName":  Equal(expected.ProtoOrigName),
+						"ProtoJSONName":  Equal(expected.ProtoJSONName),
+						"GoJSONName":     Equal(expected.GoJSONName),
 						"ProtoToGoType":  Equal(expected.ProtoToGoType),
 						"GoToProtoType":  Equal(expected.GoToProtoType),
 						"ProtoType":      Equal(expected.ProtoType),
 						"GoIsPointer":    Equal(expected.GoIsPointer),
 						"ProtoIsPointer": Equal(expected.ProtoIsPointer),
 						"UsePackage":     Equal(expected.UsePackage),
+						"Helper":         Equal(expected.Helper),
 						"OneofDecl":      Equal(expected.OneofDecl),
 						"Opts":           Equal(expected.Opts),
+						"EmbeddedFields": Equal(expected.EmbeddedFields),
+						"Elem":           Equal(expected.Elem),
+						"Wrapper":        Equal(expected.Wrapper),
+						"Enum":           Equal(expected.Enum),
+						"Dep":            Equal(expected.Dep),
+						"Signature":      Equal(expected.Signature),
+						"WithError":      Equal(expected.WithError),
+						"EmptySlice":     Equal(expected.EmptySlice),
+						"WithContext":    Equal(expected.WithContext),
+						"Promoted":       Equal(expected.Promoted),
+						"Edge":           Equal(expected.Edge),
+						"HelperCall":     Equal(expected.HelperCall),
+						"Case":           Equal(expected.Case),
+						"SkipPbToGo":     Equal(expected.SkipPbToGo),
+						"SkipGoToPb":     Equal(expected.SkipGoToPb),
 					}))
 				}
 			},
 
-			Entry("int64", &descriptor.FieldDescriptorProto{
+			Entry("int64", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("int64_field"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, false, &Field{
 				Name:           "Int64Field",
 				ProtoName:      "Int64Field",
+				ProtoOrigName:  "int64_field",
+				ProtoJSONName:  "int64Field",
+				GoJSONName:     "Int64Field",
 				ProtoType:      "",
 				ProtoToGoType:  "",
 				GoToProtoType:  "",
@@ -463,16 +1515,20 @@ var _ = Describe("Field", func() {
 				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           "",
+				Signature:      "int64_field LABEL_OPTIONAL int64 => Int64Field int64",
 			}, nil),
 
-			Entry("int64: capitalized ID", &descriptor.FieldDescriptorProto{
+			Entry("int64: capitalized ID", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("ID"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, false, &Field{
 				Name:           "ID",
 				ProtoName:      "ID",
+				ProtoOrigName:  "ID",
+				ProtoJSONName:  "ID",
+				GoJSONName:     "ID",
 				ProtoType:      "",
 				ProtoToGoType:  "",
 				GoToProtoType:  "",
@@ -481,16 +1537,20 @@ var _ = Describe("Field", func() {
 				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           "",
+				Signature:      "ID LABEL_OPTIONAL int64 => ID int64",
 			}, nil),
 
-			Entry("int64: id", &descriptor.FieldDescriptorProto{
+			Entry("int64: id", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("id"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, false, &Field{
 				Name:           "ID",
 				ProtoName:      "Id",
+				ProtoOrigName:  "id",
+				ProtoJSONName:  "id",
+				GoJSONName:     "ID",
 				ProtoType:      "",
 				ProtoToGoType:  "",
 				GoToProtoType:  "",
@@ -499,30 +1559,34 @@ var _ = Describe("Field", func() {
 				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           "",
+				Signature:      "id LABEL_OPTIONAL int64 => ID int64",
 			}, nil),
 
-			Entry("Skip", &descriptor.FieldDescriptorProto{
+			Entry("Skip", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("int64_field"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, true, false, nil, newLoggableError("field skipped: int64_field")),
 
-			Entry("Target field not found", &descriptor.FieldDescriptorProto{
+			Entry("Target field not found", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("not_exists"),
 				TypeName: sp("int64"),
 				Type:     &typInt64,
-				Options:  &descriptor.FieldOptions{},
-			}, false, false, nil, pkgerrors.Wrap(errors.New("field not found in destination structure"), "NotExists")),
+				Options:  &descriptorpb.FieldOptions{},
+			}, false, false, nil, pkgerrors.Wrap(errors.New("field not found in destination structure; hint: add field NotExists to model, use (transformer.map_to) option if model field has another name or skip the field with (transformer.skip) = true"), "NotExists")),
 
-			Entry("embed", &descriptor.FieldDescriptorProto{
+			Entry("embed", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("PkgTypeField"),
 				TypeName: sp(".PkgType"),
 				Type:     &typMessage,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, true, &Field{
 				Name:           "PkgField",
 				ProtoName:      "PkgTypeField",
+				ProtoOrigName:  "PkgTypeField",
+				ProtoJSONName:  "PkgTypeField",
+				GoJSONName:     "PkgField",
 				ProtoType:      "Pb",
 				ProtoToGoType:  "PbToPkgField",
 				GoToProtoType:  "PkgFieldToPb",
@@ -531,16 +1595,20 @@ var _ = Describe("Field", func() {
 				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           ", opts...",
+				Signature:      "PkgTypeField LABEL_OPTIONAL .PkgType => PkgField pkg.Type",
 			}, nil),
 
-			Entry("WKT: Timestamp", &descriptor.FieldDescriptorProto{
+			Entry("WKT: Timestamp", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("time_field"),
 				TypeName: sp(".google.protobuf.Timestamp"),
 				Type:     &typMessage,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, true, &Field{
 				Name:           "TimeField",
 				ProtoName:      "TimeField",
+				ProtoOrigName:  "time_field",
+				ProtoJSONName:  "timeField",
+				GoJSONName:     "TimeField",
 				ProtoType:      "",
 				ProtoToGoType:  "",
 				GoToProtoType:  "",
@@ -549,24 +1617,32 @@ var _ = Describe("Field", func() {
 				UsePackage:     false,
 				OneofDecl:      "",
 				Opts:           "",
+				// nullable proto field is *time.Time, nil becomes zero value.
+				Wrapper:   &Elem{Kind: elemValue, ProtoType: "time.Time", GoType: "time.Time", ProtoIsPointer: true},
+				Signature: "time_field LABEL_OPTIONAL .google.protobuf.Timestamp => TimeField time.Time",
 			}, nil),
 
-			Entry("WKT: StringValue", &descriptor.FieldDescriptorProto{
+			Entry("WKT: StringValue", &descriptorpb.FieldDescriptorProto{
 				Name:     sp("string_field"),
 				TypeName: sp(".google.protobuf.StringValue"),
 				Type:     &typMessage,
-				Options:  &descriptor.FieldOptions{},
+				Options:  &descriptorpb.FieldOptions{},
 			}, false, true, &Field{
 				Name:           "StringField",
 				ProtoName:      "StringField",
+				ProtoOrigName:  "string_field",
+				ProtoJSONName:  "stringField",
+				GoJSONName:     "StringField",
 				ProtoType:      "",
 				ProtoToGoType:  "StringValueToString",
 				GoToProtoType:  "StringToStringValue",
 				GoIsPointer:    false,
 				ProtoIsPointer: false,
 				UsePackage:     true,
+				Helper:         helperWrappers,
 				OneofDecl:      "",
 				Opts:           "",
+				Signature:      "string_field LABEL_OPTIONAL .google.protobuf.StringValue => StringField string",
 			}, nil),
 		)
 
@@ -4,26 +4,78 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"text/template"
 
 	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
 	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
-	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 var (
 	// header is a header for each generated files.
 	header = "// Code generated by protoc-gen-struct-transformer, version: %s. DO NOT EDIT.\n"
 
+	// headerTemplate replaces header if it's set, see SetHeader.
+	headerTemplate *template.Template
+
+	// sourceRelative is true if generated files are placed next to .proto
+	// files, see SetPaths.
+	sourceRelative bool
+
+	// modulePrefix is removed from output paths in import mode, see
+	// SetPaths.
+	modulePrefix string
+
+	// disableReverse is true if functions which transform models into proto
+	// messages are not generated, see SetDisableReverse.
+	disableReverse bool
+
 	// Next three variables are set by "make install" command and are used as
 	// version information. See Makefile for details.
 	version   = "<dev>"
 	buildTime = "<build_time>"
 )
 
+// SetPaths sets mode of output paths, it's the same as paths parameter of
+// protoc-gen-go: "import" (or empty string) places generated file into package
+// directory next to .proto file, see use-package-in-path parameter,
+// "source_relative" places generated file next to .proto file it's generated
+// from. Prefix module is removed from output paths of import mode like module
+// parameter of protoc-gen-go, it can't be used in source_relative mode.
+func SetPaths(mode, module string) error {
+	switch mode {
+	case "", "import":
+		sourceRelative = false
+	case "source_relative":
+		sourceRelative = true
+	default:
+		return fmt.Errorf("paths: unknown value %q, should be one of: import, source_relative", mode)
+	}
+
+	if sourceRelative && module != "" {
+		return fmt.Errorf("module: parameter can be used with paths=import only, got paths=%s", mode)
+	}
+	modulePrefix = strings.Trim(module, "/")
+
+	return nil
+}
+
+// SetDisableReverse turns off generation of functions which transform models
+// into proto messages, e.g. for read-only services.
+func SetDisableReverse(on bool) {
+	disableReverse = on
+}
+
 // WriteStringer exposes two methods:
 // Write(p []byte) (n int, err error)
 // String() string.
@@ -37,17 +89,78 @@ func Version() string {
 	return fmt.Sprintf("version: %s\nbuild-time: %s\n", version, buildTime)
 }
 
+// HeaderData contains data for custom header template, see SetHeader.
+type HeaderData struct {
+	// Generator version.
+	Version string
+	// Name of source .proto file, empty for helper files, such as options.go.
+	SourceFile string
+	// Package name of source .proto file, empty for helper files.
+	SourcePackage string
+}
+
+// SetHeader replaces default header of generated files with text/template
+// tpl, template is executed with HeaderData. Empty tpl restores default
+// header.
+func SetHeader(tpl string) error {
+	if tpl == "" {
+		headerTemplate = nil
+		return nil
+	}
+
+	t, err := template.New("header").Parse(tpl)
+	if err != nil {
+		return pkgerrors.Wrap(err, "header template")
+	}
+
+	// check template execution, so it never fails later.
+	if err := t.Execute(ioutil.Discard, HeaderData{}); err != nil {
+		return pkgerrors.Wrap(err, "header template")
+	}
+
+	headerTemplate = t
+
+	return nil
+}
+
+// customHeader returns WriteStringer with header rendered by custom header
+// template.
+func customHeader(hd HeaderData) WriteStringer {
+	w := &bytes.Buffer{}
+	_ = headerTemplate.Execute(w, hd)
+
+	if b := w.Bytes(); len(b) > 0 && b[len(b)-1] != '\n' {
+		w.WriteByte('\n')
+	}
+
+	return w
+}
+
 // output initializes io.Writer with information about current version.
 func output() WriteStringer {
+	if headerTemplate != nil {
+		return customHeader(HeaderData{Version: version})
+	}
+
 	return bytes.NewBufferString(fmt.Sprintf(header, version))
 }
 
 // fileHeader adds source file/package info into initialized header.
 func fileHeader(srcFileName, srcFilePackage, dstPackage string) WriteStringer {
-	w := output()
+	var w WriteStringer
+
+	if headerTemplate != nil {
+		w = customHeader(HeaderData{
+			Version:       version,
+			SourceFile:    srcFileName,
+			SourcePackage: srcFilePackage,
+		})
+	} else {
+		w = output()
+		fmt.Fprintln(w, "// source file:", srcFileName)
+		fmt.Fprintln(w, "// source package:", srcFilePackage)
+	}
 
-	fmt.Fprintln(w, "// source file:", srcFileName)
-	fmt.Fprintln(w, "// source package:", srcFilePackage)
 	fmt.Fprintln(w, "\npackage", dstPackage)
 
 	return w
@@ -56,19 +169,53 @@ func fileHeader(srcFileName, srcFilePackage, dstPackage string) WriteStringer {
 // CollectAllMessages processes all files passed within plugin request to
 // collect info about all incoming messages. Generator should have information
 // about all messages regardless have those messages transformer options or
-// haven't.
-func CollectAllMessages(req plugin.CodeGeneratorRequest) (MessageOptionList, error) {
+// haven't. Messages with transformer.target_message option are pointed to
+// their target messages, see resolveTargetMessages.
+func CollectAllMessages(req *pluginpb.CodeGeneratorRequest) (MessageOptionList, error) {
 	mol := MessageOptionList{}
 
+	protoTypes = collectProtoTypes(req.ProtoFile)
+	if err := resolveTargetMessages(req.ProtoFile, protoTypes); err != nil {
+		return nil, err
+	}
+
 	for _, f := range req.ProtoFile {
-		for _, m := range f.MessageType {
+		nf, err := newFuncNameFormats(f.Options)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", f.GetName(), err)
+		}
+		funcs := map[string]string{}
+		tp, _ := getStringOption(f.Options, options.E_GoTransformerPackage)
+		_, ip := goPackage(f.GetOptions())
+
+		for _, fm := range fileMessages(f.MessageType, "") {
+			m := fm.desc
 			structName, _ := extractStructNameOption(m)
 
 			so := messageOption{
 				targetName: structName,
+				desc:       m,
+				goName:     fm.goName(),
+
+				fileName:           f.GetName(),
+				goPackage:          ip,
+				transformerPackage: tp,
+			}
+
+			if nf != nil && structName != "" {
+				if so.pbToGo, so.goToPb, err = nf.names(fm.goName(), structName); err != nil {
+					return nil, fmt.Errorf("%s: %s", f.GetName(), err)
+				}
+
+				for _, name := range []string{so.pbToGo, so.goToPb} {
+					if other, ok := funcs[name]; ok {
+						return nil, fmt.Errorf("%s: transformers of messages %s and %s have the same name %s", f.GetName(), other, fm.name, name)
+					}
+					funcs[name] = fm.name
+				}
 			}
 
-			if len(m.OneofDecl) > 0 {
+			if oneofs := realOneofs(m); len(oneofs) > 0 {
 				hasInt64Value := false
 				hasStringValue := false
 				// Check if it implements a specific case of migration from Int64ToString
@@ -85,124 +232,712 @@ func CollectAllMessages(req plugin.CodeGeneratorRequest) (MessageOptionList, err
 
 				int64ToStringOneOf := len(m.Field) == 2 && hasInt64Value && hasStringValue
 
-				if int64ToStringOneOf && len(m.OneofDecl) == 1 {
-					so.oneofDecl = *m.OneofDecl[0].Name
+				if int64ToStringOneOf && len(oneofs) == 1 {
+					so.oneofDecl = oneofs[0].GetName()
 				}
 			}
 
-			mol[fmt.Sprintf("%s.%s", *f.Package, *m.Name)] = so
+			mol[fmt.Sprintf("%s.%s", *f.Package, fm.name)] = so
 		}
 	}
 
 	return mol, nil
 }
 
-// modelsPath returns absolute path to file with models or an error if
-// transformer.go_models_file_path option not found.
-func modelsPath(m proto.Message) (string, error) {
+// fileMessage is a message declared in proto file, top-level or nested one.
+type fileMessage struct {
+	// Message name relative to proto package, e.g. Order.Item.
+	name string
+	desc *descriptorpb.DescriptorProto
+	// Path of SourceCodeInfo location of the message, e.g. [4 0 3 1] for the
+	// second nested message of the first message, see sourceLocations.
+	path []int32
+}
+
+// goName returns name of Go structure generated for the message, e.g.
+// Order_Item for nested message Item of message Order.
+func (fm fileMessage) goName() string {
+	return strings.Replace(fm.name, ".", "_", -1)
+}
+
+// fileMessages returns messages msgs together with their nested messages,
+// including map entries, parent messages precede nested ones. Parent is a
+// name of parent message, empty for top-level messages.
+func fileMessages(msgs []*descriptorpb.DescriptorProto, parent string) []fileMessage {
+	return nestedMessages(msgs, parent, []int32{messageTypePath})
+}
+
+// nestedMessages returns messages msgs of parent message with location path,
+// see fileMessages.
+func nestedMessages(msgs []*descriptorpb.DescriptorProto, parent string, path []int32) []fileMessage {
+	out := []fileMessage{}
+
+	for i, m := range msgs {
+		name := m.GetName()
+		if parent != "" {
+			name = parent + "." + name
+		}
+
+		mp := append(append([]int32{}, path...), int32(i))
+		out = append(out, fileMessage{name: name, desc: m, path: mp})
+		out = append(out, nestedMessages(m.NestedType, name, append(append([]int32{}, mp...), nestedTypePath))...)
+	}
+
+	return out
+}
+
+// modelsPaths returns absolute paths to files with models from
+// comma-separated list of transformer.go_models_file_path option or an error
+// if option not found.
+func modelsPaths(m proto.Message) ([]string, error) {
 	optionPath, err := getStringOption(m, options.E_GoModelsFilePath)
 	if err != nil {
-		return "", ErrFileSkipped
+		return nil, ErrFileSkipped
+	}
+
+	paths := []string{}
+	for _, p := range strings.Split(optionPath, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+
+		path, err := filepath.Abs(p)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	if len(paths) == 0 {
+		return nil, ErrFileSkipped
 	}
 
-	path, err := filepath.Abs(optionPath)
+	return paths, nil
+}
+
+// modelsOptions are file options which point source of models, only one of
+// them can be set.
+var modelsOptions = []protoreflect.ExtensionType{
+	options.E_GoModelsFilePath,
+	options.E_GoModelsDir,
+	options.E_GoModelsImportPath,
+}
+
+// parseModels returns structures of models and paths to their source files
+// by one of transformer.go_models_file_path, transformer.go_models_dir and
+// transformer.go_models_import_path options of file options m.
+func parseModels(m proto.Message) (source.StructureList, []string, error) {
+	set := []string{}
+	for _, opt := range modelsOptions {
+		if hasOption(m, opt) {
+			set = append(set, "("+optionName(opt)+")")
+		}
+	}
+
+	if len(set) > 1 {
+		return nil, nil, fmt.Errorf("options %s can not be used together", strings.Join(set, " and "))
+	}
+
+	if ip, err := getStringOption(m, options.E_GoModelsImportPath); err == nil && ip != "" {
+		return source.ParseImportPath(ip)
+	}
+
+	if dir, err := getStringOption(m, options.E_GoModelsDir); err == nil && dir != "" {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return source.ParseDir(abs)
+	}
+
+	paths, err := modelsPaths(m)
 	if err != nil {
-		return "", err
+		return nil, nil, err
 	}
 
-	return path, nil
+	structs, err := source.ParseFiles(paths)
+	return structs, paths, err
 }
 
-// ProcessFile processes .proto file and returns content as a string.
-func ProcessFile(f *descriptor.FileDescriptorProto, packageName, helperPackageName *string, messages MessageOptionList, debug, usePackageInPath bool) (string, string, error) {
-	path, err := modelsPath(f.Options)
+// ProcessFile processes .proto file and returns content as a string. If
+// helperPackagePath is not empty, helper package is imported into generated
+// file. Transformers are generated into package packageName, which is
+// replaced by fallback package before files are processed if it would create
+// an import cycle, see ResolvePackage. Output path depends on paths mode, see
+// SetPaths. Generation modes are set by SetVerify, SetDisableReverse,
+// SetModelFirst, SetConverter and other setters.
+func ProcessFile(f *descriptorpb.FileDescriptorProto, packageName, helperPackageName, helperPackagePath *string, messages MessageOptionList, debug, usePackageInPath bool) (string, string, error) {
+	if !fileFilter.match(f.GetName()) {
+		return "", "", ErrFileSkipped
+	}
+
+	// file with package defaults may contain options only.
+	if extractPackageDefaultsOption(f.Options) && len(f.MessageType) == 0 {
+		return "", "", ErrFileSkipped
+	}
+
+	msgs := optedIn(fileMessages(f.MessageType, ""))
+	if optIn && len(msgs) == 0 {
+		return "", "", ErrFileSkipped
+	}
+
+	// messages with several models are processed once per model.
+	msgs, err := expandTargets(msgs)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: %s", f.GetName(), err)
+	}
+
+	// messages of file can be transformed into other proto messages only.
+	structs, paths, err := parseModels(f.Options)
+	if err == ErrFileSkipped && hasTargetMessages(msgs) {
+		structs, err = source.StructureList{}, nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	targets := targetStructures(msgs, structs)
+
+	source.PromoteEntEdges(structs)
+	if extractPolicies(f.Options).modelStyle == options.ModelStyle_GORM {
+		source.PromoteGormModel(structs)
+	}
+
+	ext, err := externalStructures(paths, msgs, structs)
+	if err != nil {
+		return "", "", err
+	}
+
+	// package names default to names of models and go_package packages, so
+	// generated code refers to packages which are imported.
+	modelName, modelPath := modelsPackage(f.Options, paths)
+	repoPackage, err := getStringOption(f.Options, options.E_GoRepoPackage)
+	if err != nil {
+		repoPackage = modelName
+		if repoPackage == "" {
+			repoPackage = "repo1"
+		}
+	}
+
+	pbName, pbPath := goPackage(f.GetOptions())
+	protoPackage, err := getStringOption(f.Options, options.E_GoProtobufPackage)
+	if err != nil {
+		protoPackage = pbName
+		if protoPackage == "" {
+			protoPackage = "pb1"
+		}
+	}
+
+	wrappersPackage, err := getStringOption(f.Options, options.E_GoWrappersPackage)
+	if err != nil {
+		wrappersPackage = "types"
+	}
+
+	hp, err := helperPackages(f.Options, *helperPackageName, *helperPackagePath)
 	if err != nil {
 		return "", "", err
 	}
 
-	structs, err := source.Parse(path, nil)
+	pn := ""
+	if usePackageInPath {
+		pn = *packageName
+	}
+
+	// transformers of messages of other files can be generated into other
+	// packages, such messages get qualified transformer names.
+	messages, cross, err := crossPackageMessages(f, msgs, messages, *packageName, pn)
 	if err != nil {
 		return "", "", err
 	}
 
 	w := fileHeader(*f.Name, *f.Package, *packageName)
 
+	if cycleNote != "" {
+		p(w, "\n// %s\n", cycleNote)
+	}
+
 	if debug {
 		p(w, "%s", messages)
 	}
 
-	repoPackage, err := getStringOption(f.Options, options.E_GoRepoPackage)
+	nf, err := newFuncNameFormats(f.Options)
 	if err != nil {
-		repoPackage = "repo1"
+		return "", "", fmt.Errorf("%s: %s", f.GetName(), err)
 	}
 
-	protoPackage, err := getStringOption(f.Options, options.E_GoProtobufPackage)
-	if err != nil {
-		protoPackage = "pb1"
+	pol := extractPolicies(f.Options)
+	if pol.modelStyle == options.ModelStyle_MODEL_STYLE_PLAIN && source.SqlcGenerated(paths) {
+		pol.modelStyle = options.ModelStyle_SQLC
 	}
 
+	// imports of helper packages are known after processing of all messages,
+	// so messages are rendered into body and added to w after imports.
+	body := &bytes.Buffer{}
+	imports := importTracker{}
+	imports.add(ext...)
+	imports.add(cross...)
+	imports.add(targets...)
+
 	var data []*Data
+	var merges []*mergeFunc
+	var gaps []string
+	var covered []messageCoverage
+	var diags, unmapped []string
+	var dms []debugMessage
+	var rts []roundTripTest
+	sl := newSourceLocations(f)
+
+	for _, fm := range msgs {
+		m := fm.desc
+		if m.GetOptions().GetMapEntry() {
+			continue
+		}
+
+		if !messageFilter.match(fm.name, f.GetPackage()+"."+fm.name) {
+			p(body, "// message %q is skipped by message filter\n", fm.name)
+			continue
+		}
+
+		dir := extractDirectionOption(m.Options)
+		if dir == options.Direction_GO_TO_PB && disableReverse {
+			p(body, "// message %q is skipped, it has (%s) = %s option and reverse functions are disabled\n", fm.name, options.E_Direction.Name, dir)
+			continue
+		}
+		noReverse := disableReverse || dir == options.Direction_PB_TO_GO
 
-	for _, m := range f.MessageType {
-		fields, sno, err := processMessage(w, m, messages, structs, debug)
+		// patch messages are merged into models instead of transformers.
+		if target := extractMergeOption(m.Options); target != "" {
+			mf, err := newMergeFunc(body, fm, target, messages, structs, protoPackage, repoPackage)
+			if err != nil {
+				return "", "", err
+			}
+			merges = append(merges, mf)
+			for _, f := range mf.Fields {
+				imports.add(changeImports(f.Changed)...)
+			}
+			continue
+		}
+
+		full := strings.TrimPrefix(f.GetPackage()+"."+fm.name, ".")
+		fields, sno, fr, err := processMessage(body, m, messages, structs, pol, debug)
 		if err != nil {
 			if e, ok := err.(loggableError); ok {
-				p(w, "// %s\n", e)
+				p(body, "// %s\n", e)
 				continue
 			}
+			return "", "", fmt.Errorf("%s: message %s: %s", sl.position(fm.path...), full, err)
+		}
+
+		modelPackage, modelName := splitTarget(sno, repoPackage)
+
+		fields, err = registerDeps(body, fields, structs[sno], modelPackage, converterMode)
+		if err != nil {
 			return "", "", err
 		}
 
-		prefixFields(fields, *helperPackageName)
+		mg := modelGaps(fields, structs[sno], extractFlattenEmbeddedOption(m.Options))
+		if pol.modelStyle == options.ModelStyle_GORM {
+			mg = withoutAssociations(mg, structs[sno], structs)
+		}
+		if modelFirst || strictMode {
+			for _, name := range mg {
+				gaps = append(gaps, fmt.Sprintf("%s.%s (message %s)", sno, name, fm.name))
+			}
+		}
+
+		diags = append(diags, messageDiagnostics(sl, fm, full, fr.issues)...)
+		unmapped = append(unmapped, messageDiagnostics(sl, fm, full, fr.unmappedIssues())...)
+		covered = append(covered, messageCoverage{
+			File:                f.GetName(),
+			Message:             full,
+			Model:               sno,
+			Mapped:              len(fields),
+			UnmappedProtoFields: fr.unmapped,
+			UnmappedModelFields: mg,
+		})
+
+		enumMappingFuncs(fields, targetFuncName(sno))
+		prefixFields(fields, *helperPackageName, hp)
+		imports.add(helperImports(fields, hp)...)
+		imports.add(stdImports(fields)...)
+
+		// errors of fields, e.g. of malformed UUID strings of clients, are
+		// returned by transformers, so they never panic on input.
+		withErrors := extractWithErrorsOption(m.Options)
+		if withErrors {
+			imports.add(`"fmt"`)
+		} else if names := fallibleFields(fields, dir != options.Direction_GO_TO_PB, !noReverse); len(names) > 0 {
+			return "", "", fmt.Errorf("%s: message %s: fields %s are transformed by functions which return errors; hint: set (%s) = true option of message %s, so transformers return the errors",
+				sl.position(fm.path...), full, strings.Join(names, ", "), options.E_WithErrors.Name, fm.name)
+		}
+
+		withContext := extractWithContextOption(m.Options)
+		if withContext {
+			imports.add(`"context"`)
+		}
+
+		emptySlices := extractEmptySliceOnNilOption(f.Options, m.Options)
+		if emptySlices {
+			emptySliceFields(fields, m)
+		}
+
+		useJSON := extractJSONOption(m.Options)
+		if useJSON {
+			if err := checkJSONFields(m, messages, map[string]bool{}); err != nil {
+				return "", "", fmt.Errorf("%s: message %s: %s", sl.position(fm.path...), full, err)
+			}
+			imports.add(jsonImports...)
+		}
+
+		usePool := extractPoolOption(m.Options)
+		if usePool {
+			imports.add(`"sync"`)
+		}
+
+		sensitive, err := sensitiveFields(directionFields(fields, true), m, messages, qualified(fm.goName(), protoPackage), protoPackage)
+		if err != nil {
+			return "", "", fmt.Errorf("%s: message %s: %s", sl.position(fm.path...), full, err)
+		}
+
+		var mf []modelField
+		if verifyModels {
+			mf = modelFields(fields, structs[sno])
+		}
+
+		var pf []patchField
+		if extractPatchOption(m.Options) {
+			if dir == options.Direction_GO_TO_PB {
+				// patch uses proto to model transformation.
+				p(body, "// message %q: patch is not generated, message has (%s) = %s option\n", fm.name, options.E_Direction.Name, dir)
+			} else {
+				pf = patchFields(directionFields(fields, false), m, messages, structs[sno], modelPackage)
+				imports.add(patchImports(pf)...)
+			}
+		}
+
+		var mp []maskedPath
+		if extractMaskedOption(m.Options) {
+			if dir == options.Direction_GO_TO_PB {
+				// masked apply uses proto to model transformation.
+				p(body, "// message %q: masked apply function is not generated, message has (%s) = %s option\n", fm.name, options.E_Direction.Name, dir)
+			} else {
+				mp = maskedPaths(directionFields(fields, false), structs[sno])
+				imports.add(maskedImports(mp)...)
+			}
+		}
+
+		var bf []modelField
+		if extractBuilderOption(m.Options) {
+			switch {
+			case disableReverse:
+				// builder uses model to proto transformation.
+				p(body, "// message %q: builder is not generated, reverse functions are disabled\n", fm.name)
+			case noReverse:
+				p(body, "// message %q: builder is not generated, message has (%s) = %s option\n", fm.name, options.E_Direction.Name, dir)
+			default:
+				bf = builderFields(directionFields(fields, true), structs[sno], modelPackage)
+			}
+		}
+
+		var p2g, g2p string
+		mo, ok := messages[f.GetPackage()+"."+fm.name]
+		if ok {
+			if mo, err = targetMessageOption(mo, sno, nf); err != nil {
+				return "", "", fmt.Errorf("%s: %s", f.GetName(), err)
+			}
+			p2g, g2p = mo.FuncNames()
+		}
+
+		if debugFile {
+			dp2g, dg2p := "PbTo"+targetFuncName(sno), targetFuncName(sno)+"ToPb"
+			if ok {
+				dp2g, dg2p = transformerNames(mo)
+			}
+			if noReverse {
+				dg2p = ""
+			}
+			dms = append(dms, newDebugMessage(m, full, sno, dp2g, dg2p, fields, fr.unmapped))
+		}
 
 		data = append(data,
 			&Data{
-				Src:        m.GetName(),
-				SrcPref:    protoPackage,
-				SrcFn:      "Pb",
-				SrcPointer: "*",
-				Dst:        sno,
-				DstPref:    repoPackage,
-				DstFn:      sno,
-				Fields:     fields,
+				Src:         fm.goName(),
+				SrcPref:     protoPackage,
+				SrcFn:       "Pb",
+				SrcPointer:  "*",
+				Dst:         modelName,
+				DstPref:     modelPackage,
+				DstFn:       targetFuncName(sno),
+				Func:        p2g,
+				ReverseFunc: g2p,
+				Fields:      fields,
+
+				WrappersPackage: wrappersPackage,
+				NoReverse:       noReverse,
+				NoForward:       dir == options.Direction_GO_TO_PB,
+				ModelFields:     mf,
+				Patch:           pf,
+				Masked:          mp,
+				Builder:         bf,
+				JSON:            useJSON,
+				Converter:       converterMode,
+				Sensitive:       sensitive,
+				WithErrors:      withErrors,
+				EmptySliceOnNil: emptySlices,
+				WithContext:     withContext,
+				Into:            usePool || extractIntoOption(m.Options),
+				Pool:            usePool,
 			})
+
+		if (roundTripTests || fuzzTests || benchmarks) && dir != options.Direction_GO_TO_PB {
+			rts = append(rts, newRoundTripTest(*data[len(data)-1], m, "."+full))
+		}
 	}
 
-	if err := execTemplate(w, data); err != nil {
+	coverage = append(coverage, covered...)
+	diagnostics = append(diagnostics, diags...)
+
+	if err := unmappedError(f.GetName(), unmapped); err != nil {
 		return "", "", err
 	}
 
-	if err := processOneofFields(w, data); err != nil {
+	if len(gaps) > 0 {
+		return "", "", fmt.Errorf("%s: model fields are not covered by proto messages: %s; hint: add proto fields, point them with (%s) option or mark model fields with //transformer:skip directive",
+			f.GetName(), strings.Join(gaps, ", "), options.E_MapTo.Name)
+	}
+
+	if err := execTemplate(body, data); err != nil {
 		return "", "", err
 	}
 
-	dir, filename := filepath.Split(*f.Name)
-	pn := ""
-	if usePackageInPath {
-		pn = *packageName
+	if err := processOneofFields(body, data); err != nil {
+		return "", "", err
+	}
+
+	for _, mf := range merges {
+		if err := mergeT.Execute(body, mf); err != nil {
+			return "", "", err
+		}
+	}
+
+	for _, ca := range clientAdapters(body, f, messages, protoPackage, repoPackage, disableReverse) {
+		imports.add(`"context"`, `"google.golang.org/grpc"`)
+		if err := clientT.Execute(body, ca); err != nil {
+			return "", "", err
+		}
+	}
+
+	imports.addPackage(protoPackage, pbPath)
+	imports.addPackage(repoPackage, modelPath)
+	imports.addModelImports(paths)
+
+	specs, err := imports.importSpecs(body.Bytes())
+	if err != nil {
+		return "", "", codeError(f.GetName(), body.Bytes(), err)
+	}
+
+	writeImports(w, specs)
+	if _, err := body.WriteTo(w); err != nil {
+		return "", "", err
+	}
+
+	path, err := outputPath(f.GetName(), pbPath, pn)
+	if err != nil {
+		return "", "", err
 	}
-	fmt.Println(*f.Name)
-	absPath := strings.Replace(filepath.Join(dir, pn, filename, "__", *f.Name), ".proto", "_transformer.go", -1)
 
-	return absPath, w.String(), nil
+	content, err := formatSource(f.GetName(), []byte(w.String()))
+	if err != nil {
+		return "", "", err
+	}
+
+	if debugFile {
+		debugReports[path] = debugReport{
+			File:         f.GetName(),
+			Package:      *packageName,
+			ProtoPackage: protoPackage,
+			ModelPackage: repoPackage,
+			Messages:     append([]debugMessage{}, dms...),
+		}
+	}
+
+	rts = withSkips(rts)
+
+	if rt := roundTrips(rts); len(rt) > 0 && roundTripTests {
+		tests, err := testFile(roundTripT, f, *packageName, protoPackage, pbPath, rt)
+		if err != nil {
+			return "", "", err
+		}
+		roundTripFiles[path] = tests
+	}
+
+	if rt := roundTrips(rts); len(rt) > 0 && fuzzTests {
+		tests, err := testFile(fuzzT, f, *packageName, protoPackage, pbPath, rt)
+		if err != nil {
+			return "", "", err
+		}
+		fuzzFiles[path] = tests
+	}
+
+	if len(rts) > 0 && benchmarks {
+		tests, err := testFile(benchT, f, *packageName, protoPackage, pbPath, withGraphs(rts))
+		if err != nil {
+			return "", "", err
+		}
+		benchFiles[path] = tests
+	}
+
+	return path, content, nil
+}
+
+// outputPath returns path of file generated from .proto file name with Go
+// package import path ip, see goPackage. In source_relative mode file is
+// placed next to .proto file, in import mode it's placed into directory of the
+// import path, or next to .proto file if go_package has no import path, and
+// into its subdirectory pkg, if pkg isn't empty. Prefix of module parameter is
+// removed from the path of import mode.
+func outputPath(name, ip, pkg string) (string, error) {
+	base := filepath.Base(strings.TrimSuffix(name, ".proto") + "_transformer.go")
+	path := filepath.ToSlash(filepath.Join(transformerDir(name, ip, pkg), base))
+	if sourceRelative {
+		return path, nil
+	}
+
+	if modulePrefix == "" {
+		return path, nil
+	}
+
+	if !strings.HasPrefix(path, modulePrefix+"/") {
+		return "", fmt.Errorf("%s: output path %s has no prefix %s of module parameter", name, path, modulePrefix)
+	}
+
+	return strings.TrimPrefix(path, modulePrefix+"/"), nil
+}
+
+// transformerDir returns output directory of transformers of .proto file
+// name with Go package import path ip, see outputPath. Prefix of module
+// parameter is not removed, so in import mode it's import path of Go package
+// of transformers, if go_package option has import path.
+func transformerDir(name, ip, pkg string) string {
+	if sourceRelative {
+		return filepath.ToSlash(filepath.Dir(name))
+	}
+
+	dir := filepath.Dir(name)
+	if ip != "" {
+		dir = ip
+	}
+
+	return filepath.ToSlash(filepath.Join(dir, pkg))
+}
+
+// writeImports writes import declaration with unique import specs, such as
+// "github.com/org/pkg" or pkg "github.com/org/pkg", into w.
+func writeImports(w io.Writer, specs []string) {
+	if len(specs) == 0 {
+		return
+	}
+
+	sort.Strings(specs)
+
+	fmt.Fprintln(w, "\nimport (")
+	for i, s := range specs {
+		if i > 0 && specs[i-1] == s {
+			continue
+		}
+		fmt.Fprintf(w, "\t%s\n", s)
+	}
+	fmt.Fprintln(w, ")")
+}
+
+// stdImports returns import specs of standard packages which are used by
+// statements of fields, e.g. sort package for ordered maps and context package
+// for transformers of sub messages with transformer.with_context option.
+func stdImports(fields []Field) []string {
+	imports := []string{}
+	sorted, stdTime, ctx := false, false, false
+
+	for _, f := range fields {
+		if f.Elem != nil && f.Elem.Kind == elemPairs {
+			sorted = true
+		}
+		if f.Wrapper != nil && (f.Wrapper.Kind == elemTime || f.Wrapper.Kind == elemDuration) {
+			stdTime = true
+		}
+		if f.WithContext || f.HelperCall != nil && f.HelperCall.Context {
+			ctx = true
+		}
+	}
+
+	if ctx {
+		imports = append(imports, `"context"`)
+	}
+	if sorted {
+		imports = append(imports, `"sort"`)
+	}
+	if stdTime {
+		imports = append(imports, `"time"`)
+	}
+
+	return imports
 }
 
-// execTemplate executes main template twice with given data, second pass is
-// used for generated reverse functions.
+// execTemplate renders transformation functions for each message into its
+// own buffer. Messages are rendered concurrently, buffers are written into w
+// in the same order as messages are declared.
 func execTemplate(w io.Writer, data []*Data) error {
-	for _, d := range data {
-		t, err := templateWithHelpers("messages")
-		if err != nil {
-			return err
+	bufs := make([]bytes.Buffer, len(data))
+	errs := make([]error, len(data))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.NumCPU())
+
+	for i, d := range data {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, d Data) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = execMessageTemplate(&bufs[i], d)
+		}(i, *d)
+	}
+
+	wg.Wait()
+
+	for i := range bufs {
+		if errs[i] != nil {
+			return errs[i]
 		}
 
-		if err := t.Execute(w, d); err != nil {
+		if _, err := bufs[i].WriteTo(w); err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+// execMessageTemplate executes main template twice with given data, second
+// pass is used for generated reverse functions, it's omitted if d.NoReverse is
+// true. Data is not modified.
+func execMessageTemplate(w io.Writer, d Data) error {
+	t, err := templateWithHelpers("messages")
+	if err != nil {
+		return err
+	}
 
-		d.swap()
+	views := []Data{d}
+	if !d.NoReverse {
+		views = append(views, d.reverse())
+	}
 
-		if err := t.Execute(w, d); err != nil {
+	for _, v := range views {
+		v.Fields = directionFields(v.Fields, v.Swapped)
+		if err := t.Execute(w, v); err != nil {
 			return err
 		}
 	}
@@ -210,18 +945,32 @@ func execTemplate(w io.Writer, data []*Data) error {
 	return nil
 }
 
-// prefixFields adds prefix to fields' convertor functions if prefix is not an
-// empty string and field has an attribute UsePackage == true,
-func prefixFields(fields []Field, prefix string) {
-	if prefix == "" {
-		return
+// prefixFields adds prefix to fields' convertor functions if field has an
+// attribute UsePackage == true. Package of helper family hp is used as prefix
+// if it's set, otherwise prefix is used if it's not an empty string.
+func prefixFields(fields []Field, prefix string, hp map[string]helperPackage) {
+	pkg := func(family string) string {
+		if p, ok := hp[family]; ok {
+			return p.name
+		}
+		return prefix
 	}
 
 	for i, f := range fields {
-		if !f.UsePackage {
+		prefixFields(f.EmbeddedFields, prefix, hp)
+
+		if e := f.Elem; e != nil && e.UsePackage {
+			if p := pkg(e.Helper); p != "" {
+				e.ProtoToGo = p + "." + e.ProtoToGo
+				e.GoToProto = p + "." + e.GoToProto
+			}
+		}
+
+		p := pkg(f.Helper)
+		if !f.UsePackage || p == "" {
 			continue
 		}
-		fields[i].ProtoToGoType = prefix + "." + f.ProtoToGoType
-		fields[i].GoToProtoType = prefix + "." + f.GoToProtoType
+		fields[i].ProtoToGoType = p + "." + f.ProtoToGoType
+		fields[i].GoToProtoType = p + "." + f.GoToProtoType
 	}
 }
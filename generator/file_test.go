@@ -8,12 +8,12 @@ import (
 	"time"
 
 	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
-	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 var _ = Describe("File", func() {
@@ -37,21 +37,54 @@ var _ = Describe("File", func() {
 				Expect(o.String()).To(Equal("// Code generated by protoc-gen-struct-transformer, version: v0.0.1. DO NOT EDIT.\n"))
 			})
 		})
+
+		Context("when custom header template is set", func() {
+
+			BeforeEach(func() {
+				version = "v0.0.1"
+				Expect(SetHeader("// Code generated by our-tool ({{ .Version }}). DO NOT EDIT.{{ if .SourceFile }}\n// {{ .SourceFile }}: {{ .SourcePackage }}{{ end }}")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(SetHeader("")).To(Succeed())
+			})
+
+			It("returns custom header for helper files", func() {
+				Expect(output().String()).To(Equal("// Code generated by our-tool (v0.0.1). DO NOT EDIT.\n"))
+			})
+
+			It("returns custom header with source info", func() {
+				Expect(fileHeader("srcfile", "srcpackage", "dstpackage").String()).To(Equal(`// Code generated by our-tool (v0.0.1). DO NOT EDIT.
+// srcfile: srcpackage
+
+package dstpackage
+`))
+			})
+		})
+
+		DescribeTable("when custom header template is invalid",
+			func(tpl, expected string) {
+				Expect(SetHeader(tpl)).To(MatchError(ContainSubstring(expected)))
+				Expect(headerTemplate).To(BeNil())
+			},
+
+			Entry("Parse error", "{{ .Version ", "header template"),
+			Entry("Unknown field", "{{ .Unknown }}", "can't evaluate field Unknown"),
+		)
 	})
 
 	Describe("CollectAllMessages", func() {
-		var mt = &descriptor.DescriptorProto{
+		var mt = &descriptorpb.DescriptorProto{
 			Name:    sp("message_name"),
-			Options: &descriptor.MessageOptions{},
+			Options: &descriptorpb.MessageOptions{},
 		}
 
 		BeforeEach(func() {
-			err := proto.SetExtension(mt.Options, options.E_GoStruct, sp("go_struct_name"))
-			Expect(err).NotTo(HaveOccurred())
+			proto.SetExtension(mt.Options, options.E_GoStruct, "go_struct_name")
 		})
 
 		DescribeTable("check code generator request",
-			func(req plugin.CodeGeneratorRequest, expectexList MessageOptionList) {
+			func(req *pluginpb.CodeGeneratorRequest, expectexList MessageOptionList) {
 				mol, err := CollectAllMessages(req)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -68,22 +101,22 @@ var _ = Describe("File", func() {
 				}
 			},
 
-			Entry("Empty file list", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
+			Entry("Empty file list", &pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{
+					&descriptorpb.FileDescriptorProto{
 						Name:        sp("protofile"),
 						Package:     sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{},
+						MessageType: []*descriptorpb.DescriptorProto{},
 					},
 				},
 			}, map[string]MessageOption{}),
 
-			Entry("Messages without go_struct option", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
+			Entry("Messages without go_struct option", &pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{
+					&descriptorpb.FileDescriptorProto{
 						Name:    sp("protofile"),
 						Package: sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{
+						MessageType: []*descriptorpb.DescriptorProto{
 							{Name: sp("message_name")},
 						},
 					},
@@ -92,30 +125,30 @@ var _ = Describe("File", func() {
 				"message_name": messageOption{targetName: "", fullName: "", oneofDecl: ""},
 			}),
 
-			Entry("Messages with go_struct option", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
+			Entry("Messages with go_struct option", &pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{
+					&descriptorpb.FileDescriptorProto{
 						Name:        sp("protofile"),
 						Package:     sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{mt},
+						MessageType: []*descriptorpb.DescriptorProto{mt},
 					},
 				},
 			}, map[string]MessageOption{
 				"message_name": messageOption{targetName: "go_struct_name", fullName: "", oneofDecl: ""},
 			}),
 
-			Entry("Messages with oneOf declaration which does match to int64toString rule", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
+			Entry("Messages with oneOf declaration which does match to int64toString rule", &pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{
+					&descriptorpb.FileDescriptorProto{
 						Name:    sp("protofile"),
 						Package: sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{
+						MessageType: []*descriptorpb.DescriptorProto{
 							{
 								Name: sp("message_name"),
-								OneofDecl: []*descriptor.OneofDescriptorProto{
+								OneofDecl: []*descriptorpb.OneofDescriptorProto{
 									{Name: sp("oneof_decl_name")},
 								},
-								Field: []*descriptor.FieldDescriptorProto{
+								Field: []*descriptorpb.FieldDescriptorProto{
 									{Name: sp("int64_value")},
 									{Name: sp("string_value")},
 								},
@@ -127,18 +160,18 @@ var _ = Describe("File", func() {
 				"message_name": messageOption{targetName: "", fullName: "", oneofDecl: "oneof_decl_name"},
 			}),
 
-			Entry("Messages with oneOf declaration which does not match to int64toString", plugin.CodeGeneratorRequest{
-				ProtoFile: []*descriptor.FileDescriptorProto{
-					&descriptor.FileDescriptorProto{
+			Entry("Messages with oneOf declaration which does not match to int64toString", &pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{
+					&descriptorpb.FileDescriptorProto{
 						Name:    sp("protofile"),
 						Package: sp("pb"),
-						MessageType: []*descriptor.DescriptorProto{
+						MessageType: []*descriptorpb.DescriptorProto{
 							{
 								Name: sp("message_name"),
-								OneofDecl: []*descriptor.OneofDescriptorProto{
+								OneofDecl: []*descriptorpb.OneofDescriptorProto{
 									{Name: sp("oneof_decl_name")},
 								},
-								Field: []*descriptor.FieldDescriptorProto{
+								Field: []*descriptorpb.FieldDescriptorProto{
 									{Name: sp("some_field")},
 									{Name: sp("some_other_field")},
 								},
@@ -150,51 +183,446 @@ var _ = Describe("File", func() {
 				"message_name": messageOption{targetName: "", fullName: "", oneofDecl: ""},
 			}),
 		)
+
+		It("collects nested messages", func() {
+			inner := &descriptorpb.DescriptorProto{Name: sp("Item"), Options: &descriptorpb.MessageOptions{}}
+			proto.SetExtension(inner.Options, options.E_GoStruct, "OrderItem")
+
+			mol, err := CollectAllMessages(&pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{{
+					Name:    sp("protofile"),
+					Package: sp("pb"),
+					MessageType: []*descriptorpb.DescriptorProto{{
+						Name:       sp("Order"),
+						NestedType: []*descriptorpb.DescriptorProto{inner},
+					}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mol).To(HaveKey("pb.Order"))
+			Expect(mol).To(HaveKey("pb.Order.Item"))
+			Expect(mol["pb.Order.Item"].Target()).To(Equal("OrderItem"))
+			Expect(mol["pb.Order.Item"].GoName()).To(Equal("Order_Item"))
+		})
+	})
+
+	Describe("fileMessages", func() {
+
+		It("returns nested messages after parent ones", func() {
+			msgs := []*descriptorpb.DescriptorProto{
+				{Name: sp("Order"), NestedType: []*descriptorpb.DescriptorProto{
+					{Name: sp("Item"), NestedType: []*descriptorpb.DescriptorProto{{Name: sp("Price")}}},
+				}},
+				{Name: sp("Product")},
+			}
+
+			names := []string{}
+			goNames := []string{}
+			for _, fm := range fileMessages(msgs, "") {
+				names = append(names, fm.name)
+				goNames = append(goNames, fm.goName())
+			}
+
+			Expect(names).To(Equal([]string{"Order", "Order.Item", "Order.Item.Price", "Product"}))
+			Expect(goNames).To(Equal([]string{"Order", "Order_Item", "Order_Item_Price", "Product"}))
+		})
 	})
 
 	Describe("ProcessFile", func() {
 		Context("when get a header", func() {
-			var f *descriptor.FileDescriptorProto
+			var f *descriptorpb.FileDescriptorProto
 
 			BeforeEach(func() {
-				f = &descriptor.FileDescriptorProto{
-					Options: &descriptor.FileOptions{},
+				f = &descriptorpb.FileDescriptorProto{
+					Options: &descriptorpb.FileOptions{},
 					Name:    sp("product.proto"),
 					Package: sp("pb"),
-					MessageType: []*descriptor.DescriptorProto{
+					MessageType: []*descriptorpb.DescriptorProto{
 						{
 							Name: sp("Product"),
-							Field: []*descriptor.FieldDescriptorProto{
-								&descriptor.FieldDescriptorProto{
+							Field: []*descriptorpb.FieldDescriptorProto{
+								&descriptorpb.FieldDescriptorProto{
 									Name:     sp("id"),
 									Number:   nil,
 									Label:    nil,
 									Type:     &typInt64,
 									TypeName: nil,
-									Options:  &descriptor.FieldOptions{},
+									Options:  &descriptorpb.FieldOptions{},
 								},
 							},
-							Options: &descriptor.MessageOptions{},
+							Options: &descriptorpb.MessageOptions{},
 						},
 					},
 				}
 
-				err := proto.SetExtension(f.Options, options.E_GoModelsFilePath, sp("testdata/model.go"))
-				Expect(err).NotTo(HaveOccurred())
+				proto.SetExtension(f.Options, options.E_GoModelsFilePath, "testdata/model.go")
 
-				err = proto.SetExtension(f.MessageType[0].Options, options.E_GoStruct, sp("Product"))
-				Expect(err).NotTo(HaveOccurred())
+				proto.SetExtension(f.MessageType[0].Options, options.E_GoStruct, "Product")
 			})
 
 			It("returns generated code", func() {
 				expectedContent, err := ioutil.ReadFile("testdata/processfile.go.golden")
 				Expect(err).NotTo(HaveOccurred())
 
-				absPath, content, err := ProcessFile(f, sp("product"), sp("helper-package"), map[string]MessageOption{}, false, false)
+				absPath, content, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(content).To(Equal(string(expectedContent)))
 				Expect(absPath).To(Equal("product_transformer.go"))
 			})
+
+			It("uses fallback package if package parameter creates an import cycle", func() {
+				defer func() { cycleNote = "" }()
+				f.Options.GoPackage = sp("github.com/ZacxDev/protoc-gen-struct-transformer/generator;pb")
+				pkg := "testdata"
+
+				Expect(ResolvePackage(&pluginpb.CodeGeneratorRequest{ProtoFile: []*descriptorpb.FileDescriptorProto{f}}, &pkg, "", "", true)).To(Succeed())
+				Expect(pkg).To(Equal("testdatatransform"))
+
+				absPath, content, err := ProcessFile(f, &pkg, sp("helper-package"), sp(""), map[string]MessageOption{}, false, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(content).To(ContainSubstring("\npackage testdatatransform\n\n// Transformers are generated into package testdatatransform instead of testdata to avoid import cycle: transformers import models of package github.com/ZacxDev/protoc-gen-struct-transformer/generator/testdata.\n"))
+				Expect(absPath).To(Equal("github.com/ZacxDev/protoc-gen-struct-transformer/generator/testdatatransform/product_transformer.go"))
+			})
+
+			It("imports packages of models and proto structures", func() {
+				f.Options.GoPackage = sp("github.com/acme/api/catalogpb;pb")
+
+				_, content, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(content).To(ContainSubstring("\nimport (\n" +
+					"\tmodel \"github.com/ZacxDev/protoc-gen-struct-transformer/generator/testdata\"\n" +
+					"\tpb \"github.com/acme/api/catalogpb\"\n)\n"))
+				Expect(content).To(ContainSubstring("func PbToProduct(src pb.Product, opts ...TransformParam) model.Product {"))
+			})
+
+			It("generates transformers for every model of go_struct list", func() {
+				proto.SetExtension(f.MessageType[0].Options, options.E_GoStruct, "Product, ProductDTO")
+
+				_, content, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(content).To(ContainSubstring("func PbToProduct(src pb1.Product, opts ...TransformParam) model.Product {"))
+				Expect(content).To(ContainSubstring("func PbToProductDTO(src pb1.Product, opts ...TransformParam) model.ProductDTO {"))
+				Expect(content).To(ContainSubstring("func ProductDTOToPb(src model.ProductDTO, opts ...TransformParam) pb1.Product {"))
+			})
+
+			It("places output next to .proto file in source_relative mode", func() {
+				Expect(SetPaths("source_relative", "")).To(Succeed())
+				defer SetPaths("", "")
+				f.Name = sp("api/product.proto")
+
+				absPath, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(absPath).To(Equal("api/product_transformer.go"))
+			})
+
+			It("removes prefix of module parameter from output path in import mode", func() {
+				Expect(SetPaths("import", "github.com/acme/api/")).To(Succeed())
+				defer SetPaths("", "")
+				f.Name = sp("github.com/acme/api/catalog/product.proto")
+
+				absPath, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(absPath).To(Equal("catalog/product/product_transformer.go"))
+			})
+
+			It("places output into directory of go_package import path in import mode", func() {
+				f.Name = sp("api/product.proto")
+				f.Options.GoPackage = sp("github.com/acme/api/catalogpb;pb")
+
+				absPath, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(absPath).To(Equal("github.com/acme/api/catalogpb/product/product_transformer.go"))
+			})
+
+			It("removes prefix of module parameter from go_package import path", func() {
+				Expect(SetPaths("import", "t")).To(Succeed())
+				defer SetPaths("", "")
+				f.Name = sp("api/product.proto")
+				f.Options.GoPackage = sp("t/api;api")
+
+				absPath, _, err := ProcessFile(f, sp("transform"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(absPath).To(Equal("api/transform/product_transformer.go"))
+			})
+
+			It("returns an error if output path is outside of module", func() {
+				Expect(SetPaths("import", "github.com/acme/api")).To(Succeed())
+				defer SetPaths("", "")
+				f.Name = sp("github.com/acme/apiv2/product.proto")
+
+				_, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+				Expect(err).To(MatchError("github.com/acme/apiv2/product.proto: output path github.com/acme/apiv2/product_transformer.go has no prefix github.com/acme/api of module parameter"))
+			})
+
+			It("returns an error if fields are transformed by functions which return errors without with_errors option", func() {
+				proto.SetExtension(f.MessageType[0].Field[0].Options, options.E_CustomPbToGo, "ParseID")
+				proto.SetExtension(f.MessageType[0].Field[0].Options, options.E_CustomGoToPb, "FormatID")
+				proto.SetExtension(f.MessageType[0].Field[0].Options, options.E_CustomWithError, true)
+
+				_, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+				Expect(err).To(MatchError("product.proto: message pb.Product: fields ID are transformed by functions which return errors; " +
+					"hint: set (transformer.with_errors) = true option of message Product, so transformers return the errors"))
+
+				proto.SetExtension(f.MessageType[0].Options, options.E_WithErrors, true)
+				_, content, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(content).To(ContainSubstring("return model.Product{}, fmt.Errorf(\"field Id: %w\", err)"))
+				Expect(content).NotTo(ContainSubstring("panic("))
+			})
+
+			It("returns model fields which are not covered by messages in model-first mode", func() {
+				f.MessageType[0].Field = nil
+				SetModelFirst(true)
+				defer SetModelFirst(false)
+
+				_, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+				Expect(err).To(MatchError("product.proto: model fields are not covered by proto messages: Product.ID (message Product); " +
+					"hint: add proto fields, point them with (transformer.map_to) option or mark model fields with //transformer:skip directive"))
+			})
+
+			Context("in strict mode", func() {
+
+				BeforeEach(func() {
+					price := &descriptorpb.FieldDescriptorProto{Name: sp("price"), Type: &typInt64, Options: &descriptorpb.FieldOptions{}}
+					proto.SetExtension(price.Options, options.E_MapTo, "ID")
+					f.MessageType[0].Field = append(f.MessageType[0].Field, price)
+					f.SourceCodeInfo = &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+						{Path: []int32{4, 0}, Span: []int32{10, 0, 13, 1}},
+						{Path: []int32{4, 0, 2, 0}, Span: []int32{11, 2, 17}},
+						{Path: []int32{4, 0, 2, 1}, Span: []int32{12, 2, 50}},
+					}}
+
+					SetStrict(true)
+					coverage = nil
+					diagnostics = nil
+				})
+
+				AfterEach(func() {
+					SetStrict(false)
+					coverage = nil
+					diagnostics = nil
+				})
+
+				It("returns proto fields which are not transformed", func() {
+					_, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).To(MatchError("product.proto: proto fields are not transformed into model fields in strict mode; hint: fix the fields or skip them with (transformer.skip) = true\n" +
+						"\tproduct.proto:12:3: message pb.Product field id: field id: model field ID is pointed by option (transformer.map_to) of field price, explicit option takes precedence over matching by name; " +
+						"hint: use (transformer.map_to) option to transform field id into another model field or skip it with (transformer.skip) = true"))
+				})
+
+				It("accepts fields which are skipped explicitly", func() {
+					proto.SetExtension(f.MessageType[0].Field[0].Options, options.E_Skip, true)
+
+					_, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns model fields which are not covered by messages", func() {
+					f.MessageType[0].Field = nil
+
+					_, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).To(MatchError(ContainSubstring("model fields are not covered by proto messages: Product.ID (message Product)")))
+				})
+
+				It("reports coverage of messages", func() {
+					SetStrict(false)
+					_, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).NotTo(HaveOccurred())
+
+					report, err := CoverageReport()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(report).To(MatchJSON(`{
+						"unmapped": 1,
+						"messages": [{
+							"file": "product.proto",
+							"message": "pb.Product",
+							"model": "Product",
+							"mapped": 1,
+							"unmapped_proto_fields": [{
+								"name": "id",
+								"reason": "field id: model field ID is pointed by option (transformer.map_to) of field price, explicit option takes precedence over matching by name; hint: use (transformer.map_to) option to transform field id into another model field or skip it with (transformer.skip) = true"
+							}],
+							"unmapped_model_fields": []
+						}]
+					}`))
+				})
+
+				It("collects diagnostics of messages", func() {
+					SetStrict(false)
+					proto.SetExtension(f.MessageType[0].Field[0].Options, options.E_EmbeddedPrefix, "id_")
+
+					_, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(diagnostics).To(Equal([]string{
+						"product.proto:12:3: message pb.Product field id: option (transformer.embedded_prefix) is ignored without (transformer.embedded) = true",
+						"product.proto:12:3: message pb.Product field id: field id: model field ID is pointed by option (transformer.map_to) of field price, explicit option takes precedence over matching by name; " +
+							"hint: use (transformer.map_to) option to transform field id into another model field or skip it with (transformer.skip) = true",
+					}))
+				})
+			})
+
+			Context("with debug files", func() {
+
+				BeforeEach(func() {
+					SetDebugFile(true)
+				})
+
+				AfterEach(func() {
+					SetDebugFile(false)
+					debugReports = map[string]debugReport{}
+				})
+
+				It("describes transformers of messages without comments in generated code", func() {
+					absPath, content, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(content).NotTo(ContainSubstring("// sf:"))
+
+					path, report, err := DebugFile(absPath)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(path).To(Equal("product_transformer.debug.json"))
+					Expect(report).To(MatchJSON(`{
+						"file": "product.proto",
+						"package": "product",
+						"proto_package": "pb1",
+						"model_package": "model",
+						"messages": [{
+							"message": "pb.Product",
+							"model": "Product",
+							"pb_to_go": "PbToProduct",
+							"go_to_pb": "ProductToPb",
+							"options": {"transformer.go_struct": "Product"},
+							"fields": [{
+								"proto": "id",
+								"model": "ID",
+								"proto_type": "int64",
+								"pb_to_go": "int",
+								"go_to_pb": "int64"
+							}]
+						}]
+					}`))
+				})
+
+				It("returns nothing for files which are not processed", func() {
+					path, report, err := DebugFile("order_transformer.go")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(path).To(BeEmpty())
+					Expect(report).To(BeEmpty())
+				})
+			})
+
+			Context("with round-trip tests", func() {
+
+				BeforeEach(func() {
+					SetRoundTripTests(true)
+				})
+
+				AfterEach(func() {
+					SetRoundTripTests(false)
+					roundTripFiles = map[string]string{}
+				})
+
+				It("generates test of transformers of messages", func() {
+					f.MessageType[0].Field[0].Number = proto.Int32(1)
+
+					absPath, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).NotTo(HaveOccurred())
+
+					path, content := RoundTripTests(absPath)
+					Expect(path).To(Equal("product_transformer_test.go"))
+					Expect(content).To(ContainSubstring("\npackage product\n"))
+					Expect(content).To(ContainSubstring(`
+import (
+	protov1 "github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+`))
+					Expect(content).To(ContainSubstring(`
+func TestPbToProductRoundTrip(t *testing.T) {
+	src := &pb1.Product{
+		Id: 1,
+	}
+
+	out := ProductToPbPtr(PbToProductPtr(src))
+
+	got := &pb1.Product{
+		Id: out.Id,
+	}
+	if !proto.Equal(protov1.MessageV2(src), protov1.MessageV2(got)) {
+		t.Errorf("PbToProduct and ProductToPb don't round-trip (-want +got):\n%s", cmp.Diff(src, got))
+	}
+}
+`))
+				})
+
+				It("generates fuzz tests if they are turned on", func() {
+					SetFuzzTests(true)
+					defer func() {
+						SetFuzzTests(false)
+						fuzzFiles = map[string]string{}
+					}()
+
+					absPath, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).NotTo(HaveOccurred())
+
+					path, content := FuzzTests(absPath)
+					Expect(path).To(Equal("product_transformer_fuzz_test.go"))
+					Expect(content).To(ContainSubstring("\nfunc FuzzPbToProduct(f *testing.F) {\n"))
+				})
+
+				It("generates benchmarks of messages which are transformed in one direction", func() {
+					SetBenchmarks(true)
+					SetDisableReverse(true)
+					defer func() {
+						SetBenchmarks(false)
+						SetDisableReverse(false)
+						benchFiles = map[string]string{}
+					}()
+
+					absPath, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).NotTo(HaveOccurred())
+
+					path, content := Benchmarks(absPath)
+					Expect(path).To(Equal("product_transformer_bench_test.go"))
+					Expect(content).To(ContainSubstring("\nfunc BenchmarkPbToProduct(b *testing.B) {\n"))
+					Expect(content).NotTo(ContainSubstring("BenchmarkProductToPb"))
+				})
+
+				It("skips messages which are transformed in one direction", func() {
+					SetDisableReverse(true)
+					defer SetDisableReverse(false)
+
+					absPath, _, err := ProcessFile(f, sp("product"), sp("helper-package"), sp(""), map[string]MessageOption{}, false, false)
+					Expect(err).NotTo(HaveOccurred())
+
+					path, content := RoundTripTests(absPath)
+					Expect(path).To(BeEmpty())
+					Expect(content).To(BeEmpty())
+				})
+			})
+		})
+	})
+
+	Describe("SetPaths", func() {
+
+		AfterEach(func() {
+			Expect(SetPaths("", "")).To(Succeed())
+		})
+
+		It("accepts known modes", func() {
+			Expect(SetPaths("import", "")).To(Succeed())
+			Expect(sourceRelative).To(BeFalse())
+			Expect(SetPaths("source_relative", "")).To(Succeed())
+			Expect(sourceRelative).To(BeTrue())
+		})
+
+		It("returns error for module parameter in source_relative mode", func() {
+			Expect(SetPaths("source_relative", "github.com/acme/api")).To(MatchError("module: parameter can be used with paths=import only, got paths=source_relative"))
+		})
+
+		It("returns error for unknown mode", func() {
+			Expect(SetPaths("module", "")).To(MatchError(`paths: unknown value "module", should be one of: import, source_relative`))
 		})
 	})
 
@@ -203,44 +631,90 @@ var _ = Describe("File", func() {
 		Context("when there is no option go_models_file_path in file", func() {
 
 			It("returns files was skipped error", func() {
-				p, err := modelsPath(&descriptor.FileOptions{})
+				p, err := modelsPaths(&descriptorpb.FileOptions{})
 				Expect(err).To(MatchError("files was skipped"))
-				Expect(p).To(Equal(""))
+				Expect(p).To(BeNil())
 			})
 		})
 
 		Context("when file contains go_models_file_path option", func() {
 			var (
-				f    *descriptor.FileDescriptorProto
+				f    *descriptorpb.FileDescriptorProto
 				base string
 				path string
 			)
 
 			BeforeEach(func() {
-				f = &descriptor.FileDescriptorProto{
-					Options: &descriptor.FileOptions{},
+				f = &descriptorpb.FileDescriptorProto{
+					Options: &descriptorpb.FileOptions{},
 				}
 				_, path, _, _ = runtime.Caller(0)
 				base = filepath.Base(path)
 
 				// set path to current test file as a value for go_models_file_path option
-				err := proto.SetExtension(f.Options, options.E_GoModelsFilePath, sp(base))
-				Expect(err).NotTo(HaveOccurred())
+				proto.SetExtension(f.Options, options.E_GoModelsFilePath, base)
 			})
 
 			It("return abs path for file", func() {
-				p, err := modelsPath(f.Options)
+				p, err := modelsPaths(f.Options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(p).To(Equal([]string{path}))
+			})
+
+			It("returns abs paths for comma-separated list of files", func() {
+				proto.SetExtension(f.Options, options.E_GoModelsFilePath, base+", testdata/model.go,")
+
+				p, err := modelsPaths(f.Options)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(p).To(Equal(path))
+				Expect(p).To(Equal([]string{path, filepath.Join(filepath.Dir(path), "testdata", "model.go")}))
 			})
 		})
 	})
 
+	Describe("parseModels", func() {
+
+		It("parses package directory of transformer.go_models_dir option", func() {
+			opts := &descriptorpb.FileOptions{}
+			proto.SetExtension(opts, options.E_GoModelsDir, "../example/model")
+
+			structs, paths, err := parseModels(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(structs).To(HaveKey("Product"))
+			Expect(structs).To(HaveKey("Memo"))
+			Expect(paths).To(ContainElement(HaveSuffix("model.go")))
+			Expect(paths).To(ContainElement(HaveSuffix("memo.go")))
+		})
+
+		It("returns an error if both models options are set", func() {
+			opts := &descriptorpb.FileOptions{}
+			proto.SetExtension(opts, options.E_GoModelsDir, "../example/model")
+			proto.SetExtension(opts, options.E_GoModelsFilePath, "../example/model/model.go")
+
+			_, _, err := parseModels(opts)
+			Expect(err).To(MatchError("options (transformer.go_models_file_path) and (transformer.go_models_dir) can not be used together"))
+		})
+
+		It("loads package of transformer.go_models_import_path option", func() {
+			opts := &descriptorpb.FileOptions{}
+			proto.SetExtension(opts, options.E_GoModelsImportPath, "github.com/ZacxDev/protoc-gen-struct-transformer/example/model")
+
+			structs, paths, err := parseModels(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(structs).To(HaveKey("Product"))
+			Expect(paths).To(ContainElement(HaveSuffix("example/model/model.go")))
+		})
+
+		It("returns files was skipped error if no models option is set", func() {
+			_, _, err := parseModels(&descriptorpb.FileOptions{})
+			Expect(err).To(Equal(ErrFileSkipped))
+		})
+	})
+
 	Describe("prefixFields", func() {
 
 		DescribeTable("check returns",
 			func(pref string, fields, expected []Field) {
-				prefixFields(fields, pref)
+				prefixFields(fields, pref, nil)
 				Expect(fields).To(Equal(expected))
 			},
 
@@ -263,7 +737,33 @@ var _ = Describe("File", func() {
 				[]Field{{ProtoToGoType: "p2g", GoToProtoType: "g2p", UsePackage: true}},
 				[]Field{{ProtoToGoType: "pref.p2g", GoToProtoType: "pref.g2p", UsePackage: true}},
 			),
+
+			Entry("Element-wise field, use package", "pref",
+				[]Field{{Elem: &Elem{Kind: elemFunc, ProtoToGo: "p2g", GoToProto: "g2p", UsePackage: true}}},
+				[]Field{{Elem: &Elem{Kind: elemFunc, ProtoToGo: "pref.p2g", GoToProto: "pref.g2p", UsePackage: true}}},
+			),
+
+			Entry("Embedded field, use package", "pref",
+				[]Field{{EmbeddedFields: []Field{{ProtoToGoType: "p2g", GoToProtoType: "g2p", UsePackage: true}}}},
+				[]Field{{EmbeddedFields: []Field{{ProtoToGoType: "pref.p2g", GoToProtoType: "pref.g2p", UsePackage: true}}}},
+			),
 		)
+
+		It("uses packages of helper families", func() {
+			hp := map[string]helperPackage{helperTime: {name: "timeconv", path: "github.com/org/timeconv"}}
+			fields := []Field{
+				{ProtoToGoType: "p2g", GoToProtoType: "g2p", UsePackage: true, Helper: helperTime},
+				{ProtoToGoType: "p2g", GoToProtoType: "g2p", UsePackage: true, Helper: helperScalar},
+				{Elem: &Elem{Kind: elemFunc, ProtoToGo: "p2g", GoToProto: "g2p", UsePackage: true, Helper: helperTime}},
+			}
+
+			prefixFields(fields, "pref", hp)
+			Expect(fields).To(Equal([]Field{
+				{ProtoToGoType: "timeconv.p2g", GoToProtoType: "timeconv.g2p", UsePackage: true, Helper: helperTime},
+				{ProtoToGoType: "pref.p2g", GoToProtoType: "pref.g2p", UsePackage: true, Helper: helperScalar},
+				{Elem: &Elem{Kind: elemFunc, ProtoToGo: "timeconv.p2g", GoToProto: "timeconv.g2p", UsePackage: true, Helper: helperTime}},
+			}))
+		})
 	})
 
 	Describe("fileHeader", func() {
@@ -346,6 +846,57 @@ package fff
 			}),
 		)
 
+		It("writes messages in the same order as they are declared", func() {
+			var (
+				data     []*Data
+				expected bytes.Buffer
+			)
+
+			for _, n := range []string{"A", "B", "C", "D", "E", "F", "G", "H"} {
+				d := &Data{Src: n, SrcPref: "pb", SrcFn: "Pb", Dst: n + "Model", DstPref: "model", DstFn: n + "Model"}
+				data = append(data, d)
+				Expect(execMessageTemplate(&expected, *d)).To(Succeed())
+			}
+
+			w := &bytes.Buffer{}
+			Expect(execTemplate(w, data)).To(Succeed())
+			Expect(w.String()).To(Equal(expected.String()))
+		})
+
+		It("omits reverse functions if they are disabled", func() {
+			d := Data{Src: "A", SrcPref: "pb", SrcFn: "Pb", Dst: "AModel", DstPref: "model", DstFn: "AModel", NoReverse: true}
+
+			w := &bytes.Buffer{}
+			Expect(execMessageTemplate(w, d)).To(Succeed())
+			Expect(w.String()).To(ContainSubstring("func PbToAModelPtr("))
+			Expect(w.String()).NotTo(ContainSubstring("func AModelToPbPtr("))
+		})
+
+		It("omits forward functions of messages with GO_TO_PB direction", func() {
+			d := Data{Src: "A", SrcPref: "pb", SrcFn: "Pb", Dst: "AModel", DstPref: "model", DstFn: "AModel", NoForward: true}
+
+			w := &bytes.Buffer{}
+			Expect(execMessageTemplate(w, d)).To(Succeed())
+			Expect(w.String()).To(ContainSubstring("func AModelToPbPtr("))
+			Expect(w.String()).To(ContainSubstring("const PbToAModelSchemaHash = "))
+			Expect(w.String()).NotTo(ContainSubstring("func PbToAModelPtr("))
+			Expect(w.String()).NotTo(ContainSubstring("PbToAModelFieldNames"))
+		})
+
+		It("leaves fields with skip_direction out of transformers of the direction", func() {
+			d := Data{Src: "A", SrcPref: "pb", SrcFn: "Pb", Dst: "AModel", DstPref: "model", DstFn: "AModel", Fields: []Field{
+				{Name: "PasswordHash", ProtoName: "PasswordHash", SkipGoToPb: true},
+				{Name: "Total", ProtoName: "Total", SkipPbToGo: true},
+			}}
+
+			w := &bytes.Buffer{}
+			Expect(execMessageTemplate(w, d)).To(Succeed())
+			out, err := formatSource("a.proto", append([]byte("package transform\n"), w.Bytes()...))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(ContainSubstring("func PbToAModel(src pb.A, opts ...TransformParam) model.AModel {\n\ts := model.AModel{\n\t\tPasswordHash: src.PasswordHash,\n\t}\n"))
+			Expect(out).To(ContainSubstring("func AModelToPb(src model.AModel, opts ...TransformParam) pb.A {\n\ts := pb.A{\n\t\tTotal: src.Total,\n\t}\n"))
+			Expect(d.Fields).To(HaveLen(2))
+		})
 	})
 
 })
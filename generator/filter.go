@@ -0,0 +1,214 @@
+package generator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// globFilter matches names with include and exclude glob patterns. Name
+// matches filter if it matches any of include patterns, or include list is
+// empty, and doesn't match any of exclude patterns. Pattern syntax is the same
+// as for path.Match, additionally "**" matches any sequence of characters
+// including "/".
+type globFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+var (
+	// messageFilter is used for choosing messages transformers are generated
+	// for, see SetMessageFilter.
+	messageFilter globFilter
+	// fileFilter is used for choosing .proto files which are processed, see
+	// SetFileFilter.
+	fileFilter globFilter
+	// optIn is true if only messages with transformer options are processed,
+	// see SetOptIn.
+	optIn bool
+)
+
+// newGlobFilter returns filter with comma-separated lists of include and
+// exclude patterns.
+func newGlobFilter(include, exclude string) (globFilter, error) {
+	in, err := compilePatterns(include)
+	if err != nil {
+		return globFilter{}, err
+	}
+
+	ex, err := compilePatterns(exclude)
+	if err != nil {
+		return globFilter{}, err
+	}
+
+	return globFilter{include: in, exclude: ex}, nil
+}
+
+// compilePatterns compiles comma-separated list of glob patterns, empty
+// patterns are omitted.
+func compilePatterns(s string) ([]*regexp.Regexp, error) {
+	res := []*regexp.Regexp{}
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+
+		re, err := globRegexp(p)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "pattern %q", p)
+		}
+		res = append(res, re)
+	}
+
+	return res, nil
+}
+
+// errBadPattern is returned for glob patterns with unclosed character class.
+var errBadPattern = errors.New("syntax error in pattern")
+
+// globRegexp converts glob pattern into regular expression which matches the
+// whole name.
+func globRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				// "a/**/b" matches "a/b" as well.
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					b.WriteString("(.*/)?")
+					continue
+				}
+				b.WriteString(".*")
+				continue
+			}
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return nil, errBadPattern
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "^") {
+				class = "^" + regexp.QuoteMeta(class[1:])
+			} else {
+				class = regexp.QuoteMeta(class)
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+				c = pattern[i]
+			}
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+// match returns true if any of names matches filter.
+func (gf globFilter) match(names ...string) bool {
+	matchAny := func(patterns []*regexp.Regexp) bool {
+		for _, re := range patterns {
+			for _, n := range names {
+				if re.MatchString(n) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if len(gf.include) > 0 && !matchAny(gf.include) {
+		return false
+	}
+
+	return !matchAny(gf.exclude)
+}
+
+// SetMessageFilter sets comma-separated lists of glob patterns for messages
+// which transformers are generated for, e.g. "Order,OrderItem" and
+// "*Internal". Patterns are matched with message name and full message name
+// with package. Empty include list means all messages.
+func SetMessageFilter(include, exclude string) error {
+	gf, err := newGlobFilter(include, exclude)
+	if err != nil {
+		return pkgerrors.Wrap(err, "message filter")
+	}
+
+	messageFilter = gf
+
+	return nil
+}
+
+// SetFileFilter sets comma-separated lists of glob patterns for paths of
+// .proto files which are processed, e.g. "api/**" and "google/**". Empty
+// include list means all files.
+func SetFileFilter(include, exclude string) error {
+	gf, err := newGlobFilter(include, exclude)
+	if err != nil {
+		return pkgerrors.Wrap(err, "file filter")
+	}
+
+	fileFilter = gf
+
+	return nil
+}
+
+// SetOptIn switches generator into opt-in mode: only messages with message
+// options of transformer package, such as transformer.go_struct, are
+// processed, other messages are ignored silently. Files without such messages
+// are skipped.
+func SetOptIn(on bool) {
+	optIn = on
+}
+
+// annotated returns true if message msg has any message option of
+// transformer package.
+func annotated(msg *descriptorpb.DescriptorProto) bool {
+	if msg.GetOptions() == nil {
+		return false
+	}
+
+	for _, opt := range []protoreflect.ExtensionType{options.E_GoStruct, options.E_GoPatch, options.E_GoBuilder, options.E_GoJson, options.E_GoMerge, options.E_GoInto, options.E_GoPool} {
+		if hasOption(msg.GetOptions(), opt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// optedIn returns messages of msgs which are processed in current mode, all
+// messages if opt-in mode is off.
+func optedIn(msgs []fileMessage) []fileMessage {
+	if !optIn {
+		return msgs
+	}
+
+	out := []fileMessage{}
+	for _, fm := range msgs {
+		if annotated(fm.desc) {
+			out = append(out, fm)
+		}
+	}
+
+	return out
+}
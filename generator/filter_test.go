@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Filter", func() {
+
+	DescribeTable("globFilter.match",
+		func(include, exclude string, names []string, expected bool) {
+			gf, err := newGlobFilter(include, exclude)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gf.match(names...)).To(Equal(expected))
+		},
+
+		Entry("empty filter", "", "", []string{"Order"}, true),
+		Entry("included", "Order,OrderItem", "", []string{"OrderItem"}, true),
+		Entry("not included", "Order,OrderItem", "", []string{"Product"}, false),
+		Entry("excluded", "", "*Internal", []string{"OrderInternal"}, false),
+		Entry("included and excluded", "Order*", "*Internal", []string{"OrderInternal"}, false),
+		Entry("full name", "svc.example.*", "", []string{"Order", "svc.example.Order"}, true),
+		Entry("star does not match slash", "api/*.proto", "", []string{"api/v1/order.proto"}, false),
+		Entry("double star", "api/**", "", []string{"api/v1/order.proto"}, true),
+		Entry("double star directories", "api/**/order.proto", "", []string{"api/order.proto"}, true),
+		Entry("excluded directory", "", "google/**", []string{"google/api/http.proto"}, false),
+		Entry("character class", "order_v[12].proto", "", []string{"order_v2.proto"}, true),
+		Entry("negated character class", "order_v[^12].proto", "", []string{"order_v2.proto"}, false),
+		Entry("escaped dot", "order.proto", "", []string{"order_proto"}, false),
+	)
+
+	It("returns an error for invalid pattern", func() {
+		_, err := newGlobFilter("[", "")
+		Expect(err).To(MatchError(`pattern "[": syntax error in pattern`))
+	})
+
+	Describe("SetFileFilter", func() {
+
+		AfterEach(func() {
+			fileFilter = globFilter{}
+		})
+
+		It("skips filtered files", func() {
+			Expect(SetFileFilter("", "google/**")).To(Succeed())
+
+			_, _, err := ProcessFile(&descriptorpb.FileDescriptorProto{Name: sp("google/api/http.proto")},
+				sp("pkg"), sp(""), sp(""), MessageOptionList{}, false, false)
+			Expect(err).To(Equal(ErrFileSkipped))
+		})
+	})
+
+	Describe("SetMessageFilter", func() {
+
+		AfterEach(func() {
+			messageFilter = globFilter{}
+		})
+
+		It("sets message filter", func() {
+			Expect(SetMessageFilter("Order", "")).To(Succeed())
+			Expect(messageFilter.match("Order")).To(BeTrue())
+			Expect(messageFilter.match("Product")).To(BeFalse())
+		})
+	})
+
+	Describe("SetOptIn", func() {
+
+		AfterEach(func() {
+			SetOptIn(false)
+		})
+
+		withStruct := func(name string) fileMessage {
+			desc := &descriptorpb.DescriptorProto{Name: sp(name), Options: &descriptorpb.MessageOptions{}}
+			proto.SetExtension(desc.Options, options.E_GoStruct, name)
+			return fileMessage{name: name, desc: desc}
+		}
+
+		It("keeps annotated messages only", func() {
+			msgs := []fileMessage{
+				withStruct("Order"),
+				{name: "Shared", desc: &descriptorpb.DescriptorProto{Name: sp("Shared")}},
+				{name: "Entry", desc: &descriptorpb.DescriptorProto{Name: sp("Entry"), Options: &descriptorpb.MessageOptions{MapEntry: bp(true)}}},
+			}
+
+			Expect(optedIn(msgs)).To(Equal(msgs))
+
+			SetOptIn(true)
+			Expect(optedIn(msgs)).To(Equal(msgs[:1]))
+		})
+
+		It("skips files without annotated messages", func() {
+			SetOptIn(true)
+
+			_, _, err := ProcessFile(&descriptorpb.FileDescriptorProto{
+				Name:        sp("shared.proto"),
+				MessageType: []*descriptorpb.DescriptorProto{{Name: sp("Shared")}},
+			}, sp("pkg"), sp(""), sp(""), MessageOptionList{}, false, false)
+			Expect(err).To(Equal(ErrFileSkipped))
+		})
+	})
+})
@@ -0,0 +1,181 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// strictFormat is true if generated transformers are formatted with stricter
+// gofumpt-style rules after go/format, see SetGofumpt.
+var strictFormat bool
+
+// SetGofumpt turns on stricter formatting of generated transformers: empty
+// lines at the beginning and the end of blocks are removed and standard
+// packages are imported in a separate group before other packages, like
+// gofumpt does.
+func SetGofumpt(on bool) {
+	strictFormat = on
+}
+
+// formatSource formats generated code src of file name with go/format and
+// stricter rules if they are turned on. If code can't be formatted, error
+// points to the line of src, see codeError.
+func formatSource(name string, src []byte) (string, error) {
+	out, err := format.Source(src)
+	if err != nil {
+		return "", codeError(name, src, err)
+	}
+
+	if !strictFormat {
+		return string(out), nil
+	}
+
+	out, err = trimBlockLines(groupStdImports(out))
+	if err != nil {
+		return "", codeError(name, out, err)
+	}
+
+	return string(out), nil
+}
+
+// codeError returns error err of generated code src of file name together
+// with lines of src around the first error, so templates which produce
+// invalid code are easy to find.
+func codeError(name string, src []byte, err error) error {
+	el, ok := err.(scanner.ErrorList)
+	if !ok || len(el) == 0 {
+		return fmt.Errorf("%s: generated code is invalid: %s", name, err)
+	}
+
+	line := el[0].Pos.Line
+	lines := strings.Split(string(src), "\n")
+
+	from, to := line-3, line+2
+	if from < 0 {
+		from = 0
+	}
+	if to > len(lines) {
+		to = len(lines)
+	}
+
+	b := &strings.Builder{}
+	for i := from; i < to; i++ {
+		marker := " "
+		if i+1 == line {
+			marker = ">"
+		}
+		fmt.Fprintf(b, "\n%s %4d | %s", marker, i+1, lines[i])
+	}
+
+	return fmt.Errorf("%s: generated code is invalid: line %d: %s%s", name, line, el[0].Msg, b)
+}
+
+// trimBlockLines removes empty lines after opening and before closing braces
+// of blocks of formatted code src.
+func trimBlockLines(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	line := func(p token.Pos) int { return fset.Position(p).Line }
+	empty := map[int]bool{}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		b, ok := n.(*ast.BlockStmt)
+		if !ok || len(b.List) == 0 {
+			return true
+		}
+
+		first, last := line(b.List[0].Pos()), line(b.List[len(b.List)-1].End())
+		for _, cg := range f.Comments {
+			if cg.Pos() > b.Lbrace && cg.End() < b.Rbrace {
+				if l := line(cg.Pos()); l < first {
+					first = l
+				}
+				if l := line(cg.End()); l > last {
+					last = l
+				}
+			}
+		}
+
+		for l := line(b.Lbrace) + 1; l < first; l++ {
+			empty[l] = true
+		}
+		for l := last + 1; l < line(b.Rbrace); l++ {
+			empty[l] = true
+		}
+
+		return true
+	})
+
+	out := &bytes.Buffer{}
+	for i, l := range bytes.SplitAfter(src, []byte("\n")) {
+		if empty[i+1] && len(bytes.TrimSpace(l)) == 0 {
+			continue
+		}
+		out.Write(l)
+	}
+
+	return out.Bytes(), nil
+}
+
+// groupStdImports moves imports of standard packages of the first import
+// declaration of formatted code src into a separate group before imports of
+// other packages. Declarations with comments are left as is.
+func groupStdImports(src []byte) []byte {
+	s := string(src)
+
+	start := strings.Index(s, "\nimport (\n")
+	if start < 0 {
+		return src
+	}
+	start += len("\nimport (\n")
+
+	end := strings.Index(s[start:], "\n)\n")
+	if end < 0 {
+		return src
+	}
+	end += start
+
+	std, other := []string{}, []string{}
+	for _, l := range strings.Split(s[start:end], "\n") {
+		spec := strings.TrimSpace(l)
+		switch {
+		case spec == "":
+		case strings.HasPrefix(spec, "//"):
+			return src
+		case strings.Contains(strings.SplitN(spec[strings.Index(spec, `"`)+1:], "/", 2)[0], "."):
+			other = append(other, l)
+		default:
+			std = append(std, l)
+		}
+	}
+
+	// specs are sorted by import paths, names go before paths.
+	byPath := func(specs []string) func(i, j int) bool {
+		return func(i, j int) bool {
+			return specs[i][strings.Index(specs[i], `"`):] < specs[j][strings.Index(specs[j], `"`):]
+		}
+	}
+	sort.SliceStable(std, byPath(std))
+	sort.SliceStable(other, byPath(other))
+
+	groups := []string{}
+	if len(std) > 0 {
+		groups = append(groups, strings.Join(std, "\n"))
+	}
+	if len(other) > 0 {
+		groups = append(groups, strings.Join(other, "\n"))
+	}
+
+	return []byte(s[:start] + strings.Join(groups, "\n\n") + s[end:])
+}
@@ -0,0 +1,95 @@
+package generator
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Format", func() {
+
+	AfterEach(func() {
+		SetGofumpt(false)
+	})
+
+	src := `package transform
+
+import (
+	"github.com/acme/model"
+	"fmt"
+	"time"
+)
+
+func PbToProduct() model.Product {
+
+	s := model.Product{
+	}
+	if s.Name == "" {
+
+		fmt.Println(time.Now())
+
+	}
+
+	return s
+}
+`
+
+	It("formats generated code with go/format", func() {
+		out, err := formatSource("product.proto", []byte(src))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(`package transform
+
+import (
+	"fmt"
+	"github.com/acme/model"
+	"time"
+)
+
+func PbToProduct() model.Product {
+
+	s := model.Product{}
+	if s.Name == "" {
+
+		fmt.Println(time.Now())
+
+	}
+
+	return s
+}
+`))
+	})
+
+	It("removes empty lines of blocks and groups standard imports in gofumpt mode", func() {
+		SetGofumpt(true)
+
+		out, err := formatSource("product.proto", []byte(src))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(`package transform
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/acme/model"
+)
+
+func PbToProduct() model.Product {
+	s := model.Product{}
+	if s.Name == "" {
+		fmt.Println(time.Now())
+	}
+
+	return s
+}
+`))
+	})
+
+	It("returns an error with lines around invalid code", func() {
+		_, err := formatSource("product.proto", []byte("package transform\n\nfunc PbToProduct() {\n\ts := model.Product{\n\treturn s\n}\n"))
+		Expect(err).To(MatchError(`product.proto: generated code is invalid: line 5: expected operand, found 'return'
+     3 | func PbToProduct() {
+     4 | 	s := model.Product{
+>    5 | 	return s
+     6 | }
+     7 | `))
+	})
+})
@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"text/template"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/proto"
+)
+
+// Default formats of transformer names, see transformer.func_name_format_pb_to_go
+// and transformer.func_name_format_go_to_pb options.
+const (
+	defaultPbToGoFormat = "PbTo{{.Dst}}"
+	defaultGoToPbFormat = "{{.Src}}ToPb"
+)
+
+// funcNameData contains data for templates of transformer names. Src and Dst
+// are source and destination of transformer, e.g. message Product and model
+// Product for PbToProduct.
+type funcNameData struct {
+	Src string
+	Dst string
+}
+
+// funcNameFormats contains parsed name formats of file transformers, nil
+// formats mean default names.
+type funcNameFormats struct {
+	pbToGo *template.Template
+	goToPb *template.Template
+}
+
+// newFuncNameFormats returns name formats of file options m, nil if file has
+// no transformer.func_name_format_* options.
+func newFuncNameFormats(m proto.Message) (*funcNameFormats, error) {
+	p2g, _ := getStringOption(m, options.E_FuncNameFormatPbToGo)
+	g2p, _ := getStringOption(m, options.E_FuncNameFormatGoToPb)
+	if p2g == "" && g2p == "" {
+		return nil, nil
+	}
+
+	if p2g == "" {
+		p2g = defaultPbToGoFormat
+	}
+	if g2p == "" {
+		g2p = defaultGoToPbFormat
+	}
+
+	var err error
+	nf := &funcNameFormats{}
+
+	if nf.pbToGo, err = template.New(options.E_FuncNameFormatPbToGo.Name).Parse(p2g); err != nil {
+		return nil, err
+	}
+	if nf.goToPb, err = template.New(options.E_FuncNameFormatGoToPb.Name).Parse(g2p); err != nil {
+		return nil, err
+	}
+
+	return nf, nil
+}
+
+// names returns names of transformers of proto message msg into model
+// target and back, e.g. ProductFromProto and ProductToProto.
+func (nf *funcNameFormats) names(msg, target string) (string, string, error) {
+	fn := targetFuncName(target)
+
+	p2g, err := execFuncName(nf.pbToGo, funcNameData{Src: msg, Dst: fn})
+	if err != nil {
+		return "", "", err
+	}
+
+	g2p, err := execFuncName(nf.goToPb, funcNameData{Src: fn, Dst: msg})
+	if err != nil {
+		return "", "", err
+	}
+
+	if p2g == g2p {
+		return "", "", fmt.Errorf("transformers of message %s and model %s have the same name %s", msg, target, p2g)
+	}
+
+	return p2g, g2p, nil
+}
+
+// execFuncName executes name format t with d and checks that result is a
+// valid Go identifier.
+func execFuncName(t *template.Template, d funcNameData) (string, error) {
+	b := &bytes.Buffer{}
+	if err := t.Execute(b, d); err != nil {
+		return "", err
+	}
+
+	name := b.String()
+	if !token.IsIdentifier(name) {
+		return "", fmt.Errorf("(%s) option gives %q, it's not a valid Go function name", t.Name(), name)
+	}
+
+	return name, nil
+}
+
+// transformerNames returns names of transformers of message mo without
+// suffixes, e.g. PbToProduct and ProductToPb.
+func transformerNames(mo MessageOption) (string, string) {
+	if p2g, g2p := mo.FuncNames(); p2g != "" {
+		return p2g, g2p
+	}
+
+	fn := targetFuncName(mo.Target())
+
+	return "PbTo" + fn, fn + "ToPb"
+}
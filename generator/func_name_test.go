@@ -0,0 +1,145 @@
+package generator
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var _ = Describe("FuncName", func() {
+
+	fileOptions := func(p2g, g2p string) *descriptorpb.FileOptions {
+		fo := &descriptorpb.FileOptions{}
+		if p2g != "" {
+			proto.SetExtension(fo, options.E_FuncNameFormatPbToGo, p2g)
+		}
+		if g2p != "" {
+			proto.SetExtension(fo, options.E_FuncNameFormatGoToPb, g2p)
+		}
+		return fo
+	}
+
+	Describe("funcNameFormats", func() {
+
+		It("returns nil if file has no format options", func() {
+			nf, err := newFuncNameFormats(fileOptions("", ""))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nf).To(BeNil())
+		})
+
+		It("makes names of transformers out of formats", func() {
+			nf, err := newFuncNameFormats(fileOptions("{{.Dst}}FromProto", "{{.Src}}ToProto"))
+			Expect(err).NotTo(HaveOccurred())
+
+			p2g, g2p, err := nf.names("Order_Item", "billing.OrderItem")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p2g).To(Equal("BillingOrderItemFromProto"))
+			Expect(g2p).To(Equal("BillingOrderItemToProto"))
+		})
+
+		It("uses default format of direction without option", func() {
+			nf, err := newFuncNameFormats(fileOptions("", "{{.Src}}ToProto"))
+			Expect(err).NotTo(HaveOccurred())
+
+			p2g, g2p, err := nf.names("Product", "Product")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p2g).To(Equal("PbToProduct"))
+			Expect(g2p).To(Equal("ProductToProto"))
+		})
+
+		It("returns an error if format is not a template", func() {
+			_, err := newFuncNameFormats(fileOptions("{{.Dst", ""))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns an error if name is not a Go identifier", func() {
+			nf, err := newFuncNameFormats(fileOptions("{{.Dst}}-FromProto", ""))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = nf.names("Product", "Product")
+			Expect(err).To(MatchError(`(transformer.func_name_format_pb_to_go) option gives "Product-FromProto", it's not a valid Go function name`))
+		})
+
+		It("returns an error if transformers of both directions have the same name", func() {
+			nf, err := newFuncNameFormats(fileOptions("Convert{{.Dst}}", "Convert{{.Src}}"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = nf.names("Product", "Product")
+			Expect(err).To(MatchError("transformers of message Product and model Product have the same name ConvertProduct"))
+		})
+	})
+
+	Describe("CollectAllMessages", func() {
+
+		message := func(name, target string) *descriptorpb.DescriptorProto {
+			m := &descriptorpb.DescriptorProto{Name: sp(name), Options: &descriptorpb.MessageOptions{}}
+			proto.SetExtension(m.Options, options.E_GoStruct, target)
+			return m
+		}
+
+		It("keeps names of transformers of file messages", func() {
+			mol, err := CollectAllMessages(&pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{{
+					Name:        sp("product.proto"),
+					Package:     sp("pb"),
+					Options:     fileOptions("{{.Dst}}FromProto", "{{.Src}}ToProto"),
+					MessageType: []*descriptorpb.DescriptorProto{message("Product", "Product"), {Name: sp("Empty")}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			p2g, g2p := mol["pb.Product"].FuncNames()
+			Expect(p2g).To(Equal("ProductFromProto"))
+			Expect(g2p).To(Equal("ProductToProto"))
+
+			p2g, _ = mol["pb.Empty"].FuncNames()
+			Expect(p2g).To(BeEmpty())
+		})
+
+		It("returns an error if transformers of messages have the same name", func() {
+			_, err := CollectAllMessages(&pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{{
+					Name:        sp("product.proto"),
+					Package:     sp("pb"),
+					Options:     fileOptions("{{.Dst}}FromProto", "{{.Src}}ToProto"),
+					MessageType: []*descriptorpb.DescriptorProto{message("Product", "Product"), message("ProductV2", "Product")},
+				}},
+			})
+			Expect(err).To(MatchError("product.proto: transformers of messages Product and ProductV2 have the same name ProductFromProto"))
+		})
+	})
+
+	Describe("transformer names", func() {
+
+		render := func(t *template.Template, d Data) string {
+			w := &bytes.Buffer{}
+			Expect(t.Execute(w, d)).To(Succeed())
+			return w.String()
+		}
+
+		It("are used by templates and references of messages", func() {
+			d := Data{Src: "Product", SrcFn: "Pb", Dst: "Product", DstFn: "Product", Func: "ProductFromProto", ReverseFunc: "ProductToProto"}
+			Expect(render(funcNameT, d)).To(Equal("ProductFromProto"))
+			Expect(render(funcNameT, d.reverse())).To(Equal("ProductToProto"))
+			Expect(render(reverseFuncNameT, d)).To(Equal("ProductToProto"))
+
+			p2g, g2p := transformerNames(messageOption{targetName: "Product", pbToGo: "ProductFromProto", goToPb: "ProductToProto"})
+			Expect(p2g).To(Equal("ProductFromProto"))
+			Expect(g2p).To(Equal("ProductToProto"))
+
+			p2g, g2p = transformerNames(messageOption{targetName: "billing.Address"})
+			Expect(p2g).To(Equal("PbToBillingAddress"))
+			Expect(g2p).To(Equal("BillingAddressToPb"))
+
+			c := OneofCase{Field: "Card", Func: "CardPayment", ProtoToGo: "CardPaymentFromProto", GoToProto: "CardPaymentToProto", VariantIsPointer: true}
+			Expect(c.toModel("v.Card", "")).To(Equal("CardPaymentFromProtoPtr(v.Card, opts...)"))
+			Expect(c.toProto("v")).To(Equal("CardPaymentToProtoPtr(v, opts...)"))
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package generator
+
+import "strings"
+
+// fuzzTests is true if fuzz tests of transformers are generated, see
+// SetFuzzTests.
+var fuzzTests bool
+
+// fuzzFiles holds content of fuzz tests by output paths of generated files,
+// see FuzzTests.
+var fuzzFiles = map[string]string{}
+
+// SetFuzzTests turns on fuzz tests: each generated file gets a _fuzz_test.go
+// file, which transforms messages decoded from random data into models and
+// back, so panics on unexpected values, e.g. nil sub messages, are caught by
+// go test -fuzz. Fuzz tests require Go 1.18.
+func SetFuzzTests(on bool) {
+	fuzzTests = on
+}
+
+// FuzzTests returns path and content of fuzz test file of generated file
+// path, empty strings are returned if fuzz tests are turned off or file has no
+// messages which are transformed in both directions.
+func FuzzTests(path string) (string, string) {
+	content, ok := fuzzFiles[path]
+	if !ok {
+		return "", ""
+	}
+
+	return strings.TrimSuffix(path, ".go") + "_fuzz_test.go", content
+}
@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fuzz tests", func() {
+
+	It("compares fields which are transformed as is", func() {
+		rt := roundTripTest{Values: []roundTripValue{
+			{Name: "Id", Value: "1"},
+			{Name: "Name", Value: `"name"`, Lossless: true},
+		}}
+
+		Expect(rt.FuzzValues()).To(Equal([]roundTripValue{{Name: "Name", Value: `"name"`, Lossless: true}}))
+	})
+
+	It("returns nothing for files which are not processed", func() {
+		path, content := FuzzTests("order_transformer.go")
+		Expect(path).To(BeEmpty())
+		Expect(content).To(BeEmpty())
+	})
+
+	Describe("fuzz template", func() {
+
+		It("transforms messages decoded from random data", func() {
+			w := &bytes.Buffer{}
+			Expect(fuzzT.Execute(w, []roundTripTest{{
+				Data: Data{Src: "Product", SrcPref: "pb", SrcFn: "Pb", Dst: "Product", DstFn: "Product"},
+				Values: []roundTripValue{
+					{Name: "Id", Value: "1"},
+					{Name: "Name", Value: `"name"`, Lossless: true},
+				},
+			}})).To(Succeed())
+
+			Expect(w.String()).To(Equal(`
+
+// FuzzPbToProduct checks that transformers of messages decoded
+// from random data don't panic and keep values of fields.
+func FuzzPbToProduct(f *testing.F) {
+	seed, err := proto.Marshal(protov1.MessageV2(&pb.Product{
+		Id: 1,
+		Name: "name",
+	}))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(seed)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		src := &pb.Product{}
+		if err := proto.Unmarshal(data, protov1.MessageV2(src)); err != nil {
+			t.Skip()
+		}
+
+		out := ProductToPbPtr(PbToProductPtr(src))
+
+		want := &pb.Product{
+			Name: src.Name,
+		}
+		got := &pb.Product{
+			Name: out.Name,
+		}
+		if !proto.Equal(protov1.MessageV2(want), protov1.MessageV2(got)) {
+			t.Errorf("PbToProduct and ProductToPb don't round-trip (-want +got):\n%s", cmp.Diff(want, got))
+		}
+	})
+}
+`))
+		})
+
+		It("accepts errors of transformers", func() {
+			w := &bytes.Buffer{}
+			Expect(fuzzT.Execute(w, []roundTripTest{{
+				Data: Data{Src: "Product", SrcPref: "pb", SrcFn: "Pb", Dst: "Product", DstFn: "Product", WithErrors: true, WithContext: true},
+			}})).To(Succeed())
+
+			Expect(w.String()).To(ContainSubstring(`
+		ctx := context.Background()
+
+		m, err := PbToProductPtr(ctx, src)
+		if err != nil {
+			return
+		}
+
+		_, err = ProductToPbPtr(ctx, m)
+		if err != nil {
+			return
+		}
+	})
+}
+`))
+		})
+
+		It("skips tests with reason", func() {
+			w := &bytes.Buffer{}
+			Expect(fuzzT.Execute(w, []roundTripTest{{
+				Data: Data{Src: "Refund", SrcPref: "pb", SrcFn: "Pb", Dst: "Refund", DstFn: "Refund"},
+				Skip: "field Currency is transformed by helper or custom functions",
+			}})).To(Succeed())
+
+			Expect(w.String()).To(ContainSubstring("func FuzzPbToRefund(f *testing.F) {\n\tf.Skip(\"field Currency is transformed by helper or custom functions\")\n}\n"))
+			Expect(w.String()).NotTo(ContainSubstring("f.Fuzz("))
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package generator
+
+import (
+	"go/ast"
+	"sort"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+)
+
+// modelGaps returns sorted names of exported fields of model structure s which
+// are not filled by transformers of fields. Model fields with
+// //transformer:skip directive are left out on purpose, so they are not gaps.
+// Fields promoted from embedded structures are gaps only if promoted is true,
+// i.e. message has transformer.flatten_embedded option. Edges of ent entities
+// are loaded on demand, so neither they nor their Edges field are gaps.
+func modelGaps(fields []Field, s source.Structure, promoted bool) []string {
+	covered := map[string]struct{}{}
+	for _, f := range flatFields(fields) {
+		covered[f.Name] = struct{}{}
+		if f.Wrapper != nil && f.Wrapper.Currency != "" {
+			covered[f.Wrapper.Currency] = struct{}{}
+		}
+	}
+
+	gaps := []string{}
+	for name, fi := range s {
+		if !ast.IsExported(name) || fi.Skip || fi.Edge || (fi.Promoted != "" && !promoted) || (name == entEdgesField && entEntity(s)) {
+			continue
+		}
+
+		if _, ok := covered[name]; !ok {
+			gaps = append(gaps, name)
+		}
+	}
+
+	sort.Strings(gaps)
+
+	return gaps
+}
@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Gap", func() {
+
+	Describe("modelGaps", func() {
+
+		It("returns sorted model fields which are not covered by fields", func() {
+			s := source.Structure{
+				"ID":                       {Type: "int64"},
+				"City":                     {Type: "string"},
+				"Price":                    {Type: "float64"},
+				"Cache":                    {Type: "string", Skip: true},
+				"Created":                  {Type: "time.Time"},
+				"internal":                 {Type: "string"},
+				"unsupported_interface_Fn": {Type: "*ast.FuncType"},
+			}
+			fields := []Field{
+				{Name: "ID"},
+				{ProtoName: "Address", EmbeddedFields: []Field{{Name: "City"}}},
+			}
+
+			Expect(modelGaps(fields, s, false)).To(Equal([]string{"Created", "Price"}))
+		})
+
+		It("returns empty list if all fields are covered", func() {
+			s := source.Structure{"ID": {Type: "int64"}}
+			Expect(modelGaps([]Field{{Name: "ID"}}, s, false)).To(BeEmpty())
+		})
+
+		It("treats currency fields of decimal fields as covered", func() {
+			s := source.Structure{"Total": {Type: "decimal.Decimal"}, "Currency": {Type: "string"}}
+			fields := []Field{{Name: "Total", Wrapper: &Elem{Kind: elemDecimal, Currency: "Currency"}}}
+
+			Expect(modelGaps(fields, s, false)).To(BeEmpty())
+		})
+
+		It("returns promoted fields if message flattens embedded structures", func() {
+			s := source.Structure{
+				"Name":      {Type: "string"},
+				"CreatedAt": {Type: "time.Time", Promoted: "BaseModel"},
+			}
+
+			Expect(modelGaps([]Field{{Name: "Name"}}, s, false)).To(BeEmpty())
+			Expect(modelGaps([]Field{{Name: "Name"}}, s, true)).To(Equal([]string{"CreatedAt"}))
+		})
+
+		It("leaves out edges of ent entities", func() {
+			s := source.Structure{
+				"Name":    {Type: "string"},
+				"Edges":   {Type: "TeamEdges"},
+				"Members": {Type: "User", IsPointer: true, IsSlice: true, Edge: true},
+			}
+
+			Expect(modelGaps([]Field{{Name: "Name"}}, s, false)).To(BeEmpty())
+		})
+	})
+})
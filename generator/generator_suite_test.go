@@ -4,9 +4,9 @@ import (
 	"testing"
 
 	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 func TestGenerator(t *testing.T) {
@@ -15,8 +15,14 @@ func TestGenerator(t *testing.T) {
 }
 
 var (
-	typInt64   = descriptor.FieldDescriptorProto_TYPE_INT64
-	typMessage = descriptor.FieldDescriptorProto_TYPE_MESSAGE
+	typInt64   = descriptorpb.FieldDescriptorProto_TYPE_INT64
+	typInt32   = descriptorpb.FieldDescriptorProto_TYPE_INT32
+	typString  = descriptorpb.FieldDescriptorProto_TYPE_STRING
+	typMessage = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+
+	typRepeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+
+	labelRepeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
 
 	sp = func(s string) *string {
 		return &s
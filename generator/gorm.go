@@ -0,0 +1,42 @@
+package generator
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+)
+
+// gormModelField returns true if model field gf is promoted from embedded
+// gorm.Model and file has transformer.model_style = GORM option, such fields
+// are transformed without transformer.flatten_embedded option.
+func gormModelField(gf source.FieldInfo, pol policies) bool {
+	return pol.modelStyle == options.ModelStyle_GORM && gf.Promoted == "Model"
+}
+
+// gormAssociation returns true if model field gf is a GORM association, i.e.
+// a structure of sl, a pointer or a slice of them, which isn't embedded into
+// the model with gorm:"embedded" tag.
+func gormAssociation(gf source.FieldInfo, sl source.StructureList) bool {
+	if gf.Key != "" || strings.Contains(reflect.StructTag(gf.Tag).Get("gorm"), "embedded") {
+		return false
+	}
+
+	_, ok := sl[gf.Type]
+
+	return ok
+}
+
+// withoutAssociations returns names of model fields of s without GORM
+// associations, see gormAssociation.
+func withoutAssociations(names []string, s source.Structure, sl source.StructureList) []string {
+	out := []string{}
+	for _, name := range names {
+		if !gormAssociation(s[name], sl) {
+			out = append(out, name)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,41 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GORM", func() {
+
+	sl := source.StructureList{
+		"Order": {
+			"ID":       {Type: "uint", Promoted: "Model"},
+			"Customer": {Type: "Customer", IsPointer: true},
+			"Items":    {Type: "Item", IsSlice: true},
+			"Address":  {Type: "Address", Tag: `gorm:"embedded;embeddedPrefix:address_"`},
+			"Labels":   {Type: "Label", Key: "string"},
+		},
+		"Customer": {},
+		"Item":     {},
+		"Address":  {},
+		"Label":    {},
+	}
+
+	DescribeTable("gormAssociation",
+		func(name string, expected bool) {
+			Expect(gormAssociation(sl["Order"][name], sl)).To(Equal(expected))
+		},
+		Entry("scalar", "ID", false),
+		Entry("pointer to model", "Customer", true),
+		Entry("slice of models", "Items", true),
+		Entry("embedded with tag", "Address", false),
+		Entry("map", "Labels", false),
+	)
+
+	It("leaves associations out of model gaps", func() {
+		Expect(withoutAssociations([]string{"Address", "Customer", "ID", "Items"}, sl["Order"], sl)).
+			To(Equal([]string{"Address", "ID"}))
+	})
+})
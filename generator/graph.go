@@ -0,0 +1,208 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// messageEdge is a field of one message which refers another message.
+type messageEdge struct {
+	// Name of referred message, e.g. pkg.Address. Map fields refer message of
+	// map values.
+	to string
+	// If true, field is neither repeated nor nullable, i.e. proto structure
+	// holds referred message by value.
+	value bool
+	// If true, generated transformer calls transformer of referred message.
+	// It's false for skipped, embedded and custom fields.
+	call bool
+}
+
+// messageGraph maps message names to references to other messages.
+type messageGraph map[string][]messageEdge
+
+// newMessageGraph returns graph of references between messages. Map entries
+// are not nodes of the graph, map fields refer messages of map values.
+func newMessageGraph(messages MessageOptionList) messageGraph {
+	g := messageGraph{}
+
+	for name, mo := range messages {
+		if isMapEntry(mo) {
+			continue
+		}
+
+		g[name] = nil
+		for _, fdp := range mo.Descriptor().GetField() {
+			to := strings.TrimPrefix(fdp.GetTypeName(), ".")
+			if fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || extractSkipOption(fdp.Options) {
+				continue
+			}
+
+			e := messageEdge{
+				to:    to,
+				value: fdp.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED && !extractNullOption(fdp),
+				call:  !extractEmbeddedOption(fdp.Options) && !getBoolOption(fdp.Options, options.E_Custom),
+			}
+
+			if entry, ok := messages[to]; ok && isMapEntry(entry) {
+				e.to, e.value = "", false
+				for _, vf := range entry.Descriptor().GetField() {
+					if vf.GetName() == "value" && vf.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+						e.to = strings.TrimPrefix(vf.GetTypeName(), ".")
+					}
+				}
+			}
+
+			// references to messages out of request, such as well-known
+			// types, are not followed.
+			if _, ok := messages[e.to]; ok {
+				g[name] = append(g[name], e)
+			}
+		}
+	}
+
+	return g
+}
+
+// isMapEntry returns true if mo is generated map entry message.
+func isMapEntry(mo MessageOption) bool {
+	return mo.Descriptor().GetOptions().GetMapEntry()
+}
+
+// cycles returns strongly connected components of graph g which contain
+// cycles, only edges accepted by follow are considered. Components and
+// message names in them are sorted.
+func (g messageGraph) cycles(follow func(messageEdge) bool) [][]string {
+	names := make([]string, 0, len(g))
+	for name := range g {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Tarjan's algorithm.
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	stack := []string{}
+	out := [][]string{}
+
+	var visit func(n string)
+	visit = func(n string) {
+		index[n], low[n] = len(index), len(index)
+		stack = append(stack, n)
+		onStack[n] = true
+
+		selfLoop := false
+		for _, e := range g[n] {
+			if !follow(e) {
+				continue
+			}
+
+			if e.to == n {
+				selfLoop = true
+			}
+
+			if _, ok := index[e.to]; !ok {
+				visit(e.to)
+				if low[e.to] < low[n] {
+					low[n] = low[e.to]
+				}
+			} else if onStack[e.to] && index[e.to] < low[n] {
+				low[n] = index[e.to]
+			}
+		}
+
+		if low[n] != index[n] {
+			return
+		}
+
+		var scc []string
+		for {
+			m := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[m] = false
+			scc = append(scc, m)
+			if m == n {
+				break
+			}
+		}
+
+		if len(scc) > 1 || selfLoop {
+			sort.Strings(scc)
+			out = append(out, scc)
+		}
+	}
+
+	for _, n := range names {
+		if _, ok := index[n]; !ok {
+			visit(n)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i][0] < out[j][0] })
+
+	return out
+}
+
+// generatedMessages returns messages of files which transformers are
+// generated for: files of request files and passing file filter, see
+// SetFileFilter.
+func generatedMessages(messages MessageOptionList, files []string) MessageOptionList {
+	generated := map[string]bool{}
+	for _, name := range files {
+		generated[name] = fileFilter.match(name)
+	}
+
+	out := MessageOptionList{}
+	for name, mo := range messages {
+		if o, ok := mo.(messageOption); ok && generated[o.fileName] {
+			out[name] = mo
+		}
+	}
+
+	return out
+}
+
+// CheckMessageCycles returns an error if messages of generated files refer
+// each other in a way which can not be transformed: messages hold each other
+// by value, or transformers of messages call each other while one of
+// messages has no transformer.go_struct option, so its transformer is never
+// generated. Files are names of files to generate of request, messages of
+// other files and of files which don't pass file filter aren't checked.
+func CheckMessageCycles(messages MessageOptionList, files []string) error {
+	messages = generatedMessages(messages, files)
+	g := newMessageGraph(messages)
+	problems := []string{}
+
+	for _, c := range g.cycles(func(e messageEdge) bool { return e.value }) {
+		problems = append(problems, fmt.Sprintf("messages %s hold each other by value with (gogoproto.nullable) = false option, such structures can not be declared",
+			strings.Join(c, ", ")))
+	}
+
+	for _, c := range g.cycles(func(e messageEdge) bool { return e.call }) {
+		var omitted, generated []string
+		for _, name := range c {
+			// oneof messages are transformed by generated oneof functions.
+			if mo := messages[name]; mo.Omitted() && mo.OneofDecl() == "" {
+				omitted = append(omitted, name)
+			} else {
+				generated = append(generated, name)
+			}
+		}
+
+		if len(omitted) > 0 && len(generated) > 0 {
+			problems = append(problems, fmt.Sprintf("messages %s refer each other, transformers of %s call transformers of %s which are not generated without (%s) option",
+				strings.Join(c, ", "), strings.Join(generated, ", "), strings.Join(omitted, ", "), options.E_GoStruct.Name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("message dependency cycles: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
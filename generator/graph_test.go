@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Graph", func() {
+
+	// ref returns field which refers message typ.
+	ref := func(name, typ string, opts map[protoreflect.ExtensionType]interface{}) *descriptorpb.FieldDescriptorProto {
+		fdp := &descriptorpb.FieldDescriptorProto{Name: sp(name), Type: &typMessage, TypeName: sp("." + typ), Options: &descriptorpb.FieldOptions{}}
+		for ext, v := range opts {
+			proto.SetExtension(fdp.Options, ext, v)
+		}
+		return fdp
+	}
+
+	// msg returns message option with fields, target is empty for messages
+	// without go_struct option.
+	msg := func(target string, fields ...*descriptorpb.FieldDescriptorProto) messageOption {
+		return messageOption{targetName: target, desc: &descriptorpb.DescriptorProto{Field: fields}, fileName: "pkg.proto"}
+	}
+
+	notNull := map[protoreflect.ExtensionType]interface{}{gogoproto.E_Nullable: false}
+
+	Describe("newMessageGraph", func() {
+
+		It("follows map values and skips fields out of request", func() {
+			g := newMessageGraph(MessageOptionList{
+				"pkg.Book": msg("Book",
+					ref("items", "pkg.Book.ItemsEntry", nil),
+					ref("created", "google.protobuf.Timestamp", nil),
+					ref("ignored", "pkg.Item", map[protoreflect.ExtensionType]interface{}{options.E_Skip: true}),
+					ref("main", "pkg.Item", notNull),
+				),
+				"pkg.Book.ItemsEntry": messageOption{desc: &descriptorpb.DescriptorProto{
+					Field:   []*descriptorpb.FieldDescriptorProto{{Name: sp("key"), Type: &typString}, ref("value", "pkg.Item", nil)},
+					Options: &descriptorpb.MessageOptions{MapEntry: bp(true)},
+				}},
+				"pkg.Item": msg("Item"),
+			})
+
+			Expect(g).To(Equal(messageGraph{
+				"pkg.Book": {
+					{to: "pkg.Item", call: true},
+					{to: "pkg.Item", value: true, call: true},
+				},
+				"pkg.Item": nil,
+			}))
+		})
+	})
+
+	Describe("CheckMessageCycles", func() {
+
+		It("accepts messages which refer each other by pointers", func() {
+			Expect(CheckMessageCycles(MessageOptionList{
+				"pkg.Node":   msg("Node", ref("parent", "pkg.Node", nil), ref("tree", "pkg.Tree", nil)),
+				"pkg.Tree":   msg("Tree", ref("root", "pkg.Node", nil)),
+				"pkg.Detail": msg("", ref("node", "pkg.Node", nil)),
+			}, []string{"pkg.proto"})).To(Succeed())
+		})
+
+		It("returns messages which hold each other by value", func() {
+			Expect(CheckMessageCycles(MessageOptionList{
+				"pkg.A": msg("A", ref("b", "pkg.B", notNull)),
+				"pkg.B": msg("B", ref("a", "pkg.A", notNull)),
+			}, []string{"pkg.proto"})).To(MatchError("message dependency cycles: messages pkg.A, pkg.B hold each other by value with (gogoproto.nullable) = false option, such structures can not be declared"))
+		})
+
+		It("returns cycles with messages without go_struct option", func() {
+			Expect(CheckMessageCycles(MessageOptionList{
+				"pkg.Order": msg("Order", ref("items", "pkg.Item", nil)),
+				"pkg.Item":  msg("", ref("order", "pkg.Order", nil)),
+				"pkg.Node":  msg("Node", ref("child", "pkg.Node", map[protoreflect.ExtensionType]interface{}{options.E_Custom: true})),
+			}, []string{"pkg.proto"})).To(MatchError("message dependency cycles: messages pkg.Item, pkg.Order refer each other, " +
+				"transformers of pkg.Order call transformers of pkg.Item which are not generated without (transformer.go_struct) option"))
+		})
+
+		Context("with messages of files which aren't generated", func() {
+
+			BeforeEach(func() {
+				Expect(SetFileFilter("", "excluded/**")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				fileFilter = globFilter{}
+			})
+
+			It("checks messages of generated files only", func() {
+				in := func(file string, mo messageOption) messageOption {
+					mo.fileName = file
+					return mo
+				}
+
+				Expect(CheckMessageCycles(MessageOptionList{
+					"pkg.Order":  msg("Order", ref("b", "dep.B", notNull)),
+					"dep.A":      in("dep.proto", msg("A", ref("b", "dep.B", notNull))),
+					"dep.B":      in("dep.proto", msg("B", ref("a", "dep.A", notNull))),
+					"excluded.C": in("excluded/x.proto", msg("C", ref("d", "excluded.D", notNull))),
+					"excluded.D": in("excluded/x.proto", msg("D", ref("c", "excluded.C", notNull))),
+				}, []string{"pkg.proto", "excluded/x.proto"})).To(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/proto"
+)
+
+// Families of helper functions, each family can use its own helper package,
+// see transformer.go_helper_packages option.
+const (
+	// Functions for google.protobuf.Timestamp and google.protobuf.Duration
+	// fields and elements, e.g. TimeToNullsTime.
+	helperTime = "time"
+	// Functions for google.protobuf wrapper fields, e.g. StringValueToString.
+	helperWrappers = "wrappers"
+	// Functions for scalar fields of different types, e.g. Int64ToString.
+	helperScalar = "scalar"
+	// Functions for fields with gogoproto.customtype or gogoproto.casttype
+	// options, e.g. DecimalToString.
+	helperCustom = "custom"
+)
+
+// helperFamilies contains all known families of helper functions.
+var helperFamilies = map[string]struct{}{
+	helperTime:     {},
+	helperWrappers: {},
+	helperScalar:   {},
+	helperCustom:   {},
+}
+
+// helperPackage is a package with helper functions of one family.
+type helperPackage struct {
+	// Package name, it's used as prefix of helper functions.
+	name string
+	// Package import path.
+	path string
+}
+
+// extractHelperPackages returns helper packages by families from
+// transformer.go_helper_packages option of file options m.
+func extractHelperPackages(m proto.Message) (map[string]helperPackage, error) {
+	hp := map[string]helperPackage{}
+
+	opt, err := getStringOption(m, options.E_GoHelperPackages)
+	if err != nil {
+		if _, ok := err.(errOptionNotExists); ok || err == ErrNilOptions {
+			return hp, nil
+		}
+		return nil, err
+	}
+
+	for _, spec := range strings.Split(opt, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+
+		kv := strings.SplitN(spec, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("%s: %q should be in format family=import/path", options.E_GoHelperPackages.Name, spec)
+		}
+
+		family, ipath := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		if _, ok := helperFamilies[family]; !ok {
+			return nil, fmt.Errorf("%s: unknown family of helper functions %q", options.E_GoHelperPackages.Name, family)
+		}
+
+		hp[family] = helperPackage{name: path.Base(ipath), path: ipath}
+	}
+
+	return hp, nil
+}
+
+// helperPackages returns helper packages by families. Families listed in
+// transformer.go_helper_packages option of file options m use their own
+// packages, other families use default package with import path defPath and
+// name defName, which is last element of path if it's empty. Nothing is
+// returned for families without packages to import.
+func helperPackages(m proto.Message, defName, defPath string) (map[string]helperPackage, error) {
+	hp, err := extractHelperPackages(m)
+	if err != nil || defPath == "" {
+		return hp, err
+	}
+
+	if defName == "" {
+		defName = path.Base(defPath)
+	}
+
+	for family := range helperFamilies {
+		if _, ok := hp[family]; !ok {
+			hp[family] = helperPackage{name: defName, path: defPath}
+		}
+	}
+
+	return hp, nil
+}
+
+// importSpec returns import spec of package, package name is added if it's
+// not the last element of import path.
+func (p helperPackage) importSpec() string {
+	if p.name != path.Base(p.path) {
+		return fmt.Sprintf("%s %q", p.name, p.path)
+	}
+
+	return fmt.Sprintf("%q", p.path)
+}
+
+// helperImports returns sorted import specs of helper packages hp which are
+// used by fields.
+func helperImports(fields []Field, hp map[string]helperPackage) []string {
+	used := map[string]struct{}{}
+
+	var collect func(fields []Field)
+	collect = func(fields []Field) {
+		for _, f := range fields {
+			collect(f.EmbeddedFields)
+
+			if e := f.Elem; e != nil && e.UsePackage {
+				if p, ok := hp[e.Helper]; ok {
+					used[p.importSpec()] = struct{}{}
+				}
+			}
+
+			if p, ok := hp[f.Helper]; ok && f.UsePackage {
+				used[p.importSpec()] = struct{}{}
+			}
+		}
+	}
+	collect(fields)
+
+	specs := make([]string, 0, len(used))
+	for s := range used {
+		specs = append(specs, s)
+	}
+	sort.Strings(specs)
+
+	return specs
+}
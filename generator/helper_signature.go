@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// helperSignature describes signature of helper functions of field, see
+// transformer.helper_signature option.
+type helperSignature struct {
+	// If true, helpers accept context.Context as the first argument.
+	Context bool
+	// If true, helpers return value and error.
+	Error bool
+	// If true, helpers accept pointer to source field.
+	PointerArg bool
+	// If true, helpers return pointer to destination field.
+	PointerResult bool
+}
+
+// withHelperSignature sets signature of helper functions of field f from
+// transformer.helper_signature option of field fdp. Fields which aren't
+// transformed by helper functions can't have the option.
+func withHelperSignature(f *Field, fdp *descriptorpb.FieldDescriptorProto) error {
+	value, _ := getStringOption(fdp.Options, options.E_HelperSignature)
+	if value == "" {
+		return nil
+	}
+
+	if !f.UsePackage || f.ProtoToGoType == "" || f.Elem != nil || f.Wrapper != nil || f.Dep != nil || f.IsOneof() {
+		return newLoggableError("field %s: option (%s) is set, but field isn't transformed by helper functions", f.Name, options.E_HelperSignature.Name).
+			withHint("remove (%s) option or set (%s) and (%s) options", options.E_HelperSignature.Name, options.E_CustomPbToGo.Name, options.E_CustomGoToPb.Name)
+	}
+
+	hs := &helperSignature{}
+	for _, flag := range strings.Split(value, ",") {
+		switch strings.TrimSpace(flag) {
+		case "context":
+			hs.Context = true
+		case "error":
+			hs.Error = true
+		case "pointer_arg":
+			hs.PointerArg = true
+		case "pointer_result":
+			hs.PointerResult = true
+		case "":
+		default:
+			return newLoggableError("field %s: option (%s) has unknown feature %q", f.Name, options.E_HelperSignature.Name, strings.TrimSpace(flag)).
+				withHint("use context, error, pointer_arg or pointer_result")
+		}
+	}
+	f.HelperCall = hs
+
+	return nil
+}
+
+// formatHelperCallField returns statements which transform field f with
+// helper function of signature of transformer.helper_signature option.
+// Source field is passed by address or dereferenced, nil source pointer
+// leaves destination field empty, result pointer is dereferenced or value
+// address is taken to match destination field.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatHelperCallField(f Field, d Data) string {
+	hs := f.HelperCall
+	src, dst, fn := f.name(d.Swapped), f.name(!d.Swapped), f.ProtoToGoType
+	srcPtr, dstPtr := f.ProtoIsPointer, f.GoIsPointer
+	if d.Swapped {
+		fn = f.GoToProtoType
+		srcPtr, dstPtr = dstPtr, srcPtr
+	}
+
+	arg, deref := "src."+src, false
+	switch {
+	case hs.PointerArg && !srcPtr:
+		arg = "&src." + src
+	case !hs.PointerArg && srcPtr:
+		arg, deref = "*src."+src, true
+	}
+
+	ctx := ""
+	if hs.Context {
+		ctx = ctxArg(d) + ", "
+	}
+
+	call := fmt.Sprintf("%s(%s%s%s)", fn, ctx, arg, f.Opts)
+
+	assign := fmt.Sprintf("s.%[1]s = v%[1]s\n", dst)
+	switch {
+	case hs.PointerResult && !dstPtr:
+		assign = fmt.Sprintf("if v%[1]s != nil {\n\t\ts.%[1]s = *v%[1]s\n\t}\n", dst)
+	case !hs.PointerResult && dstPtr:
+		assign = fmt.Sprintf("s.%[1]s = &v%[1]s\n", dst)
+	}
+
+	body := fmt.Sprintf("s.%s = %s\n", dst, call)
+	switch {
+	case hs.Error:
+		body = fmt.Sprintf("v%s, err := %s\n\tif err != nil {\n\t\t%s\n\t}\n\t%s", dst, call, failField(src, d), assign)
+	case hs.PointerResult != dstPtr:
+		body = fmt.Sprintf("v%s := %s\n\t%s", dst, call, assign)
+	}
+
+	if !deref {
+		return "\t" + body
+	}
+
+	return fmt.Sprintf("\tif src.%s != nil {\n\t\t%s\t}\n", src, strings.Replace(body, "\n\t", "\n\t\t", -1))
+}
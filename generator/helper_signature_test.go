@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Helper signature", func() {
+
+	field := func(signature string) *descriptorpb.FieldDescriptorProto {
+		fdp := &descriptorpb.FieldDescriptorProto{Name: sp("price"), Type: &typString, Options: &descriptorpb.FieldOptions{}}
+		proto.SetExtension(fdp.Options, options.E_HelperSignature, signature)
+		return fdp
+	}
+
+	Describe("withHelperSignature", func() {
+
+		It("sets signature of helper functions", func() {
+			f := &Field{Name: "Price", ProtoToGoType: "StringToDecimal", GoToProtoType: "DecimalToString", UsePackage: true}
+
+			Expect(withHelperSignature(f, field("context, error,pointer_result"))).To(Succeed())
+			Expect(f.HelperCall).To(Equal(&helperSignature{Context: true, Error: true, PointerResult: true}))
+		})
+
+		It("leaves fields without option as is", func() {
+			f := &Field{Name: "Price", ProtoToGoType: "StringToDecimal", UsePackage: true}
+
+			Expect(withHelperSignature(f, &descriptorpb.FieldDescriptorProto{Name: sp("price")})).To(Succeed())
+			Expect(f.HelperCall).To(BeNil())
+		})
+
+		It("returns error for unknown feature", func() {
+			f := &Field{Name: "Price", ProtoToGoType: "StringToDecimal", UsePackage: true}
+
+			err := withHelperSignature(f, field("error,ptr"))
+			Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+			Expect(err.Error()).To(Equal(`field Price: option (transformer.helper_signature) has unknown feature "ptr"; hint: use context, error, pointer_arg or pointer_result`))
+		})
+
+		It("returns error for fields which aren't transformed by helpers", func() {
+			f := &Field{Name: "Price", ProtoName: "Price"}
+
+			err := withHelperSignature(f, field("error"))
+			Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+			Expect(err.Error()).To(Equal("field Price: option (transformer.helper_signature) is set, but field isn't transformed by helper functions; " +
+				"hint: remove (transformer.helper_signature) option or set (transformer.custom_pb_to_go) and (transformer.custom_go_to_pb) options"))
+		})
+	})
+
+	Describe("formatHelperCallField", func() {
+
+		DescribeTable("check returns",
+			func(f Field, d Data, expected string) {
+				f.Name, f.ProtoName, f.ProtoToGoType, f.GoToProtoType = "Price", "Price", "h.StringToDecimal", "h.DecimalToString"
+				Expect(formatHelperCallField(f, d)).To(Equal(expected))
+			},
+
+			Entry("Context", Field{HelperCall: &helperSignature{Context: true}}, Data{WithContext: true},
+				"\ts.Price = h.StringToDecimal(ctx, src.Price)\n"),
+			Entry("Context of transformer without context", Field{HelperCall: &helperSignature{Context: true}}, Data{},
+				"\ts.Price = h.StringToDecimal(context.TODO(), src.Price)\n"),
+			Entry("Error", Field{HelperCall: &helperSignature{Error: true}}, Data{Dst: "Product", DstPref: "model", WithErrors: true}, `	vPrice, err := h.StringToDecimal(src.Price)
+	if err != nil {
+		return model.Product{}, fmt.Errorf("field Price: %w", err)
+	}
+	s.Price = vPrice
+`),
+			Entry("Pointer argument", Field{HelperCall: &helperSignature{PointerArg: true}}, Data{},
+				"\ts.Price = h.StringToDecimal(&src.Price)\n"),
+			Entry("Pointer argument of pointer field", Field{HelperCall: &helperSignature{PointerArg: true}, ProtoIsPointer: true}, Data{},
+				"\ts.Price = h.StringToDecimal(src.Price)\n"),
+			Entry("Pointer result", Field{HelperCall: &helperSignature{PointerResult: true}}, Data{}, `	vPrice := h.StringToDecimal(src.Price)
+	if vPrice != nil {
+		s.Price = *vPrice
+	}
+`),
+			Entry("Value result of pointer field", Field{HelperCall: &helperSignature{}, GoIsPointer: true}, Data{}, `	vPrice := h.StringToDecimal(src.Price)
+	s.Price = &vPrice
+`),
+			Entry("Value argument of pointer field, swapped", Field{HelperCall: &helperSignature{Error: true}, GoIsPointer: true, ProtoIsPointer: true}, Data{Dst: "Product", DstPref: "pb", Swapped: true, WithErrors: true}, `	if src.Price != nil {
+		vPrice, err := h.DecimalToString(*src.Price)
+		if err != nil {
+			return pb.Product{}, fmt.Errorf("field Price: %w", err)
+		}
+		s.Price = &vPrice
+	}
+`),
+		)
+
+		It("transforms field after model is created", func() {
+			d := Data{Src: "Product", SrcPref: "pb", SrcFn: "Pb", Dst: "Product", DstPref: "model", DstFn: "Product", NoReverse: true,
+				Fields: []Field{{Name: "Price", ProtoName: "Price", ProtoToGoType: "h.StringToDecimal", GoToProtoType: "h.DecimalToString",
+					UsePackage: true, HelperCall: &helperSignature{Context: true}}}}
+
+			w := &bytes.Buffer{}
+			Expect(execMessageTemplate(w, d)).To(Succeed())
+			Expect(w.String()).To(ContainSubstring("s := model.Product{\n\t}\n"))
+			Expect(w.String()).To(ContainSubstring("\ts.Price = h.StringToDecimal(context.TODO(), src.Price)\n"))
+		})
+	})
+})
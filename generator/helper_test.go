@@ -0,0 +1,102 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Helper", func() {
+
+	DescribeTable("extractHelperPackages",
+		func(opt string, expected map[string]helperPackage, expErr string) {
+			o := &descriptorpb.FileOptions{}
+			if opt != "" {
+				proto.SetExtension(o, options.E_GoHelperPackages, opt)
+			}
+
+			hp, err := extractHelperPackages(o)
+			if expErr != "" {
+				Expect(err).To(MatchError(expErr))
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hp).To(Equal(expected))
+		},
+
+		Entry("no option", "", map[string]helperPackage{}, ""),
+		Entry("several families", "time=github.com/org/timeconv, custom=github.com/org/money", map[string]helperPackage{
+			helperTime:   {name: "timeconv", path: "github.com/org/timeconv"},
+			helperCustom: {name: "money", path: "github.com/org/money"},
+		}, ""),
+		Entry("unknown family", "slice=github.com/org/slices", nil,
+			`transformer.go_helper_packages: unknown family of helper functions "slice"`),
+		Entry("invalid format", "time", nil,
+			`transformer.go_helper_packages: "time" should be in format family=import/path`),
+	)
+
+	DescribeTable("helperPackages",
+		func(defName, defPath string, expected helperPackage) {
+			o := &descriptorpb.FileOptions{}
+			proto.SetExtension(o, options.E_GoHelperPackages, "time=github.com/org/timeconv")
+
+			hp, err := helperPackages(o, defName, defPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hp[helperTime]).To(Equal(helperPackage{name: "timeconv", path: "github.com/org/timeconv"}))
+			Expect(hp[helperScalar]).To(Equal(expected))
+		},
+
+		Entry("without default path", "helpers", "", helperPackage{}),
+		Entry("name from default path", "", "github.com/org/helpers", helperPackage{name: "helpers", path: "github.com/org/helpers"}),
+		Entry("explicit name", "conv", "github.com/org/helpers", helperPackage{name: "conv", path: "github.com/org/helpers"}),
+	)
+
+	DescribeTable("importSpec",
+		func(p helperPackage, expected string) {
+			Expect(p.importSpec()).To(Equal(expected))
+		},
+
+		Entry("package name from path", helperPackage{name: "helpers", path: "github.com/org/helpers"}, `"github.com/org/helpers"`),
+		Entry("named import", helperPackage{name: "conv", path: "github.com/org/helpers"}, `conv "github.com/org/helpers"`),
+	)
+
+	Describe("helperImports", func() {
+
+		It("returns packages used by fields", func() {
+			hp := map[string]helperPackage{
+				helperTime:   {name: "timeconv", path: "github.com/org/timeconv"},
+				helperCustom: {name: "money", path: "github.com/org/money"},
+				helperScalar: {name: "scalars", path: "github.com/org/scalars"},
+			}
+			fields := []Field{
+				{UsePackage: true, Helper: helperTime},
+				{EmbeddedFields: []Field{{UsePackage: true, Helper: helperCustom}}},
+				{Elem: &Elem{UsePackage: true, Helper: helperTime}},
+				{Helper: helperScalar},
+			}
+
+			Expect(helperImports(fields, hp)).To(Equal([]string{`"github.com/org/money"`, `"github.com/org/timeconv"`}))
+		})
+	})
+
+	Describe("writeImports", func() {
+
+		It("writes unique imports", func() {
+			w := &bytes.Buffer{}
+			writeImports(w, []string{`"b"`, `a "a/v2"`, `"b"`})
+			Expect(w.String()).To(Equal("\nimport (\n\t\"b\"\n\ta \"a/v2\"\n)\n"))
+		})
+
+		It("writes nothing for empty list", func() {
+			w := &bytes.Buffer{}
+			writeImports(w, nil)
+			Expect(w.String()).To(BeEmpty())
+		})
+	})
+})
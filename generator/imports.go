@@ -0,0 +1,171 @@
+package generator
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// knownImports are import paths of packages which templates refer to without
+// recording them, by package names.
+var knownImports = map[string]string{
+	"context":   "context",
+	"errors":    "errors",
+	"fmt":       "fmt",
+	"json":      "encoding/json",
+	"reflect":   "reflect",
+	"sort":      "sort",
+	"strconv":   "strconv",
+	"strings":   "strings",
+	"sync":      "sync",
+	"time":      "time",
+	"grpc":      "google.golang.org/grpc",
+	"protojson": "google.golang.org/protobuf/encoding/protojson",
+	"protov1":   "github.com/golang/protobuf/proto",
+	"types":     "github.com/gogo/protobuf/types",
+	"uuid":      "github.com/google/uuid",
+	"decimal":   "github.com/shopspring/decimal",
+	"_type":     "github.com/gogo/googleapis/google/type",
+	"gorm":      "gorm.io/gorm",
+}
+
+// importTracker records import specs of packages which generated code may
+// refer to by package names in the code, e.g. "billing": billing
+// "github.com/org/billing". Only packages which are referred are imported, see
+// importSpecs.
+type importTracker map[string]string
+
+// add records import specs, such as "fmt" or pkg "github.com/org/pkg".
+func (it importTracker) add(specs ...string) {
+	for _, s := range specs {
+		name, ip := "", s
+		if i := strings.Index(s, " "); i > 0 {
+			name, ip = s[:i], s[i+1:]
+		}
+
+		ip, err := strconv.Unquote(ip)
+		if err != nil {
+			continue
+		}
+
+		if name == "" {
+			name = path.Base(ip)
+		}
+
+		it[name] = s
+	}
+}
+
+// addPackage records package name with import path ip, name is added to
+// import spec if it's not the last element of path. Unknown packages with
+// empty path are ignored.
+func (it importTracker) addPackage(name, ip string) {
+	switch {
+	case ip == "":
+	case name == "" || name == path.Base(ip):
+		it[path.Base(ip)] = strconv.Quote(ip)
+	default:
+		it[name] = name + " " + strconv.Quote(ip)
+	}
+}
+
+// importSpecs returns sorted import specs of packages which are referred by
+// generated code src, i.e. selectors of package names which are not declared
+// in src. Packages which are not recorded are looked up in knownImports,
+// other names, e.g. of declarations of other generated files, are skipped.
+func (it importTracker) importSpecs(src []byte) ([]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", append([]byte("package p;"), src...), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]struct{}{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if se, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := se.X.(*ast.Ident); ok && id.Obj == nil {
+				used[id.Name] = struct{}{}
+			}
+		}
+		return true
+	})
+
+	specs := make([]string, 0, len(used))
+	for name := range used {
+		if spec, ok := it[name]; ok {
+			specs = append(specs, spec)
+		} else if ip, ok := knownImports[name]; ok && name == path.Base(ip) {
+			specs = append(specs, strconv.Quote(ip))
+		} else if ok {
+			specs = append(specs, name+" "+strconv.Quote(ip))
+		}
+	}
+	sort.Strings(specs)
+
+	return specs, nil
+}
+
+// addModelImports records packages imported by models source files paths,
+// such as packages of model field types, e.g. nulls.Time. Files which
+// imports can't be resolved are skipped.
+func (it importTracker) addModelImports(paths []string) {
+	for _, p := range paths {
+		im, err := source.Imports(p)
+		if err != nil {
+			continue
+		}
+
+		for name, ip := range im {
+			if _, ok := it[name]; !ok {
+				it.addPackage(name, ip)
+			}
+		}
+	}
+}
+
+// goPackage returns name and import path of Go package of proto structures
+// by go_package option of file fo, e.g. "github.com/org/pb;pb". Import path is
+// empty if option has package name only.
+func goPackage(fo *descriptorpb.FileOptions) (string, string) {
+	gp := fo.GetGoPackage()
+	if i := strings.Index(gp, ";"); i >= 0 {
+		return gp[i+1:], gp[:i]
+	}
+
+	if !strings.Contains(gp, "/") {
+		return gp, ""
+	}
+
+	return path.Base(gp), gp
+}
+
+// modelsPackage returns name and import path of Go package of models, which
+// are parsed from files paths, see parseModels. Empty strings are returned if
+// package can't be loaded, e.g. files are outside of Go module.
+func modelsPackage(fo *descriptorpb.FileOptions, paths []string) (string, string) {
+	var name, ip string
+	var err error
+
+	switch mip, _ := getStringOption(fo, options.E_GoModelsImportPath); {
+	case mip != "":
+		name, ip, err = source.PackageOf("", mip)
+	case len(paths) > 0:
+		name, ip, err = source.PackageOf(filepath.Dir(paths[0]), ".")
+	default:
+		return "", ""
+	}
+
+	if err != nil {
+		return "", ""
+	}
+
+	return name, ip
+}
@@ -0,0 +1,68 @@
+package generator
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Imports", func() {
+
+	Describe("importTracker", func() {
+
+		It("imports packages which are referred by code", func() {
+			it := importTracker{}
+			it.add(`"fmt"`, `billing "github.com/acme/billing/v2"`, `"github.com/acme/helpers"`)
+			it.addPackage("model", "github.com/acme/models")
+			it.addPackage("pb", "")
+
+			specs, err := it.importSpecs([]byte(`
+func PbToOrder(src pb.Order) model.Order {
+	var version string
+	_ = version.Len
+	return model.Order{Address: billing.Address{}, CreatedAt: time.Unix(src.Created, 0), Total: applyOptions.X}
+}
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(specs).To(Equal([]string{
+				`"time"`,
+				`billing "github.com/acme/billing/v2"`,
+				`model "github.com/acme/models"`,
+			}))
+		})
+
+		It("imports packages of model field types", func() {
+			it := importTracker{}
+			it.addModelImports([]string{"../example/model/model.go"})
+
+			specs, err := it.importSpecs([]byte("var deadlines map[string]nulls.Time\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(specs).To(Equal([]string{`"github.com/ZacxDev/protoc-gen-struct-transformer/example/nulls"`}))
+		})
+
+		It("names known packages which names differ from import paths", func() {
+			specs, err := importTracker{}.importSpecs([]byte("var total _type.Money\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(specs).To(Equal([]string{`_type "github.com/gogo/googleapis/google/type"`}))
+		})
+
+		It("returns an error if code can't be parsed", func() {
+			_, err := importTracker{}.importSpecs([]byte("func {"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("returns package of go_package option", func() {
+		name, ip := goPackage(&descriptorpb.FileOptions{GoPackage: sp("github.com/acme/api/catalogpb;pb")})
+		Expect(name).To(Equal("pb"))
+		Expect(ip).To(Equal("github.com/acme/api/catalogpb"))
+
+		name, ip = goPackage(&descriptorpb.FileOptions{GoPackage: sp("github.com/acme/api/pb")})
+		Expect(name).To(Equal("pb"))
+		Expect(ip).To(Equal("github.com/acme/api/pb"))
+
+		name, ip = goPackage(&descriptorpb.FileOptions{GoPackage: sp("example")})
+		Expect(name).To(Equal("example"))
+		Expect(ip).To(BeEmpty())
+	})
+})
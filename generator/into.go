@@ -0,0 +1,52 @@
+package generator
+
+import "strings"
+
+// reusedField is a slice or map field of destination structure which is
+// filled element by element, so in-place transformers reuse its backing array
+// or map instead of allocating a new one.
+type reusedField struct {
+	// Field name in destination structure.
+	Name string
+	// If true, field is a map, it's cleared before it's filled, otherwise
+	// it's a slice which is truncated.
+	Map bool
+}
+
+// InPlace returns a view of Data which renders fields into existing
+// destination structure, see intoT. Used inside template.
+func (d Data) InPlace() Data {
+	d.Reuse = true
+	return d
+}
+
+// Reused returns slice and map fields of destination structure which are
+// reused by in-place transformers. Fields which are transformed as a whole,
+// e.g. by list transformers of sub messages, and fields which can't be set in
+// composite literal, i.e. promoted fields and ent edges, are not reused.
+// Used inside template.
+func (d Data) Reused() []reusedField {
+	var rf []reusedField
+
+	for _, f := range d.Fields {
+		if f.Elem == nil || f.Elem.Kind == elemPairs || f.Case != nil || f.Edge || f.Promoted && !d.Swapped {
+			continue
+		}
+		rf = append(rf, reusedField{Name: f.name(!d.Swapped), Map: f.Elem.MapKey != ""})
+	}
+
+	return rf
+}
+
+// FieldStatements returns statements which set fields of destination
+// structure after its composite literal, see fieldsStmtT. Trailing empty
+// lines are trimmed, so in-place transformers which return nothing end with
+// the last statement. Used inside template.
+func (d Data) FieldStatements() (string, error) {
+	var b strings.Builder
+	if err := fieldsStmtT.Execute(&b, d); err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(b.String(), "\n"), nil
+}
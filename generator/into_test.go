@@ -0,0 +1,145 @@
+package generator
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Into", func() {
+
+	Describe("into template", func() {
+
+		execute := func(d Data) string {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "into", d)).To(Succeed())
+
+			return w.String()
+		}
+
+		fields := []Field{
+			{Name: "ID", ProtoName: "Id"},
+			{Name: "Tags", ProtoName: "Tags", Elem: &Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string", ProtoIsPointer: true}},
+			{Name: "Prices", ProtoName: "Prices", Elem: &Elem{Kind: elemValue, ProtoType: "int64", GoType: "int64", MapKey: "string"}},
+		}
+
+		It("sets existing model field by field", func() {
+			out := execute(Data{
+				Src:        "Order",
+				SrcPref:    "pb",
+				SrcFn:      "Pb",
+				SrcPointer: "*",
+				Dst:        "Order",
+				DstPref:    "model",
+				DstFn:      "Order",
+				Fields:     fields[:1],
+			})
+
+			Expect(out).To(ContainSubstring(`func PbToOrderInto(src *pb.Order, dst *model.Order, opts ...TransformParam) {
+	if src == nil {
+		*dst = model.Order{}
+		return
+	}
+
+	s := dst
+	*s = model.Order{
+			ID: src.Id,
+	}
+
+	applyOptions(opts...)
+}`))
+			Expect(out).NotTo(ContainSubstring("PbToOrder(*src"))
+			Expect(out).To(ContainSubstring(`func PbToOrderListInto(src []*pb.Order, dst []model.Order, opts ...TransformParam) []model.Order {`))
+			Expect(out).To(ContainSubstring("\t\tPbToOrderInto(src[i], &dst[i], opts...)\n"))
+		})
+
+		It("reuses slices and maps of existing model", func() {
+			out := execute(Data{
+				Src:        "Order",
+				SrcPref:    "pb",
+				SrcFn:      "Pb",
+				SrcPointer: "*",
+				Dst:        "Order",
+				DstPref:    "model",
+				DstFn:      "Order",
+				Fields:     fields,
+			})
+
+			Expect(out).To(ContainSubstring(`	for k := range dst.Prices {
+		delete(dst.Prices, k)
+	}
+	if src == nil {
+		*dst = model.Order{
+		Tags: dst.Tags[:0],
+		Prices: dst.Prices,
+		}
+		return
+	}`))
+			Expect(out).To(ContainSubstring(`		Tags: dst.Tags[:0],
+		Prices: dst.Prices,
+	}`))
+			Expect(out).To(ContainSubstring(`		s.Tags = append(s.Tags[:0], make([]string, len(src.Tags))...)
+		for i, v := range src.Tags {
+			s.Tags[i] = v.GetValue()
+		}`))
+			Expect(out).To(ContainSubstring(`	if src.Prices != nil {
+		if s.Prices == nil {
+			s.Prices = make(map[string]int64, len(src.Prices))
+		}
+		for k, v := range src.Prices {`))
+		})
+
+		It("reuses structures of pointer elements", func() {
+			out := execute(Data{
+				Src:        "Order",
+				SrcPref:    "model",
+				SrcFn:      "Order",
+				Dst:        "Order",
+				DstPref:    "pb",
+				DstFn:      "Pb",
+				DstPointer: "*",
+				Swapped:    true,
+			})
+
+			Expect(out).To(ContainSubstring(`func OrderToPbListInto(src []model.Order, dst []*pb.Order, opts ...TransformParam) []*pb.Order {`))
+			Expect(out).To(ContainSubstring(`		if dst[i] == nil {
+			dst[i] = &pb.Order{}
+		}
+		OrderToPbInto(&src[i], dst[i], opts...)`))
+		})
+
+		It("returns errors of transformers", func() {
+			out := execute(Data{
+				Src:         "Order",
+				SrcPref:     "pb",
+				SrcFn:       "Pb",
+				SrcPointer:  "*",
+				Dst:         "Order",
+				DstPref:     "model",
+				DstFn:       "Order",
+				WithErrors:  true,
+				WithContext: true,
+				Fields:      []Field{{Name: "ID", ProtoName: "Id", Wrapper: &Elem{Kind: elemUUID, ProtoType: "string", GoType: "uuid.UUID"}}},
+			})
+
+			Expect(out).To(ContainSubstring(`func PbToOrderInto(ctx context.Context, src *pb.Order, dst *model.Order, opts ...TransformParam) error {`))
+			Expect(out).To(ContainSubstring(`		vID, err := uuid.Parse(src.Id)
+		if err != nil {
+			return fmt.Errorf("field Id: %w", err)
+		}
+		s.ID = vID
+	}
+
+	return nil
+}`))
+			Expect(out).To(ContainSubstring(`		if err := PbToOrderInto(ctx, src[i], &dst[i], opts...); err != nil {
+			return nil, err
+		}`))
+			Expect(out).To(ContainSubstring("\treturn dst, nil\n}"))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// jsonImports are import specs of proto-JSON entry points, see jsonT. Proto
+// structures of gogo and golang/protobuf APIv1 generators are wrapped into
+// APIv2 messages by proto.MessageV2, messages of APIv2 generators are used
+// as is.
+var jsonImports = []string{
+	`"google.golang.org/protobuf/encoding/protojson"`,
+	`protov1 "github.com/golang/protobuf/proto"`,
+}
+
+// jsonTypeOptions are gogoproto options which replace Go types of fields with
+// types that aren't proto structures, e.g. time.Time. protojson encodes
+// proto structures by their descriptors, so such fields are not encoded.
+var jsonTypeOptions = []protoreflect.ExtensionType{
+	gogoproto.E_Stdtime,
+	gogoproto.E_Stdduration,
+	gogoproto.E_Customtype,
+	gogoproto.E_Wktpointer,
+}
+
+// checkJSONFields returns an error if message msg or its sub messages have
+// fields which can't be encoded into proto-JSON, see jsonTypeOptions. Sub
+// messages are looked up in messages, seen holds names of checked ones.
+func checkJSONFields(msg *descriptorpb.DescriptorProto, messages MessageOptionList, seen map[string]bool) error {
+	for _, fdp := range msg.GetField() {
+		for _, xt := range jsonTypeOptions {
+			if !hasJSONTypeOption(fdp, xt) {
+				continue
+			}
+			return fmt.Errorf("field %s of message %s has (%s) option, its Go type can not be encoded into proto-JSON; hint: remove (%s) option or (%s) option",
+				fdp.GetName(), msg.GetName(), xt.TypeDescriptor().FullName(), options.E_GoJson.Name, xt.TypeDescriptor().FullName())
+		}
+
+		name := strings.TrimPrefix(fdp.GetTypeName(), ".")
+		if fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		mo, ok := messages[name]
+		if !ok || mo.Descriptor() == nil {
+			continue
+		}
+		if err := checkJSONFields(mo.Descriptor(), messages, seen); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasJSONTypeOption returns true if field fdp has gogoproto option xt which
+// changes its Go type, i.e. bool option is true or string option isn't empty.
+func hasJSONTypeOption(fdp *descriptorpb.FieldDescriptorProto, xt protoreflect.ExtensionType) bool {
+	if fdp.GetOptions() == nil || !proto.HasExtension(fdp.GetOptions(), xt) {
+		return false
+	}
+
+	switch v := proto.GetExtension(fdp.GetOptions(), xt).(type) {
+	case bool:
+		return v
+	case string:
+		return v != ""
+	}
+
+	return false
+}
@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("JSON", func() {
+
+	Describe("checkJSONFields", func() {
+
+		stdTime := func() *descriptorpb.FieldOptions {
+			o := &descriptorpb.FieldOptions{}
+			proto.SetExtension(o, gogoproto.E_Stdtime, true)
+			return o
+		}
+
+		order := &descriptorpb.DescriptorProto{
+			Name: sp("Order"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: sp("id")},
+				{Name: sp("shipment"), Type: &typMessage, TypeName: sp(".pkg.Shipment")},
+			},
+		}
+
+		It("accepts fields of proto types", func() {
+			messages := MessageOptionList{
+				"pkg.Shipment": messageOption{desc: &descriptorpb.DescriptorProto{
+					Name:  sp("Shipment"),
+					Field: []*descriptorpb.FieldDescriptorProto{{Name: sp("carrier")}},
+				}},
+			}
+
+			Expect(checkJSONFields(order, messages, map[string]bool{})).To(Succeed())
+		})
+
+		It("returns an error for fields of sub messages with gogoproto types", func() {
+			messages := MessageOptionList{
+				"pkg.Shipment": messageOption{desc: &descriptorpb.DescriptorProto{
+					Name:  sp("Shipment"),
+					Field: []*descriptorpb.FieldDescriptorProto{{Name: sp("shipped_at"), Options: stdTime()}},
+				}},
+			}
+
+			err := checkJSONFields(order, messages, map[string]bool{})
+			Expect(err).To(MatchError(ContainSubstring("field shipped_at of message Shipment has (gogoproto.stdtime) option")))
+			Expect(err).To(MatchError(ContainSubstring("hint: remove (transformer.go_json) option")))
+		})
+
+		It("checks recursive messages once", func() {
+			node := &descriptorpb.DescriptorProto{
+				Name:  sp("Node"),
+				Field: []*descriptorpb.FieldDescriptorProto{{Name: sp("parent"), Type: &typMessage, TypeName: sp(".pkg.Node")}},
+			}
+
+			Expect(checkJSONFields(node, MessageOptionList{"pkg.Node": messageOption{desc: node}}, map[string]bool{})).To(Succeed())
+		})
+	})
+})
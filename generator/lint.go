@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// diagnostics holds problems of messages processed by ProcessFile in order of
+// processing, such as option conflicts and proto fields which are not
+// transformed, see LintWriter.
+var diagnostics []string
+
+// errorWriter is a FileWriter which can report plugin error.
+type errorWriter interface {
+	WriteError(msg string) error
+}
+
+// LintWriter discards generated Go files, so options are validated without
+// generating code. Diagnostics of processed files are reported on Close as
+// plugin error, or are written into report file if its name is set. Other
+// files, e.g. coverage report, are written into underlying FileWriter as is.
+type LintWriter struct {
+	w      FileWriter
+	report string
+}
+
+// NewLintWriter returns LintWriter which reports diagnostics into w. If report
+// is not empty diagnostics are written into file report instead of error, and
+// the file is written even if there are no diagnostics.
+func NewLintWriter(w FileWriter, report string) *LintWriter {
+	return &LintWriter{w: w, report: report}
+}
+
+// WriteFile discards generated Go files and writes other files into
+// underlying FileWriter.
+func (lw *LintWriter) WriteFile(name, content string) error {
+	if path.Ext(name) == ".go" {
+		return nil
+	}
+
+	return lw.w.WriteFile(name, content)
+}
+
+// Fail adds error err of file processing to diagnostics, so the rest of
+// files are validated too.
+func (lw *LintWriter) Fail(err error) {
+	if err != nil {
+		diagnostics = append(diagnostics, err.Error())
+	}
+}
+
+// Close reports diagnostics and closes underlying FileWriter.
+func (lw *LintWriter) Close() error {
+	switch {
+	case lw.report != "":
+		content := ""
+		if len(diagnostics) > 0 {
+			content = strings.Join(diagnostics, "\n") + "\n"
+		}
+		if err := lw.w.WriteFile(lw.report, content); err != nil {
+			return err
+		}
+	case len(diagnostics) > 0:
+		msg := "problems found by lint:\n" + strings.Join(diagnostics, "\n")
+		ew, ok := lw.w.(errorWriter)
+		if !ok {
+			return errors.New(msg)
+		}
+		if err := ew.WriteError(msg); err != nil {
+			return err
+		}
+	}
+
+	return lw.w.Close()
+}
+
+// messageDiagnostics returns compiler-style diagnostics of issues of fields
+// of message fm with full name, such as fields which are not transformed or
+// have conflicting options, e.g. product.proto:12:3: message pb.Product field
+// price: ...
+func messageDiagnostics(sl sourceLocations, fm fileMessage, name string, issues []fieldIssue) []string {
+	d := []string{}
+	for _, fi := range issues {
+		d = append(d, fmt.Sprintf("%s: message %s field %s: %s", sl.fieldPosition(fm, fi.index), name, fi.name, fi.desc))
+	}
+
+	return d
+}
@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var _ = Describe("LintWriter", func() {
+
+	var buf *bytes.Buffer
+
+	BeforeEach(func() {
+		buf = &bytes.Buffer{}
+		diagnostics = nil
+	})
+
+	AfterEach(func() {
+		diagnostics = nil
+	})
+
+	It("discards generated Go files", func() {
+		lw := NewLintWriter(NewStreamWriter(buf), "")
+
+		Expect(lw.WriteFile("transform/product_transformer.go", "package transform")).To(Succeed())
+		Expect(lw.WriteFile("transform/coverage.json", "{}")).To(Succeed())
+		Expect(lw.Close()).To(Succeed())
+
+		Expect(buf.String()).To(Equal("// >>> file: transform/coverage.json\n{}\n// <<< file: transform/coverage.json\n"))
+	})
+
+	It("reports diagnostics as plugin error", func() {
+		lw := NewLintWriter(NewResponseWriter(buf), "")
+		diagnostics = []string{"product.proto: message pb.Product field id: conflict"}
+		lw.Fail(errors.New("order.proto: model fields are not covered"))
+
+		Expect(lw.WriteFile("transform/product_transformer.go", "package transform")).To(Succeed())
+		Expect(lw.Close()).To(Succeed())
+
+		resp := &pluginpb.CodeGeneratorResponse{}
+		Expect(proto.Unmarshal(buf.Bytes(), resp)).To(Succeed())
+		Expect(resp.File).To(BeEmpty())
+		Expect(resp.GetError()).To(Equal("problems found by lint:\n" +
+			"product.proto: message pb.Product field id: conflict\n" +
+			"order.proto: model fields are not covered"))
+	})
+
+	It("writes diagnostics into report file", func() {
+		lw := NewLintWriter(NewStreamWriter(buf), "lint.txt")
+		lw.Fail(errors.New("order.proto: model fields are not covered"))
+
+		Expect(lw.Close()).To(Succeed())
+		Expect(buf.String()).To(Equal("// >>> file: lint.txt\norder.proto: model fields are not covered\n// <<< file: lint.txt\n"))
+	})
+
+	It("returns diagnostics if writer can't report errors", func() {
+		lw := NewLintWriter(NewPackageWriter(NewStreamWriter(buf)), "")
+		lw.Fail(errors.New("order.proto: model fields are not covered"))
+
+		Expect(lw.Close()).To(MatchError("problems found by lint:\norder.proto: model fields are not covered"))
+	})
+})
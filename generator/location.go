@@ -0,0 +1,97 @@
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Numbers of FileDescriptorProto and DescriptorProto fields which make up
+// paths of SourceCodeInfo locations of messages and their fields.
+const (
+	messageTypePath = 4
+	nestedTypePath  = 3
+	fieldPath       = 2
+)
+
+// sourceLocations holds spans of definitions of .proto file by their
+// SourceCodeInfo paths, see position.
+type sourceLocations struct {
+	file  string
+	spans map[string][]int32
+}
+
+// newSourceLocations returns locations of definitions of file f. Locations
+// are empty if request has no source code info, e.g. for descriptor sets
+// built without it.
+func newSourceLocations(f *descriptorpb.FileDescriptorProto) sourceLocations {
+	sl := sourceLocations{file: f.GetName(), spans: map[string][]int32{}}
+
+	for _, l := range f.GetSourceCodeInfo().GetLocation() {
+		key := fmt.Sprint(l.GetPath())
+		if _, ok := sl.spans[key]; !ok && len(l.GetSpan()) >= 3 {
+			sl.spans[key] = l.GetSpan()
+		}
+	}
+
+	return sl
+}
+
+// position returns compiler-style position of definition by its path, e.g.
+// product.proto:12:3 for the first field of message defined at line 11. File
+// name is returned if location is unknown.
+func (sl sourceLocations) position(path ...int32) string {
+	span, ok := sl.spans[fmt.Sprint(path)]
+	if !ok {
+		return sl.file
+	}
+
+	// lines and columns of spans are zero-based.
+	return fmt.Sprintf("%s:%d:%d", sl.file, span[0]+1, span[1]+1)
+}
+
+// fieldPosition returns position of field with index i of message fm.
+func (sl sourceLocations) fieldPosition(fm fileMessage, i int) string {
+	return sl.position(append(append([]int32{}, fm.path...), fieldPath, int32(i))...)
+}
+
+// compactSourceLocations reduces source code info of file f to locations which
+// are used for positions of messages and fields, see newSourceLocations.
+// Comments and locations of other definitions are dropped, so files which wait
+// for processing keep a small part of their source code info in memory.
+func compactSourceLocations(f *descriptorpb.FileDescriptorProto) {
+	sci := f.GetSourceCodeInfo()
+	if sci == nil {
+		return
+	}
+
+	seen := map[string]bool{}
+	locs := []*descriptorpb.SourceCodeInfo_Location{}
+
+	for _, l := range sci.GetLocation() {
+		key := fmt.Sprint(l.GetPath())
+		if seen[key] || len(l.GetSpan()) < 3 || !definitionPath(l.GetPath()) {
+			continue
+		}
+		seen[key] = true
+		locs = append(locs, &descriptorpb.SourceCodeInfo_Location{Path: l.Path, Span: l.Span})
+	}
+
+	sci.Location = locs
+}
+
+// definitionPath returns true if SourceCodeInfo path points a message, e.g.
+// [4 0 3 1], or a field of a message, e.g. [4 0 2 3].
+func definitionPath(path []int32) bool {
+	if len(path) < 2 || len(path)%2 != 0 || path[0] != messageTypePath {
+		return false
+	}
+
+	for i := 2; i < len(path); i += 2 {
+		if path[i] != nestedTypePath && (path[i] != fieldPath || i != len(path)-2) {
+			return false
+		}
+	}
+
+	return true
+}
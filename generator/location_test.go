@@ -0,0 +1,67 @@
+package generator
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("SourceLocations", func() {
+
+	var sl sourceLocations
+
+	BeforeEach(func() {
+		sl = newSourceLocations(&descriptorpb.FileDescriptorProto{
+			Name: sp("order.proto"),
+			SourceCodeInfo: &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+				{Path: []int32{4, 0}, Span: []int32{4, 0, 12, 1}},
+				{Path: []int32{4, 0, 3, 0, 2, 1}, Span: []int32{7, 4, 20}},
+				{Path: []int32{4, 0, 3, 0, 2, 1}, Span: []int32{9, 4, 20}},
+			}},
+		})
+	})
+
+	It("returns compiler-style positions of definitions", func() {
+		Expect(sl.position(4, 0)).To(Equal("order.proto:5:1"))
+	})
+
+	It("returns positions of fields of nested messages", func() {
+		msgs := fileMessages([]*descriptorpb.DescriptorProto{
+			{Name: sp("Order"), NestedType: []*descriptorpb.DescriptorProto{{Name: sp("Item")}}},
+		}, "")
+
+		Expect(msgs[1].path).To(Equal([]int32{4, 0, 3, 0}))
+		Expect(sl.fieldPosition(msgs[1], 1)).To(Equal("order.proto:8:5"))
+		Expect(msgs[1].path).To(Equal([]int32{4, 0, 3, 0}))
+	})
+
+	It("returns file name if location is unknown", func() {
+		Expect(sl.position(4, 1)).To(Equal("order.proto"))
+		Expect(newSourceLocations(&descriptorpb.FileDescriptorProto{Name: sp("order.proto")}).position(4, 0)).To(Equal("order.proto"))
+	})
+
+	It("keeps only positions of messages and fields", func() {
+		f := &descriptorpb.FileDescriptorProto{
+			Name: sp("order.proto"),
+			SourceCodeInfo: &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+				{Path: []int32{}, Span: []int32{0, 0, 20, 1}},
+				{Path: []int32{4, 0}, Span: []int32{4, 0, 12, 1}, LeadingComments: sp(" Order is an order.\n")},
+				{Path: []int32{4, 0, 1}, Span: []int32{4, 8, 13}},
+				{Path: []int32{4, 0, 3, 0, 2, 1}, Span: []int32{7, 4, 20}},
+				{Path: []int32{4, 0, 3, 0, 2, 1}, Span: []int32{9, 4, 20}},
+				{Path: []int32{4, 0, 3, 0, 2, 1, 5}, Span: []int32{7, 4, 10}},
+				{Path: []int32{4, 0, 4, 0}, Span: []int32{13, 2, 16, 3}},
+				{Path: []int32{5, 0}, Span: []int32{17, 0, 20, 1}},
+			}},
+		}
+
+		compactSourceLocations(f)
+
+		Expect(f.SourceCodeInfo.Location).To(HaveLen(2))
+		Expect(f.SourceCodeInfo.Location[0].LeadingComments).To(BeNil())
+
+		compacted := newSourceLocations(f)
+		Expect(compacted.position(4, 0)).To(Equal("order.proto:5:1"))
+		Expect(compacted.position(4, 0, 3, 0, 2, 1)).To(Equal("order.proto:8:5"))
+	})
+})
@@ -0,0 +1,70 @@
+package generator
+
+import "github.com/ZacxDev/protoc-gen-struct-transformer/source"
+
+// maskedPath is a path of google.protobuf.FieldMask which is handled by
+// masked apply functions of message with transformer.go_masked option.
+type maskedPath struct {
+	// Path of proto field, e.g. name or address.city for fields of embedded
+	// messages.
+	Path string
+	// Model fields which are set for the path. Path of embedded message sets
+	// all its fields.
+	Fields []maskedField
+}
+
+// maskedField is a model field which is set by masked apply functions.
+type maskedField struct {
+	// Model field name.
+	Name string
+	// Condition which is true if value of transformed model m changes model
+	// field dst, see changeCond.
+	Changed string
+}
+
+// maskedPaths returns field mask paths of fields of model structure s. Fields
+// of oneofs without transformer.oneof_case option and fields transformed by
+// Converter dependencies are omitted, like in patch structures.
+func maskedPaths(fields []Field, s source.Structure) []maskedPath {
+	mp := []maskedPath{}
+
+	field := func(name string) maskedField {
+		return maskedField{Name: name, Changed: changeCond("dst."+name, "m."+name, s[name])}
+	}
+
+	for _, f := range fields {
+		if f.OneofDecl != "" || f.Dep != nil {
+			continue
+		}
+
+		if len(f.EmbeddedFields) == 0 {
+			mp = append(mp, maskedPath{Path: f.ProtoOrigName, Fields: []maskedField{field(f.Name)}})
+			continue
+		}
+
+		all := maskedPath{Path: f.ProtoOrigName}
+		nested := []maskedPath{}
+		for _, ef := range f.EmbeddedFields {
+			all.Fields = append(all.Fields, field(ef.Name))
+			nested = append(nested, maskedPath{Path: ef.ProtoOrigName, Fields: []maskedField{field(ef.Name)}})
+		}
+
+		mp = append(mp, all)
+		mp = append(mp, nested...)
+	}
+
+	return mp
+}
+
+// maskedImports returns import specs which are used by masked apply functions
+// of paths mp.
+func maskedImports(mp []maskedPath) []string {
+	conds := []string{}
+	for _, p := range mp {
+		for _, f := range p.Fields {
+			conds = append(conds, f.Changed)
+		}
+	}
+
+	return append([]string{`"fmt"`}, changeImports(conds...)...)
+}
@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Masked", func() {
+
+	Describe("maskedPaths", func() {
+
+		It("returns paths of fields and fields of embedded messages", func() {
+			s := source.Structure{
+				"ID":     {Type: "int64"},
+				"Street": {Type: "string"},
+				"City":   {Type: "City"},
+			}
+			fields := []Field{
+				{Name: "ID", ProtoOrigName: "id"},
+				{Name: "Kind", ProtoOrigName: "kind", OneofDecl: "Kind"},
+				{Name: "Rate", ProtoOrigName: "rate", Dep: &Dep{Iface: "Rates"}},
+				{ProtoOrigName: "address", EmbeddedFields: []Field{
+					{Name: "Street", ProtoOrigName: "address.street"},
+					{Name: "City", ProtoOrigName: "address.city"},
+				}},
+			}
+
+			street := maskedField{Name: "Street", Changed: "dst.Street != m.Street"}
+			city := maskedField{Name: "City", Changed: "!reflect.DeepEqual(dst.City, m.City)"}
+
+			mp := maskedPaths(fields, s)
+			Expect(mp).To(Equal([]maskedPath{
+				{Path: "id", Fields: []maskedField{{Name: "ID", Changed: "dst.ID != m.ID"}}},
+				{Path: "address", Fields: []maskedField{street, city}},
+				{Path: "address.street", Fields: []maskedField{street}},
+				{Path: "address.city", Fields: []maskedField{city}},
+			}))
+			Expect(maskedImports(mp)).To(Equal([]string{`"fmt"`, `"reflect"`}))
+		})
+	})
+
+	Describe("masked template", func() {
+
+		d := Data{
+			Src:             "Order",
+			SrcPref:         "pb",
+			SrcFn:           "Pb",
+			SrcPointer:      "*",
+			Dst:             "Order",
+			DstPref:         "model",
+			DstFn:           "Order",
+			WrappersPackage: "types",
+			Masked: []maskedPath{{Path: "address", Fields: []maskedField{
+				{Name: "Street", Changed: "dst.Street != m.Street"},
+				{Name: "City", Changed: "!reflect.DeepEqual(dst.City, m.City)"},
+			}}},
+		}
+
+		It("sets model fields of listed paths", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "masked", d)).To(Succeed())
+			Expect(w.String()).To(ContainSubstring(`func ApplyPbToOrderMasked(src *pb.Order, dst *model.Order, mask *types.FieldMask, opts ...TransformParam) error {
+	m := PbToOrderPtrVal(src, opts...)
+
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "address":
+			dst.Street = m.Street
+			dst.City = m.City
+		default:
+			return fmt.Errorf("field mask path %q is not a field of pb.Order", path)
+		}
+	}
+
+	return nil
+}`))
+		})
+
+		It("returns names of changed model fields", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "masked", d)).To(Succeed())
+			Expect(w.String()).To(ContainSubstring(`func ApplyPbToOrderMaskedChanges(src *pb.Order, dst *model.Order, mask *types.FieldMask, opts ...TransformParam) ([]string, error) {
+	m := PbToOrderPtrVal(src, opts...)
+
+	var changed []string
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "address":
+			if dst.Street != m.Street {
+				dst.Street = m.Street
+				changed = append(changed, "Street")
+			}
+			if !reflect.DeepEqual(dst.City, m.City) {
+				dst.City = m.City
+				changed = append(changed, "City")
+			}
+		default:
+			return nil, fmt.Errorf("field mask path %q is not a field of pb.Order", path)
+		}
+	}
+
+	return changed, nil
+}`))
+		})
+
+		It("returns error of transformer of message with transformer.with_errors option", func() {
+			d := d
+			d.WithErrors, d.WithContext = true, true
+
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "masked", d)).To(Succeed())
+			Expect(w.String()).To(ContainSubstring(`func ApplyPbToOrderMasked(ctx context.Context, src *pb.Order, dst *model.Order, mask *types.FieldMask, opts ...TransformParam) error {
+	m, err := PbToOrderPtrVal(ctx, src, opts...)
+	if err != nil {
+		return err
+	}
+`))
+		})
+	})
+})
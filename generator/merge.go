@@ -0,0 +1,160 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// mergeFunc is a function generated for patch message with transformer.go_merge
+// option, it sets model fields which are not nil in the message. Its
+// counterpart with Changes suffix also returns names of changed model fields.
+type mergeFunc struct {
+	// Patch message name and proto package, e.g. ProductPatch and pb.
+	Src     string
+	SrcPref string
+	// Model structure name and package, e.g. Product and model.
+	Dst     string
+	DstPref string
+	Fields  []mergeField
+}
+
+// mergeField is a model field which is set by merge function if nillable
+// patch field is present.
+type mergeField struct {
+	// Model field name.
+	Name string
+	// Patch field name.
+	ProtoName string
+	// Model field value of present patch field, e.g. patch.Price.Value.
+	Value string
+	// If true, model field is a pointer to copy of the value.
+	Ref bool
+	// Condition which is true if the value v changes model field dst, it's
+	// used by merge functions which track changes.
+	Changed string
+}
+
+// newMergeFunc returns merge function of patch message fm into model
+// structure target. Fields which can't be merged are reported into w.
+func newMergeFunc(
+	w io.Writer,
+	fm fileMessage,
+	target string,
+	messages MessageOptionList,
+	structs source.StructureList,
+	protoPackage, repoPackage string,
+) (*mergeFunc, error) {
+
+	s, err := source.Lookup(structs, target)
+	if err != nil {
+		return nil, err
+	}
+
+	modelPackage, modelName := splitTarget(target, repoPackage)
+	mf := &mergeFunc{Src: fm.goName(), SrcPref: protoPackage, Dst: modelName, DstPref: modelPackage}
+
+	for _, fdp := range fm.desc.GetField() {
+		// patch fields are merged into model, i.e. in PB_TO_GO direction.
+		if extractSkipOption(fdp.Options) || extractSkipDirectionOption(fdp.Options) == options.Direction_PB_TO_GO {
+			continue
+		}
+
+		f, err := newMergeField(fdp, messages, s)
+		if err != nil {
+			if e, ok := err.(loggableError); ok {
+				p(w, "// message %q: %s\n", fm.name, e)
+				continue
+			}
+			return nil, err
+		}
+
+		mf.Fields = append(mf.Fields, *f)
+	}
+
+	return mf, nil
+}
+
+// newMergeField returns merge field of patch field fdp. Proto3 optional
+// scalars and google.protobuf wrappers are merged into model fields of the
+// same type or pointers to them, message fields with transformer.go_struct
+// option are merged with their transformers. Other fields have no presence,
+// so they can't be merged.
+func newMergeField(fdp *descriptorpb.FieldDescriptorProto, messages MessageOptionList, s source.Structure) (*mergeField, error) {
+	mapTo, _ := getStringOption(fdp.Options, options.E_MapTo)
+	mapAs, _ := getStringOption(fdp.Options, options.E_MapAs)
+	pname, gname := prepareFieldNames(fdp.GetName(), mapAs, mapTo)
+
+	gf, ok := s[gname]
+	if !ok {
+		return nil, newLoggableError("field %s is not merged, model has no field %s", fdp.GetName(), gname).
+			withHint("point model field with (%s) option or skip the field with (%s) = true", options.E_MapTo.Name, options.E_Skip.Name)
+	}
+
+	f := &mergeField{Name: gname, ProtoName: pname}
+	src := "patch." + pname
+
+	typ := ""
+	switch {
+	case fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED:
+	case proto3Optional(fdp) && fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		if t, ok := types[fdp.GetType()]; ok {
+			typ = t.pbType
+			if typ == "" {
+				typ = t.goType
+			}
+			f.Value = "*" + src
+		}
+	case fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE && extractNullOption(fdp):
+		if t, ok := wrappers[fdp.GetTypeName()]; ok {
+			typ = t
+			f.Value = src + ".Value"
+			break
+		}
+
+		mo, ok := messages[strings.TrimPrefix(fdp.GetTypeName(), ".")]
+		if !ok || mo.Omitted() {
+			break
+		}
+
+		if opts := mo.Descriptor().GetOptions(); extractWithErrorsOption(opts) || extractWithContextOption(opts) {
+			return nil, newLoggableError("field %s is not merged, transformers of message %s return errors or use context", fdp.GetName(), mo.Full())
+		}
+
+		if gf.Type != mo.Target() && gf.Type != lastName(mo.Target()) || gf.IsSlice || gf.Key != "" {
+			return nil, newLoggableError("field %s is not merged, message %s is transformed into %s, got %s", fdp.GetName(), mo.Full(), mo.Target(), gf.GoType())
+		}
+
+		fn, _ := transformerNames(mo)
+		if gf.IsPointer {
+			f.Value = fmt.Sprintf("%sPtr(%s, opts...)", fn, src)
+		} else {
+			f.Value = fmt.Sprintf("%s(*%s, opts...)", fn, src)
+		}
+		f.Changed = changeCond("dst."+gname, "v", gf)
+
+		return f, nil
+	}
+
+	if typ == "" {
+		return nil, newLoggableError("field %s is not merged, it can't be nil", fdp.GetName()).
+			withHint("use optional label or google.protobuf wrapper type for the field, or skip it with (%s) = true", options.E_Skip.Name)
+	}
+
+	if gf.Type != typ || gf.IsSlice || gf.Key != "" {
+		return nil, newLoggableError("field %s is not merged, model field %s has type %s, want %s or *%s", fdp.GetName(), gname, gf.GoType(), typ, typ)
+	}
+
+	f.Ref = gf.IsPointer
+	if f.Ref {
+		f.Changed = fmt.Sprintf("dst.%s == nil || *dst.%s != v", gname, gname)
+	} else {
+		f.Changed = changeCond("dst."+gname, "v", gf)
+	}
+
+	return f, nil
+}
@@ -0,0 +1,144 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Merge", func() {
+
+	messages := MessageOptionList{
+		"pkg.Address": messageOption{targetName: "Address", fullName: "pkg.Address", desc: &descriptorpb.DescriptorProto{}},
+		"pkg.Secret":  messageOption{targetName: "Secret", fullName: "pkg.Secret", desc: &descriptorpb.DescriptorProto{Options: &descriptorpb.MessageOptions{}}},
+	}
+	proto.SetExtension(messages["pkg.Secret"].Descriptor().Options, options.E_WithErrors, true)
+
+	s := source.Structure{
+		"Name":     {Type: "string"},
+		"Price":    {Type: "int64", IsPointer: true},
+		"Nickname": {Type: "string", IsPointer: true},
+		"Address":  {Type: "Address"},
+		"Billing":  {Type: "Address", IsPointer: true},
+		"Secret":   {Type: "Secret"},
+		"Count":    {Type: "int"},
+	}
+
+	wrapper := func(name, typ string) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{Name: sp(name), Type: &typMessage, TypeName: sp(typ)}
+	}
+
+	Describe("newMergeField", func() {
+
+		It("merges google.protobuf wrappers into values and pointers", func() {
+			f, err := newMergeField(wrapper("name", ".google.protobuf.StringValue"), messages, s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(Equal(&mergeField{Name: "Name", ProtoName: "Name", Value: "patch.Name.Value", Changed: "dst.Name != v"}))
+
+			f, err = newMergeField(wrapper("price", ".google.protobuf.Int64Value"), messages, s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(Equal(&mergeField{Name: "Price", ProtoName: "Price", Value: "patch.Price.Value", Ref: true, Changed: "dst.Price == nil || *dst.Price != v"}))
+		})
+
+		It("merges proto3 optional scalars", func() {
+			f, err := newMergeField(optionalField(typString), messages, s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(Equal(&mergeField{Name: "Nickname", ProtoName: "Nickname", Value: "*patch.Nickname", Ref: true, Changed: "dst.Nickname == nil || *dst.Nickname != v"}))
+		})
+
+		It("merges messages with transformers", func() {
+			f, err := newMergeField(wrapper("address", ".pkg.Address"), messages, s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Value).To(Equal("PbToAddress(*patch.Address, opts...)"))
+			Expect(f.Changed).To(Equal("!reflect.DeepEqual(dst.Address, v)"))
+
+			f, err = newMergeField(wrapper("billing", ".pkg.Address"), messages, s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Value).To(Equal("PbToAddressPtr(patch.Billing, opts...)"))
+		})
+
+		It("returns an error for fields which can't be nil", func() {
+			_, err := newMergeField(&descriptorpb.FieldDescriptorProto{Name: sp("count"), Type: &typInt64}, messages, s)
+			Expect(err).To(MatchError("field count is not merged, it can't be nil; " +
+				"hint: use optional label or google.protobuf wrapper type for the field, or skip it with (transformer.skip) = true"))
+		})
+
+		It("returns an error if model field has another type", func() {
+			_, err := newMergeField(wrapper("count", ".google.protobuf.Int64Value"), messages, s)
+			Expect(err).To(MatchError("field count is not merged, model field Count has type int, want int64 or *int64"))
+		})
+
+		It("returns an error for messages transformed with errors", func() {
+			_, err := newMergeField(wrapper("secret", ".pkg.Secret"), messages, s)
+			Expect(err).To(MatchError("field secret is not merged, transformers of message pkg.Secret return errors or use context"))
+		})
+
+		It("returns an error if model has no field", func() {
+			_, err := newMergeField(wrapper("color", ".google.protobuf.StringValue"), messages, s)
+			Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		})
+	})
+
+	Describe("merge template", func() {
+
+		It("sets fields which are not nil", func() {
+			w := &bytes.Buffer{}
+			Expect(mergeT.Execute(w, mergeFunc{
+				Src: "ProductPatch", SrcPref: "pb", Dst: "Product", DstPref: "model",
+				Fields: []mergeField{
+					{Name: "Name", ProtoName: "Name", Value: "patch.Name.Value", Changed: "dst.Name != v"},
+					{Name: "Price", ProtoName: "Price", Value: "patch.Price.Value", Ref: true, Changed: "dst.Price == nil || *dst.Price != v"},
+				},
+			})).To(Succeed())
+
+			Expect(w.String()).To(Equal(`
+// MergeProductPatch sets fields of dst which are present in patch, nil fields of
+// patch are not present and don't change dst.
+func MergeProductPatch(dst *model.Product, patch *pb.ProductPatch, opts ...TransformParam) {
+	if patch == nil {
+		return
+	}
+	if patch.Name != nil {
+		dst.Name = patch.Name.Value
+	}
+	if patch.Price != nil {
+		v := patch.Price.Value
+		dst.Price = &v
+	}
+}
+
+// MergeProductPatchChanges sets fields of dst which are present in patch, like
+// MergeProductPatch, and returns names of dst fields which values are changed,
+// e.g. for audit logs.
+func MergeProductPatchChanges(dst *model.Product, patch *pb.ProductPatch, opts ...TransformParam) []string {
+	if patch == nil {
+		return nil
+	}
+
+	var changed []string
+	if patch.Name != nil {
+		v := patch.Name.Value
+		if dst.Name != v {
+			dst.Name = v
+			changed = append(changed, "Name")
+		}
+	}
+	if patch.Price != nil {
+		v := patch.Price.Value
+		if dst.Price == nil || *dst.Price != v {
+			dst.Price = &v
+			changed = append(changed, "Price")
+		}
+	}
+
+	return changed
+}
+`))
+		})
+	})
+})
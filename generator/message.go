@@ -1,37 +1,44 @@
 package generator
 
 import (
+	"fmt"
 	"io"
+	"strings"
 
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
 	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // processMessage processes each message regardless of contains it an options or
-// it doesn't. It returns set of fields for template and destination structure
-// name extracted from proto message go_struct option.
+// it doesn't. It returns set of fields for template, destination structure
+// name extracted from proto message go_struct option and report of fields
+// which are not transformed or have conflicting options, explicitly skipped
+// fields are not reported as unmapped. File-level
+// policies pol set defaults of field transformations.
 func processMessage(
 	w io.Writer,
-	msg *descriptor.DescriptorProto,
+	msg *descriptorpb.DescriptorProto,
 	subMessages map[string]MessageOption,
 	str source.StructureList,
+	pol policies,
 	debug bool,
-) ([]Field, string, error) {
+) ([]Field, string, fieldReport, error) {
 
 	structName, err := extractStructNameOption(msg)
 	if err != nil {
 		if msg != nil {
-			for _, d := range msg.OneofDecl {
+			for _, d := range realOneofs(msg) {
 				p(w, "// Oneof: %#v\n\n", *d.Name)
 			}
 		}
 
-		return nil, "", err
+		return nil, "", fieldReport{}, err
 	}
 
 	tsf, err := source.Lookup(str, structName)
 	if err != nil {
-		return nil, "", err
+		return nil, "", fieldReport{}, err
 	}
 
 	debugWriter := (io.Writer)(nil)
@@ -41,24 +48,87 @@ func processMessage(
 
 	p(debugWriter, "%s", tsf)
 
+	msg, err = withDirectives(w, msg, tsf)
+	if err != nil {
+		return nil, "", fieldReport{}, err
+	}
+
+	targets, err := fieldTargets(msg)
+	if err != nil {
+		return nil, "", fieldReport{}, err
+	}
+
+	pol.flattenEmbedded = extractFlattenEmbeddedOption(msg.Options)
+	dir := extractDirectionOption(msg.Options)
 	fields := []Field{}
+	fr := fieldReport{unmapped: []unmappedField{}}
+
+	for i, f := range msg.Field {
+		gname, _ := modelFieldName(f)
+
+		// fields are left out with comments in generated file, unless the
+		// field is skipped explicitly they are unmapped.
+		skip := func(err error) {
+			p(w, "// %s\n", err)
+			if !extractSkipOption(f.Options) && !tsf[gname].Skip {
+				fr.unmapped = append(fr.unmapped, unmappedField{Name: f.GetName(), Reason: err.Error()})
+				fr.issues = append(fr.issues, fieldIssue{index: i, name: f.GetName(), desc: err.Error(), unmapped: true})
+			}
+		}
+
+		for _, c := range optionConflicts(f, subMessages, tsf[gname].Type) {
+			p(w, "// conflict: %s\n", c)
+			fr.issues = append(fr.issues, fieldIssue{index: i, name: f.GetName(), desc: strings.TrimPrefix(c, "field "+f.GetName()+": ")})
+		}
+
+		if err := targetConflict(f, targets); err != nil {
+			skip(err)
+			continue
+		}
 
-	for _, f := range msg.Field {
-		pf, err := processField(debugWriter, f, subMessages, tsf)
+		if err := directionConflict(f, subMessages, dir); err != nil {
+			skip(err)
+			continue
+		}
+
+		// associations are loaded by GORM, so they are left out unless the
+		// field is mapped explicitly.
+		if _, mapped := modelFieldName(f); pol.modelStyle == options.ModelStyle_GORM && !mapped && gormAssociation(tsf[gname], str) {
+			p(w, "// field skipped: %s, model field %s is a GORM association\n", f.GetName(), gname)
+			continue
+		}
+
+		process := processField
+		if decl := oneofDecl(msg, f); decl != nil {
+			process = func(w io.Writer, fdp *descriptorpb.FieldDescriptorProto, sm MessageOptionList, s source.Structure, pol policies) (*Field, error) {
+				return processOneofCase(w, msg, decl, fdp, sm, s, pol)
+			}
+		} else if extractEmbeddedOption(f.Options) {
+			process = processEmbeddedField
+		}
+
+		pf, err := process(debugWriter, f, subMessages, tsf, pol)
 		if err != nil {
 			if e, ok := err.(loggableError); ok {
-				p(w, "// %s\n", e)
+				skip(e)
 				continue
 			}
 			if err != ErrNilOptions {
-				return nil, "", err
+				return nil, "", fieldReport{}, err
 			}
-			p(w, "// error: %s\n", err)
+			skip(fmt.Errorf("error: %s", err))
 			continue
 		}
 
+		switch extractSkipDirectionOption(f.Options) {
+		case options.Direction_PB_TO_GO:
+			pf.SkipPbToGo = true
+		case options.Direction_GO_TO_PB:
+			pf.SkipGoToPb = true
+		}
+
 		fields = append(fields, *pf)
 	}
 
-	return fields, structName, nil
+	return fields, structName, fr, nil
 }
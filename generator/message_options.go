@@ -1,6 +1,10 @@
 package generator
 
-import "fmt"
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
 
 // MessageOption represents protobuf message options.
 type MessageOption interface {
@@ -14,6 +18,17 @@ type MessageOption interface {
 	Omitted() bool
 	// Returns Oneof message name.
 	OneofDecl() string
+	// Descriptor returns proto message descriptor.
+	Descriptor() *descriptorpb.DescriptorProto
+	// GoName returns name of Go structure generated for the message, nested
+	// messages are prefixed by names of parent messages, e.g.
+	// Order_Item.
+	GoName() string
+	// FuncNames returns names of proto to model and model to proto
+	// transformers of the message made by transformer.func_name_format_*
+	// options of its file, empty names mean default names, e.g. PbToProduct and
+	// ProductToPb.
+	FuncNames() (string, string)
 }
 
 // MessageOptionList is a list of proto message option. Map key is a message
@@ -38,6 +53,18 @@ type messageOption struct {
 	fullName string
 	// OneOf name.
 	oneofDecl string
+	// Message descriptor.
+	desc *descriptorpb.DescriptorProto
+	// Name of generated Go structure, message name by default.
+	goName string
+	// Names of transformers, empty for default names.
+	pbToGo string
+	goToPb string
+	// Name of .proto file of the message, import path of its go_package option
+	// and value of its transformer.go_transformer_package option.
+	fileName           string
+	goPackage          string
+	transformerPackage string
 }
 
 func (so messageOption) Target() string {
@@ -55,3 +82,19 @@ func (so messageOption) Omitted() bool {
 func (so messageOption) OneofDecl() string {
 	return so.oneofDecl
 }
+
+func (so messageOption) Descriptor() *descriptorpb.DescriptorProto {
+	return so.desc
+}
+
+func (so messageOption) GoName() string {
+	if so.goName != "" {
+		return so.goName
+	}
+
+	return so.desc.GetName()
+}
+
+func (so messageOption) FuncNames() (string, string) {
+	return so.pbToGo, so.goToPb
+}
@@ -5,12 +5,12 @@ import (
 
 	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
 	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var _ = Describe("Message", func() {
@@ -23,13 +23,12 @@ var _ = Describe("Message", func() {
 	Describe("processMessage", func() {
 
 		DescribeTable("check result",
-			func(msg *descriptor.DescriptorProto, dstStruct string, expFields []Field, expSructName string, expError error) {
+			func(msg *descriptorpb.DescriptorProto, dstStruct string, expFields []Field, expSructName string, expError error) {
 				if msg != nil && dstStruct != "" {
-					err := proto.SetExtension(msg.Options, options.E_GoStruct, sp(dstStruct))
-					Expect(err).NotTo(HaveOccurred())
+					proto.SetExtension(msg.Options, options.E_GoStruct, dstStruct)
 				}
 
-				fields, structName, err := processMessage(nil, msg, subm, messagesData, false)
+				fields, structName, _, err := processMessage(nil, msg, subm, messagesData, policies{}, false)
 				if expError == nil {
 					Expect(err).NotTo(HaveOccurred())
 				} else {
@@ -43,44 +42,47 @@ var _ = Describe("Message", func() {
 			},
 			Entry("Nil message", nil, "", nil, "", newLoggableError("message is nil")),
 
-			Entry("Message without fields", &descriptor.DescriptorProto{
+			Entry("Message without fields", &descriptorpb.DescriptorProto{
 				Name:    sp("Msg1"),
 				Field:   nil,
-				Options: &descriptor.MessageOptions{},
+				Options: &descriptorpb.MessageOptions{},
 			}, "msg1", []Field{}, "msg1", nil),
 
-			Entry("Message with non_existent field", &descriptor.DescriptorProto{
+			Entry("Message with non_existent field", &descriptorpb.DescriptorProto{
 				Name: sp("Msg1"),
-				Field: []*descriptor.FieldDescriptorProto{
-					&descriptor.FieldDescriptorProto{
+				Field: []*descriptorpb.FieldDescriptorProto{
+					&descriptorpb.FieldDescriptorProto{
 						Name:     sp("not_exists"),
 						Number:   nil,
 						Label:    nil,
 						Type:     &typInt64,
 						TypeName: nil, // sub message type
-						Options:  &descriptor.FieldOptions{},
+						Options:  &descriptorpb.FieldOptions{},
 					},
 				},
-				Options: &descriptor.MessageOptions{},
-			}, "msg1", nil, "", pkgerrors.Wrap(errors.New("field not found in destination structure"), "NotExists")),
+				Options: &descriptorpb.MessageOptions{},
+			}, "msg1", nil, "", pkgerrors.Wrap(errors.New("field not found in destination structure; hint: add field NotExists to model, use (transformer.map_to) option if model field has another name or skip the field with (transformer.skip) = true"), "NotExists")),
 
-			Entry("Message with fields", &descriptor.DescriptorProto{
+			Entry("Message with fields", &descriptorpb.DescriptorProto{
 				Name: sp("Msg1"),
-				Field: []*descriptor.FieldDescriptorProto{
-					&descriptor.FieldDescriptorProto{
+				Field: []*descriptorpb.FieldDescriptorProto{
+					&descriptorpb.FieldDescriptorProto{
 						Name:     sp("int64_field"),
 						Number:   nil,
 						Label:    nil,
 						Type:     &typInt64,
 						TypeName: nil, // sub message type
-						Options:  &descriptor.FieldOptions{},
+						Options:  &descriptorpb.FieldOptions{},
 					},
 				},
-				Options: &descriptor.MessageOptions{},
+				Options: &descriptorpb.MessageOptions{},
 			}, "msg1", []Field{
 				{
 					Name:           "Int64Field",
 					ProtoName:      "Int64Field",
+					ProtoOrigName:  "int64_field",
+					ProtoJSONName:  "int64Field",
+					GoJSONName:     "Int64Field",
 					ProtoType:      "",
 					ProtoToGoType:  "",
 					GoToProtoType:  "",
@@ -89,26 +91,30 @@ var _ = Describe("Message", func() {
 					UsePackage:     false,
 					OneofDecl:      "",
 					Opts:           "",
+					Signature:      "int64_field LABEL_OPTIONAL TYPE_INT64 => Int64Field int64",
 				},
 			}, "msg1", nil),
 
-			Entry("Message with ID field", &descriptor.DescriptorProto{
+			Entry("Message with ID field", &descriptorpb.DescriptorProto{
 				Name: sp("Msg1"),
-				Field: []*descriptor.FieldDescriptorProto{
-					&descriptor.FieldDescriptorProto{
+				Field: []*descriptorpb.FieldDescriptorProto{
+					&descriptorpb.FieldDescriptorProto{
 						Name:     sp("ID"),
 						Number:   nil,
 						Label:    nil,
 						Type:     &typInt64,
 						TypeName: nil, // sub message type
-						Options:  &descriptor.FieldOptions{},
+						Options:  &descriptorpb.FieldOptions{},
 					},
 				},
-				Options: &descriptor.MessageOptions{},
+				Options: &descriptorpb.MessageOptions{},
 			}, "msg1", []Field{
 				{
 					Name:           "ID",
 					ProtoName:      "ID",
+					ProtoOrigName:  "ID",
+					ProtoJSONName:  "ID",
+					GoJSONName:     "ID",
 					ProtoType:      "",
 					ProtoToGoType:  "",
 					GoToProtoType:  "",
@@ -117,9 +123,29 @@ var _ = Describe("Message", func() {
 					UsePackage:     false,
 					OneofDecl:      "",
 					Opts:           "",
+					Signature:      "ID LABEL_OPTIONAL TYPE_INT64 => ID int64",
 				},
 			}, "msg1", nil),
 		)
+
+		It("marks fields with skip_direction option", func() {
+			dir := options.Direction_GO_TO_PB
+			fo := &descriptorpb.FieldOptions{}
+			proto.SetExtension(fo, options.E_SkipDirection, dir)
+
+			msg := &descriptorpb.DescriptorProto{
+				Name:    sp("Msg1"),
+				Field:   []*descriptorpb.FieldDescriptorProto{{Name: sp("int64_field"), Type: &typInt64, Options: fo}},
+				Options: &descriptorpb.MessageOptions{},
+			}
+			proto.SetExtension(msg.Options, options.E_GoStruct, "msg1")
+
+			fields, _, _, err := processMessage(nil, msg, subm, messagesData, policies{}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fields).To(HaveLen(1))
+			Expect(fields[0].SkipGoToPb).To(BeTrue())
+			Expect(fields[0].SkipPbToGo).To(BeFalse())
+		})
 	})
 
 })
@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// processNullField returns *Field for singular scalar, wrapper or
+// google.protobuf.Timestamp field fdp which is transformed into model field
+// gf of nullable type, such as sql.NullString or pgtype.Int8, see
+// source.FieldInfo.Null. Values are set with Valid flag, nil optional or
+// wrapper fields become invalid values and back. Nil is returned for other
+// fields, e.g. of gogoproto.stdtime timestamps.
+func processNullField(fdp *descriptorpb.FieldDescriptorProto, pname, gname string, gf source.FieldInfo) (*Field, error) {
+	nt, ok := gf.Null()
+	if !ok || fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil, nil
+	}
+
+	e := &Elem{Kind: elemNull, GoType: gf.Type, Value: nt.Value}
+	vt := ""
+
+	switch t := fdp.GetTypeName(); {
+	case fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+		rel, ok := types[fdp.GetType()]
+		if !ok {
+			return nil, nil
+		}
+		vt = rel.pbType
+		if vt == "" {
+			vt = rel.goType
+		}
+		e.ProtoIsPointer = proto3Optional(fdp)
+	case t == ".google.protobuf.Timestamp" && !extractStdTimeOption(fdp):
+		e.ProtoType, e.ProtoIsPointer, vt = lastName(t), extractNullOption(fdp), "time.Time"
+	case wrappers[t] != "":
+		e.ProtoType, e.ProtoIsPointer, vt = lastName(t), extractNullOption(fdp), wrappers[t]
+	default:
+		return nil, nil
+	}
+
+	if vt != nt.Type {
+		if !widens(vt, nt.Type) {
+			return nil, newLoggableError("field %s: value of type %s can't be transformed into %s with value of type %s",
+				gname, vt, gf.Type, nt.Type).
+				withHint("change type of model field %s or skip the field with (transformer.skip) = true", gname)
+		}
+		e.ProtoToGo, e.GoToProto = nt.Type, vt
+	}
+
+	return &Field{Name: gname, ProtoName: pname, Wrapper: e}, nil
+}
+
+// numericType describes integer or floating point Go type.
+type numericType struct {
+	// Kind of type: 'i' for signed integers, 'u' for unsigned integers and
+	// 'f' for floating point numbers.
+	kind byte
+	// Size of type in bits.
+	bits int
+}
+
+// numericSizes are integer and floating point Go types by names, such values
+// are converted into each other by type conversion.
+var numericSizes = map[string]numericType{
+	"int":     {'i', 64},
+	"int8":    {'i', 8},
+	"int16":   {'i', 16},
+	"int32":   {'i', 32},
+	"int64":   {'i', 64},
+	"uint":    {'u', 64},
+	"uint8":   {'u', 8},
+	"byte":    {'u', 8},
+	"uint16":  {'u', 16},
+	"uint32":  {'u', 32},
+	"uint64":  {'u', 64},
+	"float32": {'f', 32},
+	"float64": {'f', 64},
+}
+
+// widens returns true if proto value of numeric type from is converted into
+// model value of numeric type to without loss, i.e. types are of the same kind
+// and to is not smaller, or from is unsigned and to is larger signed integer.
+// Other conversions, e.g. double into int64 or int64 into int32, are lossy.
+func widens(from, to string) bool {
+	f, ok := numericSizes[from]
+	if !ok {
+		return false
+	}
+	t, ok := numericSizes[to]
+	if !ok {
+		return false
+	}
+
+	return f.kind == t.kind && f.bits <= t.bits || f.kind == 'u' && t.kind == 'i' && f.bits < t.bits
+}
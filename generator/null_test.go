@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Nullable model fields", func() {
+
+	field := func(typ *descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		fdp := &descriptorpb.FieldDescriptorProto{Name: sp("email"), Type: typ, Options: &descriptorpb.FieldOptions{}}
+		if typeName != "" {
+			fdp.TypeName = sp(typeName)
+		}
+		return fdp
+	}
+
+	It("transforms scalar fields", func() {
+		f, err := processNullField(field(&typString, ""), "Email", "Email", source.FieldInfo{Type: "sql.NullString"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f).To(Equal(&Field{Name: "Email", ProtoName: "Email", Wrapper: &Elem{Kind: elemNull, GoType: "sql.NullString", Value: "String"}}))
+	})
+
+	It("converts values of wrapper fields", func() {
+		f, err := processNullField(field(&typMessage, ".google.protobuf.Int32Value"), "Age", "Age", source.FieldInfo{Type: "pgtype.Int8"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{
+			Kind: elemNull, ProtoType: "Int32Value", GoType: "pgtype.Int8", ProtoIsPointer: true, Value: "Int64",
+			ProtoToGo: "int64", GoToProto: "int32",
+		}))
+	})
+
+	It("transforms timestamps", func() {
+		f, err := processNullField(field(&typMessage, ".google.protobuf.Timestamp"), "VerifiedAt", "VerifiedAt", source.FieldInfo{Type: "sql.NullTime"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{Kind: elemNull, ProtoType: "Timestamp", GoType: "sql.NullTime", ProtoIsPointer: true, Value: "Time"}))
+	})
+
+	It("transforms timestamp into gorm.DeletedAt", func() {
+		f, err := processNullField(field(&typMessage, ".google.protobuf.Timestamp"), "DeletedAt", "DeletedAt", source.FieldInfo{Type: "gorm.DeletedAt", Promoted: "Model"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{Kind: elemNull, ProtoType: "Timestamp", GoType: "gorm.DeletedAt", ProtoIsPointer: true, Value: "Time"}))
+	})
+
+	It("returns nil for other model types and stdtime timestamps", func() {
+		Expect(processNullField(field(&typString, ""), "Email", "Email", source.FieldInfo{Type: "nulls.String"})).To(BeNil())
+		Expect(processNullField(field(&typString, ""), "Email", "Email", source.FieldInfo{Type: "sql.NullString", IsPointer: true})).To(BeNil())
+		Expect(processNullField(field(&typMessage, ".pkg.Address"), "Email", "Email", source.FieldInfo{Type: "sql.NullString"})).To(BeNil())
+	})
+
+	DescribeTable("converts numeric values without loss only",
+		func(typ *descriptorpb.FieldDescriptorProto_Type, typeName, model string, expected bool) {
+			f, err := processNullField(field(typ, typeName), "Score", "Score", source.FieldInfo{Type: model})
+			if expected {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(f).NotTo(BeNil())
+				return
+			}
+			Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+			Expect(err.Error()).To(HaveSuffix("hint: change type of model field Score or skip the field with (transformer.skip) = true"))
+		},
+
+		Entry("Same type", &typInt64, "", "sql.NullInt64", true),
+		Entry("Wider integer", &typInt32, "", "sql.NullInt64", true),
+		Entry("Unsigned into wider signed integer", &typMessage, ".google.protobuf.UInt32Value", "pgtype.Int8", true),
+		Entry("Wider float", &typMessage, ".google.protobuf.FloatValue", "sql.NullFloat64", true),
+		Entry("Narrower integer", &typInt64, "", "sql.NullInt32", false),
+		Entry("Unsigned into signed integer of the same size", &typMessage, ".google.protobuf.UInt64Value", "sql.NullInt64", false),
+		Entry("Float into integer", &typMessage, ".google.protobuf.DoubleValue", "sql.NullInt64", false),
+		Entry("Integer into float", &typInt64, "", "sql.NullFloat64", false),
+		Entry("Narrower float", &typMessage, ".google.protobuf.DoubleValue", "pgtype.Float4", false),
+	)
+
+	It("returns error with hint for incompatible values", func() {
+		_, err := processNullField(field(&typString, ""), "Email", "Email", source.FieldInfo{Type: "sql.NullInt64"})
+		Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		Expect(err.Error()).To(Equal("field Email: value of type string can't be transformed into sql.NullInt64 with value of type int64; " +
+			"hint: change type of model field Email or skip the field with (transformer.skip) = true"))
+	})
+})
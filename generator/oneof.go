@@ -21,7 +21,6 @@ func processOneofFields(w io.Writer, data []*Data) error {
 		if d == nil {
 			continue
 		}
-		d.swap()
 
 		for _, f := range d.Fields {
 			if !f.IsOneof() {
@@ -0,0 +1,327 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// numericTypes contains Go numeric types, zero value of model fields of such
+// types is 0.
+var numericTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true, "byte": true, "rune": true,
+}
+
+// oneofDecl returns oneof declaration of message msg which field fdp is a
+// case of, nil if field is not a member of oneof. Synthetic oneofs of proto3
+// optional fields are ignored.
+func oneofDecl(msg *descriptorpb.DescriptorProto, fdp *descriptorpb.FieldDescriptorProto) *descriptorpb.OneofDescriptorProto {
+	if fdp.OneofIndex == nil || proto3Optional(fdp) || int(fdp.GetOneofIndex()) >= len(msg.GetOneofDecl()) {
+		return nil
+	}
+
+	return msg.GetOneofDecl()[fdp.GetOneofIndex()]
+}
+
+// processOneofCase returns *Field created out of field fdp which is a case of
+// oneof declaration decl of message msg. Without transformer.oneof_case
+// option the case is transformed into model field as regular fields are,
+// otherwise it's transformed into model type of the option, which is set into
+// model field named after oneof declaration. Cases of oneofs with
+// transformer.go_sum_type option without oneof_case option are transformed
+// into generated wrapper structures.
+func processOneofCase(
+	w io.Writer,
+	msg *descriptorpb.DescriptorProto,
+	decl *descriptorpb.OneofDescriptorProto,
+	fdp *descriptorpb.FieldDescriptorProto,
+	subMessages MessageOptionList,
+	goStructFields source.Structure,
+	pol policies,
+) (*Field, error) {
+	if extractSkipOption(fdp.Options) {
+		return nil, newLoggableError("field skipped: %s", fdp.GetName())
+	}
+
+	variant, _ := getStringOption(fdp.Options, options.E_OneofCase)
+	sumType, _ := getStringOption(decl.GetOptions(), options.E_GoSumType)
+	for _, sibling := range msg.GetField() {
+		// cases of sum type may either have the option or not.
+		if sumType != "" || sibling.OneofIndex == nil || sibling.GetOneofIndex() != fdp.GetOneofIndex() || extractSkipOption(sibling.Options) {
+			continue
+		}
+
+		if hasOption(sibling.Options, options.E_OneofCase) != (variant != "") {
+			return nil, newLoggableError("field skipped: %s, cases of oneof %s are transformed both into model fields and into model types", fdp.GetName(), decl.GetName()).
+				withHint("set (%s) option of all cases of oneof %s or remove it", options.E_OneofCase.Name, decl.GetName())
+		}
+	}
+
+	c := &OneofCase{
+		Decl:  strcase.ToCamel(decl.GetName()),
+		Field: strcase.ToCamel(fdp.GetName()),
+	}
+
+	if variant == "" && sumType != "" {
+		c.Wrapped = true
+		variant = sumType + c.Field
+	}
+
+	if variant != "" {
+		return processVariantCase(fdp, c, variant, subMessages, goStructFields)
+	}
+
+	f, err := processField(w, fdp, subMessages, goStructFields, pol)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Elem != nil || f.Wrapper != nil || f.Dep != nil || f.WithError || f.WithContext || f.HelperCall != nil || f.IsOneof() || f.IsEmbedded() {
+		return nil, newLoggableError("field skipped: %s, case of oneof %s can not be transformed into model field %s", fdp.GetName(), decl.GetName(), f.Name).
+			withHint("set (%s) option of cases of oneof %s or skip the field with (%s) = true", options.E_OneofCase.Name, decl.GetName(), options.E_Skip.Name)
+	}
+
+	gf := goStructFields[f.Name]
+	cond, ok := caseCond(f.Name, gf)
+	if !ok {
+		return nil, newLoggableError("field skipped: %s, model field %s of type %s can not be checked for oneof %s case", fdp.GetName(), f.Name, gf, decl.GetName()).
+			withHint("change type of model field %s to pointer, zero value of model field means that the case is not set", f.Name)
+	}
+
+	c.Cond = cond
+	f.Case = c
+
+	return f, nil
+}
+
+// processVariantCase returns *Field created out of oneof case fdp which is
+// transformed into model type variant, e.g. *CardPayment. Message cases are
+// transformed by transformers of the message, scalar cases are converted into
+// named model types.
+func processVariantCase(fdp *descriptorpb.FieldDescriptorProto, c *OneofCase, variant string, subMessages MessageOptionList, goStructFields source.Structure) (*Field, error) {
+	gf, ok := goStructFields[c.Decl]
+	if !ok {
+		return nil, newLoggableError("field skipped: %s, model has no field %s for oneof case %s", fdp.GetName(), c.Decl, variant).
+			withHint("add model field %s of interface type which is implemented by model types of oneof cases", c.Decl)
+	}
+
+	c.VariantIsPointer = strings.HasPrefix(variant, "*")
+	c.Variant = strings.TrimPrefix(variant, "*")
+
+	if fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		mo, ok := subMessages[strings.TrimPrefix(fdp.GetTypeName(), ".")]
+		if !ok || mo.Omitted() {
+			return nil, newLoggableError("field skipped: %s, message %s of oneof case has no (%s) option", fdp.GetName(), strings.TrimPrefix(fdp.GetTypeName(), "."), options.E_GoStruct.Name)
+		}
+
+		if c.Wrapped && strings.Contains(mo.Target(), ".") {
+			return nil, newLoggableError("field skipped: %s, wrapper of oneof case can not refer to model %s of another package", fdp.GetName(), mo.Target()).
+				withHint("set (%s) = %q option of the field", options.E_OneofCase.Name, mo.Target())
+		}
+
+		if !c.Wrapped && lastName(c.Variant) != lastName(mo.Target()) {
+			return nil, newLoggableError("field skipped: %s, model type %s of oneof case differs from model %s of message %s", fdp.GetName(), c.Variant, mo.Target(), mo.Full()).
+				withHint("set (%s) = %q option of the field", options.E_OneofCase.Name, strings.TrimSuffix(variant, c.Variant)+mo.Target())
+		}
+
+		if opts := mo.Descriptor().GetOptions(); extractWithErrorsOption(opts) || extractWithContextOption(opts) {
+			return nil, newLoggableError("field skipped: %s, transformers of message %s of oneof case return errors or accept context", fdp.GetName(), mo.Full()).
+				withHint("remove (%s) and (%s) options of message %s", options.E_WithErrors.Name, options.E_WithContext.Name, mo.Full())
+		}
+
+		c.Func = targetFuncName(mo.Target())
+		c.ProtoToGo, c.GoToProto = mo.FuncNames()
+	} else {
+		t, ok := types[fdp.GetType()]
+		if !ok {
+			return nil, newLoggableError("field skipped: %s, oneof case of type %s can not be converted into model type %s", fdp.GetName(), fdp.GetType(), c.Variant).
+				withHint("use message or scalar type of proto field, e.g. string or int64")
+		}
+
+		c.ProtoType = t.pbType
+		if c.ProtoType == "" {
+			c.ProtoType = t.goType
+		}
+	}
+
+	return &Field{
+		Name:          c.Decl,
+		ProtoName:     c.Field,
+		ProtoOrigName: fdp.GetName(),
+		ProtoJSONName: protoJSONName(fdp),
+		GoJSONName:    goJSONName(c.Decl, gf.Tag),
+		Signature:     fieldSignature(fdp.GetName(), fdp, c.Decl, gf),
+		Case:          c,
+	}, nil
+}
+
+// caseCond returns condition which is true if model field gname of type gf is
+// set, i.e. it has non-zero value. False is returned if zero value can't be
+// detected by comparison, e.g. for structures.
+func caseCond(gname string, gf source.FieldInfo) (string, bool) {
+	v := "src." + gname
+
+	switch {
+	case gf.IsPointer || gf.IsSlice || gf.Key != "":
+		return v + " != nil", true
+	case gf.Type == "string":
+		return v + ` != ""`, true
+	case gf.Type == "bool":
+		return v, true
+	case gf.Type == "time.Time":
+		return "!" + v + ".IsZero()", true
+	case numericTypes[gf.Type]:
+		return v + " != 0", true
+	}
+
+	return "", false
+}
+
+// formatOneofCases returns switch statement which transforms all cases of
+// oneof declaration of field f. Statement is returned for the first case of
+// the declaration only, empty string is returned for other cases. Proto to
+// model switch checks type of oneof wrapper, model to proto switch checks
+// either model type of interface field or conditions of model fields.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatOneofCases(f Field, d Data) string {
+	var cases []Field
+	for _, cf := range d.Fields {
+		if cf.Case == nil || cf.Case.Decl != f.Case.Decl {
+			continue
+		}
+		if len(cases) == 0 && cf.ProtoOrigName != f.ProtoOrigName {
+			return ""
+		}
+		cases = append(cases, cf)
+	}
+
+	// Proto package and Go name of proto message, its oneof wrappers are
+	// named like Order_Card.
+	pbPref, pbMsg, modelPref := d.SrcPref, d.Src, d.DstPref
+	if d.Swapped {
+		pbPref, pbMsg, modelPref = d.DstPref, d.Dst, d.SrcPref
+	}
+
+	wrapper := func(c *OneofCase) string {
+		return fmt.Sprintf("%s_%s", qualified(pbMsg, pbPref), c.Field)
+	}
+
+	variant := func(c *OneofCase) string {
+		t := c.Variant
+		if !strings.Contains(t, ".") {
+			t = qualified(t, modelPref)
+		}
+		if c.VariantIsPointer {
+			t = "*" + t
+		}
+		return t
+	}
+
+	b := &strings.Builder{}
+	byType := f.Case.Variant != ""
+
+	switch {
+	case !d.Swapped && byType:
+		fmt.Fprintf(b, "\tswitch v := src.%s.(type) {\n", f.Case.Decl)
+	case !d.Swapped:
+		fmt.Fprintf(b, "\tswitch src.%s.(type) {\n", f.Case.Decl)
+	case byType:
+		fmt.Fprintf(b, "\tswitch v := src.%s.(type) {\n", f.Name)
+	default:
+		fmt.Fprint(b, "\tswitch {\n")
+	}
+
+	for _, cf := range cases {
+		c := cf.Case
+
+		switch {
+		case !d.Swapped && byType:
+			fmt.Fprintf(b, "\tcase *%s:\n", wrapper(c))
+			fmt.Fprintf(b, "\t\ts.%s = %s\n", cf.Name, c.toModel("v."+c.Field, variant(c)))
+		case !d.Swapped:
+			fmt.Fprintf(b, "\tcase *%s:\n", wrapper(c))
+			cf.ProtoName = fmt.Sprintf("Get%s()", c.Field)
+			fmt.Fprintf(b, "\t\ts.%s = %s\n", cf.Name, fieldValue(cf, false, d.DstPref))
+		case byType:
+			fmt.Fprintf(b, "\tcase %s:\n", variant(c))
+			fmt.Fprintf(b, "\t\ts.%s = &%s{%s: %s}\n", c.Decl, wrapper(c), c.Field, c.toProto("v"))
+		default:
+			fmt.Fprintf(b, "\tcase %s:\n", c.Cond)
+			fmt.Fprintf(b, "\t\ts.%s = &%s{%s: %s}\n", c.Decl, wrapper(c), c.Field, fieldValue(cf, true, d.DstPref))
+		}
+	}
+
+	fmt.Fprint(b, "\t}\n")
+
+	return b.String()
+}
+
+// toModel returns expression which transforms value v of oneof wrapper field
+// into model type t of the case.
+func (c OneofCase) toModel(v, t string) string {
+	if c.Func != "" {
+		suffix := "PtrVal"
+		if c.VariantIsPointer || c.Wrapped {
+			suffix = "Ptr"
+		}
+		p2g, _ := c.funcNames()
+		v = fmt.Sprintf("%s%s(%s, opts...)", p2g, suffix, v)
+	}
+
+	switch {
+	case c.Wrapped:
+		return fmt.Sprintf("%s{%s: %s}", t, c.Field, v)
+	case c.Func == "":
+		return fmt.Sprintf("%s(%s)", t, v)
+	}
+
+	return v
+}
+
+// toProto returns expression which transforms value v of model type of the
+// case into value of oneof wrapper field.
+func (c OneofCase) toProto(v string) string {
+	if c.Wrapped {
+		v += "." + c.Field
+	}
+
+	_, g2p := c.funcNames()
+
+	switch {
+	case c.Func == "" && c.Wrapped:
+		return v
+	case c.Func == "":
+		return fmt.Sprintf("%s(%s)", c.ProtoType, v)
+	case c.VariantIsPointer || c.Wrapped:
+		return fmt.Sprintf("%sPtr(%s, opts...)", g2p, v)
+	}
+
+	return fmt.Sprintf("%sValPtr(%s, opts...)", g2p, v)
+}
+
+// funcNames returns names of proto to model and model to proto transformers
+// of message case without suffixes.
+func (c OneofCase) funcNames() (string, string) {
+	if c.ProtoToGo != "" {
+		return c.ProtoToGo, c.GoToProto
+	}
+
+	return "PbTo" + c.Func, c.Func + "ToPb"
+}
+
+// qualified returns type name t prefixed by package pref if it's not empty.
+func qualified(t, pref string) string {
+	if pref == "" {
+		return t
+	}
+
+	return pref + "." + t
+}
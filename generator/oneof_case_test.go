@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"io/ioutil"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("OneofCase", func() {
+
+	// oneofField returns member of the first oneof declaration of type typ
+	// with transformer.oneof_case option variant, if it's not empty.
+	oneofField := func(name string, typ descriptorpb.FieldDescriptorProto_Type, typeName, variant string) *descriptorpb.FieldDescriptorProto {
+		fdp := &descriptorpb.FieldDescriptorProto{Name: sp(name), Type: &typ, OneofIndex: proto.Int32(0), Options: &descriptorpb.FieldOptions{}}
+		if typeName != "" {
+			fdp.TypeName = sp(typeName)
+		}
+		if variant != "" {
+			proto.SetExtension(fdp.Options, options.E_OneofCase, variant)
+		}
+		return fdp
+	}
+
+	subMessages := MessageOptionList{
+		"pkg.Card": messageOption{targetName: "CardPayment", fullName: "pkg.Card", desc: &descriptorpb.DescriptorProto{}},
+	}
+
+	decl := &descriptorpb.OneofDescriptorProto{Name: sp("method")}
+
+	Describe("processOneofCase", func() {
+
+		It("transforms cases into model fields", func() {
+			email := oneofField("email", typString, "", "")
+			msg := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{email}, OneofDecl: []*descriptorpb.OneofDescriptorProto{decl}}
+
+			f, err := processOneofCase(ioutil.Discard, msg, decl, email, subMessages, source.Structure{"Email": {Type: "string"}}, policies{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Name).To(Equal("Email"))
+			Expect(f.Case).To(Equal(&OneofCase{Decl: "Method", Field: "Email", Cond: `src.Email != ""`}))
+		})
+
+		It("transforms cases into model types", func() {
+			card := oneofField("card", typMessage, ".pkg.Card", "*CardPayment")
+			coupon := oneofField("coupon", typString, "", "Coupon")
+			msg := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{card, coupon}, OneofDecl: []*descriptorpb.OneofDescriptorProto{decl}}
+			s := source.Structure{"Method": {Type: "PaymentMethod"}}
+
+			f, err := processOneofCase(ioutil.Discard, msg, decl, card, subMessages, s, policies{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Name).To(Equal("Method"))
+			Expect(f.Case).To(Equal(&OneofCase{Decl: "Method", Field: "Card", Variant: "CardPayment", VariantIsPointer: true, Func: "CardPayment"}))
+
+			f, err = processOneofCase(ioutil.Discard, msg, decl, coupon, subMessages, s, policies{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Case).To(Equal(&OneofCase{Decl: "Method", Field: "Coupon", Variant: "Coupon", ProtoType: "string"}))
+		})
+
+		It("transforms cases of sum type into generated wrappers", func() {
+			sumDecl := &descriptorpb.OneofDescriptorProto{Name: sp("target"), Options: &descriptorpb.OneofOptions{}}
+			proto.SetExtension(sumDecl.Options, options.E_GoSumType, "PayoutTarget")
+			card := oneofField("card", typMessage, ".pkg.Card", "")
+			msg := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{card}, OneofDecl: []*descriptorpb.OneofDescriptorProto{sumDecl}}
+
+			f, err := processOneofCase(ioutil.Discard, msg, sumDecl, card, subMessages, source.Structure{"Target": {Type: "PayoutTarget"}}, policies{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Case).To(Equal(&OneofCase{Decl: "Target", Field: "Card", Variant: "PayoutTargetCard", Wrapped: true, Func: "CardPayment"}))
+		})
+
+		It("returns an error if model type differs from go_struct of message", func() {
+			card := oneofField("card", typMessage, ".pkg.Card", "Card")
+			msg := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{card}, OneofDecl: []*descriptorpb.OneofDescriptorProto{decl}}
+
+			_, err := processOneofCase(ioutil.Discard, msg, decl, card, subMessages, source.Structure{"Method": {Type: "PaymentMethod"}}, policies{})
+			Expect(err).To(MatchError("field skipped: card, model type Card of oneof case differs from model CardPayment of message pkg.Card; " +
+				`hint: set (transformer.oneof_case) = "CardPayment" option of the field`))
+		})
+
+		It("returns an error if cases are transformed both into model fields and types", func() {
+			email := oneofField("email", typString, "", "")
+			coupon := oneofField("coupon", typString, "", "Coupon")
+			msg := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{email, coupon}, OneofDecl: []*descriptorpb.OneofDescriptorProto{decl}}
+
+			_, err := processOneofCase(ioutil.Discard, msg, decl, email, subMessages, source.Structure{"Email": {Type: "string"}}, policies{})
+			Expect(err).To(MatchError("field skipped: email, cases of oneof method are transformed both into model fields and into model types; " +
+				"hint: set (transformer.oneof_case) option of all cases of oneof method or remove it"))
+		})
+
+		It("returns an error if model field can not be checked for zero value", func() {
+			email := oneofField("email", typString, "", "")
+			msg := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{email}, OneofDecl: []*descriptorpb.OneofDescriptorProto{decl}}
+
+			_, err := processOneofCase(ioutil.Discard, msg, decl, email, subMessages, source.Structure{"Email": {Type: "Address"}}, policies{})
+			Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		})
+	})
+
+	Describe("formatOneofCases", func() {
+
+		d := Data{Src: "Payment", SrcPref: "pb", Dst: "Payment", DstPref: "model"}
+
+		It("renders type switches of cases transformed into model types", func() {
+			d := d
+			d.Fields = []Field{
+				{Name: "Method", ProtoOrigName: "card", Case: &OneofCase{Decl: "Method", Field: "Card", Variant: "CardPayment", VariantIsPointer: true, Func: "CardPayment"}},
+				{Name: "Method", ProtoOrigName: "coupon", Case: &OneofCase{Decl: "Method", Field: "Coupon", Variant: "Coupon", ProtoType: "string"}},
+			}
+
+			Expect(formatOneofCases(d.Fields[0], d)).To(Equal(`	switch v := src.Method.(type) {
+	case *pb.Payment_Card:
+		s.Method = PbToCardPaymentPtr(v.Card, opts...)
+	case *pb.Payment_Coupon:
+		s.Method = model.Coupon(v.Coupon)
+	}
+`))
+			Expect(formatOneofCases(d.Fields[1], d)).To(BeEmpty())
+
+			Expect(formatOneofCases(d.Fields[0], d.reverse())).To(Equal(`	switch v := src.Method.(type) {
+	case *model.CardPayment:
+		s.Method = &pb.Payment_Card{Card: CardPaymentToPbPtr(v, opts...)}
+	case model.Coupon:
+		s.Method = &pb.Payment_Coupon{Coupon: string(v)}
+	}
+`))
+		})
+
+		It("renders type switches of cases transformed into wrappers of sum type", func() {
+			d := d
+			d.Fields = []Field{
+				{Name: "Target", ProtoOrigName: "card", Case: &OneofCase{Decl: "Target", Field: "Card", Variant: "PayoutTargetCard", Wrapped: true, Func: "CardPayment"}},
+				{Name: "Target", ProtoOrigName: "account", Case: &OneofCase{Decl: "Target", Field: "Account", Variant: "PayoutTargetAccount", Wrapped: true, ProtoType: "string"}},
+			}
+
+			Expect(formatOneofCases(d.Fields[0], d)).To(Equal(`	switch v := src.Target.(type) {
+	case *pb.Payment_Card:
+		s.Target = model.PayoutTargetCard{Card: PbToCardPaymentPtr(v.Card, opts...)}
+	case *pb.Payment_Account:
+		s.Target = model.PayoutTargetAccount{Account: v.Account}
+	}
+`))
+
+			Expect(formatOneofCases(d.Fields[0], d.reverse())).To(Equal(`	switch v := src.Target.(type) {
+	case model.PayoutTargetCard:
+		s.Target = &pb.Payment_Card{Card: CardPaymentToPbPtr(v.Card, opts...)}
+	case model.PayoutTargetAccount:
+		s.Target = &pb.Payment_Account{Account: v.Account}
+	}
+`))
+		})
+
+		It("renders switches of cases transformed into model fields", func() {
+			d := d
+			d.Fields = []Field{
+				{Name: "Email", ProtoName: "Email", ProtoOrigName: "email", Case: &OneofCase{Decl: "Receipt", Field: "Email", Cond: `src.Email != ""`}},
+				{Name: "Phone", ProtoName: "Phone", ProtoOrigName: "phone", Case: &OneofCase{Decl: "Receipt", Field: "Phone", Cond: `src.Phone != ""`}},
+			}
+
+			Expect(formatOneofCases(d.Fields[0], d)).To(Equal(`	switch src.Receipt.(type) {
+	case *pb.Payment_Email:
+		s.Email = src.GetEmail()
+	case *pb.Payment_Phone:
+		s.Phone = src.GetPhone()
+	}
+`))
+
+			Expect(formatOneofCases(d.Fields[0], d.reverse())).To(Equal(`	switch {
+	case src.Email != "":
+		s.Receipt = &pb.Payment_Email{Email: src.Email}
+	case src.Phone != "":
+		s.Receipt = &pb.Payment_Phone{Phone: src.Phone}
+	}
+`))
+		})
+	})
+
+	Describe("nameFields", func() {
+
+		It("maps model field of oneof cases into the first case in reverse maps", func() {
+			fields := []Field{
+				{Name: "Method", ProtoOrigName: "card", Case: &OneofCase{Variant: "CardPayment"}},
+				{Name: "Method", ProtoOrigName: "coupon", Case: &OneofCase{Variant: "Coupon"}},
+			}
+
+			Expect(nameFields(fields, false)).To(HaveLen(2))
+			Expect(nameFields(fields, true)).To(Equal(fields[:1]))
+		})
+	})
+})
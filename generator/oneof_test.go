@@ -25,14 +25,14 @@ var _ = Describe("Oneof", func() {
 
 		Entry("Empty field list", []*Data{
 			{
-				SrcPref:       "src_pref",
-				Src:           "src",
-				SrcFn:         "src_fn",
-				SrcPointer:    "src_pointer",
-				DstPref:       "dst_pref",
-				Dst:           "dst",
-				DstFn:         "dst_fn",
-				DstPointer:    "dst_pointer",
+				SrcPref:       "dst_pref",
+				Src:           "dst",
+				SrcFn:         "dst_fn",
+				SrcPointer:    "dst_pointer",
+				DstPref:       "src_pref",
+				Dst:           "src",
+				DstFn:         "src_fn",
+				DstPointer:    "src_pointer",
 				Swapped:       false,
 				HelperPackage: "hp",
 				Ptr:           false,
@@ -42,14 +42,14 @@ var _ = Describe("Oneof", func() {
 
 		Entry("Single field", []*Data{
 			&Data{
-				SrcPref:       "src_pref",
-				Src:           "src",
-				SrcFn:         "src_fn",
-				SrcPointer:    "src_pointer",
-				DstPref:       "dst_pref",
-				Dst:           "dst",
-				DstFn:         "dst_fn",
-				DstPointer:    "dst_pointer",
+				SrcPref:       "dst_pref",
+				Src:           "dst",
+				SrcFn:         "dst_fn",
+				SrcPointer:    "dst_pointer",
+				DstPref:       "src_pref",
+				Dst:           "src",
+				DstFn:         "src_fn",
+				DstPointer:    "src_pointer",
 				Swapped:       false,
 				HelperPackage: "hp",
 				Ptr:           false,
@@ -66,14 +66,14 @@ var _ = Describe("Oneof", func() {
 
 		Entry("Two fields, 2nd is oneof", []*Data{
 			&Data{
-				SrcPref:       "src_pref",
-				Src:           "src",
-				SrcFn:         "src_fn",
-				SrcPointer:    "src_pointer",
-				DstPref:       "dst_pref",
-				Dst:           "dst",
-				DstFn:         "dst_fn",
-				DstPointer:    "dst_pointer",
+				SrcPref:       "dst_pref",
+				Src:           "dst",
+				SrcFn:         "dst_fn",
+				SrcPointer:    "dst_pointer",
+				DstPref:       "src_pref",
+				Dst:           "src",
+				DstFn:         "src_fn",
+				DstPointer:    "src_pointer",
 				Swapped:       false,
 				HelperPackage: "hp",
 				Ptr:           false,
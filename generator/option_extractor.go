@@ -2,15 +2,18 @@ package generator
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
-	"github.com/gogo/protobuf/gogoproto"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
-// extractStructNameOption returns transformer.go_struct option value.
-func extractStructNameOption(msg *descriptor.DescriptorProto) (string, error) {
+// extractStructNameOption returns transformer.go_struct option value, the
+// primary model if option holds a list of models, see expandTargets.
+func extractStructNameOption(msg *descriptorpb.DescriptorProto) (string, error) {
 	if msg == nil {
 		return "", newLoggableError("message is nil")
 	}
@@ -19,46 +22,37 @@ func extractStructNameOption(msg *descriptor.DescriptorProto) (string, error) {
 		return "", newLoggableError("message %q has no option %q, skipped...", *msg.Name, options.E_GoStruct.Name)
 	}
 
-	ext, err := proto.GetExtension(msg.Options, options.E_GoStruct)
-	if err != nil {
-		return "", err
-	}
-
-	option, ok := ext.(*string)
+	option, ok := proto.GetExtension(msg.Options, options.E_GoStruct).(string)
 	if !ok {
-		return "", fmt.Errorf("extension is %T; want an *string", ext)
+		return "", fmt.Errorf("extension is %T; want a string", option)
 	}
 
-	return *option, nil
+	return splitTargets(option)[0], nil
 }
 
 // getStringOption return any option of string type for proto.Message. If
 // option exists but has different type, function returns an error.
-func getStringOption(m proto.Message, opt *proto.ExtensionDesc) (string, error) {
+func getStringOption(m proto.Message, opt protoreflect.ExtensionType) (string, error) {
 	if m == nil {
 		return "", ErrNilOptions
 	}
 
 	if !proto.HasExtension(m, opt) {
-		return "", newErrOptionNotExists(opt.Name)
-	}
-
-	ext, err := proto.GetExtension(m, opt)
-	if err != nil {
-		return "", err
+		return "", newErrOptionNotExists(optionName(opt))
 	}
 
-	option, ok := ext.(*string)
+	ext := proto.GetExtension(m, opt)
+	option, ok := ext.(string)
 	if !ok {
-		return "", fmt.Errorf("extension is %T; want an *string", ext)
+		return "", fmt.Errorf("extension is %T; want a string", ext)
 	}
 
-	return *option, nil
+	return option, nil
 }
 
 // getBoolOption return any option of bool type for proto.Message. If
 // option exists but has different type, function returns false.
-func getBoolOption(m proto.Message, opt *proto.ExtensionDesc) bool {
+func getBoolOption(m proto.Message, opt protoreflect.ExtensionType) bool {
 	if m == nil {
 		return false
 	}
@@ -67,17 +61,14 @@ func getBoolOption(m proto.Message, opt *proto.ExtensionDesc) bool {
 		return false
 	}
 
-	ext, err := proto.GetExtension(m, opt)
-	if err != nil {
-		return false
-	}
+	option, _ := proto.GetExtension(m, opt).(bool)
 
-	option, ok := ext.(*bool)
-	if !ok {
-		return false
-	}
+	return option
+}
 
-	return *option
+// optionName returns full name of option opt, e.g. transformer.go_struct.
+func optionName(opt protoreflect.ExtensionType) string {
+	return string(opt.TypeDescriptor().FullName())
 }
 
 // extractEmbedOption returns true if proto.Message has an option
@@ -86,6 +77,160 @@ func extractEmbedOption(m proto.Message) bool {
 	return getBoolOption(m, options.E_Embed)
 }
 
+// extractEmbeddedOption returns true if proto.Message has an option
+// transformer.embedded which equals to true.
+func extractEmbeddedOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_Embedded)
+}
+
+// extractPatchOption returns true if message options have an option
+// transformer.go_patch which equals to true.
+func extractPatchOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_GoPatch)
+}
+
+// extractWithErrorsOption returns true if message options have an option
+// transformer.with_errors which equals to true.
+func extractWithErrorsOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_WithErrors)
+}
+
+// extractWithContextOption returns true if message options have an option
+// transformer.with_context which equals to true.
+func extractWithContextOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_WithContext)
+}
+
+// extractFlattenEmbeddedOption returns true if message options have an option
+// transformer.flatten_embedded which equals to true.
+func extractFlattenEmbeddedOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_FlattenEmbedded)
+}
+
+// extractMaskedOption returns true if message options have an option
+// transformer.go_masked which equals to true.
+func extractMaskedOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_GoMasked)
+}
+
+// extractMergeOption returns value of transformer.go_merge option of message
+// options m, empty string if there is no such option.
+func extractMergeOption(m proto.Message) string {
+	target, _ := getStringOption(m, options.E_GoMerge)
+	return target
+}
+
+// extractBuilderOption returns true if message options have an option
+// transformer.go_builder which equals to true.
+func extractBuilderOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_GoBuilder)
+}
+
+// extractIntoOption returns true if message options have an option
+// transformer.go_into which equals to true.
+func extractIntoOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_GoInto)
+}
+
+// extractPoolOption returns true if message options have an option
+// transformer.go_pool which equals to true.
+func extractPoolOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_GoPool)
+}
+
+// extractJSONOption returns true if message options have an option
+// transformer.go_json which equals to true.
+func extractJSONOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_GoJson)
+}
+
+// extractUnwrapListOption returns true if field options have an option
+// transformer.unwrap_list which equals to true.
+func extractUnwrapListOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_UnwrapList)
+}
+
+// extractOrderedMapOption returns true if field options have an option
+// transformer.ordered_map which equals to true.
+func extractOrderedMapOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_OrderedMap)
+}
+
+// extractSensitiveOption returns true if field options have an option
+// transformer.sensitive which equals to true.
+func extractSensitiveOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_Sensitive)
+}
+
+// extractUseStdTimeOption returns true if field options have an option
+// transformer.use_std_time which equals to true.
+func extractUseStdTimeOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_UseStdTime)
+}
+
+// extractStructAsJSONOption returns true if field options have an option
+// transformer.struct_as_json which equals to true.
+func extractStructAsJSONOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_StructAsJson)
+}
+
+// extractEnumMappingOption returns pairs of transformer.enum_mapping option
+// of field gname, nil if option is not set.
+func extractEnumMappingOption(m proto.Message, gname string) ([]EnumValue, error) {
+	v, err := getStringOption(m, options.E_EnumMapping)
+	if err != nil || v == "" {
+		return nil, nil
+	}
+
+	return parseEnumMapping(gname, v)
+}
+
+// extractModelPointerOption returns value of transformer.model_pointer
+// option, DETECT_POINTER if option is not set.
+func extractModelPointerOption(m proto.Message) options.ModelPointer {
+	if v, ok := getExtension(m, options.E_ModelPointer).(options.ModelPointer); ok {
+		return v
+	}
+
+	return options.ModelPointer_DETECT_POINTER
+}
+
+// extractDirectionOption returns value of transformer.direction option, BOTH
+// if option is not set.
+func extractDirectionOption(m proto.Message) options.Direction {
+	if v, ok := getExtension(m, options.E_Direction).(options.Direction); ok {
+		return v
+	}
+
+	return options.Direction_BOTH
+}
+
+// extractSkipDirectionOption returns value of transformer.skip_direction
+// option, BOTH if option is not set.
+func extractSkipDirectionOption(m proto.Message) options.Direction {
+	if v, ok := getExtension(m, options.E_SkipDirection).(options.Direction); ok {
+		return v
+	}
+
+	return options.Direction_BOTH
+}
+
+// extractDurationAsOption returns value of transformer.duration_as option,
+// DURATION_AS_MODEL_TYPE if option is not set.
+func extractDurationAsOption(m proto.Message) options.DurationAs {
+	if v, ok := getExtension(m, options.E_DurationAs).(options.DurationAs); ok {
+		return v
+	}
+
+	return options.DurationAs_DURATION_AS_MODEL_TYPE
+}
+
+// extractClientAdapterOption returns true if service options have an option
+// transformer.go_client_adapter which equals to true.
+func extractClientAdapterOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_GoClientAdapter)
+}
+
 // extractSkipOption return value of transformer.skip option or false if
 // option does not exist.
 func extractSkipOption(m proto.Message) bool {
@@ -94,6 +239,68 @@ func extractSkipOption(m proto.Message) bool {
 
 // extractNullOption returns true if Field has a gogoproto.nullable option which
 // equals to true.
-func extractNullOption(f *descriptor.FieldDescriptorProto) bool {
+func extractNullOption(f *descriptorpb.FieldDescriptorProto) bool {
 	return gogoproto.IsNullable(f)
 }
+
+// extractStdTimeOption returns true if Timestamp field f is generated as
+// time.Time with gogoproto.stdtime option.
+func extractStdTimeOption(f *descriptorpb.FieldDescriptorProto) bool {
+	return gogoproto.IsStdTime(f)
+}
+
+// extractGoTypeOption returns Go type of proto field from gogoproto.customtype
+// or gogoproto.casttype option, such as "uuid.UUID" for
+// "github.com/google/uuid.UUID". Custom flag is true for customtype option.
+// Empty string is returned if field has no such options.
+func extractGoTypeOption(f *descriptorpb.FieldDescriptorProto) (string, bool) {
+	typ, custom := gogoproto.GetCastType(f), false
+	if gogoproto.IsCustomType(f) {
+		typ, custom = gogoproto.GetCustomType(f), true
+	}
+
+	if i := strings.LastIndex(typ, "/"); i >= 0 {
+		typ = typ[i+1:]
+	}
+
+	return typ, custom
+}
+
+// extractEmptySliceOnNilOption returns value of transformer.empty_slice_on_nil
+// option of message options msg. If message has no option, value of
+// transformer.empty_slice_on_nil_all option of file options file is returned.
+func extractEmptySliceOnNilOption(file, msg proto.Message) bool {
+	if hasOption(msg, options.E_EmptySliceOnNil) {
+		return getBoolOption(msg, options.E_EmptySliceOnNil)
+	}
+
+	return getBoolOption(file, options.E_EmptySliceOnNilAll)
+}
+
+// extractDecimalOptions returns values of transformer.decimal_scale option
+// and method of decimal.Decimal which rounds values by
+// transformer.decimal_rounding option of field options m, e.g. 2 and
+// RoundBank.
+func extractDecimalOptions(m proto.Message) (int32, string) {
+	var scale int32
+	if v, ok := getExtension(m, options.E_DecimalScale).(int32); ok {
+		scale = v
+	}
+
+	r := options.Rounding_HALF_UP
+	if v, ok := getExtension(m, options.E_DecimalRounding).(options.Rounding); ok {
+		r = v
+	}
+
+	return scale, roundingMethods[r]
+}
+
+// roundingMethods are methods of decimal.Decimal by rounding modes of
+// transformer.decimal_rounding option.
+var roundingMethods = map[options.Rounding]string{
+	options.Rounding_HALF_UP:   "Round",
+	options.Rounding_HALF_EVEN: "RoundBank",
+	options.Rounding_DOWN:      "Truncate",
+	options.Rounding_CEILING:   "RoundCeil",
+	options.Rounding_FLOOR:     "RoundFloor",
+}
@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// proto3Optional returns true if field fdp is declared with optional label in
+// proto3 file. Such fields are members of synthetic oneofs and are generated as
+// pointers by protoc-gen-go.
+func proto3Optional(fdp *descriptorpb.FieldDescriptorProto) bool {
+	return fdp.GetProto3Optional()
+}
+
+// realOneofs returns oneof declarations of message msg without synthetic
+// oneofs of proto3 optional fields.
+func realOneofs(msg *descriptorpb.DescriptorProto) []*descriptorpb.OneofDescriptorProto {
+	synthetic := map[int32]struct{}{}
+	for _, fdp := range msg.GetField() {
+		if fdp.OneofIndex != nil && proto3Optional(fdp) {
+			synthetic[fdp.GetOneofIndex()] = struct{}{}
+		}
+	}
+
+	decls := []*descriptorpb.OneofDescriptorProto{}
+	for i, d := range msg.GetOneofDecl() {
+		if _, ok := synthetic[int32(i)]; !ok {
+			decls = append(decls, d)
+		}
+	}
+
+	return decls
+}
+
+// processOptionalField returns *Field created out of proto3 optional field
+// of scalar type t. Proto field is a pointer, it's transformed into pointer or
+// value model field of the same type, nil pointer becomes zero value. Model
+// fields of other types are not supported.
+func processOptionalField(pname, gname string, t typeRel, gf source.FieldInfo) (*Field, error) {
+	vt := t.pbType
+	if vt == "" {
+		vt = t.goType
+	}
+
+	if gf.Type != vt || gf.IsSlice || gf.Key != "" {
+		return nil, newLoggableError("field %s: optional field of type %s can not be transformed into %s", gname, vt, gf).
+			withHint("change type of model field %s to *%s or %s", gname, vt, vt)
+	}
+
+	return &Field{
+		Name:           gname,
+		ProtoName:      pname,
+		ProtoIsPointer: true,
+		GoIsPointer:    gf.IsPointer,
+		Wrapper: &Elem{
+			Kind:           elemOptional,
+			ProtoType:      vt,
+			GoType:         vt,
+			ProtoIsPointer: true,
+			GoIsPointer:    gf.IsPointer,
+		},
+	}, nil
+}
@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// optionalField returns proto3 optional field of type typ, which is the only
+// member of the first oneof of message.
+func optionalField(typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:           sp("nickname"),
+		Type:           &typ,
+		OneofIndex:     proto.Int32(0),
+		Options:        &descriptorpb.FieldOptions{},
+		Proto3Optional: proto.Bool(true),
+	}
+}
+
+var _ = Describe("Proto3 optional", func() {
+
+	Describe("proto3Optional", func() {
+
+		It("detects flag of optional field", func() {
+			Expect(proto3Optional(optionalField(typString))).To(BeTrue())
+		})
+
+		It("returns false for regular fields", func() {
+			Expect(proto3Optional(&descriptorpb.FieldDescriptorProto{Name: sp("name"), Type: &typString})).To(BeFalse())
+			Expect(proto3Optional(nil)).To(BeFalse())
+		})
+	})
+
+	It("drops synthetic oneofs", func() {
+		msg := &descriptorpb.DescriptorProto{
+			Field: []*descriptorpb.FieldDescriptorProto{
+				optionalField(typString),
+				{Name: sp("int64_value"), Type: &typInt64, OneofIndex: proto.Int32(1)},
+			},
+			OneofDecl: []*descriptorpb.OneofDescriptorProto{
+				{Name: sp("_nickname")},
+				{Name: sp("value")},
+			},
+		}
+
+		Expect(realOneofs(msg)).To(Equal([]*descriptorpb.OneofDescriptorProto{{Name: sp("value")}}))
+	})
+
+	DescribeTable("processOptionalField",
+		func(gf source.FieldInfo, expected *Elem) {
+			f, err := processOptionalField("Nickname", "Nickname", types[typString], gf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.ProtoIsPointer).To(BeTrue())
+			Expect(f.Wrapper).To(Equal(expected))
+		},
+
+		Entry("Pointer model field", source.FieldInfo{Type: "string", IsPointer: true},
+			&Elem{Kind: elemOptional, ProtoType: "string", GoType: "string", ProtoIsPointer: true, GoIsPointer: true}),
+		Entry("Value model field", source.FieldInfo{Type: "string"},
+			&Elem{Kind: elemOptional, ProtoType: "string", GoType: "string", ProtoIsPointer: true}),
+	)
+
+	It("returns error for model field of another type", func() {
+		_, err := processOptionalField("Nickname", "Nickname", types[typInt64], source.FieldInfo{Type: "string", IsPointer: true})
+		Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		Expect(err.Error()).To(ContainSubstring("field Nickname: optional field of type int64 can not be transformed into"))
+	})
+
+	It("processes optional field without oneof machinery", func() {
+		s := source.Structure{"Nickname": source.FieldInfo{Type: "string", IsPointer: true}}
+
+		f, err := processField(nil, optionalField(typString), MessageOptionList{}, s, policies{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.OneofDecl).To(BeEmpty())
+		Expect(f.Wrapper.Kind).To(Equal(elemOptional))
+	})
+})
@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// packageOptions contains file options which are inherited from file with
+// transformer.package_defaults option by other files of the same package.
+var packageOptions = []protoreflect.ExtensionType{
+	options.E_GoModelsFilePath,
+	options.E_GoModelsDir,
+	options.E_GoModelsImportPath,
+	options.E_GoRepoPackage,
+	options.E_GoProtobufPackage,
+	options.E_GoWrappersPackage,
+	options.E_GoStatusDetails,
+	options.E_GoHelperPackages,
+	options.E_TimestampsAs,
+	options.E_WrappersAs,
+	options.E_EnumsAs,
+	options.E_ModelTimestamps,
+	options.E_GoTransformerPackage,
+}
+
+// extractPackageDefaultsOption returns true if file options m contain
+// transformer.package_defaults = true.
+func extractPackageDefaultsOption(m proto.Message) bool {
+	return getBoolOption(m, options.E_PackageDefaults)
+}
+
+// InheritPackageOptions copies file options of files with
+// transformer.package_defaults option into other files of the same proto
+// package, options which are set in file itself are not changed. It returns an
+// error if package has more than one file with defaults.
+func InheritPackageOptions(req *pluginpb.CodeGeneratorRequest) error {
+	defaults := map[string]*descriptorpb.FileDescriptorProto{}
+
+	for _, f := range req.ProtoFile {
+		if !extractPackageDefaultsOption(f.Options) {
+			continue
+		}
+
+		if prev, ok := defaults[f.GetPackage()]; ok {
+			return fmt.Errorf("package %s: files %s and %s both have option (%s)",
+				f.GetPackage(), prev.GetName(), f.GetName(), options.E_PackageDefaults.Name)
+		}
+		defaults[f.GetPackage()] = f
+	}
+
+	for _, f := range req.ProtoFile {
+		d, ok := defaults[f.GetPackage()]
+		if !ok || d == f {
+			continue
+		}
+
+		if f.Options == nil {
+			f.Options = &descriptorpb.FileOptions{}
+		}
+
+		for _, opt := range packageOptions {
+			if hasOption(f.Options, opt) || !hasOption(d.Options, opt) {
+				continue
+			}
+
+			proto.SetExtension(f.Options, opt, proto.GetExtension(d.Options, opt))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var _ = Describe("Package", func() {
+
+	// file returns proto file of package pkg with options opts.
+	file := func(name, pkg string, opts map[protoreflect.ExtensionType]interface{}) *descriptorpb.FileDescriptorProto {
+		f := &descriptorpb.FileDescriptorProto{Name: sp(name), Package: sp(pkg)}
+		if opts != nil {
+			f.Options = &descriptorpb.FileOptions{}
+			for ext, v := range opts {
+				proto.SetExtension(f.Options, ext, v)
+			}
+		}
+		return f
+	}
+
+	Describe("InheritPackageOptions", func() {
+
+		It("copies options from package defaults", func() {
+			defaults := file("svc/defaults.proto", "svc", map[protoreflect.ExtensionType]interface{}{
+				options.E_PackageDefaults:   true,
+				options.E_GoRepoPackage:     "model",
+				options.E_GoProtobufPackage: "pb",
+			})
+			plain := file("svc/order.proto", "svc", nil)
+			override := file("svc/product.proto", "svc", map[protoreflect.ExtensionType]interface{}{
+				options.E_GoRepoPackage: "catalog",
+			})
+			other := file("billing/invoice.proto", "billing", nil)
+
+			req := &pluginpb.CodeGeneratorRequest{ProtoFile: []*descriptorpb.FileDescriptorProto{plain, defaults, override, other}}
+			Expect(InheritPackageOptions(req)).To(Succeed())
+
+			Expect(getStringOption(plain.Options, options.E_GoRepoPackage)).To(Equal("model"))
+			Expect(getStringOption(plain.Options, options.E_GoProtobufPackage)).To(Equal("pb"))
+			Expect(extractPackageDefaultsOption(plain.Options)).To(BeFalse())
+
+			Expect(getStringOption(override.Options, options.E_GoRepoPackage)).To(Equal("catalog"))
+			Expect(getStringOption(override.Options, options.E_GoProtobufPackage)).To(Equal("pb"))
+
+			Expect(other.Options).To(BeNil())
+		})
+
+		It("returns an error for several defaults of the same package", func() {
+			opts := map[protoreflect.ExtensionType]interface{}{options.E_PackageDefaults: true}
+			req := &pluginpb.CodeGeneratorRequest{ProtoFile: []*descriptorpb.FileDescriptorProto{
+				file("svc/a.proto", "svc", opts),
+				file("svc/b.proto", "svc", opts),
+			}}
+
+			Expect(InheritPackageOptions(req)).To(MatchError("package svc: files svc/a.proto and svc/b.proto both have option (transformer.package_defaults)"))
+		})
+	})
+})
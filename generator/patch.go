@@ -0,0 +1,213 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// patchField is a field of patch structure generated for messages with
+// transformer.go_patch option.
+type patchField struct {
+	// Model field name.
+	Name string
+	// Patch field type, it's always a nillable type.
+	Type string
+	// Condition of proto field presence, empty if field is always present.
+	Cond string
+	// If true, patch field is a pointer to model field.
+	Ref bool
+	// Condition which is true if patch field p changes model field dst, see
+	// changeCond.
+	Changed string
+}
+
+// patchFields returns fields of patch structure for message msg. Fields of
+// oneofs, fields transformed by Converter dependencies and fields which don't
+// exist in model structure s are omitted. Model
+// types without package are prefixed by modelPackage.
+func patchFields(
+	fields []Field,
+	msg *descriptorpb.DescriptorProto,
+	messages MessageOptionList,
+	s source.Structure,
+	modelPackage string,
+) []patchField {
+
+	pf := []patchField{}
+
+	for _, f := range fields {
+		fdp := fieldByName(msg, f.ProtoOrigName)
+		if fdp == nil || f.OneofDecl != "" || f.Case != nil || f.Dep != nil {
+			continue
+		}
+
+		src := "src." + f.ProtoName
+
+		if len(f.EmbeddedFields) == 0 {
+			if gf, ok := s[f.Name]; ok {
+				pf = append(pf, newPatchField(f.Name, presenceCond(src, fdp), gf, modelPackage))
+			}
+			continue
+		}
+
+		mo, ok := messages[strings.TrimPrefix(fdp.GetTypeName(), ".")]
+		if !ok || mo.Descriptor() == nil {
+			continue
+		}
+
+		for _, ef := range f.EmbeddedFields {
+			gf, ok := s[ef.Name]
+			sfd := fieldByName(mo.Descriptor(), strings.TrimPrefix(ef.ProtoOrigName, f.ProtoOrigName+"."))
+			if !ok || sfd == nil {
+				continue
+			}
+
+			cond := presenceCond(src+"."+ef.ProtoName, sfd)
+			if f.ProtoIsPointer {
+				cond = joinConds(src+" != nil", cond)
+			}
+
+			pf = append(pf, newPatchField(ef.Name, cond, gf, modelPackage))
+		}
+	}
+
+	return pf
+}
+
+// newPatchField returns patch field for model field name of type gf. Fields
+// of nillable types, such as pointers, slices and maps, keep their types, in
+// other cases patch field is a pointer to model field.
+func newPatchField(name, cond string, gf source.FieldInfo, modelPackage string) patchField {
+	pf := patchField{
+		Name: name,
+		Type: qualifiedGoType(gf, modelPackage),
+		Cond: cond,
+		Ref:  !gf.IsPointer && !gf.IsSlice && gf.Key == "" && gf.Type != "error",
+	}
+
+	value := "p." + name
+	if pf.Ref {
+		pf.Type = "*" + pf.Type
+		value = "*" + value
+	}
+	pf.Changed = changeCond("dst."+name, value, gf)
+
+	return pf
+}
+
+// patchImports returns import specs which are used by change tracking of
+// patch fields pf.
+func patchImports(pf []patchField) []string {
+	conds := make([]string, 0, len(pf))
+	for _, f := range pf {
+		conds = append(conds, f.Changed)
+	}
+
+	return changeImports(conds...)
+}
+
+// changeCond returns Go condition which is true if value x of model field gf
+// differs from value y of the same type. Values of basic types are compared
+// with != operator, values of types with Equal method, e.g. time.Time, with
+// the method, since equal times may have different locations, other values
+// with reflect.DeepEqual.
+func changeCond(x, y string, gf source.FieldInfo) string {
+	plain := !gf.IsPointer && !gf.IsSlice && gf.Key == ""
+
+	if _, basic := basicTypes[gf.Type]; basic && plain {
+		return x + " != " + y
+	}
+
+	if gf.Equal && plain {
+		return "!" + x + ".Equal(" + y + ")"
+	}
+
+	return "!reflect.DeepEqual(" + x + ", " + y + ")"
+}
+
+// changeImports returns import specs which are used by change conditions
+// conds, see changeCond.
+func changeImports(conds ...string) []string {
+	for _, c := range conds {
+		if strings.Contains(c, "reflect.DeepEqual(") {
+			return []string{`"reflect"`}
+		}
+	}
+
+	return nil
+}
+
+// qualifiedGoType returns full Go type of model field gf, types declared in
+// model package are prefixed by modelPackage.
+func qualifiedGoType(gf source.FieldInfo, modelPackage string) string {
+	if _, basic := basicTypes[gf.Type]; !basic && !strings.Contains(gf.Type, ".") && gf.Type != "error" {
+		gf.Type = modelPackage + "." + gf.Type
+	}
+
+	return gf.GoType()
+}
+
+// presenceCond returns Go condition which is true if proto field fdp, which
+// is accessible as x, is present in message. Proto3 scalar fields have no
+// presence information, they are considered present if they have non-zero
+// values.
+func presenceCond(x string, fdp *descriptorpb.FieldDescriptorProto) string {
+	if fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return "len(" + x + ") > 0"
+	}
+
+	// proto3 optional scalars are pointers.
+	if proto3Optional(fdp) && fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_BYTES {
+		return x + " != nil"
+	}
+
+	// values of custom types can be compared with nil only.
+	if typ, custom := extractGoTypeOption(fdp); typ != "" && custom {
+		if !extractNullOption(fdp) {
+			return ""
+		}
+		return x + " != nil"
+	}
+
+	switch fdp.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+		if !extractNullOption(fdp) {
+			return ""
+		}
+		return x + " != nil"
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return "len(" + x + ") > 0"
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		return x + ` != ""`
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return x
+	}
+
+	return x + " != 0"
+}
+
+// joinConds joins non-empty conditions with && operator.
+func joinConds(conds ...string) string {
+	nonEmpty := []string{}
+	for _, c := range conds {
+		if c != "" {
+			nonEmpty = append(nonEmpty, c)
+		}
+	}
+
+	return strings.Join(nonEmpty, " && ")
+}
+
+// fieldByName returns field of message msg with proto name, nil if message has
+// no such field.
+func fieldByName(msg *descriptorpb.DescriptorProto, name string) *descriptorpb.FieldDescriptorProto {
+	for _, f := range msg.GetField() {
+		if f.GetName() == name {
+			return f
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Patch", func() {
+
+	var (
+		typBool  = descriptorpb.FieldDescriptorProto_TYPE_BOOL
+		typBytes = descriptorpb.FieldDescriptorProto_TYPE_BYTES
+	)
+
+	notNullable := func() *descriptorpb.FieldOptions {
+		o := &descriptorpb.FieldOptions{}
+		proto.SetExtension(o, gogoproto.E_Nullable, false)
+		return o
+	}
+
+	DescribeTable("presenceCond",
+		func(fdp *descriptorpb.FieldDescriptorProto, expected string) {
+			Expect(presenceCond("src.F", fdp)).To(Equal(expected))
+		},
+
+		Entry("string", &descriptorpb.FieldDescriptorProto{Type: &typString}, `src.F != ""`),
+		Entry("int64", &descriptorpb.FieldDescriptorProto{Type: &typInt64}, "src.F != 0"),
+		Entry("bool", &descriptorpb.FieldDescriptorProto{Type: &typBool}, "src.F"),
+		Entry("bytes", &descriptorpb.FieldDescriptorProto{Type: &typBytes}, "len(src.F) > 0"),
+		Entry("repeated", &descriptorpb.FieldDescriptorProto{Type: &typInt64, Label: &typRepeated}, "len(src.F) > 0"),
+		Entry("message", &descriptorpb.FieldDescriptorProto{Type: &typMessage}, "src.F != nil"),
+		Entry("non-nullable message", &descriptorpb.FieldDescriptorProto{Type: &typMessage, Options: notNullable()}, ""),
+		Entry("proto3 optional string", optionalField(typString), "src.F != nil"),
+		Entry("proto3 optional bytes", optionalField(typBytes), "len(src.F) > 0"),
+	)
+
+	DescribeTable("newPatchField",
+		func(gf source.FieldInfo, expected patchField) {
+			Expect(newPatchField("F", "cond", gf, "model")).To(Equal(expected))
+		},
+
+		Entry("value", source.FieldInfo{Type: "int64"},
+			patchField{Name: "F", Type: "*int64", Cond: "cond", Ref: true, Changed: "dst.F != *p.F"}),
+		Entry("pointer", source.FieldInfo{Type: "string", IsPointer: true},
+			patchField{Name: "F", Type: "*string", Cond: "cond", Changed: "!reflect.DeepEqual(dst.F, p.F)"}),
+		Entry("model type", source.FieldInfo{Type: "Address"},
+			patchField{Name: "F", Type: "*model.Address", Cond: "cond", Ref: true, Changed: "!reflect.DeepEqual(dst.F, *p.F)"}),
+		Entry("type with Equal method", source.FieldInfo{Type: "time.Time", Equal: true},
+			patchField{Name: "F", Type: "*time.Time", Cond: "cond", Ref: true, Changed: "!dst.F.Equal(*p.F)"}),
+		Entry("slice", source.FieldInfo{Type: "Address", IsPointer: true, IsSlice: true},
+			patchField{Name: "F", Type: "[]*model.Address", Cond: "cond", Changed: "!reflect.DeepEqual(dst.F, p.F)"}),
+		Entry("map", source.FieldInfo{Type: "time.Time", Key: "string"},
+			patchField{Name: "F", Type: "map[string]time.Time", Cond: "cond", Changed: "!reflect.DeepEqual(dst.F, p.F)"}),
+	)
+
+	DescribeTable("changeCond",
+		func(gf source.FieldInfo, expected string) {
+			Expect(changeCond("dst.F", "v", gf)).To(Equal(expected))
+		},
+
+		Entry("basic type", source.FieldInfo{Type: "string"}, "dst.F != v"),
+		Entry("type with Equal method", source.FieldInfo{Type: "time.Time", Equal: true}, "!dst.F.Equal(v)"),
+		Entry("pointer to type with Equal method", source.FieldInfo{Type: "time.Time", IsPointer: true, Equal: true}, "!reflect.DeepEqual(dst.F, v)"),
+		Entry("structure", source.FieldInfo{Type: "Address"}, "!reflect.DeepEqual(dst.F, v)"),
+		Entry("slice of basic type", source.FieldInfo{Type: "string", IsSlice: true}, "!reflect.DeepEqual(dst.F, v)"),
+	)
+
+	Describe("patchFields", func() {
+
+		It("returns fields present in model", func() {
+			msg := &descriptorpb.DescriptorProto{
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("id"), Type: &typInt64},
+					{Name: sp("location"), Type: &typMessage, TypeName: sp(".pkg.Location")},
+					{Name: sp("unknown"), Type: &typString},
+				},
+			}
+			messages := MessageOptionList{
+				"pkg.Location": messageOption{desc: &descriptorpb.DescriptorProto{
+					Field: []*descriptorpb.FieldDescriptorProto{{Name: sp("city"), Type: &typString}},
+				}},
+			}
+			fields := []Field{
+				{Name: "ID", ProtoName: "Id", ProtoOrigName: "id"},
+				{ProtoName: "Location", ProtoOrigName: "location", ProtoIsPointer: true, EmbeddedFields: []Field{
+					{Name: "LocationCity", ProtoName: "City", ProtoOrigName: "location.city"},
+				}},
+				{Name: "Unknown", ProtoName: "Unknown", ProtoOrigName: "unknown"},
+			}
+			s := source.Structure{
+				"ID":           {Type: "int64"},
+				"LocationCity": {Type: "string"},
+			}
+
+			Expect(patchFields(fields, msg, messages, s, "model")).To(Equal([]patchField{
+				{Name: "ID", Type: "*int64", Cond: "src.Id != 0", Ref: true, Changed: "dst.ID != *p.ID"},
+				{Name: "LocationCity", Type: "*string", Cond: `src.Location != nil && src.Location.City != ""`, Ref: true, Changed: "dst.LocationCity != *p.LocationCity"},
+			}))
+		})
+	})
+
+	Describe("patchImports", func() {
+
+		It("returns reflect package if fields are not comparable", func() {
+			Expect(patchImports([]patchField{{Changed: "dst.F != *p.F"}, {Changed: "!reflect.DeepEqual(dst.G, p.G)"}})).To(Equal([]string{`"reflect"`}))
+		})
+
+		It("returns nothing for comparable fields", func() {
+			Expect(patchImports([]patchField{{Changed: "dst.F != *p.F"}, {Changed: "!dst.T.Equal(*p.T)"}})).To(BeEmpty())
+		})
+	})
+})
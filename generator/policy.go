@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// policies contains file-level defaults of field transformations, see
+// transformer.timestamps_as, transformer.wrappers_as, transformer.enums_as,
+// transformer.model_timestamps and transformer.model_style options.
+type policies struct {
+	timestamps      options.TimestampsAs
+	wrappers        options.WrappersAs
+	enums           options.EnumsAs
+	modelTimestamps options.ModelPointer
+	// SQLC style is detected by ProcessFile if model files are generated by
+	// sqlc.
+	modelStyle options.ModelStyle
+	// Message-level transformer.flatten_embedded option of message which
+	// fields are processed.
+	flattenEmbedded bool
+}
+
+// getExtension returns value of option opt of options m, nil if option does
+// not exist.
+func getExtension(m proto.Message, opt protoreflect.ExtensionType) interface{} {
+	if m == nil || !proto.HasExtension(m, opt) {
+		return nil
+	}
+
+	return proto.GetExtension(m, opt)
+}
+
+// extractPolicies returns transformation policies from file options m,
+// missing options have default values.
+func extractPolicies(m proto.Message) policies {
+	p := policies{}
+
+	if v, ok := getExtension(m, options.E_TimestampsAs).(options.TimestampsAs); ok {
+		p.timestamps = v
+	}
+
+	if v, ok := getExtension(m, options.E_WrappersAs).(options.WrappersAs); ok {
+		p.wrappers = v
+	}
+
+	if v, ok := getExtension(m, options.E_EnumsAs).(options.EnumsAs); ok {
+		p.enums = v
+	}
+
+	if v, ok := getExtension(m, options.E_ModelTimestamps).(options.ModelPointer); ok {
+		p.modelTimestamps = v
+	}
+
+	if v, ok := getExtension(m, options.E_ModelStyle).(options.ModelStyle); ok {
+		p.modelStyle = v
+	}
+
+	return p
+}
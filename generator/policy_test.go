@@ -0,0 +1,40 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Policy", func() {
+
+	Describe("extractPolicies", func() {
+
+		It("returns defaults for file without options", func() {
+			Expect(extractPolicies(nil)).To(Equal(policies{}))
+			Expect(extractPolicies(&descriptorpb.FileOptions{})).To(Equal(policies{}))
+		})
+
+		It("returns policies from file options", func() {
+			ts, wr, en := options.TimestampsAs_TIMESTAMP, options.WrappersAs_POINTER, options.EnumsAs_STRING
+			mt, ms := options.ModelPointer_MODEL_POINTER, options.ModelStyle_GORM
+
+			o := &descriptorpb.FileOptions{}
+			proto.SetExtension(o, options.E_TimestampsAs, ts)
+			proto.SetExtension(o, options.E_WrappersAs, wr)
+			proto.SetExtension(o, options.E_EnumsAs, en)
+			proto.SetExtension(o, options.E_ModelTimestamps, mt)
+			proto.SetExtension(o, options.E_ModelStyle, ms)
+
+			Expect(extractPolicies(o)).To(Equal(policies{
+				timestamps:      options.TimestampsAs_TIMESTAMP,
+				wrappers:        options.WrappersAs_POINTER,
+				enums:           options.EnumsAs_STRING,
+				modelTimestamps: options.ModelPointer_MODEL_POINTER,
+				modelStyle:      options.ModelStyle_GORM,
+			}))
+		})
+	})
+})
@@ -0,0 +1,147 @@
+package generator
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pool", func() {
+
+	Describe("PoolName", func() {
+
+		It("returns unexported name of pool", func() {
+			Expect(Data{SrcFn: "Pb", DstFn: "Order"}.PoolName()).To(Equal("pbToOrderPool"))
+			Expect(Data{SrcFn: "Pb", DstFn: "Order", Func: "OrderFromProto"}.PoolName()).To(Equal("orderFromProtoPool"))
+		})
+	})
+
+	Describe("pool template", func() {
+
+		execute := func(d Data) string {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "pool", d)).To(Succeed())
+
+			return w.String()
+		}
+
+		It("acquires, resets and releases models", func() {
+			out := execute(Data{
+				Src:        "Order",
+				SrcPref:    "pb",
+				SrcFn:      "Pb",
+				SrcPointer: "*",
+				Dst:        "Order",
+				DstPref:    "model",
+				DstFn:      "Order",
+			})
+
+			Expect(out).To(ContainSubstring(`var pbToOrderPool = sync.Pool{
+	New: func() interface{} {
+		return &model.Order{}
+	},
+}`))
+			Expect(out).To(ContainSubstring(`func PbToOrderAcquire() *model.Order {
+	return pbToOrderPool.Get().(*model.Order)
+}`))
+			Expect(out).To(ContainSubstring(`func PbToOrderRelease(dst *model.Order) {
+	if dst == nil {
+		return
+	}
+
+	*dst = model.Order{}
+	pbToOrderPool.Put(dst)
+}`))
+			Expect(out).To(ContainSubstring(`func PbToOrderPooled(src *pb.Order, opts ...TransformParam) *model.Order {
+	if src == nil {
+		return nil
+	}
+
+	dst := PbToOrderAcquire()
+	PbToOrderInto(src, dst, opts...)
+
+	return dst
+}`))
+		})
+
+		It("keeps reused slices and maps of released models", func() {
+			out := execute(Data{
+				Src:        "Order",
+				SrcPref:    "pb",
+				SrcFn:      "Pb",
+				SrcPointer: "*",
+				Dst:        "Order",
+				DstPref:    "model",
+				DstFn:      "Order",
+				Fields: []Field{
+					{Name: "ID", ProtoName: "Id"},
+					{Name: "Tags", ProtoName: "Tags", Elem: &Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string", ProtoIsPointer: true}},
+					{Name: "Prices", ProtoName: "Prices", Elem: &Elem{Kind: elemValue, ProtoType: "int64", GoType: "int64", MapKey: "string"}},
+				},
+			})
+
+			Expect(out).To(ContainSubstring(`func PbToOrderRelease(dst *model.Order) {
+	if dst == nil {
+		return
+	}
+	for k := range dst.Prices {
+		delete(dst.Prices, k)
+	}
+
+	*dst = model.Order{
+		Tags: dst.Tags[:0],
+		Prices: dst.Prices,
+	}
+	pbToOrderPool.Put(dst)
+}`))
+		})
+
+		It("acquires messages in swapped view", func() {
+			out := execute(Data{
+				Src:        "Order",
+				SrcPref:    "model",
+				SrcFn:      "Order",
+				Dst:        "Order",
+				DstPref:    "pb",
+				DstFn:      "Pb",
+				DstPointer: "*",
+				Swapped:    true,
+			})
+
+			Expect(out).To(ContainSubstring(`func OrderToPbAcquire() *pb.Order {`))
+			Expect(out).To(ContainSubstring(`func OrderToPbPooled(src *model.Order, opts ...TransformParam) *pb.Order {`))
+		})
+
+		It("releases models if transformer fails", func() {
+			out := execute(Data{
+				Src:         "Order",
+				SrcPref:     "pb",
+				SrcFn:       "Pb",
+				SrcPointer:  "*",
+				Dst:         "Order",
+				DstPref:     "model",
+				DstFn:       "Order",
+				WithErrors:  true,
+				WithContext: true,
+			})
+
+			Expect(out).To(ContainSubstring(`func PbToOrderPooled(ctx context.Context, src *pb.Order, opts ...TransformParam) (*model.Order, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	dst := PbToOrderAcquire()
+	if err := PbToOrderInto(ctx, src, dst, opts...); err != nil {
+		PbToOrderRelease(dst)
+		return nil, err
+	}
+
+	return dst, nil
+}`))
+		})
+	})
+})
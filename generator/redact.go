@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// redactedField is a field of proto structure which is left empty by redacted
+// model to proto transformers.
+type redactedField struct {
+	// Path to the field from proto structure, e.g. Email, or Contact.Email
+	// for field of embedded sub message Contact. Go name of oneof field for
+	// oneof cases, e.g. Secret.
+	Path string
+	// Zero value of the field, e.g. empty.Email or (&pb.Contact{}).Email.
+	Zero string
+	// Condition which is true if embedded sub messages of the path are not
+	// nil, e.g. dst.Contact != nil. Empty for fields of message itself and of
+	// value sub messages.
+	Cond string
+	// Wrapper type of oneof case, e.g. *pb.User_Ssn, oneof field is cleared
+	// only if the case is set. Empty for fields which are not oneof cases.
+	Case string
+}
+
+// redactedFields is a list of fields which are left empty by redacted
+// transformers.
+type redactedFields []redactedField
+
+// UseEmpty returns true if zero values of fields are taken from empty proto
+// structure.
+func (rf redactedFields) UseEmpty() bool {
+	for _, f := range rf {
+		if strings.HasPrefix(f.Zero, "empty.") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sensitiveFields returns fields of proto structure of message msg which have
+// transformer.sensitive option, including fields of embedded sub messages and
+// oneof cases. pbMsg is a qualified Go name of proto structure, e.g. pb.User,
+// pbPref is a proto package of sub messages.
+func sensitiveFields(fields []Field, msg *descriptorpb.DescriptorProto, subMessages MessageOptionList, pbMsg, pbPref string) (redactedFields, error) {
+	var rf redactedFields
+
+	for _, f := range fields {
+		fdp := fieldByName(msg, f.ProtoOrigName)
+		if fdp == nil {
+			continue
+		}
+
+		sensitive := extractSensitiveOption(fdp.Options)
+		switch {
+		case sensitive && f.Case != nil:
+			rf = append(rf, redactedField{
+				Path: f.Case.Decl,
+				Case: fmt.Sprintf("*%s_%s", pbMsg, f.Case.Field),
+			})
+		case sensitive:
+			rf = append(rf, redactedField{Path: f.ProtoName, Zero: "empty." + f.ProtoName})
+		case f.IsEmbedded():
+			ef, err := embeddedSensitiveFields(f, fdp, subMessages, pbPref, "", nil)
+			if err != nil {
+				return nil, err
+			}
+			rf = append(rf, ef...)
+		}
+	}
+
+	return rf, nil
+}
+
+// embeddedSensitiveFields returns sensitive fields of embedded sub message f
+// with descriptor fdp. path and conds are a path to the parent of f and nil
+// checks of its embedded pointer sub messages.
+func embeddedSensitiveFields(f Field, fdp *descriptorpb.FieldDescriptorProto, subMessages MessageOptionList, pbPref, path string, conds []string) (redactedFields, error) {
+	mo, ok := subMessages[strings.TrimPrefix(fdp.GetTypeName(), ".")]
+	if !ok || mo.Descriptor() == nil {
+		return nil, fmt.Errorf("field %s: embedded message %q not found, its (%s) fields can not be redacted", fdp.GetName(), fdp.GetTypeName(), options.E_Sensitive.Name)
+	}
+	sub := mo.Descriptor()
+
+	path += f.ProtoName
+	if f.ProtoIsPointer {
+		conds = append(conds[:len(conds):len(conds)], fmt.Sprintf("dst.%s != nil", path))
+	}
+	typ := qualified(f.ProtoType, pbPref)
+
+	var rf redactedFields
+	for _, ef := range f.EmbeddedFields {
+		// names of fields of embedded messages are prefixed with names of
+		// parent fields, e.g. contact.email.
+		name := ef.ProtoOrigName[strings.LastIndex(ef.ProtoOrigName, ".")+1:]
+		sf := fieldByName(sub, name)
+		if sf == nil {
+			continue
+		}
+
+		switch {
+		case extractSensitiveOption(sf.Options):
+			rf = append(rf, redactedField{
+				Path: path + "." + ef.ProtoName,
+				Zero: fmt.Sprintf("(&%s{}).%s", typ, ef.ProtoName),
+				Cond: strings.Join(conds, " && "),
+			})
+		case ef.IsEmbedded():
+			nested, err := embeddedSensitiveFields(ef, sf, subMessages, pbPref, path+".", conds)
+			if err != nil {
+				return nil, err
+			}
+			rf = append(rf, nested...)
+		}
+	}
+
+	return rf, nil
+}
@@ -0,0 +1,92 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Redact", func() {
+
+	Describe("sensitiveFields", func() {
+
+		sensitive := func(fdp *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+			fdp.Options = &descriptorpb.FieldOptions{}
+			proto.SetExtension(fdp.Options, options.E_Sensitive, true)
+			return fdp
+		}
+
+		contact := &descriptorpb.FieldDescriptorProto{Name: sp("contact"), Type: &typMessage, TypeName: sp(".pkg.Contact")}
+
+		msg := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{
+			{Name: sp("id"), Type: &typInt64},
+			sensitive(&descriptorpb.FieldDescriptorProto{Name: sp("email"), Type: &typString}),
+			sensitive(&descriptorpb.FieldDescriptorProto{Name: sp("ssn"), Type: &typString, OneofIndex: proto.Int32(0)}),
+			{Name: sp("passport"), Type: &typString, OneofIndex: proto.Int32(0)},
+			contact,
+		}}
+
+		subMessages := MessageOptionList{
+			"pkg.Contact": messageOption{desc: &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: sp("city"), Type: &typString},
+				sensitive(&descriptorpb.FieldDescriptorProto{Name: sp("phone"), Type: &typString}),
+			}}},
+		}
+
+		embedded := func(pointer bool) Field {
+			return Field{
+				ProtoName: "Contact", ProtoOrigName: "contact", ProtoType: "Contact", ProtoIsPointer: pointer,
+				EmbeddedFields: []Field{
+					{Name: "City", ProtoName: "City", ProtoOrigName: "contact.city"},
+					{Name: "Phone", ProtoName: "Phone", ProtoOrigName: "contact.phone"},
+				},
+			}
+		}
+
+		It("returns proto names of sensitive fields", func() {
+			fields := []Field{
+				{Name: "ID", ProtoName: "Id", ProtoOrigName: "id"},
+				{Name: "Email", ProtoName: "Email", ProtoOrigName: "email"},
+			}
+
+			Expect(sensitiveFields(fields, msg, subMessages, "pb.User", "pb")).To(Equal(redactedFields{
+				{Path: "Email", Zero: "empty.Email"},
+			}))
+		})
+
+		It("clears oneof of sensitive cases", func() {
+			fields := []Field{
+				{Name: "Ssn", ProtoName: "Ssn", ProtoOrigName: "ssn", Case: &OneofCase{Decl: "Secret", Field: "Ssn"}},
+				{Name: "Passport", ProtoName: "Passport", ProtoOrigName: "passport", Case: &OneofCase{Decl: "Secret", Field: "Passport"}},
+			}
+
+			Expect(sensitiveFields(fields, msg, subMessages, "pb.User", "pb")).To(Equal(redactedFields{
+				{Path: "Secret", Case: "*pb.User_Ssn"},
+			}))
+		})
+
+		It("returns sensitive fields of embedded sub messages", func() {
+			Expect(sensitiveFields([]Field{embedded(true)}, msg, subMessages, "pb.User", "pb")).To(Equal(redactedFields{
+				{Path: "Contact.Phone", Zero: "(&pb.Contact{}).Phone", Cond: "dst.Contact != nil"},
+			}))
+			Expect(sensitiveFields([]Field{embedded(false)}, msg, subMessages, "pb.User", "pb")).To(Equal(redactedFields{
+				{Path: "Contact.Phone", Zero: "(&pb.Contact{}).Phone"},
+			}))
+		})
+
+		It("returns error if embedded sub message is not found", func() {
+			_, err := sensitiveFields([]Field{embedded(true)}, msg, MessageOptionList{}, "pb.User", "pb")
+			Expect(err).To(MatchError(ContainSubstring("can not be redacted")))
+		})
+	})
+
+	Describe("UseEmpty", func() {
+
+		It("returns true for fields with zero values of empty structure", func() {
+			Expect(redactedFields{{Path: "Email", Zero: "empty.Email"}}.UseEmpty()).To(BeTrue())
+			Expect(redactedFields{{Path: "Secret", Case: "*pb.User_Ssn"}}.UseEmpty()).To(BeFalse())
+		})
+	})
+})
@@ -1,7 +1,18 @@
 package generator
 
 import (
+	"bufio"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"math"
 	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 // Setter is a interface which allows to set map key with value. Both are of
@@ -12,23 +23,161 @@ type Setter interface {
 
 // SetParameters accepts flag.CommandLine as a setter, string with params
 // from protobuf compile input. Functions decodes params and adds it as command
-// line flags.
+// line flags. Parameters without value, e.g. items of buf.gen.yaml opt list
+// such as "goimports", turn on boolean flags if setter is able to look flags
+// up.
 func SetParameters(setter Setter, param *string) error {
 	if param == nil {
 		return nil
 	}
 
+	// key and value of last parameter, value is extended by parts without
+	// "=", so parameters can contain comma-separated lists, e.g.
+	// include-messages=Order,OrderItem.
+	key, value := "", ""
+
 	for _, p := range strings.Split(*param, ",") {
 		spec := strings.SplitN(p, "=", 2)
-		// skip output dir and modifiers
-		if len(spec) == 1 || strings.HasPrefix(spec[0], "M") {
+		if len(spec) == 1 {
+			switch {
+			case isBoolFlag(setter, p):
+				key, value = p, "true"
+			case key == "":
+				// skip output dir
+				continue
+			default:
+				value += "," + p
+			}
+		} else {
+			key, value = spec[0], spec[1]
+		}
+
+		// skip modifiers
+		if strings.HasPrefix(key, "M") {
+			key = ""
 			continue
 		}
 
-		if err := setter.Set(spec[0], spec[1]); err != nil {
+		if err := setter.Set(key, value); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// isBoolFlag returns true if setter is a flag set, such as flag.CommandLine,
+// with boolean flag name.
+func isBoolFlag(setter Setter, name string) bool {
+	fs, ok := setter.(interface{ Lookup(string) *flag.Flag })
+	if !ok {
+		return false
+	}
+
+	f := fs.Lookup(name)
+	if f == nil {
+		return false
+	}
+
+	bf, ok := f.Value.(interface{ IsBoolFlag() bool })
+
+	return ok && bf.IsBoolFlag()
+}
+
+// protoFileNumber is a number of proto_file field of CodeGeneratorRequest.
+const protoFileNumber protowire.Number = 15
+
+// ReadRequest reads and decodes CodeGeneratorRequest. Descriptors of proto
+// files are decoded one by one while request is read, so raw data of already
+// decoded files isn't kept in memory, and source code info of each file is
+// reduced to positions of messages and fields, see compactSourceLocations.
+// The rest of request fields are decoded after all. Options of transformer and
+// gogoproto are decoded as extensions of descriptor options, since their
+// packages are imported by generator.
+//
+// Descriptors of all files are still kept until generation ends: messages of
+// any file may refer to messages of other files, see CollectAllMessages, so
+// memory usage grows with the size of descriptors of the request.
+func ReadRequest(r io.Reader) (*pluginpb.CodeGeneratorRequest, error) {
+	br := bufio.NewReader(r)
+	req := &pluginpb.CodeGeneratorRequest{}
+
+	// rest holds encoded fields other than proto_file.
+	var rest []byte
+	for {
+		tag, err := binary.ReadUvarint(br)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+
+		num, typ := protowire.DecodeTag(tag)
+		if num < protowire.MinValidNumber {
+			return nil, fmt.Errorf("invalid field number %d of request", num)
+		}
+
+		var value []byte
+		switch typ {
+		case protowire.VarintType:
+			v, err := binary.ReadUvarint(br)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			value = protowire.AppendVarint(nil, v)
+		case protowire.Fixed32Type:
+			value = make([]byte, 4)
+		case protowire.Fixed64Type:
+			value = make([]byte, 8)
+		case protowire.BytesType:
+			n, err := binary.ReadUvarint(br)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			if n > math.MaxInt32 {
+				return nil, fmt.Errorf("field %d of request is too long: %d bytes", num, n)
+			}
+			value = make([]byte, n)
+		default:
+			return nil, fmt.Errorf("unsupported wire type %d of field %d of request", typ, num)
+		}
+
+		if typ != protowire.VarintType {
+			if _, err := io.ReadFull(br, value); err != nil {
+				return nil, unexpectedEOF(err)
+			}
+		}
+
+		if num == protoFileNumber && typ == protowire.BytesType {
+			f := &descriptorpb.FileDescriptorProto{}
+			if err := proto.Unmarshal(value, f); err != nil {
+				return nil, err
+			}
+			compactSourceLocations(f)
+			req.ProtoFile = append(req.ProtoFile, f)
+			continue
+		}
+
+		rest = protowire.AppendTag(rest, num, typ)
+		if typ == protowire.BytesType {
+			rest = protowire.AppendVarint(rest, uint64(len(value)))
+		}
+		rest = append(rest, value...)
+	}
+
+	if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(rest, req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// unexpectedEOF returns io.ErrUnexpectedEOF instead of io.EOF, since request
+// ends in the middle of field.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -1,12 +1,21 @@
 package generator
 
 import (
+	"bytes"
 	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"testing/iotest"
 
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 // setter is a faked setter.
@@ -47,7 +56,101 @@ var _ = Describe("Request", func() {
 			Entry("", set, sp("Mkey1=val1"), ""),
 			Entry("", set, sp("Mkey1=val1,Mkey2=val2"), ""),
 			Entry("", set, sp("Mkey1=val1,Mkey2=val2,key3=val3"), "key3=val3,"),
+			Entry("list value", setter{}, sp("Mkey1=val1,list,key4=a,b,c"), "key4=a,b,c,"),
 		)
 
+		It("turns on boolean flags without value", func() {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			goimports := fs.Bool("goimports", false, "")
+			pkg := fs.String("package", "", "")
+			messages := fs.String("include-messages", "", "")
+
+			Expect(SetParameters(fs, sp("package=transform,goimports,include-messages=Order,OrderItem"))).To(Succeed())
+			Expect(*goimports).To(BeTrue())
+			Expect(*pkg).To(Equal("transform"))
+			Expect(*messages).To(Equal("Order,OrderItem"))
+		})
+
+	})
+
+	Describe("ReadRequest", func() {
+
+		It("keeps transformer options of messages", func() {
+			msg := &descriptorpb.DescriptorProto{
+				Name:    sp("Order"),
+				Options: &descriptorpb.MessageOptions{},
+			}
+			proto.SetExtension(msg.Options, options.E_GoStruct, "Order")
+
+			data, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+				Parameter: sp("package=transform"),
+				ProtoFile: []*descriptorpb.FileDescriptorProto{{
+					Name:        sp("order.proto"),
+					MessageType: []*descriptorpb.DescriptorProto{msg},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := ReadRequest(bytes.NewReader(data))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.GetParameter()).To(Equal("package=transform"))
+			Expect(req.ProtoFile).To(HaveLen(1))
+
+			got := req.ProtoFile[0].MessageType[0]
+			Expect(got.GetName()).To(Equal("Order"))
+			Expect(getStringOption(got.Options, options.E_GoStruct)).To(Equal("Order"))
+		})
+
+		It("keeps gogoproto options of fields", func() {
+			fdp := &descriptorpb.FieldDescriptorProto{Name: sp("address"), Type: &typMessage, Options: &descriptorpb.FieldOptions{}}
+			proto.SetExtension(fdp.Options, gogoproto.E_Nullable, false)
+
+			data, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{{
+					Name:        sp("order.proto"),
+					MessageType: []*descriptorpb.DescriptorProto{{Name: sp("Order"), Field: []*descriptorpb.FieldDescriptorProto{fdp}}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := ReadRequest(bytes.NewReader(data))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(extractNullOption(req.ProtoFile[0].MessageType[0].Field[0])).To(BeFalse())
+		})
+
+		It("decodes files one by one and keeps the rest of request", func() {
+			want := &pluginpb.CodeGeneratorRequest{
+				FileToGenerate:  []string{"order.proto"},
+				Parameter:       sp("package=transform"),
+				CompilerVersion: &pluginpb.Version{Major: proto.Int32(3), Minor: proto.Int32(21)},
+				ProtoFile: []*descriptorpb.FileDescriptorProto{
+					{Name: sp("common.proto")},
+					{Name: sp("order.proto"), Dependency: []string{"common.proto"}},
+				},
+			}
+			data, err := proto.Marshal(want)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Request is read byte by byte, so files are decoded from
+			// partial reads.
+			req, err := ReadRequest(iotest.OneByteReader(bytes.NewReader(data)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proto.Equal(req, want)).To(BeTrue(), req.String())
+		})
+
+		It("returns error for truncated request", func() {
+			data, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+				ProtoFile: []*descriptorpb.FileDescriptorProto{{Name: sp("order.proto")}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = ReadRequest(bytes.NewReader(data[:len(data)-1]))
+			Expect(err).To(MatchError(io.ErrUnexpectedEOF))
+		})
+
+		It("returns error for malformed request", func() {
+			_, err := ReadRequest(bytes.NewReader([]byte{0xff}))
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
@@ -0,0 +1,116 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// FileWriter writes generated files. Close is called once after all files
+// are written.
+type FileWriter interface {
+	WriteFile(name, content string) error
+	Close() error
+}
+
+// ResponseWriter writes plugin response file by file. Each file is written as
+// a separate CodeGeneratorResponse message, protobuf decoder merges
+// concatenated messages into one message with all files, so generated content
+// is not accumulated in memory until all files are processed.
+type ResponseWriter struct {
+	w io.Writer
+}
+
+// NewResponseWriter returns ResponseWriter which writes into w, usually it's
+// os.Stdout.
+func NewResponseWriter(w io.Writer) *ResponseWriter {
+	return &ResponseWriter{w: w}
+}
+
+// WriteFile writes one generated file into response.
+func (rw *ResponseWriter) WriteFile(name, content string) error {
+	data, err := proto.Marshal(&pluginpb.CodeGeneratorResponse{
+		File: []*pluginpb.CodeGeneratorResponse_File{{
+			Name:    proto.String(name),
+			Content: proto.String(content),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = rw.w.Write(data)
+	return err
+}
+
+// WriteError writes plugin error into response, protoc reports the error
+// and doesn't write generated files.
+func (rw *ResponseWriter) WriteError(msg string) error {
+	data, err := proto.Marshal(&pluginpb.CodeGeneratorResponse{
+		Error: proto.String(msg),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = rw.w.Write(data)
+	return err
+}
+
+// Close writes features supported by plugin, such as proto3 optional fields,
+// as a separate message, so they are merged into response even if no files
+// are generated.
+func (rw *ResponseWriter) Close() error {
+	data, err := proto.Marshal(&pluginpb.CodeGeneratorResponse{
+		SupportedFeatures: proto.Uint64(uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = rw.w.Write(data)
+	return err
+}
+
+// StreamWriter writes generated files as a plain text stream, each file is
+// wrapped by begin and end lines with file name:
+//
+//	// >>> file: example/transform/options.go
+//	package transform
+//	...
+//	// <<< file: example/transform/options.go
+//
+// Such stream can't be read by protoc, it's used for debugging and tests of
+// generator itself.
+type StreamWriter struct {
+	w io.Writer
+}
+
+// NewStreamWriter returns StreamWriter which writes into w.
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{w: w}
+}
+
+// WriteFile writes one generated file into stream.
+func (sw *StreamWriter) WriteFile(name, content string) error {
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+
+	_, err := fmt.Fprintf(sw.w, "// >>> file: %s\n%s// <<< file: %s\n", name, content, name)
+	return err
+}
+
+// WriteError writes plugin error into stream wrapped by begin and end lines.
+func (sw *StreamWriter) WriteError(msg string) error {
+	_, err := fmt.Fprintf(sw.w, "// >>> error\n%s\n// <<< error\n", msg)
+	return err
+}
+
+// Close does nothing, stream has no trailer.
+func (sw *StreamWriter) Close() error {
+	return nil
+}
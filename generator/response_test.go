@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var _ = Describe("ResponseWriter", func() {
+
+	It("writes files which are decoded as one response", func() {
+		buf := &bytes.Buffer{}
+		rw := NewResponseWriter(buf)
+
+		Expect(rw.WriteFile("one.go", "package one")).To(Succeed())
+		Expect(rw.WriteFile("two.go", "package two")).To(Succeed())
+
+		resp := &pluginpb.CodeGeneratorResponse{}
+		Expect(proto.Unmarshal(buf.Bytes(), resp)).To(Succeed())
+		Expect(resp.File).To(HaveLen(2))
+		Expect(resp.File[0].GetName()).To(Equal("one.go"))
+		Expect(resp.File[0].GetContent()).To(Equal("package one"))
+		Expect(resp.File[1].GetName()).To(Equal("two.go"))
+		Expect(resp.File[1].GetContent()).To(Equal("package two"))
+	})
+
+	It("declares supported features on close", func() {
+		buf := &bytes.Buffer{}
+		rw := NewResponseWriter(buf)
+
+		Expect(rw.WriteFile("one.go", "package one")).To(Succeed())
+		Expect(rw.Close()).To(Succeed())
+
+		resp := &pluginpb.CodeGeneratorResponse{}
+		Expect(proto.Unmarshal(buf.Bytes(), resp)).To(Succeed())
+		Expect(resp.File).To(HaveLen(1))
+		Expect(resp.GetSupportedFeatures()).To(Equal(uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)))
+	})
+
+	It("writes plugin error", func() {
+		buf := &bytes.Buffer{}
+		rw := NewResponseWriter(buf)
+
+		Expect(rw.WriteError("product.proto: invalid option")).To(Succeed())
+		Expect(rw.Close()).To(Succeed())
+
+		resp := &pluginpb.CodeGeneratorResponse{}
+		Expect(proto.Unmarshal(buf.Bytes(), resp)).To(Succeed())
+		Expect(resp.GetError()).To(Equal("product.proto: invalid option"))
+	})
+})
+
+var _ = Describe("StreamWriter", func() {
+
+	It("marks file boundaries", func() {
+		buf := &bytes.Buffer{}
+		sw := NewStreamWriter(buf)
+
+		Expect(sw.WriteFile("one.go", "package one")).To(Succeed())
+		Expect(sw.WriteFile("two.go", "package two\n")).To(Succeed())
+
+		Expect(buf.String()).To(Equal(`// >>> file: one.go
+package one
+// <<< file: one.go
+// >>> file: two.go
+package two
+// <<< file: two.go
+`))
+	})
+
+	It("marks error boundaries", func() {
+		buf := &bytes.Buffer{}
+		sw := NewStreamWriter(buf)
+
+		Expect(sw.WriteError("product.proto: invalid option")).To(Succeed())
+		Expect(buf.String()).To(Equal("// >>> error\nproduct.proto: invalid option\n// <<< error\n"))
+	})
+})
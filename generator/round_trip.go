@@ -0,0 +1,306 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// roundTripTests is true if round-trip tests of transformers are generated,
+// see SetRoundTripTests.
+var roundTripTests bool
+
+// roundTripFiles holds content of round-trip tests by output paths of
+// generated files, see RoundTripTests.
+var roundTripFiles = map[string]string{}
+
+// roundTripTest is a test of transformers of one message: message with
+// values of fields is transformed into model and back. It also describes
+// benchmarks of transformers, see benchT.
+type roundTripTest struct {
+	Data
+	// Values of proto structure fields set by test.
+	Values []roundTripValue
+	// Values of fields of other generated messages, which are set by graph
+	// benchmark, see withGraphs.
+	Graph []roundTripValue
+	// Reason of skipping of tests and benchmarks of message, e.g. field
+	// transformed by helper functions which may reject generated values, see
+	// withSkips.
+	Skip string
+
+	desc *descriptorpb.DescriptorProto
+	// Full name of message, e.g. .pb.Product.
+	full string
+}
+
+// roundTripValue is a value of proto structure field, e.g. Name: "name".
+type roundTripValue struct {
+	Name  string
+	Value string
+	// If true, field is transformed as is, so any value is kept, see
+	// FuzzValues.
+	Lossless bool
+}
+
+// FuzzValues returns values of fields which are compared by fuzz tests:
+// fields which keep any value, e.g. fields converted into narrower numeric
+// types or floating-point fields, which can be NaN, are not compared.
+func (rt roundTripTest) FuzzValues() []roundTripValue {
+	var values []roundTripValue
+	for _, v := range rt.Values {
+		if v.Lossless {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+// pointerValueFuncs are functions of proto package which return pointers to
+// values of proto2 scalar fields by field types.
+var pointerValueFuncs = map[descriptorpb.FieldDescriptorProto_Type]string{
+	descriptorpb.FieldDescriptorProto_TYPE_STRING: "proto.String",
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:   "proto.Bool",
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:  "proto.Int32",
+	descriptorpb.FieldDescriptorProto_TYPE_SINT32: "proto.Int32",
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:  "proto.Int64",
+	descriptorpb.FieldDescriptorProto_TYPE_SINT64: "proto.Int64",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32: "proto.Uint32",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64: "proto.Uint64",
+	descriptorpb.FieldDescriptorProto_TYPE_FLOAT:  "proto.Float32",
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE: "proto.Float64",
+}
+
+// SetRoundTripTests turns on round-trip tests: each generated file gets a
+// _test.go file, which checks that messages transformed into models and back
+// are equal to the original ones.
+func SetRoundTripTests(on bool) {
+	roundTripTests = on
+}
+
+// RoundTripTests returns path and content of test file of generated file
+// path, empty strings are returned if tests are turned off or file has no
+// messages which are transformed in both directions.
+func RoundTripTests(path string) (string, string) {
+	content, ok := roundTripFiles[path]
+	if !ok {
+		return "", ""
+	}
+
+	return strings.TrimSuffix(path, ".go") + "_test.go", content
+}
+
+// newRoundTripTest returns round-trip test of transformers described by d of
+// message m with full name. Fields of message are set if their values are
+// transformed in both directions as is or by type conversion, the rest of
+// fields, e.g. skipped ones or fields transformed by helper functions, are
+// left empty.
+func newRoundTripTest(d Data, m *descriptorpb.DescriptorProto, full string) roundTripTest {
+	byName := map[string]*descriptorpb.FieldDescriptorProto{}
+	for _, fdp := range m.GetField() {
+		byName[fdp.GetName()] = fdp
+	}
+
+	rt := roundTripTest{Data: d, desc: m, full: full}
+	if name, ok := userFuncField(d.Fields); ok {
+		rt.Skip = fmt.Sprintf("field %s is transformed by helper or custom functions, which may reject generated values", name)
+	}
+
+	for _, f := range d.Fields {
+		fdp, ok := byName[f.ProtoOrigName]
+		if !ok || !roundTripField(f, fdp) {
+			continue
+		}
+
+		v, ok := roundTripFieldValue(fdp, f.ProtoIsPointer)
+		if !ok {
+			continue
+		}
+		lossless := f.ProtoToGoType == "" && f.GoToProtoType == "" &&
+			fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_FLOAT && fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_DOUBLE
+		rt.Values = append(rt.Values, roundTripValue{Name: f.ProtoName, Value: v, Lossless: lossless})
+	}
+
+	return rt
+}
+
+// userFuncField returns name of the first field of fields, including embedded
+// ones, which is transformed by functions of user code: helper functions,
+// functions of transformer.custom_pb_to_go, transformer.custom_go_to_pb and
+// transformer.bytes_converter options. Such functions may reject empty or
+// generated values, e.g. currency code "" or nil pointer.
+func userFuncField(fields []Field) (string, bool) {
+	for _, f := range fields {
+		if name, ok := userFuncField(f.EmbeddedFields); ok {
+			return name, true
+		}
+
+		switch {
+		case f.UsePackage, f.HelperCall != nil:
+			return f.Name, true
+		case userFuncElem(f.Elem), userFuncElem(f.Wrapper):
+			return f.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// userFuncElem returns true if element-wise transformation e calls functions
+// of user code, see userFuncField.
+func userFuncElem(e *Elem) bool {
+	if e == nil {
+		return false
+	}
+
+	switch e.Kind {
+	case elemFunc, elemCustom, elemBytes:
+		return true
+	}
+
+	return e.UsePackage
+}
+
+// withSkips skips tests of messages which hold messages with skipped tests by
+// value: such sub messages are transformed even if they are empty. Tests of
+// messages which refer skipped messages by pointers or in repeated fields are
+// kept, these fields are left nil.
+func withSkips(tests []roundTripTest) []roundTripTest {
+	out := append([]roundTripTest{}, tests...)
+
+	for changed := true; changed; {
+		changed = false
+
+		skipped := map[string]bool{}
+		for _, rt := range out {
+			if rt.Skip != "" {
+				skipped[rt.full] = true
+			}
+		}
+
+		for i, rt := range out {
+			if rt.Skip != "" {
+				continue
+			}
+
+			fields := map[string]Field{}
+			for _, f := range rt.Fields {
+				fields[f.ProtoOrigName] = f
+			}
+
+			for _, fdp := range rt.desc.GetField() {
+				f, mapped := fields[fdp.GetName()]
+				switch {
+				case !mapped, !skipped[fdp.GetTypeName()], fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+					continue
+				case fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED, fdp.OneofIndex != nil, extractNullOption(fdp):
+					continue
+				}
+
+				out[i].Skip = fmt.Sprintf("field %s holds message %s, tests of which are skipped", f.Name, strings.TrimPrefix(fdp.GetTypeName(), "."))
+				changed = true
+				break
+			}
+		}
+	}
+
+	return out
+}
+
+// roundTripField returns true if value of singular field fdp is transformed
+// by field f in both directions without helpers, so transformed value is
+// equal to the original one.
+func roundTripField(f Field, fdp *descriptorpb.FieldDescriptorProto) bool {
+	switch {
+	case fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED, fdp.OneofIndex != nil:
+		return false
+	case f.SkipPbToGo, f.SkipGoToPb, f.UsePackage, f.Helper != "", f.OneofDecl != "":
+		return false
+	case f.Elem != nil, f.Wrapper != nil, f.Enum != nil, f.Dep != nil, f.Case != nil, f.HelperCall != nil:
+		return false
+	case len(f.EmbeddedFields) > 0, f.WithError, f.WithContext, f.Promoted, f.Edge:
+		return false
+	}
+
+	return true
+}
+
+// roundTripFieldValue returns Go literal of value of scalar field fdp, e.g.
+// field name for strings and field number for numbers. If pointer is true,
+// value is wrapped by function of proto package, e.g. proto.String("name").
+func roundTripFieldValue(fdp *descriptorpb.FieldDescriptorProto, pointer bool) (string, bool) {
+	var v string
+
+	switch fdp.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		v = strconv.Quote(fdp.GetName())
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		v = fmt.Sprintf("[]byte(%q)", fdp.GetName())
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		v = "true"
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		v = fmt.Sprintf("%d.5", fdp.GetNumber())
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, descriptorpb.FieldDescriptorProto_TYPE_GROUP, descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		return "", false
+	default:
+		v = strconv.Itoa(int(fdp.GetNumber()))
+	}
+
+	if !pointer {
+		return v, true
+	}
+
+	fn, ok := pointerValueFuncs[fdp.GetType()]
+	if !ok {
+		return "", false
+	}
+
+	return fn + "(" + v + ")", true
+}
+
+// roundTrips returns tests of messages which are transformed in both
+// directions.
+func roundTrips(tests []roundTripTest) []roundTripTest {
+	var out []roundTripTest
+	for _, rt := range tests {
+		if !rt.NoReverse {
+			out = append(out, rt)
+		}
+	}
+
+	return out
+}
+
+// testFile returns content of test file with tests of transformers of
+// .proto file f in package packageName, which are rendered by template t, see
+// roundTripT and fuzzT. Proto structures are imported from package
+// protoPackage with import path pbPath. Proto structures are wrapped into
+// APIv2 messages by proto.MessageV2, like in jsonT.
+func testFile(t *template.Template, f *descriptorpb.FileDescriptorProto, packageName, protoPackage, pbPath string, tests []roundTripTest) (string, error) {
+	body := &bytes.Buffer{}
+	if err := t.Execute(body, tests); err != nil {
+		return "", err
+	}
+
+	it := importTracker{}
+	it.add(`"context"`, `"testing"`, `"google.golang.org/protobuf/proto"`, `protov1 "github.com/golang/protobuf/proto"`, `"github.com/google/go-cmp/cmp"`)
+	it.addPackage(protoPackage, pbPath)
+
+	specs, err := it.importSpecs(body.Bytes())
+	if err != nil {
+		return "", codeError(f.GetName(), body.Bytes(), err)
+	}
+
+	w := fileHeader(f.GetName(), f.GetPackage(), packageName)
+	writeImports(w, specs)
+	if _, err := body.WriteTo(w); err != nil {
+		return "", err
+	}
+
+	return formatSource(f.GetName(), []byte(w.String()))
+}
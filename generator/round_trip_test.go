@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Round-trip tests", func() {
+
+	Describe("newRoundTripTest", func() {
+
+		It("sets fields which are transformed without helpers", func() {
+			m := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: sp("id"), Number: proto.Int32(1), Type: &typInt64},
+				{Name: sp("name"), Number: proto.Int32(2), Type: &typString},
+				{Name: sp("price"), Number: proto.Int32(3), Type: &typString},
+				{Name: sp("tags"), Number: proto.Int32(4), Type: &typString, Label: &typRepeated},
+				{Name: sp("secret"), Number: proto.Int32(5), Type: &typString},
+			}}
+			d := Data{Fields: []Field{
+				{Name: "ID", ProtoName: "Id", ProtoOrigName: "id", ProtoToGoType: "int", GoToProtoType: "int64"},
+				{Name: "Name", ProtoName: "Name", ProtoOrigName: "name", ProtoIsPointer: true},
+				{Name: "Price", ProtoName: "Price", ProtoOrigName: "price", ProtoToGoType: "StringToDecimal", GoToProtoType: "DecimalToString", UsePackage: true},
+				{Name: "Tags", ProtoName: "Tags", ProtoOrigName: "tags", Elem: &Elem{Kind: elemValue}},
+				{Name: "Secret", ProtoName: "Secret", ProtoOrigName: "secret", SkipGoToPb: true},
+			}}
+
+			rt := newRoundTripTest(d, m, ".pb.Product")
+			Expect(rt.Values).To(Equal([]roundTripValue{
+				{Name: "Id", Value: "1"},
+				{Name: "Name", Value: `proto.String("name")`, Lossless: true},
+			}))
+			Expect(rt.Skip).To(Equal("field Price is transformed by helper or custom functions, which may reject generated values"))
+		})
+
+		It("doesn't skip messages without helper and custom functions", func() {
+			m := &descriptorpb.DescriptorProto{Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: sp("id"), Number: proto.Int32(1), Type: &typInt64},
+			}}
+			d := Data{Fields: []Field{
+				{Name: "ID", ProtoName: "Id", ProtoOrigName: "id", ProtoToGoType: "int", GoToProtoType: "int64"},
+			}}
+
+			Expect(newRoundTripTest(d, m, ".pb.Product").Skip).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("userFuncField",
+		func(f Field, expected string) {
+			name, ok := userFuncField([]Field{{Name: "ID"}, f})
+			Expect(ok).To(Equal(expected != ""))
+			Expect(name).To(Equal(expected))
+		},
+
+		Entry("assigned as is", Field{Name: "Name"}, ""),
+		Entry("helper", Field{Name: "Price", UsePackage: true}, "Price"),
+		Entry("helper with signature", Field{Name: "Price", HelperCall: &helperSignature{}}, "Price"),
+		Entry("custom function of elements", Field{Name: "Tags", Elem: &Elem{Kind: elemCustom}}, "Tags"),
+		Entry("elements converted by value", Field{Name: "Tags", Elem: &Elem{Kind: elemValue}}, ""),
+		Entry("bytes converter", Field{Name: "Checksum", Elem: &Elem{Kind: elemBytes}}, "Checksum"),
+		Entry("helper of wrapper", Field{Name: "Note", Wrapper: &Elem{UsePackage: true}}, "Note"),
+		Entry("embedded field", Field{Name: "Base", EmbeddedFields: []Field{{Name: "Price", UsePackage: true}}}, "Price"),
+	)
+
+	Describe("withSkips", func() {
+
+		field := func(name, typeName string, label descriptorpb.FieldDescriptorProto_Label, nullable bool) *descriptorpb.FieldDescriptorProto {
+			fdp := &descriptorpb.FieldDescriptorProto{Name: sp(name), Type: &typMessage, TypeName: sp(typeName), Label: &label, Options: &descriptorpb.FieldOptions{}}
+			proto.SetExtension(fdp.Options, gogoproto.E_Nullable, nullable)
+			return fdp
+		}
+
+		test := func(full, skip string, fdps ...*descriptorpb.FieldDescriptorProto) roundTripTest {
+			fields := []Field{}
+			for _, fdp := range fdps {
+				fields = append(fields, Field{Name: strings.Title(fdp.GetName()), ProtoOrigName: fdp.GetName()})
+			}
+
+			return roundTripTest{Data: Data{Fields: fields}, desc: &descriptorpb.DescriptorProto{Field: fdps}, full: full, Skip: skip}
+		}
+
+		optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+
+		It("skips messages which hold skipped messages by value", func() {
+			tests := withSkips([]roundTripTest{
+				test(".pb.Order", "", field("refund", ".pb.Refund", optional, false)),
+				test(".pb.Refund", "helpers"),
+				test(".pb.Batch", "", field("order", ".pb.Order", optional, false)),
+			})
+
+			Expect(tests[0].Skip).To(Equal("field Refund holds message pb.Refund, tests of which are skipped"))
+			Expect(tests[1].Skip).To(Equal("helpers"))
+			Expect(tests[2].Skip).To(Equal("field Order holds message pb.Order, tests of which are skipped"))
+		})
+
+		It("keeps messages which hold skipped messages in repeated fields", func() {
+			tests := withSkips([]roundTripTest{
+				test(".pb.Batch", "", field("refunds", ".pb.Refund", labelRepeated, false)),
+				test(".pb.Refund", "helpers"),
+			})
+
+			Expect(tests[0].Skip).To(BeEmpty())
+		})
+
+		It("keeps messages which hold skipped messages by pointers", func() {
+			tests := withSkips([]roundTripTest{
+				test(".pb.Order", "", field("refund", ".pb.Refund", optional, true)),
+				test(".pb.Refund", "helpers"),
+			})
+
+			Expect(tests[0].Skip).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("roundTripFieldValue",
+		func(typ descriptorpb.FieldDescriptorProto_Type, pointer bool, expected string, ok bool) {
+			fdp := &descriptorpb.FieldDescriptorProto{Name: sp("value"), Number: proto.Int32(7), Type: &typ}
+
+			v, found := roundTripFieldValue(fdp, pointer)
+			Expect(found).To(Equal(ok))
+			Expect(v).To(Equal(expected))
+		},
+
+		Entry("string", descriptorpb.FieldDescriptorProto_TYPE_STRING, false, `"value"`, true),
+		Entry("bytes", descriptorpb.FieldDescriptorProto_TYPE_BYTES, false, `[]byte("value")`, true),
+		Entry("bool", descriptorpb.FieldDescriptorProto_TYPE_BOOL, false, "true", true),
+		Entry("uint32", descriptorpb.FieldDescriptorProto_TYPE_UINT32, false, "7", true),
+		Entry("double", descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, false, "7.5", true),
+		Entry("pointer", descriptorpb.FieldDescriptorProto_TYPE_FIXED32, true, "", false),
+		Entry("pointer to int64", descriptorpb.FieldDescriptorProto_TYPE_INT64, true, "proto.Int64(7)", true),
+		Entry("enum", descriptorpb.FieldDescriptorProto_TYPE_ENUM, false, "", false),
+		Entry("message", descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, false, "", false),
+	)
+
+	Describe("roundTrip template", func() {
+
+		It("checks errors of transformers with context", func() {
+			w := &bytes.Buffer{}
+			Expect(roundTripT.Execute(w, []roundTripTest{{
+				Data:   Data{Src: "Product", SrcPref: "pb", SrcFn: "Pb", Dst: "Product", DstFn: "Product", WithErrors: true, WithContext: true},
+				Values: []roundTripValue{{Name: "Id", Value: "1"}},
+			}})).To(Succeed())
+
+			Expect(w.String()).To(ContainSubstring(`	ctx := context.Background()
+
+	m, err := PbToProductPtr(ctx, src)
+	if err != nil {
+		t.Fatalf("PbToProduct: %v", err)
+	}
+
+	out, err := ProductToPbPtr(ctx, m)
+	if err != nil {
+		t.Fatalf("ProductToPb: %v", err)
+	}
+
+	got := &pb.Product{
+		Id: out.Id,
+	}
+`))
+		})
+
+		It("calls transformers of messages without values", func() {
+			w := &bytes.Buffer{}
+			Expect(roundTripT.Execute(w, []roundTripTest{{
+				Data: Data{Src: "Product", SrcPref: "pb", SrcFn: "Pb", Dst: "Product", DstFn: "Product", Func: "ProductFromProto", ReverseFunc: "ProductToProto"},
+			}})).To(Succeed())
+
+			Expect(w.String()).To(ContainSubstring("func TestProductFromProtoRoundTrip(t *testing.T) {\n"))
+			Expect(w.String()).To(ContainSubstring("\tProductToProtoPtr(ProductFromProtoPtr(src))\n}"))
+			Expect(w.String()).NotTo(ContainSubstring("proto.Equal"))
+		})
+
+		It("skips tests with reason", func() {
+			w := &bytes.Buffer{}
+			Expect(roundTripT.Execute(w, []roundTripTest{{
+				Data:   Data{Src: "Refund", SrcPref: "pb", SrcFn: "Pb", Dst: "Refund", DstFn: "Refund"},
+				Values: []roundTripValue{{Name: "Id", Value: "1"}},
+				Skip:   "field Currency is transformed by helper or custom functions",
+			}})).To(Succeed())
+
+			Expect(w.String()).To(ContainSubstring("func TestPbToRefundRoundTrip(t *testing.T) {\n\tt.Skip(\"field Currency is transformed by helper or custom functions\")\n}\n"))
+			Expect(w.String()).NotTo(ContainSubstring("src :="))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package generator
+
+import (
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// emptySliceFields sets Field.EmptySlice of repeated scalar fields of message
+// msg which are copied as is, e.g. []string into []string. Such fields are
+// replaced with empty slices after transformation if they are nil, see
+// transformer.empty_slice_on_nil. Element-wise and sub message fields allocate
+// slices by themselves.
+func emptySliceFields(fields []Field, msg *descriptorpb.DescriptorProto) {
+	for i, f := range fields {
+		fdp := fieldByName(msg, f.ProtoOrigName)
+		if fdp == nil || fdp.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+			continue
+		}
+
+		if f.Elem != nil || f.Wrapper != nil || f.Dep != nil || f.Enum != nil || f.ProtoToGoType != "" || f.IsOneof() {
+			continue
+		}
+
+		t, ok := types[fdp.GetType()]
+		if !ok || fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+			continue
+		}
+
+		et := t.pbType
+		if et == "" {
+			et = t.goType
+		}
+
+		fields[i].EmptySlice = "[]" + et
+	}
+}
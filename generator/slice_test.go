@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Empty slices", func() {
+
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+
+	Describe("extractEmptySliceOnNilOption", func() {
+
+		It("uses file option if message has no option", func() {
+			file := &descriptorpb.FileOptions{}
+			proto.SetExtension(file, options.E_EmptySliceOnNilAll, true)
+
+			Expect(extractEmptySliceOnNilOption(file, &descriptorpb.MessageOptions{})).To(BeTrue())
+			Expect(extractEmptySliceOnNilOption(file, (*descriptorpb.MessageOptions)(nil))).To(BeTrue())
+		})
+
+		It("overrides file option by message option", func() {
+			file := &descriptorpb.FileOptions{}
+			proto.SetExtension(file, options.E_EmptySliceOnNilAll, true)
+			msg := &descriptorpb.MessageOptions{}
+			proto.SetExtension(msg, options.E_EmptySliceOnNil, false)
+
+			Expect(extractEmptySliceOnNilOption(file, msg)).To(BeFalse())
+		})
+
+		It("returns false without options", func() {
+			Expect(extractEmptySliceOnNilOption((*descriptorpb.FileOptions)(nil), (*descriptorpb.MessageOptions)(nil))).To(BeFalse())
+		})
+	})
+
+	It("marks repeated scalar fields which are copied as is", func() {
+		msg := &descriptorpb.DescriptorProto{
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: sp("tags"), Type: &typString, Label: &repeated},
+				{Name: sp("ids"), Type: &typInt64, Label: &repeated},
+				{Name: sp("name"), Type: &typString},
+				{Name: sp("names"), Type: &typMessage, Label: &repeated},
+			},
+		}
+		fields := []Field{
+			{Name: "Tags", ProtoOrigName: "tags"},
+			{Name: "IDs", ProtoOrigName: "ids", ProtoToGoType: "Int64ToIntList"},
+			{Name: "Name", ProtoOrigName: "name"},
+			{Name: "Names", ProtoOrigName: "names", Elem: &Elem{Kind: elemWrapper}},
+		}
+
+		emptySliceFields(fields, msg)
+		Expect(fields[0].EmptySlice).To(Equal("[]string"))
+		Expect(fields[1].EmptySlice).To(BeEmpty())
+		Expect(fields[2].EmptySlice).To(BeEmpty())
+		Expect(fields[3].EmptySlice).To(BeEmpty())
+	})
+
+	DescribeTable("formatEmptySliceField",
+		func(swapped bool, expected string) {
+			f := Field{Name: "Tags", ProtoName: "ProtoTags", EmptySlice: "[]string"}
+			Expect(formatEmptySliceField(f, Data{Swapped: swapped})).To(Equal(expected))
+		},
+
+		Entry("Proto to model", false, "\tif s.Tags == nil {\n\t\ts.Tags = []string{}\n\t}\n"),
+		Entry("Model to proto", true, "\tif s.ProtoTags == nil {\n\t\ts.ProtoTags = []string{}\n\t}\n"),
+	)
+
+	It("allocates empty slice for nil element-wise field", func() {
+		f := Field{Name: "Names", ProtoName: "Names", Elem: &Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string", ProtoIsPointer: true}}
+
+		Expect(formatElemField(f, Data{EmptySliceOnNil: true})).To(HaveSuffix("\t} else {\n\t\ts.Names = []string{}\n\t}\n"))
+	})
+})
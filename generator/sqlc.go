@@ -0,0 +1,14 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+)
+
+// sqlcTime returns true if google.protobuf.Timestamp structure is transformed
+// into model field gf of time.Time type without helpers, because models are
+// generated by sqlc.
+func sqlcTime(gf source.FieldInfo, pol policies) bool {
+	return pol.modelStyle == options.ModelStyle_SQLC && pol.timestamps == options.TimestampsAs_TIMESTAMP &&
+		gf.Type == "time.Time" && !gf.IsSlice && gf.Key == ""
+}
@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("sqlc models", func() {
+
+	sqlc := policies{modelStyle: options.ModelStyle_SQLC, timestamps: options.TimestampsAs_TIMESTAMP}
+
+	s := source.Structure{
+		"APIKey":    {Type: "string"},
+		"CreatedAt": {Type: "time.Time"},
+		"DeletedAt": {Type: "time.Time", IsPointer: true},
+	}
+
+	field := func(name string, typ *descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		fdp := &descriptorpb.FieldDescriptorProto{Name: sp(name), Type: typ, Options: &descriptorpb.FieldOptions{}}
+		if typeName != "" {
+			fdp.TypeName = sp(typeName)
+		}
+		return fdp
+	}
+
+	It("matches model fields with initialisms", func() {
+		f, err := processField(nil, field("api_key", &typString, ""), nil, s, sqlc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Name).To(Equal("APIKey"))
+		Expect(f.ProtoName).To(Equal("ApiKey"))
+
+		_, err = processField(nil, field("api_key", &typString, ""), nil, s, policies{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("transforms timestamps into time.Time without helpers", func() {
+		f, err := processField(nil, field("created_at", &typMessage, ".google.protobuf.Timestamp"), nil, s, sqlc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{Kind: elemTime, ProtoType: "Timestamp", GoType: "time.Time", ProtoIsPointer: true}))
+
+		f, err = processField(nil, field("deleted_at", &typMessage, ".google.protobuf.Timestamp"), nil, s, sqlc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{Kind: elemTime, ProtoType: "Timestamp", GoType: "time.Time", ProtoIsPointer: true, GoIsPointer: true}))
+	})
+
+	It("uses helpers for other model types", func() {
+		s := source.Structure{"CreatedAt": {Type: "int64"}}
+
+		f, err := processField(nil, field("created_at", &typMessage, ".google.protobuf.Timestamp"), nil, s, sqlc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(BeNil())
+		Expect(f.ProtoToGoType).To(Equal("TimestampPtrToInt64"))
+	})
+})
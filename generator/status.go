@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// googleRpcStatus is a FQTN of google.rpc.Status message.
+const googleRpcStatus = ".google.rpc.Status"
+
+// errorDetails contains google.rpc error details types which can be used in
+// transformer.go_status_details option.
+var errorDetails = map[string]struct{}{
+	"RetryInfo":           {},
+	"DebugInfo":           {},
+	"QuotaFailure":        {},
+	"ErrorInfo":           {},
+	"PreconditionFailure": {},
+	"BadRequest":          {},
+	"RequestInfo":         {},
+	"ResourceInfo":        {},
+	"Help":                {},
+	"LocalizedMessage":    {},
+}
+
+// UsesStatus returns true if any message of proto file has a field of type
+// google.rpc.Status, such files require functions from StatusHelpers.
+func UsesStatus(f *descriptorpb.FileDescriptorProto) bool {
+	for _, m := range f.MessageType {
+		for _, fd := range m.Field {
+			if fd.GetTypeName() == googleRpcStatus {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// StatusDetails returns list of google.rpc error details types from
+// transformer.go_status_details option of proto file.
+func StatusDetails(f *descriptorpb.FileDescriptorProto) ([]string, error) {
+	opt, err := getStringOption(f.Options, options.E_GoStatusDetails)
+	if err != nil {
+		return nil, nil
+	}
+
+	var details []string
+
+	for _, d := range strings.Split(opt, ",") {
+		d = strings.TrimSpace(d)
+		if d == "" {
+			continue
+		}
+
+		if _, ok := errorDetails[d]; !ok {
+			return nil, fmt.Errorf("%s: unknown google.rpc error details type %q", f.GetName(), d)
+		}
+
+		details = append(details, d)
+	}
+
+	return details, nil
+}
+
+// StatusHelpers returns file content with functions for transforming
+// google.rpc.Status fields into errors and vice versa. For each type from
+// details typed accessor of error details is added.
+func StatusHelpers(packageName string, details []string) (string, error) {
+	uniq := map[string]struct{}{}
+	for _, d := range details {
+		uniq[d] = struct{}{}
+	}
+
+	details = details[:0]
+	for d := range uniq {
+		details = append(details, d)
+	}
+	sort.Strings(details)
+
+	w := output()
+	fmt.Fprintln(w, "\npackage", packageName)
+
+	if err := statusT.Execute(w, details); err != nil {
+		return "", err
+	}
+
+	return w.String(), nil
+}
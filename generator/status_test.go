@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Status", func() {
+
+	DescribeTable("UsesStatus",
+		func(typeName string, expected bool) {
+			f := &descriptorpb.FileDescriptorProto{
+				MessageType: []*descriptorpb.DescriptorProto{{
+					Name: sp("Operation"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("id"), Type: &typInt64},
+						{Name: sp("result"), Type: &typMessage, TypeName: sp(typeName)},
+					},
+				}},
+			}
+			Expect(UsesStatus(f)).To(Equal(expected))
+		},
+
+		Entry("Status field", ".google.rpc.Status", true),
+		Entry("No status fields", ".google.protobuf.Timestamp", false),
+	)
+
+	DescribeTable("StatusDetails",
+		func(opt string, expected []string, expectedErr string) {
+			f := &descriptorpb.FileDescriptorProto{Name: sp("file.proto"), Options: &descriptorpb.FileOptions{}}
+			if opt != "" {
+				proto.SetExtension(f.Options, options.E_GoStatusDetails, opt)
+			}
+
+			d, err := StatusDetails(f)
+			if expectedErr != "" {
+				Expect(err).To(MatchError(expectedErr))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(d).To(Equal(expected))
+		},
+
+		Entry("No option", "", nil, ""),
+		Entry("Allowed types", "BadRequest, PreconditionFailure", []string{"BadRequest", "PreconditionFailure"}, ""),
+		Entry("Unknown type", "BadRequest,Unknown", nil, `file.proto: unknown google.rpc error details type "Unknown"`),
+	)
+
+	Describe("StatusHelpers", func() {
+
+		BeforeEach(func() {
+			version = "v1.1.1"
+		})
+
+		It("returns status converters", func() {
+			r, err := StatusHelpers("one", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r).To(HavePrefix("// Code generated by protoc-gen-struct-transformer, version: v1.1.1. DO NOT EDIT.\n\npackage one\n"))
+			Expect(r).To(ContainSubstring("func StatusToError(s *rpc.Status) error {"))
+			Expect(r).To(ContainSubstring("func ErrorToStatus(err error) *rpc.Status {"))
+			Expect(r).NotTo(ContainSubstring("Details()"))
+		})
+
+		It("returns sorted unique details accessors", func() {
+			r, err := StatusHelpers("one", []string{"PreconditionFailure", "BadRequest", "PreconditionFailure"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r).To(HaveSuffix(`
+// ErrorBadRequest returns first google.rpc.BadRequest detail of error, nil if error
+// has no such detail.
+func ErrorBadRequest(err error) *rpc.BadRequest {
+	for _, d := range status.Convert(err).Details() {
+		if v, ok := d.(*rpc.BadRequest); ok {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// ErrorPreconditionFailure returns first google.rpc.PreconditionFailure detail of error, nil if error
+// has no such detail.
+func ErrorPreconditionFailure(err error) *rpc.PreconditionFailure {
+	for _, d := range status.Convert(err).Details() {
+		if v, ok := d.(*rpc.PreconditionFailure); ok {
+			return v
+		}
+	}
+
+	return nil
+}
+`))
+		})
+	})
+})
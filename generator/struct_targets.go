@@ -0,0 +1,82 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// splitTargets returns models of comma-separated list of transformer.go_struct
+// option value, e.g. "Product, dto.Product". Value without commas is
+// returned as is.
+func splitTargets(value string) []string {
+	if !strings.Contains(value, ",") {
+		return []string{value}
+	}
+
+	targets := []string{}
+	for _, t := range strings.Split(value, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			targets = append(targets, t)
+		}
+	}
+
+	return targets
+}
+
+// expandTargets returns messages msgs where messages with several models in
+// transformer.go_struct option are repeated for each of them. Copies of such
+// messages hold single model, so transformers are generated for every model.
+// The first model is the primary one, fields of other messages are
+// transformed by its transformers.
+func expandTargets(msgs []fileMessage) ([]fileMessage, error) {
+	out := []fileMessage{}
+
+	for _, fm := range msgs {
+		value, _ := getStringOption(fm.desc.GetOptions(), options.E_GoStruct)
+		targets := splitTargets(value)
+		if len(targets) < 2 {
+			out = append(out, fm)
+			continue
+		}
+
+		seen := map[string]bool{}
+		for _, t := range targets {
+			if seen[t] {
+				return nil, fmt.Errorf("message %s: option (%s): model %s is listed twice", fm.name, options.E_GoStruct.Name, t)
+			}
+			seen[t] = true
+
+			m := proto.Clone(fm.desc).(*descriptorpb.DescriptorProto)
+			proto.SetExtension(m.Options, options.E_GoStruct, t)
+			out = append(out, fileMessage{name: fm.name, desc: m, path: fm.path})
+		}
+	}
+
+	return out, nil
+}
+
+// targetMessageOption returns options mo of message with several models for
+// model target: transformers of models other than the primary one get names
+// by transformer.func_name_format_* options nf, or default names if nf is
+// nil, e.g. PbToProductDTO and ProductDTOToPb.
+func targetMessageOption(mo MessageOption, target string, nf *funcNameFormats) (MessageOption, error) {
+	so, ok := mo.(messageOption)
+	if !ok || so.targetName == target {
+		return mo, nil
+	}
+
+	so.targetName = target
+	so.pbToGo, so.goToPb = "", ""
+	if nf != nil {
+		var err error
+		if so.pbToGo, so.goToPb, err = nf.names(so.goName, target); err != nil {
+			return nil, err
+		}
+	}
+
+	return so, nil
+}
@@ -0,0 +1,72 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Struct targets", func() {
+
+	message := func(goStruct string) fileMessage {
+		m := &descriptorpb.DescriptorProto{Name: sp("Product"), Options: &descriptorpb.MessageOptions{}}
+		proto.SetExtension(m.Options, options.E_GoStruct, goStruct)
+		return fileMessage{name: "Product", desc: m, path: []int32{4, 0}}
+	}
+
+	It("splits comma-separated list of models", func() {
+		Expect(splitTargets("Product")).To(Equal([]string{"Product"}))
+		Expect(splitTargets("Product, dto.Product,")).To(Equal([]string{"Product", "dto.Product"}))
+	})
+
+	It("returns primary model as go_struct of message", func() {
+		Expect(extractStructNameOption(message("Product, dto.Product").desc)).To(Equal("Product"))
+	})
+
+	It("repeats messages for every model", func() {
+		fm := message("Product, dto.Product")
+
+		msgs, err := expandTargets([]fileMessage{fm, message("Order")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msgs).To(HaveLen(3))
+
+		for i, target := range []string{"Product", "dto.Product", "Order"} {
+			Expect(getStringOption(msgs[i].desc.Options, options.E_GoStruct)).To(Equal(target))
+		}
+		Expect(msgs[1].name).To(Equal("Product"))
+		Expect(msgs[1].path).To(Equal([]int32{4, 0}))
+		Expect(getStringOption(fm.desc.Options, options.E_GoStruct)).To(Equal("Product, dto.Product"))
+	})
+
+	It("returns error if model is listed twice", func() {
+		_, err := expandTargets([]fileMessage{message("Product,Product")})
+		Expect(err).To(MatchError("message Product: option (transformer.go_struct): model Product is listed twice"))
+	})
+
+	It("names transformers of other models", func() {
+		mo := messageOption{targetName: "Product", goName: "Product", pbToGo: "ProductFromProto", goToPb: "ProductToProto"}
+
+		other, err := targetMessageOption(mo, "Product", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other).To(Equal(mo))
+
+		other, err = targetMessageOption(mo, "dto.Product", nil)
+		Expect(err).NotTo(HaveOccurred())
+		p2g, g2p := transformerNames(other)
+		Expect(p2g).To(Equal("PbToDtoProduct"))
+		Expect(g2p).To(Equal("DtoProductToPb"))
+
+		fo := &descriptorpb.FileOptions{}
+		proto.SetExtension(fo, options.E_FuncNameFormatPbToGo, "{{.Dst}}FromProto")
+		nf, err := newFuncNameFormats(fo)
+		Expect(err).NotTo(HaveOccurred())
+
+		other, err = targetMessageOption(mo, "dto.Product", nf)
+		Expect(err).NotTo(HaveOccurred())
+		p2g, g2p = other.FuncNames()
+		Expect(p2g).To(Equal("DtoProductFromProto"))
+		Expect(g2p).To(Equal("DtoProductToPb"))
+	})
+})
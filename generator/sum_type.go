@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// sumType is an interface of oneof declaration with transformer.go_sum_type
+// option, which is generated into models package.
+type sumType struct {
+	// Interface name, e.g. PaymentMethod.
+	Name string
+	// Names of oneof declaration and its message, e.g. method and Payment.
+	Oneof   string
+	Message string
+	// Wrapper structures of cases without transformer.oneof_case option.
+	Wrappers []sumWrapper
+	// Existing model types of cases with transformer.oneof_case option, they
+	// have to implement the interface.
+	Types []string
+}
+
+// sumWrapper is a structure which wraps value of oneof case, e.g.
+// PaymentMethodCard{Card *CardPayment}.
+type sumWrapper struct {
+	// Structure name, e.g. PaymentMethodCard.
+	Name string
+	// Name of the only structure field, e.g. Card.
+	Field string
+	// Type of the field, e.g. *CardPayment or string.
+	Type string
+	// Name of proto field of the case, e.g. card.
+	Case string
+}
+
+// SumTypes returns path and content of file with interfaces and wrapper
+// structures of oneofs with transformer.go_sum_type option of proto file f.
+// File is written into models directory of transformer.go_models_file_path
+// or transformer.go_models_dir option. Empty path is returned if file has no
+// such oneofs.
+func SumTypes(f *descriptorpb.FileDescriptorProto, messages MessageOptionList) (string, string, error) {
+	var sts []sumType
+
+	for _, fm := range fileMessages(f.MessageType, "") {
+		if messages[f.GetPackage()+"."+fm.name].Omitted() {
+			continue
+		}
+
+		for i, decl := range fm.desc.GetOneofDecl() {
+			name, _ := getStringOption(decl.GetOptions(), options.E_GoSumType)
+			if name == "" {
+				continue
+			}
+
+			sts = append(sts, newSumType(name, decl.GetName(), fm, int32(i), messages))
+		}
+	}
+
+	if len(sts) == 0 {
+		return "", "", nil
+	}
+
+	dir, err := modelsDir(f.Options)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: %s", f.GetName(), err)
+	}
+
+	pkg, err := getStringOption(f.Options, options.E_GoRepoPackage)
+	if err != nil {
+		pkg = filepath.Base(dir)
+	}
+
+	w := output()
+	fmt.Fprint(w, "\npackage ", pkg)
+
+	if err := sumTypesT.Execute(w, sts); err != nil {
+		return "", "", err
+	}
+
+	path := filepath.Join(dir, strings.TrimSuffix(filepath.Base(f.GetName()), ".proto")+"_sum_types.go")
+
+	return path, w.String(), nil
+}
+
+// newSumType returns sum type name of oneof decl with index i of message fm.
+// Cases which can't be wrapped, e.g. messages without go_struct option, are
+// omitted, their fields are reported by transformers.
+func newSumType(name, decl string, fm fileMessage, i int32, messages MessageOptionList) sumType {
+	st := sumType{Name: name, Oneof: decl, Message: fm.goName()}
+
+	for _, fdp := range fm.desc.GetField() {
+		if fdp.OneofIndex == nil || fdp.GetOneofIndex() != i || extractSkipOption(fdp.Options) {
+			continue
+		}
+
+		if v, _ := getStringOption(fdp.Options, options.E_OneofCase); v != "" {
+			st.Types = append(st.Types, v)
+			continue
+		}
+
+		typ := ""
+		if fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+			if mo, ok := messages[strings.TrimPrefix(fdp.GetTypeName(), ".")]; ok && !mo.Omitted() && !strings.Contains(mo.Target(), ".") {
+				typ = "*" + mo.Target()
+			}
+		} else if t, ok := types[fdp.GetType()]; ok {
+			typ = t.pbType
+			if typ == "" {
+				typ = t.goType
+			}
+		}
+
+		if typ == "" {
+			continue
+		}
+
+		field := strcase.ToCamel(fdp.GetName())
+		st.Wrappers = append(st.Wrappers, sumWrapper{Name: name + field, Field: field, Type: typ, Case: fdp.GetName()})
+	}
+
+	return st
+}
+
+// modelsDir returns directory of models from transformer.go_models_dir
+// option or directory of the first file of transformer.go_models_file_path
+// option of file options m.
+func modelsDir(m proto.Message) (string, error) {
+	if dir, err := getStringOption(m, options.E_GoModelsDir); err == nil && dir != "" {
+		return dir, nil
+	}
+
+	if paths, err := getStringOption(m, options.E_GoModelsFilePath); err == nil && strings.TrimSpace(paths) != "" {
+		return filepath.Dir(strings.TrimSpace(strings.Split(paths, ",")[0])), nil
+	}
+
+	return "", fmt.Errorf("sum types are generated into models directory, set (%s) or (%s) option", options.E_GoModelsFilePath.Name, options.E_GoModelsDir.Name)
+}
@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("SumType", func() {
+
+	var f *descriptorpb.FileDescriptorProto
+
+	messages := MessageOptionList{
+		"pkg.Payout": messageOption{targetName: "Payout"},
+		"pkg.Card":   messageOption{targetName: "CardPayment"},
+	}
+
+	BeforeEach(func() {
+		decl := &descriptorpb.OneofDescriptorProto{Name: sp("target"), Options: &descriptorpb.OneofOptions{}}
+		proto.SetExtension(decl.Options, options.E_GoSumType, "PayoutTarget")
+
+		coupon := &descriptorpb.FieldDescriptorProto{Name: sp("coupon"), Type: &typString, OneofIndex: proto.Int32(0), Options: &descriptorpb.FieldOptions{}}
+		proto.SetExtension(coupon.Options, options.E_OneofCase, "Coupon")
+
+		f = &descriptorpb.FileDescriptorProto{
+			Name:    sp("api/payout.proto"),
+			Package: sp("pkg"),
+			Options: &descriptorpb.FileOptions{},
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name: sp("Payout"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("id"), Type: &typInt64},
+					{Name: sp("card"), Type: &typMessage, TypeName: sp(".pkg.Card"), OneofIndex: proto.Int32(0)},
+					{Name: sp("account"), Type: &typString, OneofIndex: proto.Int32(0)},
+					coupon,
+				},
+				OneofDecl: []*descriptorpb.OneofDescriptorProto{decl},
+			}},
+		}
+		proto.SetExtension(f.Options, options.E_GoModelsFilePath, "internal/model/payout.go")
+		proto.SetExtension(f.Options, options.E_GoRepoPackage, "model")
+	})
+
+	It("generates interfaces and wrappers of cases into models directory", func() {
+		path, content, err := SumTypes(f, messages)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(Equal("internal/model/payout_sum_types.go"))
+		Expect(content).To(HaveSuffix(`
+package model
+
+// PayoutTarget is a sum type of oneof target of message Payout.
+// Model types Coupon have to implement it.
+type PayoutTarget interface {
+	isPayoutTarget()
+}
+
+// PayoutTargetCard is card case of PayoutTarget.
+type PayoutTargetCard struct {
+	Card *CardPayment
+}
+
+func (PayoutTargetCard) isPayoutTarget() {}
+
+// PayoutTargetAccount is account case of PayoutTarget.
+type PayoutTargetAccount struct {
+	Account string
+}
+
+func (PayoutTargetAccount) isPayoutTarget() {}
+`))
+	})
+
+	It("returns nothing if file has no sum types", func() {
+		f.MessageType[0].OneofDecl[0].Options = nil
+
+		path, _, err := SumTypes(f, messages)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(BeEmpty())
+	})
+
+	It("returns an error if models directory is unknown", func() {
+		f.Options = &descriptorpb.FileOptions{}
+
+		_, _, err := SumTypes(f, messages)
+		Expect(err).To(MatchError("api/payout.proto: sum types are generated into models directory, set (transformer.go_models_file_path) or (transformer.go_models_dir) option"))
+	})
+})
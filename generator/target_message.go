@@ -0,0 +1,385 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options/gogoproto"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// protoTypes holds Go types of messages and enums of files of the request by
+// FQTN, such as ".svc.example.v2.Product", see CollectAllMessages. Messages
+// with transformer.target_message option are transformed into such types.
+var protoTypes = map[string]protoType{}
+
+// protoType is Go type generated for proto message or enum.
+type protoType struct {
+	// Go package name and import path of the type.
+	pkg        string
+	importPath string
+	// Name of Go type, e.g. Order_Item for nested message.
+	goName string
+	// Message descriptor, nil for enums.
+	desc *descriptorpb.DescriptorProto
+	// Enum descriptor, nil for messages.
+	enum *descriptorpb.EnumDescriptorProto
+	// Name of .proto file of the type.
+	file string
+}
+
+// qualified returns name of Go type with package, e.g. v2.Product.
+func (pt protoType) qualified() string {
+	return pt.pkg + "." + pt.goName
+}
+
+// scalarGoTypes contains Go types of scalar proto fields.
+var scalarGoTypes = map[descriptorpb.FieldDescriptorProto_Type]string{
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:   "float64",
+	descriptorpb.FieldDescriptorProto_TYPE_FLOAT:    "float32",
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:    "int64",
+	descriptorpb.FieldDescriptorProto_TYPE_SINT64:   "int64",
+	descriptorpb.FieldDescriptorProto_TYPE_SFIXED64: "int64",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64:   "uint64",
+	descriptorpb.FieldDescriptorProto_TYPE_FIXED64:  "uint64",
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:    "int32",
+	descriptorpb.FieldDescriptorProto_TYPE_SINT32:   "int32",
+	descriptorpb.FieldDescriptorProto_TYPE_SFIXED32: "int32",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32:   "uint32",
+	descriptorpb.FieldDescriptorProto_TYPE_FIXED32:  "uint32",
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:     "bool",
+	descriptorpb.FieldDescriptorProto_TYPE_STRING:   "string",
+}
+
+// collectProtoTypes returns Go types of messages and enums of files, see
+// protoTypes.
+func collectProtoTypes(files []*descriptorpb.FileDescriptorProto) map[string]protoType {
+	out := map[string]protoType{}
+
+	for _, f := range files {
+		pkg, ip := goPackage(f.GetOptions())
+		prefix := "."
+		if f.GetPackage() != "" {
+			prefix += f.GetPackage() + "."
+		}
+
+		add := func(name string, desc *descriptorpb.DescriptorProto, enum *descriptorpb.EnumDescriptorProto) {
+			out[prefix+name] = protoType{
+				pkg:        pkg,
+				importPath: ip,
+				goName:     strings.Replace(name, ".", "_", -1),
+				desc:       desc,
+				enum:       enum,
+				file:       f.GetName(),
+			}
+		}
+
+		for _, ed := range f.GetEnumType() {
+			add(ed.GetName(), nil, ed)
+		}
+
+		for _, fm := range fileMessages(f.MessageType, "") {
+			add(fm.name, fm.desc, nil)
+			for _, ed := range fm.desc.GetEnumType() {
+				add(fm.name+"."+ed.GetName(), nil, ed)
+			}
+		}
+	}
+
+	return out
+}
+
+// resolveTargetMessages points messages of files with
+// transformer.target_message option to Go types of target messages: the
+// option is replaced by go_struct option, e.g. "v2.Product", and fields get
+// transformer.map_to option with Go names of target fields, so messages are
+// processed as if target messages were models.
+func resolveTargetMessages(files []*descriptorpb.FileDescriptorProto, types map[string]protoType) error {
+	for _, f := range files {
+		for _, fm := range fileMessages(f.MessageType, "") {
+			tm, _ := getStringOption(fm.desc.GetOptions(), options.E_TargetMessage)
+			if tm == "" {
+				continue
+			}
+
+			if sn, _ := getStringOption(fm.desc.GetOptions(), options.E_GoStruct); sn != "" {
+				return fmt.Errorf("%s: message %s: options (%s) and (%s) can not be used together",
+					f.GetName(), fm.name, options.E_GoStruct.Name, options.E_TargetMessage.Name)
+			}
+
+			target, ok := types["."+strings.TrimPrefix(tm, ".")]
+			if !ok || target.desc == nil {
+				return fmt.Errorf("%s: message %s: option (%s): message %s not found; hint: import .proto file of the message",
+					f.GetName(), fm.name, options.E_TargetMessage.Name, tm)
+			}
+
+			if target.importPath == "" {
+				return fmt.Errorf("%s: message %s: option (%s): import path of Go package of message %s is unknown; hint: set full go_package option of file %s",
+					f.GetName(), fm.name, options.E_TargetMessage.Name, tm, target.file)
+			}
+
+			proto.SetExtension(fm.desc.Options, options.E_GoStruct, target.qualified())
+
+			if err := mapTargetFields(fm.desc, target.desc); err != nil {
+				return fmt.Errorf("%s: message %s: %s", f.GetName(), fm.name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// mapTargetFields sets transformer.map_to option of fields of message m to
+// Go names of fields of target message, which are pointed by
+// transformer.target_field option or have the same names. Fields which
+// already have map_to option are not changed.
+func mapTargetFields(m, target *descriptorpb.DescriptorProto) error {
+	names := map[string]*descriptorpb.FieldDescriptorProto{}
+	for _, fdp := range target.GetField() {
+		names[fdp.GetName()] = fdp
+	}
+
+	for _, fdp := range m.GetField() {
+		if mapTo, _ := getStringOption(fdp.Options, options.E_MapTo); mapTo != "" {
+			continue
+		}
+
+		name := fdp.GetName()
+		tf, _ := getStringOption(fdp.Options, options.E_TargetField)
+		if tf != "" {
+			name = tf
+		}
+
+		t, ok := names[name]
+		if !ok {
+			if tf != "" {
+				return fmt.Errorf("field %s: option (%s): field %s not found in message %s", fdp.GetName(), options.E_TargetField.Name, tf, target.GetName())
+			}
+			continue
+		}
+
+		if fdp.Options == nil {
+			fdp.Options = &descriptorpb.FieldOptions{}
+		}
+		proto.SetExtension(fdp.Options, options.E_MapTo, goFieldName(t))
+	}
+
+	return nil
+}
+
+// goFieldName returns name of Go structure field generated for proto field
+// fdp, including gogoproto.customname option.
+func goFieldName(fdp *descriptorpb.FieldDescriptorProto) string {
+	if n := gogoproto.GetCustomName(fdp); n != "" {
+		return n
+	}
+
+	return camelCase(fdp.GetName())
+}
+
+// camelCase returns name of Go structure field for proto field name as
+// protoc-gen-go and gogo generate it: underscores followed by lower case
+// letters are dropped, words start with upper case letters, e.g.
+// _my_field_name_2 becomes XMyFieldName_2.
+func camelCase(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	t := make([]byte, 0, len(name)+1)
+	i := 0
+	if name[0] == '_' {
+		t = append(t, 'X')
+		i++
+	}
+
+	for ; i < len(name); i++ {
+		c := name[i]
+		if c == '_' && i+1 < len(name) && isLower(name[i+1]) {
+			continue
+		}
+		if '0' <= c && c <= '9' {
+			t = append(t, c)
+			continue
+		}
+		if isLower(c) {
+			c ^= ' '
+		}
+		t = append(t, c)
+		for i+1 < len(name) && isLower(name[i+1]) {
+			i++
+			t = append(t, name[i])
+		}
+	}
+
+	return string(t)
+}
+
+// isLower returns true if c is ASCII lower case letter.
+func isLower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+// targetStructures adds structures of target messages of messages msgs, see
+// transformer.target_message, into structs with names of go_struct options.
+// Import specs of Go packages of target messages are returned.
+func targetStructures(msgs []fileMessage, structs source.StructureList) []string {
+	pkgs := map[string]string{}
+
+	for _, fm := range msgs {
+		tm, _ := getStringOption(fm.desc.GetOptions(), options.E_TargetMessage)
+		target, ok := protoTypes["."+strings.TrimPrefix(tm, ".")]
+		if tm == "" || !ok || target.desc == nil {
+			continue
+		}
+
+		structs[target.qualified()] = messageStructure(target.desc)
+		pkgs[target.pkg] = target.importPath
+	}
+
+	specs := []string{}
+	for name, ip := range pkgs {
+		specs = append(specs, name+" "+strconv.Quote(ip))
+	}
+	sort.Strings(specs)
+
+	return specs
+}
+
+// hasTargetMessages returns true if any of messages msgs has
+// transformer.target_message option.
+func hasTargetMessages(msgs []fileMessage) bool {
+	for _, fm := range msgs {
+		if tm, _ := getStringOption(fm.desc.GetOptions(), options.E_TargetMessage); tm != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// messageStructure returns fields of Go structure generated for message m by
+// their Go names. Fields of oneofs and fields which types are not supported
+// are left out.
+func messageStructure(m *descriptorpb.DescriptorProto) source.Structure {
+	s := source.Structure{}
+
+	for _, fdp := range m.GetField() {
+		if fdp.OneofIndex != nil && !proto3Optional(fdp) {
+			continue
+		}
+
+		fi, ok := protoFieldInfo(fdp)
+		if !ok {
+			continue
+		}
+
+		fi.Tag = fmt.Sprintf(`json:"%s,omitempty"`, fdp.GetName())
+		s[goFieldName(fdp)] = fi
+	}
+
+	return s
+}
+
+// protoFieldInfo returns Go type of proto field fdp as gogo generates it,
+// false if type is not supported, e.g. repeated bytes.
+func protoFieldInfo(fdp *descriptorpb.FieldDescriptorProto) (source.FieldInfo, bool) {
+	repeated := fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+
+	switch fdp.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return source.FieldInfo{Type: "byte", IsSlice: true}, !repeated
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		t, ok := protoTypes[fdp.GetTypeName()]
+		return source.FieldInfo{Type: t.qualified(), IsSlice: repeated, IsPointer: proto3Optional(fdp)}, ok
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, descriptorpb.FieldDescriptorProto_TYPE_GROUP:
+	default:
+		t, ok := scalarGoTypes[fdp.GetType()]
+		return source.FieldInfo{Type: t, IsSlice: repeated, IsPointer: proto3Optional(fdp)}, ok
+	}
+
+	tn := fdp.GetTypeName()
+	if t, ok := protoTypes[tn]; ok && repeated && t.desc.GetOptions().GetMapEntry() {
+		var key, value source.FieldInfo
+		for _, f := range t.desc.GetField() {
+			fi, ok := protoFieldInfo(f)
+			if !ok {
+				return source.FieldInfo{}, false
+			}
+			if f.GetNumber() == 1 {
+				key = fi
+			} else {
+				value = fi
+			}
+		}
+		value.Key = key.Type
+
+		return value, true
+	}
+
+	fi := source.FieldInfo{IsSlice: repeated, IsPointer: gogoproto.IsNullable(fdp)}
+	switch {
+	case tn == ".google.protobuf.Timestamp" && gogoproto.IsStdTime(fdp):
+		fi.Type = "time.Time"
+	case tn == ".google.protobuf.Duration" && gogoproto.IsStdDuration(fdp):
+		fi.Type = "time.Duration"
+	case strings.HasPrefix(tn, ".google.protobuf."):
+		fi.Type = "types." + strings.TrimPrefix(tn, ".google.protobuf.")
+	default:
+		t, ok := protoTypes[tn]
+		if !ok {
+			return source.FieldInfo{}, false
+		}
+		fi.Type = t.qualified()
+	}
+
+	return fi, true
+}
+
+// targetEnumMapping returns pairs of values of proto enum typ and constants
+// of enum of another proto message, which model field gf of target message
+// has, see transformer.target_message. Values are matched by names without
+// prefixes of enum names, e.g. STOCK_STATUS_IN_STOCK with IN_STOCK. Nil is
+// returned if gf isn't a proto enum.
+func targetEnumMapping(typ string, gf source.FieldInfo) []EnumValue {
+	src := protoTypes[typ]
+	if src.enum == nil {
+		return nil
+	}
+
+	var target protoType
+	for _, pt := range protoTypes {
+		if pt.enum != nil && pt.qualified() == gf.Type {
+			target = pt
+		}
+	}
+	if target.enum == nil {
+		return nil
+	}
+
+	constants := map[string]string{}
+	for _, v := range target.enum.GetValue() {
+		constants[enumValueName(target.enum, v)] = target.pkg + "." + enumValuePrefix(target.goName) + "_" + v.GetName()
+	}
+
+	mapping := []EnumValue{}
+	for _, v := range src.enum.GetValue() {
+		if c, ok := constants[enumValueName(src.enum, v)]; ok {
+			mapping = append(mapping, EnumValue{Proto: v.GetName(), Model: c})
+		}
+	}
+
+	return mapping
+}
+
+// enumValueName returns name of value v of enum e without prefix of enum
+// name, e.g. IN_STOCK for STOCK_STATUS_IN_STOCK value of StockStatus.
+func enumValueName(e *descriptorpb.EnumDescriptorProto, v *descriptorpb.EnumValueDescriptorProto) string {
+	return strings.TrimPrefix(v.GetName(), strcase.ToScreamingSnake(e.GetName())+"_")
+}
@@ -0,0 +1,137 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Target messages", func() {
+
+	typEnum := descriptorpb.FieldDescriptorProto_TYPE_ENUM
+	typBytes := descriptorpb.FieldDescriptorProto_TYPE_BYTES
+
+	enum := func(name string, values ...string) *descriptorpb.EnumDescriptorProto {
+		e := &descriptorpb.EnumDescriptorProto{Name: sp(name)}
+		for i, v := range values {
+			e.Value = append(e.Value, &descriptorpb.EnumValueDescriptorProto{Name: sp(v), Number: proto.Int32(int32(i))})
+		}
+		return e
+	}
+
+	var files []*descriptorpb.FileDescriptorProto
+	var saved map[string]protoType
+
+	BeforeEach(func() {
+		v2 := &descriptorpb.FileDescriptorProto{
+			Name:     sp("v2/catalog.proto"),
+			Package:  sp("svc.v2"),
+			Options:  &descriptorpb.FileOptions{GoPackage: sp("example.com/api/v2;v2")},
+			EnumType: []*descriptorpb.EnumDescriptorProto{enum("Availability", "AVAILABILITY_UNKNOWN", "IN_STOCK", "DISCONTINUED")},
+			MessageType: []*descriptorpb.DescriptorProto{
+				{Name: sp("Size")},
+				{
+					Name: sp("Product"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: sp("id"), Type: &typInt64},
+						{Name: sp("display_name"), Type: &typString},
+						{Name: sp("tags"), Type: &typString, Label: &typRepeated},
+						{Name: sp("size"), Type: &typMessage, TypeName: sp(".svc.v2.Size")},
+						{Name: sp("availability"), Type: &typEnum, TypeName: sp(".svc.v2.Availability")},
+						{Name: sp("released_at"), Type: &typMessage, TypeName: sp(".google.protobuf.Timestamp")},
+						{Name: sp("blobs"), Type: &typBytes, Label: &typRepeated},
+					},
+				},
+			},
+		}
+
+		titleOptions := &descriptorpb.FieldOptions{}
+		proto.SetExtension(titleOptions, options.E_TargetField, "display_name")
+		productOptions := &descriptorpb.MessageOptions{}
+		proto.SetExtension(productOptions, options.E_TargetMessage, "svc.v2.Product")
+
+		v1 := &descriptorpb.FileDescriptorProto{
+			Name:     sp("v1/catalog.proto"),
+			Package:  sp("svc.v1"),
+			Options:  &descriptorpb.FileOptions{GoPackage: sp("example.com/api/v1;v1")},
+			EnumType: []*descriptorpb.EnumDescriptorProto{enum("StockStatus", "STOCK_STATUS_UNKNOWN", "STOCK_STATUS_IN_STOCK", "STOCK_STATUS_SOLD_OUT")},
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name:    sp("Product"),
+				Options: productOptions,
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: sp("id"), Type: &typInt64},
+					{Name: sp("title"), Type: &typString, Options: titleOptions},
+					{Name: sp("comment"), Type: &typString},
+				},
+			}},
+		}
+
+		files = []*descriptorpb.FileDescriptorProto{v2, v1}
+
+		saved = protoTypes
+		protoTypes = collectProtoTypes(files)
+	})
+
+	AfterEach(func() {
+		protoTypes = saved
+	})
+
+	It("collects Go types of messages and enums", func() {
+		Expect(protoTypes).To(HaveKey(".svc.v2.Product"))
+		Expect(protoTypes[".svc.v2.Product"].qualified()).To(Equal("v2.Product"))
+		Expect(protoTypes[".svc.v2.Product"].importPath).To(Equal("example.com/api/v2"))
+		Expect(protoTypes[".svc.v1.StockStatus"].enum).NotTo(BeNil())
+	})
+
+	It("points messages with target_message option to target messages", func() {
+		Expect(resolveTargetMessages(files, protoTypes)).To(Succeed())
+
+		m := files[1].MessageType[0]
+		Expect(extractStructNameOption(m)).To(Equal("v2.Product"))
+		Expect(getStringOption(m.Field[0].Options, options.E_MapTo)).To(Equal("Id"))
+		Expect(getStringOption(m.Field[1].Options, options.E_MapTo)).To(Equal("DisplayName"))
+		Expect(m.Field[2].Options).To(BeNil())
+	})
+
+	It("returns error if target message or field is not found", func() {
+		m := files[1].MessageType[0]
+		proto.SetExtension(m.Options, options.E_TargetMessage, "svc.v2.Missing")
+		Expect(resolveTargetMessages(files, protoTypes)).To(MatchError("v1/catalog.proto: message Product: option (transformer.target_message): message svc.v2.Missing not found; hint: import .proto file of the message"))
+
+		proto.SetExtension(m.Options, options.E_TargetMessage, "svc.v2.Product")
+		proto.SetExtension(m.Field[1].Options, options.E_TargetField, "name")
+		Expect(resolveTargetMessages(files, protoTypes)).To(MatchError("v1/catalog.proto: message Product: field title: option (transformer.target_field): field name not found in message Product"))
+	})
+
+	It("returns error if go_struct option is set too", func() {
+		m := files[1].MessageType[0]
+		proto.SetExtension(m.Options, options.E_GoStruct, "Product")
+		Expect(resolveTargetMessages(files, protoTypes)).To(MatchError("v1/catalog.proto: message Product: options (transformer.go_struct) and (transformer.target_message) can not be used together"))
+	})
+
+	It("returns Go structure of target message", func() {
+		structs := source.StructureList{}
+		Expect(targetStructures([]fileMessage{{name: "Product", desc: files[1].MessageType[0]}}, structs)).
+			To(Equal([]string{`v2 "example.com/api/v2"`}))
+
+		Expect(structs["v2.Product"]).To(Equal(source.Structure{
+			"Id":           {Type: "int64", Tag: `json:"id,omitempty"`},
+			"DisplayName":  {Type: "string", Tag: `json:"display_name,omitempty"`},
+			"Tags":         {Type: "string", IsSlice: true, Tag: `json:"tags,omitempty"`},
+			"Size":         {Type: "v2.Size", IsPointer: true, Tag: `json:"size,omitempty"`},
+			"Availability": {Type: "v2.Availability", Tag: `json:"availability,omitempty"`},
+			"ReleasedAt":   {Type: "types.Timestamp", IsPointer: true, Tag: `json:"released_at,omitempty"`},
+		}))
+	})
+
+	It("maps enum values by names without prefixes", func() {
+		Expect(targetEnumMapping(".svc.v1.StockStatus", source.FieldInfo{Type: "v2.Availability"})).To(Equal([]EnumValue{
+			{Proto: "STOCK_STATUS_UNKNOWN", Model: "v2.Availability_AVAILABILITY_UNKNOWN"},
+			{Proto: "STOCK_STATUS_IN_STOCK", Model: "v2.Availability_IN_STOCK"},
+		}))
+		Expect(targetEnumMapping(".svc.v1.StockStatus", source.FieldInfo{Type: "string"})).To(BeNil())
+	})
+})
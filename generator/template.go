@@ -1,8 +1,10 @@
 package generator
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"text/template"
 	"text/template/parse"
@@ -25,11 +27,25 @@ func mt(name, tpl string, inc ...*template.Template) *template.Template {
 
 var (
 	funcMap = template.FuncMap{
-		"formatField":          formatField,
-		"formatOneofInitField": formatOneofInitField,
+		"formatField":           formatField,
+		"formatPromotedField":   formatPromotedField,
+		"formatEdgeField":       formatEdgeField,
+		"formatOneofInitField":  formatOneofInitField,
+		"formatFieldNames":      formatFieldNames,
+		"formatJSONNames":       formatJSONNames,
+		"flatFields":            flatFields,
+		"formatElemField":       formatElemField,
+		"formatWrapperField":    formatWrapperField,
+		"formatCallField":       formatCallField,
+		"formatHelperCallField": formatHelperCallField,
+		"formatEmptySliceField": formatEmptySliceField,
+		"formatOneofCases":      formatOneofCases,
+		"nameFields":            nameFields,
+		"formatEnumMappings":    formatEnumMappings,
+		"schemaHash":            schemaHash,
 	}
 
-	funcNameT = mt("FuncName", `{{- .SrcFn }}To{{ .DstFn }}`)
+	funcNameT = mt("FuncName", `{{- if .Func }}{{ .Func }}{{ else }}{{ .SrcFn }}To{{ .DstFn }}{{ end }}`)
 	srcParamT = mt("SrcParam", `{{- if .SrcPref }}{{- .SrcPref }}.{{ end }}{{ .Src }}, opts ...TransformParam`)
 	dstParamT = mt("DstParam", `{{- if .DstPref }}{{- .DstPref }}.{{ end }}{{ .Dst }}`)
 	ptrValT   = mt("PtrValName", `{{- if .Swapped -}} ValPtr {{- else -}} PtrVal {{- end }}`)
@@ -37,314 +53,2772 @@ var (
 	ptrOnlyT  = mt("ptrOnly", `{{ if .Ptr -}} Ptr {{- end }}`)
 	starT     = mt("star", `{{ if .Ptr -}} * {{- end }}`)
 
-	ptr2ptrT = mt("ptr2ptr", `func {{ template "FuncName" . }}Ptr(src *{{ template "SrcParam" . }}) *{{ template "DstParam" . }} {
+	// Name of reverse transformer, e.g. ProductToPb for PbToProduct.
+	reverseFuncNameT = mt("ReverseFuncName", `{{- if .ReverseFunc }}{{ .ReverseFunc }}{{ else }}{{ .DstFn }}To{{ .SrcFn }}{{ end }}`)
+
+	// Result types and return values of transformers which return error,
+	// see transformer.with_errors.
+	errOpenT  = mt("errOpen", `{{ if .WithErrors }}({{ end }}`)
+	errCloseT = mt("errClose", `{{ if .WithErrors }}, error){{ end }}`)
+	errNilT   = mt("errNil", `{{ if .WithErrors }}, nil{{ end }}`)
+
+	// Context parameter and argument of transformers which accept context,
+	// see transformer.with_context.
+	ctxParamT = mt("ctxParam", `{{ if .WithContext }}ctx context.Context, {{ end }}`)
+	ctxArgT   = mt("ctxArg", `{{ if .WithContext }}ctx, {{ end }}`)
+
+	ptr2ptrT = mt("ptr2ptr", `func {{ template "FuncName" . }}Ptr({{ template "ctxParam" . }}src *{{ template "SrcParam" . }}) {{ template "errOpen" . }}*{{ template "DstParam" . }}{{ template "errClose" . }} {
 	if src == nil {
-		return nil
+		return nil{{ template "errNil" . }}
+	}
+{{ if .WithErrors }}
+	d, err := {{ template "FuncName" . }}({{ template "ctxArg" . }}*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+{{- else }}
+	d := {{ template "FuncName" . }}({{ template "ctxArg" . }}*src, opts...)
+	return &d
+{{- end }}
+}`, funcNameT, srcParamT, dstParamT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	ptr2valT = mt("ptr2val", `func {{ template "FuncName" . }}PtrVal({{ template "ctxParam" . }}src *{{ template "SrcParam" . }}) {{ template "errOpen" . }}{{ template "DstParam" . }}{{ template "errClose" . }} {
+	if src == nil {
+		return {{ template "DstParam" . }}{}{{ template "errNil" . }}
+	}
+
+	return {{ template "FuncName" . }}({{ template "ctxArg" . }}*src, opts...)
+}`, funcNameT, srcParamT, dstParamT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	val2ptrT = mt("val2ptr", `func {{ template "FuncName" . }}ValPtr({{ template "ctxParam" . }}src {{ template "SrcParam" . }}) {{ template "errOpen" . }}*{{ template "DstParam" . }}{{ template "errClose" . }} {
+{{- if .WithErrors }}
+	d, err := {{ template "FuncName" . }}({{ template "ctxArg" . }}src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+{{- else }}
+	d := {{ template "FuncName" . }}({{ template "ctxArg" . }}src, opts...)
+	return &d
+{{- end }}
+}`, funcNameT, srcParamT, dstParamT, errOpenT, errCloseT, ctxParamT, ctxArgT)
+
+	// Entries of composite literal of destination structure and statements
+	// which set the rest of its fields, s is a destination structure. They
+	// are shared by transformers and in-place transformers, see intoT.
+	fieldsLitT = mt("fieldsLit", `{{- with $R := . }}
+			{{- range $f := .Fields}}
+			{{- if not (or $f.Elem $f.Wrapper $f.Dep $f.WithError $f.WithContext $f.HelperCall $f.Case $f.Edge (and $f.Promoted (not $R.Swapped))) }}
+			{{ formatField $f $R.Swapped $R.DstPref }}
+			{{- end }}
+			{{- end -}}
+		{{- end }}`)
+
+	fieldsStmtT = mt("fieldsStmt", `{{- with $R := . }}
+{{ range $f := .Fields }}
+{{ formatOneofInitField $f $R.Swapped }}
+{{- end -}}
+{{ range $f := .Fields }}
+{{- if $f.Elem }}
+{{ formatElemField $f $R }}
+{{- end }}
+{{- if $f.Wrapper }}
+{{ formatWrapperField $f $R }}
+{{- end }}
+{{- if or $f.WithError $f.WithContext }}
+{{ formatCallField $f $R }}
+{{- end }}
+{{- if $f.HelperCall }}
+{{ formatHelperCallField $f $R }}
+{{- end }}
+{{- if and $f.Promoted (not $R.Swapped) (not (or $f.Elem $f.Wrapper $f.Dep $f.WithError $f.WithContext $f.HelperCall $f.Case)) }}
+{{ formatPromotedField $f $R }}
+{{- end }}
+{{- if and $f.Edge (not (or $f.Elem $f.Wrapper $f.Dep $f.WithError $f.WithContext $f.HelperCall $f.Case)) }}
+{{ formatEdgeField $f $R }}
+{{- end }}
+{{- with $f.Case }}
+{{- with formatOneofCases $f $R }}
+{{ . }}
+{{- end }}
+{{- end }}
+{{- if and $R.EmptySliceOnNil $f.EmptySlice }}
+{{ formatEmptySliceField $f $R }}
+{{- end }}
+{{- end -}}
+{{- end }}`)
+
+	// Entries of composite literal of destination structure which keep
+	// reused slices and maps of existing structure, see Data.Reused.
+	reusedLitT = mt("reusedLit", `{{- range .Reused }}
+		{{ .Name }}: dst.{{ .Name }}{{ if not .Map }}[:0]{{ end }},
+		{{- end }}`)
+
+	val2valT = mt("val2val", `func {{ template "FuncName" . }}({{ template "ctxParam" . }}src {{ template "SrcParam" . }}) {{ template "errOpen" . }}{{ template "DstParam" . }}{{ template "errClose" . }} {
+	s := {{ template "DstParam" . }}{ {{- template "fieldsLit" . }}
+	}
+
+	applyOptions(opts...)
+{{- template "fieldsStmt" . }}
+	return s{{ template "errNil" . }}
+}`, funcNameT, srcParamT, dstParamT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT, fieldsLitT, fieldsStmtT)
+
+	lst2lstT = mt("lst2lst", `func {{ template "FuncName" . }}{{ template "ptr" . }}List({{ template "ctxParam" . }}src []{{ template "star" . }}{{ template "SrcParam" . }}) {{ template "errOpen" . }}[]{{ template "star" . }}{{ template "DstParam" . }}{{ template "errClose" . }} {
+	resp := make([]{{ template "star" . }}{{ template "DstParam" . }}, len(src))
+
+	for i, s := range src {
+		{{- if .WithErrors }}
+		d, err := {{ template "FuncName" . }}{{ template "ptrOnly" . }}({{ template "ctxArg" . }}s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+		{{- else }}
+		resp[i] = {{ template "FuncName" . }}{{ template "ptrOnly" . }}({{ template "ctxArg" . }}s, opts...)
+		{{- end }}
+	}
+
+	return resp{{ template "errNil" . }}
+}`, funcNameT, ptrT, srcParamT, starT, dstParamT, ptrOnlyT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	ptrlst2ptrlstT = mt("ptrlst2ptrlst", `{{ template "lst2lst" .P true }}`, lst2lstT, funcNameT, ptrT, starT, srcParamT, dstParamT, ptrOnlyT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	vallst2vallstT = mt("vallst2vallst", `{{ template "lst2lst" . }}`, lst2lstT, funcNameT, ptrT, starT, srcParamT, dstParamT, ptrOnlyT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	// Lists of pointers are transformed into lists of values without nil
+	// elements, lists of values are transformed into lists of pointers to
+	// transformed elements. Name of the function depends on element pointers
+	// only, so the template renders both PtrVal and ValPtr lists.
+	ptrlst2vallstT = mt("ptrlst2vallst", `func {{ template "FuncName" . }}{{ if .SrcPointer }}PtrVal{{ else }}ValPtr{{ end }}List({{ template "ctxParam" . }}src []{{ .SrcPointer }}{{ template "SrcParam" . }}) {{ template "errOpen" . }}[]{{ .DstPointer }}{{ template "DstParam" . }}{{ template "errClose" . }} {
+{{- if .SrcPointer }}
+	resp := make([]{{ .DstPointer }}{{ template "DstParam" . }}, 0, len(src))
+
+	for {{ if .WithErrors }}i{{ else }}_{{ end }}, s := range src {
+		if s == nil {
+			continue
+		}
+		{{- if .WithErrors }}
+		g, err := {{ template "FuncName" . }}({{ template "ctxArg" . }}*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+		{{- else }}
+		resp = append(resp, {{ template "FuncName" . }}({{ template "ctxArg" . }}*s, opts...))
+		{{- end }}
+	}
+{{- else }}
+	resp := make([]{{ .DstPointer }}{{ template "DstParam" . }}, len(src))
+
+	for i, s := range src {
+		{{- if .WithErrors }}
+		g, err := {{ template "FuncName" . }}({{ template "ctxArg" . }}s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		{{- else }}
+		g := {{ template "FuncName" . }}({{ template "ctxArg" . }}s, opts...)
+		{{- end }}
+		resp[i] = &g
+	}
+{{- end }}
+
+	return resp{{ template "errNil" . }}
+}`, funcNameT, srcParamT, dstParamT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	vallst2ptrlstT = mt("vallst2ptrlst", `{{ template "ptrlst2vallst" .FlipPointers }}`, ptrlst2vallstT, funcNameT, srcParamT, dstParamT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	ptr2vallstT = mt("ptr2vallst", `// {{ template "FuncName" . }}List is DEPRECATED. Use {{ template "FuncName" . }}{{ template "PtrValName" . }}List instead.
+func {{ template "FuncName" . }}List({{ template "ctxParam" . }}src []{{ .SrcPointer }}{{ template "SrcParam" . }}) {{ template "errOpen" . }}[]{{ .DstPointer }}{{ template "DstParam" . }}{{ template "errClose" . }} {
+	return {{ template "FuncName" . }}{{ template "PtrValName" . }}List({{ template "ctxArg" . }}src, opts...)
+}`, funcNameT, ptrValT, srcParamT, dstParamT, errOpenT, errCloseT, ctxParamT, ctxArgT)
+
+	srcTypeT = mt("SrcType", `{{- if .SrcPref }}{{- .SrcPref }}.{{ end }}{{ .Src }}`)
+
+	fieldNamesT = mt("fieldNames", `// {{ template "FuncName" . }}FieldNames maps {{ template "SrcType" . }} field names to {{ template "DstParam" . }} field names.
+var {{ template "FuncName" . }}FieldNames = map[string]string{
+{{- with $R := . }}
+	{{- range $f := nameFields .Fields $R.Swapped }}
+	{{ formatFieldNames $f $R.Swapped }}
+	{{- end }}
+{{- end }}
+}`, funcNameT, srcTypeT, dstParamT)
+
+	jsonNamesT = mt("jsonNames", `// {{ template "FuncName" . }}JSONNames maps {{ template "SrcType" . }} JSON field names to {{ template "DstParam" . }} JSON field names.
+var {{ template "FuncName" . }}JSONNames = map[string]string{
+{{- with $R := . }}
+	{{- range $f := nameFields .Fields $R.Swapped }}
+	{{- if ne $f.GoJSONName "-" }}
+	{{ formatJSONNames $f $R.Swapped }}
+	{{- end }}
+	{{- end }}
+{{- end }}
+}`, funcNameT, srcTypeT, dstParamT)
+
+	schemaHashT = mt("schemaHash", `// {{ template "FuncName" . }}SchemaHash is a hash of fields mapping between {{ template "SrcType" . }} and {{ template "DstParam" . }}.
+// It changes when mapped fields or their types are changed.
+const {{ template "FuncName" . }}SchemaHash = "{{ schemaHash .Fields }}"`, funcNameT, srcTypeT, dstParamT)
+
+	verifyT = mt("verify", `// {{ template "DstParam" . }} fields used by {{ template "FuncName" . }} are checked by VerifyTransformers.
+var _ = registerVerifier(reflect.TypeOf({{ template "DstParam" . }}{}), [][2]string{
+{{- range .ModelFields }}
+	{"{{ .Name }}", "{{ .Type }}"},
+{{- end }}
+})`, funcNameT, dstParamT)
+
+	patchT = mt("patch", `// {{ .Dst }}Patch contains {{ template "DstParam" . }} fields which are present in {{ template "SrcType" . }}, nil field is not present.
+type {{ .Dst }}Patch struct {
+{{- range .Patch }}
+	{{ .Name }} {{ .Type }}
+{{- end }}
+}
+
+// {{ template "FuncName" . }}Patch returns {{ .Dst }}Patch with fields which are present in src. Message
+// fields are present if they are not nil, repeated, string and bytes fields if
+// they are not empty, other scalar fields if they have non-zero values.
+func {{ template "FuncName" . }}Patch({{ template "ctxParam" . }}src {{ .SrcPointer }}{{ template "SrcParam" . }}) {{ template "errOpen" . }}{{ .Dst }}Patch{{ template "errClose" . }} {
+	patch := {{ .Dst }}Patch{}
+	if src == nil {
+		return patch{{ template "errNil" . }}
+	}
+{{ if .WithErrors }}
+	m, err := {{ template "FuncName" . }}Ptr({{ template "ctxArg" . }}src, opts...)
+	if err != nil {
+		return patch, err
+	}
+{{- else }}
+	m := {{ template "FuncName" . }}Ptr({{ template "ctxArg" . }}src, opts...)
+{{- end }}
+{{- range .Patch }}
+	{{- if .Cond }}
+	if {{ .Cond }} {
+		patch.{{ .Name }} = {{ if .Ref }}&{{ end }}m.{{ .Name }}
+	}
+	{{- else }}
+	patch.{{ .Name }} = {{ if .Ref }}&{{ end }}m.{{ .Name }}
+	{{- end }}
+{{- end }}
+
+	return patch{{ template "errNil" . }}
+}
+
+// Apply sets dst fields which are present in patch.
+func (p {{ .Dst }}Patch) Apply(dst *{{ template "DstParam" . }}) {
+{{- range .Patch }}
+	if p.{{ .Name }} != nil {
+		dst.{{ .Name }} = {{ if .Ref }}*{{ end }}p.{{ .Name }}
+	}
+{{- end }}
+}
+
+// ApplyChanges sets dst fields which are present in patch and returns names
+// of dst fields which values are changed, e.g. for audit logs.
+func (p {{ .Dst }}Patch) ApplyChanges(dst *{{ template "DstParam" . }}) []string {
+	var changed []string
+{{- range .Patch }}
+	if p.{{ .Name }} != nil && {{ .Changed }} {
+		dst.{{ .Name }} = {{ if .Ref }}*{{ end }}p.{{ .Name }}
+		changed = append(changed, "{{ .Name }}")
+	}
+{{- end }}
+
+	return changed
+}`, funcNameT, srcTypeT, srcParamT, dstParamT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	maskedT = mt("masked", `// Apply{{ template "FuncName" . }}Masked sets fields of dst which are listed in paths of field mask to
+// values of src fields, other fields are not changed. Paths are proto field names,
+// fields of embedded messages are addressed by dot-separated paths, e.g.
+// "address.city". Nil src sets listed fields to zero values. An error is returned
+// for unknown paths.
+func Apply{{ template "FuncName" . }}Masked({{ template "ctxParam" . }}src *{{ template "SrcType" . }}, dst *{{ template "DstParam" . }}, mask *{{ .WrappersPackage }}.FieldMask, opts ...TransformParam) error {
+{{- if .WithErrors }}
+	m, err := {{ template "FuncName" . }}PtrVal({{ template "ctxArg" . }}src, opts...)
+	if err != nil {
+		return err
+	}
+{{- else }}
+	m := {{ template "FuncName" . }}PtrVal({{ template "ctxArg" . }}src, opts...)
+{{- end }}
+
+	for _, path := range mask.GetPaths() {
+		switch path {
+{{- range .Masked }}
+		case "{{ .Path }}":
+	{{- range .Fields }}
+			dst.{{ .Name }} = m.{{ .Name }}
+	{{- end }}
+{{- end }}
+		default:
+			return fmt.Errorf("field mask path %q is not a field of {{ template "SrcType" . }}", path)
+		}
+	}
+
+	return nil
+}
+
+// Apply{{ template "FuncName" . }}MaskedChanges sets fields of dst which are listed in paths of field
+// mask, like Apply{{ template "FuncName" . }}Masked, and returns names of dst fields which values are
+// changed, e.g. for audit logs.
+func Apply{{ template "FuncName" . }}MaskedChanges({{ template "ctxParam" . }}src *{{ template "SrcType" . }}, dst *{{ template "DstParam" . }}, mask *{{ .WrappersPackage }}.FieldMask, opts ...TransformParam) ([]string, error) {
+{{- if .WithErrors }}
+	m, err := {{ template "FuncName" . }}PtrVal({{ template "ctxArg" . }}src, opts...)
+	if err != nil {
+		return nil, err
+	}
+{{- else }}
+	m := {{ template "FuncName" . }}PtrVal({{ template "ctxArg" . }}src, opts...)
+{{- end }}
+
+	var changed []string
+	for _, path := range mask.GetPaths() {
+		switch path {
+{{- range .Masked }}
+		case "{{ .Path }}":
+	{{- range .Fields }}
+			if {{ .Changed }} {
+				dst.{{ .Name }} = m.{{ .Name }}
+				changed = append(changed, "{{ .Name }}")
+			}
+	{{- end }}
+{{- end }}
+		default:
+			return nil, fmt.Errorf("field mask path %q is not a field of {{ template "SrcType" . }}", path)
+		}
+	}
+
+	return changed, nil
+}`, funcNameT, srcTypeT, dstParamT, ctxParamT, ctxArgT)
+
+	builderT = mt("builder", `// {{ .Src }}PbBuilder builds {{ template "SrcType" . }} out of {{ template "DstParam" . }} fields.
+type {{ .Src }}PbBuilder struct {
+	model {{ template "DstParam" . }}
+	sets  []func(*{{ template "DstParam" . }})
+}
+
+// New{{ .Src }}PbBuilder returns builder of {{ template "SrcType" . }}. Fields set by With methods
+// override fields of model passed to FromModel regardless of calls order.
+func New{{ .Src }}PbBuilder() *{{ .Src }}PbBuilder {
+	return &{{ .Src }}PbBuilder{}
+}
+
+// FromModel sets model which is used as a base for built message.
+func (b *{{ .Src }}PbBuilder) FromModel(m {{ template "DstParam" . }}) *{{ .Src }}PbBuilder {
+	b.model = m
+	return b
+}
+{{- $R := . }}
+{{- range .Builder }}
+
+// With{{ .Name }} sets {{ .Name }} field of model.
+func (b *{{ $R.Src }}PbBuilder) With{{ .Name }}(v {{ .Type }}) *{{ $R.Src }}PbBuilder {
+	b.sets = append(b.sets, func(m *{{ template "DstParam" $R }}) { m.{{ .Name }} = v })
+	return b
+}
+{{- end }}
+
+// Build returns message built out of model and fields set by With methods.
+func (b *{{ .Src }}PbBuilder) Build({{ template "ctxParam" . }}opts ...TransformParam) {{ template "errOpen" . }}{{ .SrcPointer }}{{ template "SrcType" . }}{{ template "errClose" . }} {
+	m := b.model
+	for _, set := range b.sets {
+		set(&m)
+	}
+
+	return {{ template "ReverseFuncName" . }}Ptr({{ template "ctxArg" . }}&m, opts...)
+}`, srcTypeT, dstParamT, reverseFuncNameT, errOpenT, errCloseT, ctxParamT, ctxArgT)
+
+	jsonT = mt("json", `{{- if not .NoForward }}
+// JSONTo{{ .DstFn }} decodes proto-JSON representation of {{ template "SrcType" . }} and
+// transforms it into {{ template "DstParam" . }}.
+func JSONTo{{ .DstFn }}({{ template "ctxParam" . }}data []byte, opts ...TransformParam) ({{ template "DstParam" . }}, error) {
+	var src {{ template "SrcType" . }}
+	if err := protojson.Unmarshal(data, protov1.MessageV2(&src)); err != nil {
+		return {{ template "DstParam" . }}{}, err
+	}
+
+	return {{ template "FuncName" . }}PtrVal({{ template "ctxArg" . }}&src, opts...){{ if not .WithErrors }}, nil{{ end }}
+}
+{{- end }}
+{{- if and (not .NoForward) (not .NoReverse) }}
+
+{{ end }}
+{{- if not .NoReverse -}}
+// {{ .DstFn }}ToJSON transforms {{ template "DstParam" . }} into {{ template "SrcType" . }} and encodes
+// it into proto-JSON.
+func {{ .DstFn }}ToJSON({{ template "ctxParam" . }}src {{ template "DstParam" . }}, opts ...TransformParam) ([]byte, error) {
+{{- if .WithErrors }}
+	m, err := {{ template "ReverseFuncName" . }}ValPtr({{ template "ctxArg" . }}src, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return protojson.Marshal(protov1.MessageV2(m))
+{{- else }}
+	return protojson.Marshal(protov1.MessageV2({{ template "ReverseFuncName" . }}ValPtr({{ template "ctxArg" . }}src, opts...)))
+{{- end }}
+}
+{{- end }}`, srcTypeT, dstParamT, funcNameT, ctxParamT, ctxArgT)
+
+	converterT = mt("converter", `// {{ template "FuncName" . }} transforms {{ template "SrcType" . }} into {{ template "DstParam" . }} with package-level transformer and converter options, fields of converter dependencies are set by their methods.
+func (c *Converter) {{ template "FuncName" . }}({{ template "ctxParam" . }}src {{ template "SrcParam" . }}) {{ template "errOpen" . }}{{ template "DstParam" . }}{{ template "errClose" . }} {
+{{- if .WithErrors }}
+	dst, err := {{ template "FuncName" . }}({{ template "ctxArg" . }}src, c.params(opts)...)
+	if err != nil {
+		return {{ template "DstParam" . }}{}, err
+	}
+{{- else }}
+	dst := {{ template "FuncName" . }}({{ template "ctxArg" . }}src, c.params(opts)...)
+{{- end }}
+{{- $R := . }}
+{{- range $f := .Fields }}
+{{- with $f.Dep }}
+{{- if and $R.Swapped .ReverseMethod }}
+	dst.{{ $f.ProtoName }} = c.{{ .Iface }}.{{ .ReverseMethod }}(src.{{ $f.Name }})
+{{- else if and (not $R.Swapped) .Method }}
+	dst.{{ $f.Name }} = c.{{ .Iface }}.{{ .Method }}(src.{{ $f.ProtoName }})
+{{- end }}
+{{- end }}
+{{- end }}
+
+	return dst{{ template "errNil" . }}
+}
+
+// {{ template "FuncName" . }}Ptr transforms {{ template "SrcType" . }} pointer into {{ template "DstParam" . }} pointer with converter options and dependencies.
+func (c *Converter) {{ template "FuncName" . }}Ptr({{ template "ctxParam" . }}src *{{ template "SrcParam" . }}) {{ template "errOpen" . }}*{{ template "DstParam" . }}{{ template "errClose" . }} {
+	if src == nil {
+		return nil{{ template "errNil" . }}
+	}
+{{ if .WithErrors }}
+	dst, err := c.{{ template "FuncName" . }}({{ template "ctxArg" . }}*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &dst, nil
+{{- else }}
+	dst := c.{{ template "FuncName" . }}({{ template "ctxArg" . }}*src, opts...)
+	return &dst
+{{- end }}
+}`, srcTypeT, srcParamT, dstParamT, funcNameT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	intoT = mt("into", `// {{ template "FuncName" . }}Into transforms {{ template "SrcType" . }} pointer into existing {{ template "DstParam" . }} field by field, so dst isn't allocated. Slices and maps of dst which are filled element by element are reused. Zero value is set if src is nil{{ if .WithErrors }}, dst is left partially set if transformation fails{{ end }}.
+func {{ template "FuncName" . }}Into({{ template "ctxParam" . }}src *{{ template "SrcType" . }}, dst *{{ template "DstParam" . }}, opts ...TransformParam){{ if .WithErrors }} error{{ end }} {
+{{- range .Reused }}
+{{- if .Map }}
+	for k := range dst.{{ .Name }} {
+		delete(dst.{{ .Name }}, k)
+	}
+{{- end }}
+{{- end }}
+	if src == nil {
+		*dst = {{ template "DstParam" . }}{ {{- template "reusedLit" . }}
+		{{- if .Reused }}
+		{{ end }}}
+		return{{ if .WithErrors }} nil{{ end }}
+	}
+
+	s := dst
+	*s = {{ template "DstParam" . }}{ {{- template "fieldsLit" . }}
+		{{- template "reusedLit" . }}
+	}
+
+	applyOptions(opts...)
+{{- .InPlace.FieldStatements }}
+{{- if .WithErrors }}
+
+	return nil
+{{- end }}
+}
+
+// {{ template "FuncName" . }}ListInto transforms {{ template "SrcType" . }} list into dst and returns resulting list, backing array of dst is reused if it has enough capacity{{ if .DstPointer }}, as well as structures which elements of dst point to{{ end }}.
+func {{ template "FuncName" . }}ListInto({{ template "ctxParam" . }}src []{{ .SrcPointer }}{{ template "SrcType" . }}, dst []{{ .DstPointer }}{{ template "DstParam" . }}, opts ...TransformParam) {{ template "errOpen" . }}[]{{ .DstPointer }}{{ template "DstParam" . }}{{ template "errClose" . }} {
+	if cap(dst) < len(src) {
+		dst = make([]{{ .DstPointer }}{{ template "DstParam" . }}, len(src))
+	}
+	dst = dst[:len(src)]
+
+	for i := range src {
+{{- if .DstPointer }}
+		if dst[i] == nil {
+			dst[i] = &{{ template "DstParam" . }}{}
+		}
+{{- end }}
+{{- if .WithErrors }}
+		if err := {{ template "FuncName" . }}Into({{ template "ctxArg" . }}{{ if not .SrcPointer }}&{{ end }}src[i], {{ if not .DstPointer }}&{{ end }}dst[i], opts...); err != nil {
+			return nil, err
+		}
+{{- else }}
+		{{ template "FuncName" . }}Into({{ template "ctxArg" . }}{{ if not .SrcPointer }}&{{ end }}src[i], {{ if not .DstPointer }}&{{ end }}dst[i], opts...)
+{{- end }}
+	}
+
+	return dst{{ template "errNil" . }}
+}`, srcTypeT, dstParamT, funcNameT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT, fieldsLitT, reusedLitT)
+
+	poolT = mt("pool", `// {{ .PoolName }} holds {{ template "DstParam" . }} structures released by {{ template "FuncName" . }}Release.
+var {{ .PoolName }} = sync.Pool{
+	New: func() interface{} {
+		return &{{ template "DstParam" . }}{}
+	},
+}
+
+// {{ template "FuncName" . }}Acquire returns {{ template "DstParam" . }} with zero fields from pool, it should be returned with {{ template "FuncName" . }}Release once it's not used. Slices and maps reused by {{ template "FuncName" . }}Into are empty but may keep capacity of released structures.
+func {{ template "FuncName" . }}Acquire() *{{ template "DstParam" . }} {
+	return {{ .PoolName }}.Get().(*{{ template "DstParam" . }})
+}
+
+// {{ template "FuncName" . }}Release resets dst fields and puts it into pool, dst and structures it refers to must not be used after release. Slices and maps reused by {{ template "FuncName" . }}Into are kept empty, so they are refilled by next transformation.
+func {{ template "FuncName" . }}Release(dst *{{ template "DstParam" . }}) {
+	if dst == nil {
+		return
+	}
+{{- range .Reused }}
+{{- if .Map }}
+	for k := range dst.{{ .Name }} {
+		delete(dst.{{ .Name }}, k)
+	}
+{{- end }}
+{{- end }}
+
+	*dst = {{ template "DstParam" . }}{ {{- template "reusedLit" . }}
+	{{- if .Reused }}
+	{{ end }}}
+	{{ .PoolName }}.Put(dst)
+}
+
+// {{ template "FuncName" . }}Pooled transforms {{ template "SrcType" . }} pointer into {{ template "DstParam" . }} acquired from pool with {{ template "FuncName" . }}Into, nil is returned if src is nil. Result should be released with {{ template "FuncName" . }}Release.
+func {{ template "FuncName" . }}Pooled({{ template "ctxParam" . }}src *{{ template "SrcType" . }}, opts ...TransformParam) {{ template "errOpen" . }}*{{ template "DstParam" . }}{{ template "errClose" . }} {
+	if src == nil {
+		return nil{{ template "errNil" . }}
+	}
+
+	dst := {{ template "FuncName" . }}Acquire()
+{{- if .WithErrors }}
+	if err := {{ template "FuncName" . }}Into({{ template "ctxArg" . }}src, dst, opts...); err != nil {
+		{{ template "FuncName" . }}Release(dst)
+		return nil, err
+	}
+{{- else }}
+	{{ template "FuncName" . }}Into({{ template "ctxArg" . }}src, dst, opts...)
+{{- end }}
+
+	return dst{{ template "errNil" . }}
+}`, srcTypeT, dstParamT, funcNameT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT, reusedLitT)
+
+	// Executed with swapped Data, i.e. for model to proto transformation.
+	redactedT = mt("redacted", `// {{ template "FuncName" . }}Redacted transforms {{ template "SrcType" . }} into {{ template "DstParam" . }}, sensitive
+// fields are left empty, so the message can be logged or returned to clients
+// which are not allowed to see them.
+func {{ template "FuncName" . }}Redacted({{ template "ctxParam" . }}src {{ template "SrcParam" . }}) {{ template "errOpen" . }}{{ template "DstParam" . }}{{ template "errClose" . }} {
+{{- if .WithErrors }}
+	dst, err := {{ template "FuncName" . }}({{ template "ctxArg" . }}src, opts...)
+	if err != nil {
+		return {{ template "DstParam" . }}{}, err
+	}
+{{- else }}
+	dst := {{ template "FuncName" . }}({{ template "ctxArg" . }}src, opts...)
+{{- end }}
+
+{{- if .Sensitive.UseEmpty }}
+
+	var empty {{ template "DstParam" . }}
+{{- end }}
+{{- range .Sensitive }}
+{{- if .Case }}
+	if _, ok := dst.{{ .Path }}.({{ .Case }}); ok {
+		dst.{{ .Path }} = nil
+	}
+{{- else if .Cond }}
+	if {{ .Cond }} {
+		dst.{{ .Path }} = {{ .Zero }}
+	}
+{{- else }}
+	dst.{{ .Path }} = {{ .Zero }}
+{{- end }}
+{{- end }}
+
+	return dst{{ template "errNil" . }}
+}
+
+// {{ template "FuncName" . }}RedactedPtr transforms {{ template "SrcType" . }} pointer into {{ template "DstParam" . }} pointer, sensitive
+// fields are left empty.
+func {{ template "FuncName" . }}RedactedPtr({{ template "ctxParam" . }}src *{{ template "SrcParam" . }}) {{ template "errOpen" . }}*{{ template "DstParam" . }}{{ template "errClose" . }} {
+	if src == nil {
+		return nil{{ template "errNil" . }}
+	}
+{{ if .WithErrors }}
+	dst, err := {{ template "FuncName" . }}Redacted({{ template "ctxArg" . }}*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &dst, nil
+{{- else }}
+	dst := {{ template "FuncName" . }}Redacted({{ template "ctxArg" . }}*src, opts...)
+	return &dst
+{{- end }}
+}`, srcTypeT, srcParamT, dstParamT, funcNameT, reverseFuncNameT, errOpenT, errCloseT, errNilT, ctxParamT, ctxArgT)
+
+	tpls = []*template.Template{
+		fieldsLitT, fieldsStmtT, reusedLitT, funcNameT, reverseFuncNameT, srcParamT, dstParamT, ptrValT, ptrT, ptrOnlyT, starT, errOpenT, errCloseT, errNilT,
+		ctxParamT, ctxArgT, ptr2ptrT,
+		ptr2valT, val2ptrT, val2valT, lst2lstT, ptrlst2ptrlstT, vallst2vallstT,
+		ptrlst2vallstT, vallst2ptrlstT, ptr2vallstT, srcTypeT, fieldNamesT, jsonNamesT,
+		schemaHashT, verifyT, patchT, maskedT, builderT, jsonT, converterT, intoT, poolT, redactedT,
+	}
+
+	// Executed with Data struct.
+	oneFuncitonSetT = `{{- if not .Skipped }}
+{{- template "ptr2ptr" . }}
+
+{{ template "ptrlst2ptrlst" . }}
+
+{{ template "ptr2val" . }}
+
+{{ template "ptrlst2vallst" . }}
+
+{{ template "ptr2vallst" . }}
+
+{{ template "val2val" . }}
+
+{{ template "val2ptr" . }}
+
+{{ template "vallst2vallst" . }}
+
+{{ template "vallst2ptrlst" . }}
+
+{{ template "fieldNames" . }}
+
+{{ template "jsonNames" . }}
+{{- with formatEnumMappings . }}
+
+{{ . }}
+{{- end }}
+{{- if .Into }}
+
+{{ template "into" . }}
+{{- end }}
+{{- if .Pool }}
+
+{{ template "pool" . }}
+{{- end }}
+{{- if .Converter }}
+
+{{ template "converter" . }}
+{{- end }}
+{{- if and .Swapped .Sensitive }}
+
+{{ template "redacted" . }}
+{{- end }}
+{{- end }}
+{{- if not .Swapped }}
+
+{{ template "schemaHash" . }}
+{{- if .ModelFields }}
+
+{{ template "verify" . }}
+{{- end }}
+{{- if .Patch }}
+
+{{ template "patch" . }}
+{{- end }}
+{{- if .Masked }}
+
+{{ template "masked" . }}
+{{- end }}
+{{- if .Builder }}
+
+{{ template "builder" . }}
+{{- end }}
+{{- if .JSON }}
+
+{{ template "json" . }}
+{{- end }}
+{{- end }}
+{{- block "custom" . }}{{ end }}
+
+`
+
+	oneofT = `
+type Oneof{{ .Decl }} interface {
+	GetStringValue() string
+	GetInt64Value() int64
+}
+
+func {{ .ProtoType }}To{{ .GoType }}(src Oneof{{ .Decl }}) string {
+	if s := src.GetStringValue(); s != "" {
+		return s
+	}
+
+	if i := src.GetInt64Value(); i != 0 {
+		return strconv.FormatInt(i, 10)
+	}
+
+	return "<nil>"
+}
+
+func {{ .GoType }}To{{ .ProtoType }}(s string, dst *{{ .ProtoPackage }}.{{ .ProtoType }}, v string) {
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil  || v == "v2"{
+		dst.{{ .Decl }} = &{{ .ProtoPackage }}.{{ .ProtoType }}_StringValue{StringValue: s}
+		return
+	}
+
+	dst.{{ .Decl }} = &{{ .ProtoPackage }}.{{ .ProtoType }}_Int64Value{Int64Value: i}
+	return
+}
+
+`
+
+	// Executed with list of converterIface structs.
+	converterHelpersT = mt("converterHelpers", `
+// Converter has transformers as methods, its fields hold dependencies of
+// transformers, so dependencies can be set per converter, e.g. per tenant or
+// in tests.
+type Converter struct {
+	// Options are passed to transformers before options of method call.
+	// Options set package variables like options of package-level
+	// transformers, so converters with different options must not be used
+	// concurrently.
+	Options []TransformParam
+{{- range . }}
+	// {{ .Name }} transforms fields with (transformer.converter_method) options.
+	{{ .Name }} {{ .Name }}
+{{- end }}
+}
+{{- range . }}
+
+// {{ .Name }} is a dependency of Converter, its methods transform fields of
+// proto messages and models.
+type {{ .Name }} interface {
+{{- range .Methods }}
+	{{ . }}
+{{- end }}
+}
+{{- end }}
+
+// NewConverter returns converter with options.
+func NewConverter(opts ...TransformParam) *Converter {
+	return &Converter{Options: opts}
+}
+
+// params returns converter options followed by opts.
+func (c *Converter) params(opts []TransformParam) []TransformParam {
+	if c == nil || len(c.Options) == 0 {
+		return opts
+	}
+
+	return append(append([]TransformParam{}, c.Options...), opts...)
+}
+`)
+
+	optionsT = `var version string
+
+// TransformParam is a function option type.
+type TransformParam func()
+
+// WithVersion sets global version variable.
+func WithVersion(v string) TransformParam {
+	return func() {
+		version = v
+	}
+}
+
+func applyOptions(opts ...TransformParam) {
+	for _, o := range opts {
+		o()
+	}
+}
+
+`
+
+	// Executed with mergeFunc struct.
+	mergeT = mt("merge", `
+// Merge{{ .Src }} sets fields of dst which are present in patch, nil fields of
+// patch are not present and don't change dst.
+func Merge{{ .Src }}(dst *{{ .DstPref }}.{{ .Dst }}, patch *{{ .SrcPref }}.{{ .Src }}, opts ...TransformParam) {
+	if patch == nil {
+		return
+	}
+{{- range .Fields }}
+	if patch.{{ .ProtoName }} != nil {
+	{{- if .Ref }}
+		v := {{ .Value }}
+		dst.{{ .Name }} = &v
+	{{- else }}
+		dst.{{ .Name }} = {{ .Value }}
+	{{- end }}
+	}
+{{- end }}
+}
+
+// Merge{{ .Src }}Changes sets fields of dst which are present in patch, like
+// Merge{{ .Src }}, and returns names of dst fields which values are changed,
+// e.g. for audit logs.
+func Merge{{ .Src }}Changes(dst *{{ .DstPref }}.{{ .Dst }}, patch *{{ .SrcPref }}.{{ .Src }}, opts ...TransformParam) []string {
+	if patch == nil {
+		return nil
+	}
+
+	var changed []string
+{{- range .Fields }}
+	if patch.{{ .ProtoName }} != nil {
+		v := {{ .Value }}
+		if {{ .Changed }} {
+			dst.{{ .Name }} = {{ if .Ref }}&{{ end }}v
+			changed = append(changed, "{{ .Name }}")
+		}
+	}
+{{- end }}
+
+	return changed
+}
+`)
+
+	// Executed with clientAdapter struct.
+	clientT = mt("client", `{{ $R := . }}
+// {{ .Service }}ModelClient calls {{ .Service }} methods with models, requests and
+// responses are transformed by generated transformers.
+type {{ .Service }}ModelClient struct {
+	client {{ .ProtoPackage }}.{{ .Service }}Client
+}
+
+// New{{ .Service }}ModelClient returns adapter of {{ .ProtoPackage }}.{{ .Service }}Client.
+func New{{ .Service }}ModelClient(client {{ .ProtoPackage }}.{{ .Service }}Client) *{{ .Service }}ModelClient {
+	return &{{ .Service }}ModelClient{client: client}
+}
+{{- range .Methods }}
+
+// {{ .Name }} transforms req into proto message, calls {{ $R.Service }}.{{ .Name }} and
+// transforms response into model.
+func (c *{{ $R.Service }}ModelClient) {{ .Name }}(ctx context.Context, req {{ $R.ModelPackage }}.{{ .Request }}, opts ...grpc.CallOption) (*{{ $R.ModelPackage }}.{{ .Response }}, error) {
+{{- if .RequestErrors }}
+	in, err := {{ .RequestFunc }}ValPtr({{ if .RequestContext }}ctx, {{ end }}req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.{{ .Name }}(ctx, in, opts...)
+{{- else }}
+	resp, err := c.client.{{ .Name }}(ctx, {{ .RequestFunc }}ValPtr({{ if .RequestContext }}ctx, {{ end }}req), opts...)
+{{- end }}
+	if err != nil {
+		return nil, err
+	}
+
+	return {{ .ResponseFunc }}Ptr({{ if .ResponseContext }}ctx, {{ end }}resp){{ if not .ResponseErrors }}, nil{{ end }}
+}
+{{- end }}
+`)
+
+	statusT = mt("status", `
+import (
+	"github.com/gogo/googleapis/google/rpc"
+	"github.com/gogo/status"
+)
+
+// StatusToError converts google.rpc.Status into error. Nil status and status
+// with code OK are converted into nil error.
+func StatusToError(s *rpc.Status) error {
+	return status.ErrorProto(s)
+}
+
+// ErrorToStatus converts error into google.rpc.Status. Nil error is converted
+// into nil status, errors which were not created by status package get code
+// Unknown.
+func ErrorToStatus(err error) *rpc.Status {
+	if err == nil {
+		return nil
+	}
+
+	return status.Convert(err).Proto()
+}
+{{ range . }}
+// Error{{ . }} returns first google.rpc.{{ . }} detail of error, nil if error
+// has no such detail.
+func Error{{ . }}(err error) *rpc.{{ . }} {
+	for _, d := range status.Convert(err).Details() {
+		if v, ok := d.(*rpc.{{ . }}); ok {
+			return v
+		}
+	}
+
+	return nil
+}
+{{ end -}}
+`)
+
+	valueT = mt("value", `
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// ValueToInterface converts google.protobuf.Value into Go value: nil,
+// float64, string, bool, map[string]interface{} or []interface{}.
+func ValueToInterface(v *types.Value) interface{} {
+	switch k := v.GetKind().(type) {
+	case *types.Value_NumberValue:
+		return k.NumberValue
+	case *types.Value_StringValue:
+		return k.StringValue
+	case *types.Value_BoolValue:
+		return k.BoolValue
+	case *types.Value_StructValue:
+		return structToMap(k.StructValue)
+	case *types.Value_ListValue:
+		return ListValueToSlice(k.ListValue)
+	}
+
+	return nil
+}
+
+// InterfaceToValue converts Go value into google.protobuf.Value. Numbers are
+// converted into float64, values of unsupported types are converted into
+// null value.
+func InterfaceToValue(v interface{}) *types.Value {
+	val, _ := newValue(v, false)
+	return val
+}
+
+// ListValueToSlice converts google.protobuf.ListValue into slice of Go
+// values, nil list is converted into nil slice.
+func ListValueToSlice(l *types.ListValue) []interface{} {
+	if l == nil {
+		return nil
+	}
+
+	s := make([]interface{}, len(l.Values))
+	for i, v := range l.Values {
+		s[i] = ValueToInterface(v)
+	}
+
+	return s
+}
+
+// SliceToListValue converts slice of Go values into google.protobuf.ListValue,
+// nil slice is converted into nil list.
+func SliceToListValue(s []interface{}) *types.ListValue {
+	if s == nil {
+		return nil
+	}
+
+	l, _ := newListValue(s, false)
+	return l
+}
+
+// StructToMap converts google.protobuf.Struct into map of Go values, nil
+// structure is converted into nil map.
+func StructToMap(s *types.Struct) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+
+	return structToMap(s)
+}
+
+// MapToStruct converts map of Go values into google.protobuf.Struct, nil map
+// is converted into nil structure. Unlike InterfaceToValue it returns an
+// error for values of unsupported types.
+func MapToStruct(m map[string]interface{}) (*types.Struct, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return newStruct(m, true)
+}
+
+// StructToJSON encodes google.protobuf.Struct into JSON object, nil
+// structure is encoded into nil.
+func StructToJSON(s *types.Struct) (json.RawMessage, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(structToMap(s))
+}
+
+// JSONToStruct decodes JSON object into google.protobuf.Struct, empty input
+// and null are decoded into nil structure.
+func JSONToStruct(raw json.RawMessage) (*types.Struct, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+
+	return MapToStruct(m)
+}
+
+func structToMap(s *types.Struct) map[string]interface{} {
+	m := make(map[string]interface{}, len(s.GetFields()))
+	for k, v := range s.GetFields() {
+		m[k] = ValueToInterface(v)
+	}
+
+	return m
+}
+
+// newValue converts Go value into google.protobuf.Value. If strict is false,
+// values of unsupported types are converted into null value, otherwise an
+// error is returned.
+func newValue(v interface{}, strict bool) (*types.Value, error) {
+	switch x := v.(type) {
+	case nil:
+		return &types.Value{Kind: &types.Value_NullValue{}}, nil
+	case bool:
+		return &types.Value{Kind: &types.Value_BoolValue{BoolValue: x}}, nil
+	case string:
+		return &types.Value{Kind: &types.Value_StringValue{StringValue: x}}, nil
+	case float64:
+		return &types.Value{Kind: &types.Value_NumberValue{NumberValue: x}}, nil
+	case float32:
+		return &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(x)}}, nil
+	case int:
+		return &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(x)}}, nil
+	case int32:
+		return &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(x)}}, nil
+	case int64:
+		return &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(x)}}, nil
+	case uint:
+		return &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(x)}}, nil
+	case uint32:
+		return &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(x)}}, nil
+	case uint64:
+		return &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(x)}}, nil
+	case map[string]interface{}:
+		s, err := newStruct(x, strict)
+		if err != nil {
+			return nil, err
+		}
+		return &types.Value{Kind: &types.Value_StructValue{StructValue: s}}, nil
+	case []interface{}:
+		l, err := newListValue(x, strict)
+		if err != nil {
+			return nil, err
+		}
+		return &types.Value{Kind: &types.Value_ListValue{ListValue: l}}, nil
+	}
+
+	if strict {
+		return nil, fmt.Errorf("unsupported value of type %T", v)
+	}
+
+	return &types.Value{Kind: &types.Value_NullValue{}}, nil
+}
+
+func newListValue(s []interface{}, strict bool) (*types.ListValue, error) {
+	l := &types.ListValue{Values: make([]*types.Value, len(s))}
+	for i, v := range s {
+		val, err := newValue(v, strict)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		l.Values[i] = val
+	}
+
+	return l, nil
+}
+
+func newStruct(m map[string]interface{}, strict bool) (*types.Struct, error) {
+	s := &types.Struct{Fields: make(map[string]*types.Value, len(m))}
+	for k, v := range m {
+		val, err := newValue(v, strict)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		s.Fields[k] = val
+	}
+
+	return s, nil
+}
+`)
+
+	// Executed with []sumType.
+	sumTypesT = mt("sumTypes", `
+{{- range $st := . }}
+
+// {{ $st.Name }} is a sum type of oneof {{ $st.Oneof }} of message {{ $st.Message }}.
+{{- with $st.Types }}
+// Model types {{ range $i, $t := . }}{{ if $i }}, {{ end }}{{ $t }}{{ end }} have to implement it.
+{{- end }}
+type {{ $st.Name }} interface {
+	is{{ $st.Name }}()
+}
+{{- range $st.Wrappers }}
+
+// {{ .Name }} is {{ .Case }} case of {{ $st.Name }}.
+type {{ .Name }} struct {
+	{{ .Field }} {{ .Type }}
+}
+
+func ({{ .Name }}) is{{ $st.Name }}() {}
+{{- end }}
+{{- end }}
+`)
+
+	// Executed with bool value: if true, check is called from init function.
+	verifyHelpersT = mt("verifyHelpers", `
+import (
+	"fmt"
+	"reflect"
+)
+
+// verifier contains model structure type and names and types of its fields
+// used by transformers.
+type verifier struct {
+	typ    reflect.Type
+	fields [][2]string
+}
+
+var verifiers []verifier
+
+// registerVerifier adds model structure t into the list of structures checked
+// by VerifyTransformers. It returns a value, so it can be called during
+// package variables initialization, before any init function.
+func registerVerifier(t reflect.Type, fields [][2]string) bool {
+	verifiers = append(verifiers, verifier{typ: t, fields: fields})
+	return true
+}
+
+// VerifyTransformers checks that model structures still contain fields of
+// types which transformers were generated for. An error means that models were
+// changed and transformers have to be regenerated.
+func VerifyTransformers() error {
+	for _, v := range verifiers {
+		for _, f := range v.fields {
+			sf, ok := v.typ.FieldByName(f[0])
+			if !ok {
+				return fmt.Errorf("%s: field %s not found", v.typ, f[0])
+			}
+
+			if typ := verifyTypeName(sf.Type, v.typ.PkgPath()); typ != f[1] {
+				return fmt.Errorf("%s: field %s has type %s, transformers expect %s", v.typ, f[0], typ, f[1])
+			}
+		}
+	}
+
+	return nil
+}
+
+// verifyTypeName returns type name in the same form as generator uses: slices
+// and maps are described by their elements type, types declared in model
+// package pkg are not qualified by package name.
+func verifyTypeName(t reflect.Type, pkg string) string {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		t = t.Elem()
+	}
+
+	ptr := ""
+	if t.Kind() == reflect.Ptr {
+		ptr, t = "*", t.Elem()
+	}
+
+	if t.PkgPath() == pkg {
+		return ptr + t.Name()
+	}
+
+	return ptr + t.String()
+}
+{{- if . }}
+
+func init() {
+	if err := VerifyTransformers(); err != nil {
+		panic(err)
+	}
+}
+{{- end }}
+`)
+
+	// Executed with []roundTripTest value. Only fields with values are
+	// compared, the rest of fields may be changed by helper functions.
+	roundTripT = mt("roundTrip", `
+{{- range . }}
+
+// Test{{ template "FuncName" . }}RoundTrip checks that message transformed into
+// model and back keeps values of fields.
+func Test{{ template "FuncName" . }}RoundTrip(t *testing.T) {
+{{- if .Skip }}
+	t.Skip({{ printf "%q" .Skip }})
+}
+{{- else }}
+	src := &{{ template "roundTripType" . }}{
+	{{- range .Values }}
+		{{ .Name }}: {{ .Value }},
+	{{- end }}
+	}
+{{- if .WithContext }}
+	ctx := context.Background()
+{{- end }}
+{{ if .WithErrors }}
+	m, err := {{ template "FuncName" . }}Ptr({{ template "ctxArg" . }}src)
+	if err != nil {
+		t.Fatalf("{{ template "FuncName" . }}: %v", err)
+	}
+
+	{{ if .Values }}out{{ else }}_{{ end }}, err {{ if .Values }}:{{ end }}= {{ template "ReverseFuncName" . }}Ptr({{ template "ctxArg" . }}m)
+	if err != nil {
+		t.Fatalf("{{ template "ReverseFuncName" . }}: %v", err)
+	}
+{{- else if .Values }}
+	out := {{ template "ReverseFuncName" . }}Ptr({{ template "ctxArg" . }}{{ template "FuncName" . }}Ptr({{ template "ctxArg" . }}src))
+{{- else }}
+	// message has no fields with values, transformers are checked not to panic.
+	{{ template "ReverseFuncName" . }}Ptr({{ template "ctxArg" . }}{{ template "FuncName" . }}Ptr({{ template "ctxArg" . }}src))
+{{- end }}
+{{- if .Values }}
+
+	got := &{{ template "roundTripType" . }}{
+	{{- range .Values }}
+		{{ .Name }}: out.{{ .Name }},
+	{{- end }}
+	}
+	if !proto.Equal(protov1.MessageV2(src), protov1.MessageV2(got)) {
+		t.Errorf("{{ template "FuncName" . }} and {{ template "ReverseFuncName" . }} don't round-trip (-want +got):\n%s", cmp.Diff(src, got))
+	}
+{{- end }}
+}
+{{- end }}
+{{- end }}
+`, funcNameT, reverseFuncNameT, ctxArgT, roundTripTypeT)
+
+	// Executed with []roundTripTest value. Messages are decoded from random
+	// data, errors of transformers are accepted, fields which are transformed
+	// as is are compared.
+	fuzzT = mt("fuzz", `
+{{- range $t := . }}
+
+// Fuzz{{ template "FuncName" $t }} checks that transformers of messages decoded
+// from random data don't panic and keep values of fields.
+func Fuzz{{ template "FuncName" $t }}(f *testing.F) {
+{{- if $t.Skip }}
+	f.Skip({{ printf "%q" $t.Skip }})
+}
+{{- else }}
+	seed, err := proto.Marshal(protov1.MessageV2(&{{ template "roundTripType" $t }}{
+	{{- range $t.Values }}
+		{{ .Name }}: {{ .Value }},
+	{{- end }}
+	}))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(seed)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		src := &{{ template "roundTripType" $t }}{}
+		if err := proto.Unmarshal(data, protov1.MessageV2(src)); err != nil {
+			t.Skip()
+		}
+{{- if $t.WithContext }}
+		ctx := context.Background()
+{{- end }}
+{{ if $t.WithErrors }}
+		m, err := {{ template "FuncName" $t }}Ptr({{ template "ctxArg" $t }}src)
+		if err != nil {
+			return
+		}
+
+		{{ if $t.FuzzValues }}out{{ else }}_{{ end }}, err {{ if $t.FuzzValues }}:{{ end }}= {{ template "ReverseFuncName" $t }}Ptr({{ template "ctxArg" $t }}m)
+		if err != nil {
+			return
+		}
+{{- else if $t.FuzzValues }}
+		out := {{ template "ReverseFuncName" $t }}Ptr({{ template "ctxArg" $t }}{{ template "FuncName" $t }}Ptr({{ template "ctxArg" $t }}src))
+{{- else }}
+		{{ template "ReverseFuncName" $t }}Ptr({{ template "ctxArg" $t }}{{ template "FuncName" $t }}Ptr({{ template "ctxArg" $t }}src))
+{{- end }}
+{{- with $t.FuzzValues }}
+
+		want := &{{ template "roundTripType" $t }}{
+		{{- range . }}
+			{{ .Name }}: src.{{ .Name }},
+		{{- end }}
+		}
+		got := &{{ template "roundTripType" $t }}{
+		{{- range . }}
+			{{ .Name }}: out.{{ .Name }},
+		{{- end }}
+		}
+		if !proto.Equal(protov1.MessageV2(want), protov1.MessageV2(got)) {
+			t.Errorf("{{ template "FuncName" $t }} and {{ template "ReverseFuncName" $t }} don't round-trip (-want +got):\n%s", cmp.Diff(want, got))
+		}
+{{- end }}
+	})
+}
+{{- end }}
+{{- end }}
+`, funcNameT, reverseFuncNameT, ctxArgT, roundTripTypeT)
+
+	// Proto structure type of round-trip test.
+	roundTripTypeT = mt("roundTripType", `{{ if .SrcPref }}{{ .SrcPref }}.{{ end }}{{ .Src }}`)
+
+	// Statements of benchmark loop which call transformer, check error, see
+	// transformer.with_errors, and keep result in sink variable. Sink is
+	// captured by closures, so results escape to heap like in callers.
+	benchCallOpenT  = mt("benchCallOpen", `{{ if .WithErrors }}v, err := {{ else }}sink = {{ end }}`)
+	benchCallCloseT = mt("benchCallClose", `{{ if .WithErrors }}
+			if err != nil {
+				b.Fatal(err)
+			}
+			sink = v{{ end }}`)
+	// Assignment of models which reverse transformers are measured with.
+	benchModelT = mt("benchModel", `{{ if .WithErrors }}, err{{ end }}`)
+	benchErrT   = mt("benchErr", `{{ if .WithErrors }}
+	if err != nil {
+		b.Fatal(err)
+	}{{ end }}`)
+
+	// Executed with []roundTripTest value. Transformers of each message are
+	// measured with one message, list of messages and message with sub
+	// messages if message has fields of other generated messages.
+	benchT = mt("bench", `
+{{- range $t := . }}
+
+// Benchmark{{ template "FuncName" $t }} measures transformation of messages into models.
+func Benchmark{{ template "FuncName" $t }}(b *testing.B) {
+{{- if $t.Skip }}
+	b.Skip({{ printf "%q" $t.Skip }})
+}
+{{- else }}
+{{- template "benchData" $t }}
+	var sink interface{}
+
+	b.Run("message", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			{{ template "benchCallOpen" $t }}{{ template "FuncName" $t }}Ptr({{ template "ctxArg" $t }}src){{ template "benchCallClose" $t }}
+		}
+	})
+
+	b.Run("list", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			{{ template "benchCallOpen" $t }}{{ template "FuncName" $t }}PtrList({{ template "ctxArg" $t }}list){{ template "benchCallClose" $t }}
+		}
+	})
+{{- if $t.Graph }}
+
+	b.Run("graph", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			{{ template "benchCallOpen" $t }}{{ template "FuncName" $t }}Ptr({{ template "ctxArg" $t }}graph){{ template "benchCallClose" $t }}
+		}
+	})
+{{- end }}
+
+	_ = sink
+}
+{{- end }}
+{{- if not $t.NoReverse }}
+
+// Benchmark{{ template "ReverseFuncName" $t }} measures transformation of models into messages.
+func Benchmark{{ template "ReverseFuncName" $t }}(b *testing.B) {
+{{- if $t.Skip }}
+	b.Skip({{ printf "%q" $t.Skip }})
+}
+{{- else }}
+{{- template "benchData" $t }}
+	var sink interface{}
+
+	m{{ template "benchModel" $t }} := {{ template "FuncName" $t }}Ptr({{ template "ctxArg" $t }}src)
+{{- template "benchErr" $t }}
+	ms{{ template "benchModel" $t }} := {{ template "FuncName" $t }}PtrList({{ template "ctxArg" $t }}list)
+{{- template "benchErr" $t }}
+{{- if $t.Graph }}
+	mg{{ template "benchModel" $t }} := {{ template "FuncName" $t }}Ptr({{ template "ctxArg" $t }}graph)
+{{- template "benchErr" $t }}
+{{- end }}
+
+	b.Run("message", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			{{ template "benchCallOpen" $t }}{{ template "ReverseFuncName" $t }}Ptr({{ template "ctxArg" $t }}m){{ template "benchCallClose" $t }}
+		}
+	})
+
+	b.Run("list", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			{{ template "benchCallOpen" $t }}{{ template "ReverseFuncName" $t }}PtrList({{ template "ctxArg" $t }}ms){{ template "benchCallClose" $t }}
+		}
+	})
+{{- if $t.Graph }}
+
+	b.Run("graph", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			{{ template "benchCallOpen" $t }}{{ template "ReverseFuncName" $t }}Ptr({{ template "ctxArg" $t }}mg){{ template "benchCallClose" $t }}
+		}
+	})
+{{- end }}
+
+	_ = sink
+}
+{{- end }}
+{{- end }}
+{{- end }}
+`, funcNameT, reverseFuncNameT, ctxArgT, roundTripTypeT, benchCallOpenT, benchCallCloseT, benchModelT, benchErrT, benchDataT)
+
+	// Messages which transformers are measured with: src, list of 100 src and
+	// graph with sub messages.
+	benchDataT = mt("benchData", `
+{{- if .WithContext }}
+	ctx := context.Background()
+{{- end }}
+	src := &{{ template "roundTripType" . }}{
+	{{- range .Values }}
+		{{ .Name }}: {{ .Value }},
+	{{- end }}
+	}
+	list := make([]*{{ template "roundTripType" . }}, 100)
+	for i := range list {
+		list[i] = src
+	}
+{{- if .Graph }}
+	graph := &{{ template "roundTripType" . }}{
+	{{- range .Values }}
+		{{ .Name }}: {{ .Value }},
+	{{- end }}
+	{{- range .Graph }}
+		{{ .Name }}: {{ .Value }},
+	{{- end }}
+	}
+{{- end }}`, roundTripTypeT)
+)
+
+// templateWithHelpers initializes main oneFuncitonSetT template with given
+// name, adds there sub-templates, templates of custom template directory and
+// maps functions into template.
+func templateWithHelpers(name string) (*template.Template, error) {
+	t := template.
+		New(name).
+		Funcs(funcMap)
+
+	for _, v := range tpls {
+		if _, err := t.AddParseTree(at(v)); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := t.Parse(oneFuncitonSetT); err != nil {
+		return nil, err
+	}
+
+	// templates of custom template directory replace built-in ones with the
+	// same names, such as "messages" or "patch", see SetTemplateDir.
+	if customTemplates != nil {
+		for _, v := range customTemplates.Templates() {
+			// files with define actions only don't replace templates.
+			if v.Tree == nil || parse.IsEmptyTree(v.Tree.Root) {
+				continue
+			}
+			if _, err := t.AddParseTree(at(v)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return t, nil
+}
+
+// Field represents one structure field.
+type Field struct {
+	// Field name in Go structure.
+	Name string
+	// Field name in .proto file.
+	ProtoName string
+	// Original field name as it's declared in .proto file, e.g. map_field_1.
+	ProtoOrigName string
+	// Field name in JSON representation of proto message, e.g. mapField1.
+	ProtoJSONName string
+	// Field name from json tag of Go structure field, "-" for ignored fields.
+	GoJSONName string
+	// Field type in .proto file.
+	ProtoType string
+	// Name of function which is used for converting proto field into Go one.
+	ProtoToGoType string
+	// Name of function which is used for converting Go field into proto one.
+	GoToProtoType string
+	// True if field in model is a pointer.
+	GoIsPointer bool
+	// True if field in .proto file has an option gogoproto.nullable = false
+	ProtoIsPointer bool
+	// It true, field GoToProtoType and ProtoToGoType functions will be used
+	// with prefix.
+	UsePackage bool
+	// Family of helper functions GoToProtoType and ProtoToGoType, it defines
+	// helper package used as prefix, see transformer.go_helper_packages.
+	Helper string
+	// The field has a value when it is used for the oneof migration from Int64 to String for the field
+	// TODO:  This is a specific case of OneOf which is used by BoldCommerce and needs to be removed from the plugin.
+	//        This field will be deprecated together with oneof.go once BoldCommerce update their code
+	OneofDecl string
+	Opts      string
+	// Fields of sub message which are merged into parent Go structure, see
+	// transformer.embedded option.
+	EmbeddedFields []Field
+	// Element-wise transformation for repeated and map fields, nil if field
+	// is transformed as a whole.
+	Elem *Elem
+	// Transformation of single google.protobuf wrapper field into pointer or
+	// value of wrapped Go type, see transformer.wrappers_as.
+	Wrapper *Elem
+	// Transformation of enum field, nil for non-enum fields.
+	Enum *Enum
+	// Transformation by methods of Converter dependency, such fields are
+	// transformed by Converter methods only.
+	Dep *Dep
+	// Names and types of proto and Go fields, used for schema hash.
+	Signature string
+	// If true, field is transformed by transformers of sub message with
+	// transformer.with_errors option, which return error.
+	WithError bool
+	// Type of empty slice, e.g. []string, which replaces nil value of
+	// repeated field copied as is, see transformer.empty_slice_on_nil.
+	EmptySlice string
+	// If true, field is transformed by transformers of sub message with
+	// transformer.with_context option, which accept context.
+	WithContext bool
+	// Signature of helper functions ProtoToGoType and GoToProtoType, nil if
+	// helpers have default signature, see transformer.helper_signature.
+	HelperCall *helperSignature
+	// If true, model field is promoted from embedded structure, it can't be
+	// set in composite literal of model, see transformer.flatten_embedded.
+	Promoted bool
+	// If true, model field is an edge of ent entity, Name is a path to the
+	// field of the Edges structure, e.g. Edges.Pets, see source.PromoteEntEdges.
+	Edge bool
+	// Case of oneof declaration, nil for fields which are not oneof members.
+	Case *OneofCase
+	// If true, field is left out of proto to model or model to proto
+	// transformers, see transformer.skip_direction.
+	SkipPbToGo bool
+	SkipGoToPb bool
+}
+
+// directionFields returns fields which are transformed by transformers of
+// direction of swapped flag, i.e. model to proto ones if swapped is true.
+func directionFields(fields []Field, swapped bool) []Field {
+	out := make([]Field, 0, len(fields))
+	for _, f := range fields {
+		if swapped && f.SkipGoToPb || !swapped && f.SkipPbToGo {
+			continue
+		}
+		out = append(out, f)
+	}
+
+	return out
+}
+
+// elemKind is a kind of element-wise transformation.
+type elemKind int
+
+const (
+	// elemWrapper is a transformation between google.protobuf wrapper type,
+	// e.g. StringValue and wrapped Go type, e.g. string.
+	elemWrapper elemKind = iota + 1
+	// elemValue is a direct assignment of elements of the same type, e.g.
+	// time.Time to time.Time.
+	elemValue
+	// elemFunc is a transformation with helper functions, e.g. TimeToNullsTime.
+	elemFunc
+	// elemList is a transformation between list wrapper message, e.g.
+	// AddressList { repeated Address items = 1; } and slice of models, e.g.
+	// []model.Address. List functions of wrapped message are used.
+	elemList
+	// elemPairs is a transformation between map and slice of model pairs
+	// with Key and Value fields, e.g. []model.LabelPair, sorted by key. See
+	// transformer.ordered_map option.
+	elemPairs
+	// elemEnum is a transformation of repeated enum field into slice of
+	// value names or numbers, see transformer.enums_as.
+	elemEnum
+	// elemTime is a transformation between google.protobuf.Timestamp
+	// structure and time.Time by generated code, see transformer.use_std_time.
+	elemTime
+	// elemDuration is a transformation between google.protobuf.Duration and
+	// time.Duration or integer number of units by generated code, see
+	// transformer.duration_as.
+	elemDuration
+	// elemMessage is a transformation of map values of message type with
+	// transformers of the message, e.g. PbToAddressPtrVal.
+	elemMessage
+	// elemOptional is a transformation of proto3 optional scalar field, which
+	// is a pointer in proto structure, into pointer or value of the same type.
+	elemOptional
+	// elemCustom is a transformation of field by functions of
+	// transformer.custom_pb_to_go and transformer.custom_go_to_pb options.
+	elemCustom
+	// elemStruct is a transformation of google.protobuf.Struct into
+	// map[string]interface{} or json.RawMessage by functions of ValueHelpers,
+	// see transformer.struct_as_json.
+	elemStruct
+	// elemBytes is a transformation of bytes field by functions of
+	// transformer.bytes_converter option.
+	elemBytes
+	// elemUUID is a transformation of string or bytes field into uuid.UUID,
+	// see transformer.uuid.
+	elemUUID
+	// elemDecimal is a transformation of string, int64 or google.type.Money
+	// field into decimal.Decimal, see transformer.decimal.
+	elemDecimal
+	// elemNull is a transformation of scalar, wrapper or
+	// google.protobuf.Timestamp field into nullable type with Valid flag,
+	// e.g. sql.NullString.
+	elemNull
+)
+
+// Elem describes element-wise transformation of repeated or map field.
+type Elem struct {
+	// Kind of transformation.
+	Kind elemKind
+	// Element type name in .proto file, e.g. StringValue, string or bytes for
+	// elemUUID, string, int64 or money for elemDecimal.
+	ProtoType string
+	// Element type in Go structure, e.g. string.
+	GoType string
+	// True if proto element is a pointer.
+	ProtoIsPointer bool
+	// True if Go element is a pointer.
+	GoIsPointer bool
+	// Name of function which converts proto element into Go one, elemFunc,
+	// elemList, elemMessage, elemCustom, elemStruct and elemBytes only. Type
+	// conversion of value of proto element, elemNull only.
+	ProtoToGo string
+	// Name of function which converts Go element into proto one, elemFunc,
+	// elemList, elemMessage, elemCustom, elemStruct and elemBytes only. Type
+	// conversion of value of Go element, elemNull only.
+	GoToProto string
+	// If true, ProtoToGo and GoToProto return value and error, elemCustom
+	// and elemStruct only. GoToProto of elemStruct and ProtoToGo of elemBytes
+	// always return an error.
+	WithError bool
+	// If true, ProtoToGo and GoToProto functions will be used with prefix.
+	UsePackage bool
+	// Family of helper functions ProtoToGo and GoToProto.
+	Helper string
+	// Key type of map field, empty for repeated fields.
+	MapKey string
+	// Name of repeated field of list wrapper message, elemList only.
+	Items string
+	// Transformation of enum elements, elemEnum only.
+	Enum *Enum
+	// Unit of integer Go element, e.g. time.Millisecond, elemDuration only.
+	Unit string
+	// Field of Go element which holds value, e.g. String of sql.NullString,
+	// elemNull only.
+	Value string
+	// Number of fractional digits of integer proto element and method of Go
+	// element which rounds it, e.g. RoundBank, elemDecimal only.
+	Scale    int32
+	Rounding string
+	// Go field which holds currency code of google.type.Money element and its
+	// type, elemDecimal only.
+	Currency     string
+	CurrencyType string
+}
+
+// Dep describes transformation of field by methods of dependency interface
+// which is held by Converter, see transformer.converter_method.
+type Dep struct {
+	// Interface name, it's also a name of Converter field.
+	Iface string
+	// Proto to model method, empty if field is not set by proto to model
+	// transformation.
+	Method string
+	// Model to proto method, empty if field is not set by model to proto
+	// transformation.
+	ReverseMethod string
+	// Go type of proto field, e.g. string.
+	ProtoType string
+}
+
+// OneofCase describes field which is a case of oneof declaration. Cases of the
+// same declaration are transformed together by switch statements.
+type OneofCase struct {
+	// Go name of oneof field of proto structure, e.g. Method.
+	Decl string
+	// Go name of proto field of the case, wrapper type of the case is named
+	// after message and the field, e.g. Order_Card.
+	Field string
+	// Condition which is true if model field of the case is set, e.g.
+	// src.Card != nil. Empty for cases with transformer.oneof_case option.
+	Cond string
+	// Model type of the case without package and pointer, e.g. CardPayment,
+	// see transformer.oneof_case.
+	Variant string
+	// If true, model type of the case is a pointer.
+	VariantIsPointer bool
+	// If true, model type of the case is a wrapper structure generated for
+	// sum type of oneof, e.g. PaymentMethodCard{Card: ...}, its field is named
+	// after proto field. See transformer.go_sum_type.
+	Wrapped bool
+	// Base name of transformers of message case, e.g. CardPayment for
+	// PbToCardPaymentPtr. Empty for scalar cases.
+	Func string
+	// Names of transformers of message case without suffixes set by
+	// transformer.func_name_format_* options, e.g. CardPaymentFromProto.
+	// Empty names are made of Func.
+	ProtoToGo string
+	GoToProto string
+	// Go type of scalar case, e.g. string.
+	ProtoType string
+}
+
+// Enum describes transformation of enum field into value name or number, see
+// transformer.enums_as.
+type Enum struct {
+	// Go type of proto enum without package, e.g. Order_Status.
+	ProtoType string
+	// Model field type, types of model package are declared without package.
+	GoType string
+	// If true, enum is transformed into value name, otherwise into number.
+	AsString bool
+	// Pairs of proto enum values and model constants, which are transformed
+	// by generated functions, see transformer.enum_mapping.
+	Mapping []EnumValue
+	// Base name of mapping functions, e.g. OrderStatus for
+	// PbToOrderStatusEnum and OrderStatusEnumToPb.
+	Func string
+}
+
+// mappingFunc returns name of function which transforms enum with mapping,
+// swapped flag means Go to proto transformation.
+func (e Enum) mappingFunc(swapped bool) string {
+	if swapped {
+		return e.Func + "EnumToPb"
+	}
+
+	return "PbTo" + e.Func + "Enum"
+}
+
+// convert returns an expression which transforms enum field v. Swapped flag
+// means Go to proto transformation, pref is destination package.
+func (e Enum) convert(v string, swapped bool, pref string) string {
+	if len(e.Mapping) > 0 {
+		return fmt.Sprintf("%s(%s)", e.mappingFunc(swapped), v)
+	}
+
+	if swapped {
+		pt := e.ProtoType
+		if pref != "" {
+			pt = pref + "." + pt
+		}
+
+		if !e.AsString {
+			return fmt.Sprintf("%s(%s)", pt, v)
+		}
+		if e.GoType != "string" {
+			v = fmt.Sprintf("string(%s)", v)
+		}
+		return fmt.Sprintf("%s(%s_value[%s])", pt, pt, v)
+	}
+
+	gt := e.GoType
+	if _, basic := basicTypes[gt]; !basic && !strings.Contains(gt, ".") && pref != "" {
+		gt = pref + "." + gt
+	}
+
+	if e.AsString {
+		v += ".String()"
+		if e.GoType == "string" {
+			return v
+		}
+	}
+
+	return fmt.Sprintf("%s(%s)", gt, v)
+}
+
+// protoType returns proto element type with package prefix.
+func (e Elem) protoType(d Data) string {
+	t := e.ProtoType
+	switch e.Kind {
+	case elemWrapper, elemTime, elemDuration, elemNull:
+		t = d.WrappersPackage + "." + t
+	case elemFunc:
+		// google.protobuf structures, see transformer.timestamps_as.
+		if !strings.Contains(t, ".") {
+			t = d.WrappersPackage + "." + t
+		}
+	case elemList, elemEnum, elemMessage:
+		pkg := d.SrcPref
+		if d.Swapped {
+			pkg = d.DstPref
+		}
+		t = pkg + "." + t
+	}
+
+	return t
+}
+
+// goType returns Go element type, types declared without package, such as
+// model structures of elemList, get package prefix.
+func (e Elem) goType(d Data) string {
+	if e.Kind != elemList && e.Kind != elemPairs && e.Kind != elemEnum && e.Kind != elemMessage {
+		return e.GoType
+	}
+
+	if _, basic := basicTypes[e.GoType]; basic {
+		return e.GoType
+	}
+
+	pkg := d.DstPref
+	if d.Swapped {
+		pkg = d.SrcPref
+	}
+
+	base := strings.TrimLeft(e.GoType, "[]*")
+	if strings.Contains(base, ".") || pkg == "" {
+		return e.GoType
+	}
+
+	return e.GoType[:len(e.GoType)-len(base)] + pkg + "." + base
+}
+
+// convert returns an expression which transforms single element v. Swapped
+// flag means Go to proto transformation. Value of v is never a nil pointer.
+func (e Elem) convert(v string, d Data) string {
+	switch e.Kind {
+	case elemWrapper:
+		if d.Swapped {
+			return fmt.Sprintf("%s{Value: %s}", e.protoType(d), v)
+		}
+		return v + ".GetValue()"
+	case elemFunc:
+		if d.Swapped {
+			return fmt.Sprintf("%s(%s)", e.GoToProto, v)
+		}
+		return fmt.Sprintf("%s(%s)", e.ProtoToGo, v)
+	case elemList:
+		if d.Swapped {
+			return fmt.Sprintf("%s{%s: %s(%s)}", e.protoType(d), e.Items, e.GoToProto, v)
+		}
+		return fmt.Sprintf("%s(%s.Get%s())", e.ProtoToGo, v, e.Items)
+	case elemEnum:
+		return e.Enum.convert(v, d.Swapped, d.DstPref)
+	case elemMessage:
+		if d.Swapped {
+			return fmt.Sprintf("%s(%s, opts...)", e.GoToProto, v)
+		}
+		return fmt.Sprintf("%s(%s, opts...)", e.ProtoToGo, v)
+	}
+
+	return v
+}
+
+// IsEmbedded returns true if Field represents an embedded sub message.
+func (f Field) IsEmbedded() bool {
+	return len(f.EmbeddedFields) > 0
+}
+
+// IsOneof returns true if Field has non-empty OneOf declaration.
+func (f Field) IsOneof() bool {
+	return f.OneofDecl != ""
+}
+
+// name based on swapped flag return Name or ProtoName for current Field.
+func (f Field) name(swapped bool) string {
+	if swapped {
+		return f.Name
+	}
+	return f.ProtoName
+}
+
+// convertFunc based on swapped flag and value of Field properties returns a type name
+// for current Field.
+func (f Field) convertFunc(swapped bool) string {
+	out := f.ProtoToGoType
+	if swapped {
+		out = f.GoToProtoType
+	}
+
+	list := strings.HasSuffix(out, "List")
+	if list {
+		out = strings.TrimSuffix(out, "List")
+	}
+
+	suffix := ""
+
+	if f.GoIsPointer && f.ProtoIsPointer {
+		suffix = "Ptr"
+	}
+
+	// Lists of values, e.g. repeated field with gogoproto.nullable = false.
+	if !f.GoIsPointer && !f.ProtoIsPointer && list {
+		suffix = "Val"
+	}
+
+	if !f.GoIsPointer && f.ProtoIsPointer {
+		if swapped {
+			suffix = "ValPtr"
+		} else {
+			suffix = "PtrVal"
+		}
+	}
+
+	if f.GoIsPointer && !f.ProtoIsPointer {
+		if swapped {
+			suffix = "PtrVal"
+		} else {
+			suffix = "ValPtr"
+		}
+	}
+
+	out += suffix
+	if list {
+		out += "List"
+	}
+
+	return out
+}
+
+// formatOneofField returns text representation of Oneof field in structure for
+// template.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatOneofField(f Field, swapped bool, pref string) string {
+	if !f.IsOneof() {
+		return fmt.Sprintf("/* field %q is not Oneof field*/", f.Name)
+	}
+
+	out := "src." + f.ProtoName
+	if swapped {
+		out = fmt.Sprintf("&%s.%s{}", pref, f.ProtoType)
+	} else {
+		if f.ProtoToGoType != "" {
+			out = fmt.Sprintf("%s(src.%s)", f.ProtoToGoType, f.ProtoName)
+		}
+	}
+
+	return out
+}
+
+// formatOneofInitField return text representation for filling up initialized
+// oneof fields.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatOneofInitField(f Field, swapped bool) string {
+	if !swapped || !f.IsOneof() {
+		return ""
+	}
+	return fmt.Sprintf(" %s(src.%s, s.%s, version)", f.GoToProtoType, f.Name, f.ProtoName)
+
+}
+
+func formatComplexField(f Field, swapped bool) string {
+	if f.ProtoToGoType != "" {
+		return fmt.Sprintf(" %s(src.%s %s)", f.convertFunc(swapped), f.name(swapped), f.Opts)
+	}
+
+	return fmt.Sprintf("src.%s", f.name(swapped))
+}
+
+// formatEmbeddedField returns text representation of fields of embedded sub
+// message. Direct transformation reads each field through getters of sub
+// message, which are safe for nil values. Reverse transformation builds sub
+// message out of Go structure fields.
+func formatEmbeddedField(f Field, swapped bool, pref string) string {
+	fields := make([]string, 0, len(f.EmbeddedFields))
+
+	for _, ef := range f.EmbeddedFields {
+		if !swapped {
+			// getters of value sub messages (gogoproto.nullable = false) return
+			// non-addressable values, so sub message field is used directly.
+			parent := fmt.Sprintf("Get%s()", f.ProtoName)
+			if !f.ProtoIsPointer {
+				parent = f.ProtoName
+			}
+			ef.ProtoName = fmt.Sprintf("%s.Get%s()", parent, ef.ProtoName)
+		}
+		fields = append(fields, formatField(ef, swapped, pref))
+	}
+
+	if !swapped {
+		return strings.Join(fields, "\n")
+	}
+
+	amp := ""
+	if f.ProtoIsPointer {
+		amp = "&"
+	}
+
+	return fmt.Sprintf("%s: %s%s.%s{\n%s\n},", f.ProtoName, amp, pref, f.ProtoType, strings.Join(fields, "\n"))
+}
+
+// flatFields returns list of fields where embedded fields are replaced with
+// fields of sub message.
+//
+// This function is mapped into template. See funcMap variable for details.
+func flatFields(fields []Field) []Field {
+	out := make([]Field, 0, len(fields))
+
+	for _, f := range fields {
+		if f.IsEmbedded() {
+			out = append(out, flatFields(f.EmbeddedFields)...)
+			continue
+		}
+		out = append(out, f)
+	}
+
+	return out
+}
+
+// nameFields returns fields of name maps, embedded fields are replaced with
+// fields of sub message. Cases of oneof which are transformed into the same
+// model field are mapped by the first case in reverse maps, so keys are
+// unique.
+//
+// This function is mapped into template. See funcMap variable for details.
+func nameFields(fields []Field, swapped bool) []Field {
+	flat := flatFields(fields)
+	if !swapped {
+		return flat
+	}
+
+	out := make([]Field, 0, len(flat))
+	seen := map[string]bool{}
+
+	for _, f := range flat {
+		if f.Case != nil && f.Case.Variant != "" {
+			if seen[f.Name] {
+				continue
+			}
+			seen[f.Name] = true
+		}
+		out = append(out, f)
+	}
+
+	return out
+}
+
+// formatField returns a string with appropriate field convert functions for
+// using in template.
+func formatField(f Field, swapped bool, pref string) string {
+	if f.IsEmbedded() {
+		return formatEmbeddedField(f, swapped, pref)
+	}
+
+	return fmt.Sprintf("%s: %s,", f.name(!swapped), fieldValue(f, swapped, pref))
+}
+
+// fieldValue returns expression which transforms source field f into
+// destination field.
+func fieldValue(f Field, swapped bool, pref string) string {
+	if f.IsOneof() {
+		return formatOneofField(f, swapped, pref)
+	}
+
+	if f.Enum != nil {
+		return f.Enum.convert("src."+f.name(swapped), swapped, pref)
+	}
+
+	return formatComplexField(f, swapped)
+}
+
+// formatPromotedField returns statement which sets model field f promoted
+// from embedded structure. Composite literals can't set promoted fields, so
+// the field is assigned after model is created.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatPromotedField(f Field, d Data) string {
+	return fmt.Sprintf("\ts.%s = %s\n", f.name(!d.Swapped), fieldValue(f, d.Swapped, d.DstPref))
+}
+
+// formatEdgeField returns statement which transforms field f of ent edge.
+// Edges which are not loaded are nil, so they are skipped in both directions.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatEdgeField(f Field, d Data) string {
+	return fmt.Sprintf("\tif src.%s != nil {\n\t\ts.%s = %s\n\t}\n", f.name(d.Swapped), f.name(!d.Swapped), fieldValue(f, d.Swapped, d.DstPref))
+}
+
+// formatFieldNames returns a map entry which links proto field name as it's
+// declared in .proto file with Go structure field name. Swapped flag reverses
+// the entry.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatFieldNames(f Field, swapped bool) string {
+	if swapped {
+		return fmt.Sprintf("%q: %q,", f.Name, f.ProtoOrigName)
+	}
+
+	return fmt.Sprintf("%q: %q,", f.ProtoOrigName, f.Name)
+}
+
+// formatJSONNames returns a map entry which links field name in JSON
+// representation of proto message with field name from json tag of Go
+// structure. Swapped flag reverses the entry.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatJSONNames(f Field, swapped bool) string {
+	if swapped {
+		return fmt.Sprintf("%q: %q,", f.GoJSONName, f.ProtoJSONName)
+	}
+
+	return fmt.Sprintf("%q: %q,", f.ProtoJSONName, f.GoJSONName)
+}
+
+// schemaHash returns SHA-256 hash of signatures of fields, embedded fields
+// are replaced with fields of sub message. Hash doesn't depend on field order.
+//
+// This function is mapped into template. See funcMap variable for details.
+func schemaHash(fields []Field) string {
+	flat := flatFields(fields)
+
+	sigs := make([]string, 0, len(flat))
+	for _, f := range flat {
+		sigs = append(sigs, f.Signature)
+	}
+	sort.Strings(sigs)
+
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(sigs, "\n"))))
+}
+
+// formatElemField returns statements which fill up destination field
+// element by element. Nil source field remains nil in destination.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatElemField(f Field, d Data) string {
+	e := f.Elem
+	if e == nil {
+		return ""
+	}
+
+	if e.Kind == elemPairs {
+		return formatPairsField(f, d)
+	}
+
+	src, dst := f.ProtoName, f.Name
+	srcPtr, dstPtr := e.ProtoIsPointer, e.GoIsPointer
+	dstType := e.goType(d)
+	if d.Swapped {
+		src, dst = dst, src
+		srcPtr, dstPtr = dstPtr, srcPtr
+		dstType = e.protoType(d)
+	}
+
+	if dstPtr {
+		dstType = "*" + dstType
+	}
+
+	coll, idx := "[]", "i"
+	if e.MapKey != "" {
+		coll, idx = fmt.Sprintf("map[%s]", e.MapKey), "k"
+	}
+
+	// getters of wrapper types and list wrappers are safe for nil values.
+	getter := (e.Kind == elemWrapper || e.Kind == elemList) && !d.Swapped
+
+	v := "v"
+	skipNil := ""
+	// transformers of messages get and return elements of both types as is,
+	// nil values are handled by them.
+	direct := e.Kind == elemMessage
+
+	if srcPtr && (dstPtr || !getter) && !direct {
+		nilElem := ""
+		if e.MapKey != "" && dstPtr {
+			nilElem = fmt.Sprintf("\t\t\t\ts.%s[k] = nil\n", dst)
+		}
+		skipNil = fmt.Sprintf("\t\t\tif v == nil {\n%s\t\t\t\tcontinue\n\t\t\t}\n", nilElem)
+	}
+	if srcPtr && !getter && !direct {
+		v = "*v"
+	}
+
+	assign := fmt.Sprintf("\t\t\ts.%s[%s] = %s\n", dst, idx, e.convert(v, d))
+	if dstPtr && !direct {
+		assign = fmt.Sprintf("\t\t\te := %s\n\t\t\ts.%s[%s] = &e\n", e.convert(v, d), dst, idx)
+	}
+
+	empty := ""
+	if d.EmptySliceOnNil && e.MapKey == "" {
+		empty = fmt.Sprintf(" else {\n\t\ts.%s = []%s{}\n\t}", dst, dstType)
+	}
+
+	alloc := fmt.Sprintf("s.%s = make(%s%s, len(src.%s))", dst, coll, dstType, src)
+	if d.Reuse {
+		// reused slice is truncated and reused map is cleared by in-place
+		// transformers before fields are set, see intoT. Appended zero
+		// elements reuse backing array if it has enough capacity.
+		if e.MapKey != "" {
+			alloc = fmt.Sprintf("if s.%[1]s == nil {\n\t\t\ts.%[1]s = make(%[2]s%[3]s, len(src.%[4]s))\n\t\t}", dst, coll, dstType, src)
+		} else {
+			alloc = fmt.Sprintf("s.%[1]s = append(s.%[1]s[:0], make(%[2]s%[3]s, len(src.%[4]s))...)", dst, coll, dstType, src)
+		}
+		if empty != "" {
+			empty = fmt.Sprintf(" else if s.%s == nil {\n\t\ts.%s = []%s{}\n\t}", dst, dst, dstType)
+		}
 	}
 
-	d := {{ template "FuncName" . }}(*src, opts...)
-	return &d
-}`, funcNameT, srcParamT, dstParamT)
+	return fmt.Sprintf("\tif src.%[1]s != nil {\n\t\t%[2]s\n\t\tfor %[5]s, v := range src.%[1]s {\n%[3]s%[4]s\t\t}\n\t}%[6]s\n",
+		src, alloc, skipNil, assign, idx, empty)
+}
 
-	ptr2valT = mt("ptr2val", `func {{ template "FuncName" . }}PtrVal(src *{{ template "SrcParam" . }}) {{ template "DstParam" . }} {
-	if src == nil {
-		return {{ template "DstParam" . }}{}
+// formatEmptySliceField returns statement which replaces nil value of
+// repeated field f, which is copied as is, with empty slice.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatEmptySliceField(f Field, d Data) string {
+	if f.EmptySlice == "" {
+		return ""
 	}
 
-	return {{ template "FuncName" . }}(*src, opts...)
-}`, funcNameT, srcParamT, dstParamT)
+	return fmt.Sprintf("\tif s.%[1]s == nil {\n\t\ts.%[1]s = %[2]s{}\n\t}\n", f.name(!d.Swapped), f.EmptySlice)
+}
 
-	val2ptrT = mt("val2ptr", `func {{ template "FuncName" . }}ValPtr(src {{ template "SrcParam" . }}) *{{ template "DstParam" . }} {
-	d := {{ template "FuncName" . }}(src, opts...)
-	return &d
-}`, funcNameT, srcParamT, dstParamT)
+// formatPairsField returns statements which transform map field into slice
+// of pairs sorted by key and vice versa. Nil source field remains nil in
+// destination, if slice contains several pairs with the same key, the last one
+// is used.
+func formatPairsField(f Field, d Data) string {
+	e := f.Elem
+
+	pair := e.goType(d)
+	if !d.Swapped {
+		star, ref := "", ""
+		if e.GoIsPointer {
+			star, ref = "*", "&"
+		}
 
-	val2valT = mt("val2val", `func {{ template "FuncName" . }}(src {{ template "SrcParam" . }}) {{ template "DstParam" . }} {
-	s := {{ template "DstParam" . }}{
-		{{- with $R := . }}
-			{{- range $f := .Fields}}
-			{{ formatField $f $R.Swapped $R.DstPref }}
-			{{- end -}}
-		{{- end }}
-	}
+		return fmt.Sprintf(`	if src.%[1]s != nil {
+		keys := make([]%[3]s, 0, len(src.%[1]s))
+		for k := range src.%[1]s {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
 
-	applyOptions(opts...)
+		s.%[2]s = make([]%[4]s%[6]s, len(keys))
+		for i, k := range keys {
+			s.%[2]s[i] = %[5]s%[6]s{Key: k, Value: src.%[1]s[k]}
+		}
+	}
+`, f.ProtoName, f.Name, e.MapKey, star, ref, pair)
+	}
 
-{{- with $R := . }}
-{{ range $f := .Fields }}
-{{ formatOneofInitField $f $R.Swapped }}
-{{- end -}}
-{{- end }}
-	return s
-}`, funcNameT, srcParamT, dstParamT)
+	skipNil := ""
+	if e.GoIsPointer {
+		skipNil = "\t\t\tif v == nil {\n\t\t\t\tcontinue\n\t\t\t}\n"
+	}
 
-	lst2lstT = mt("lst2lst", `func {{ template "FuncName" . }}{{ template "ptr" . }}List(src []{{ template "star" . }}{{ template "SrcParam" . }}) []{{ template "star" . }}{{ template "DstParam" . }} {
-	resp := make([]{{ template "star" . }}{{ template "DstParam" . }}, len(src))
+	return fmt.Sprintf("\tif src.%[1]s != nil {\n\t\ts.%[2]s = make(map[%[3]s]%[4]s, len(src.%[1]s))\n\t\tfor _, v := range src.%[1]s {\n%[5]s\t\t\ts.%[2]s[v.Key] = v.Value\n\t\t}\n\t}\n",
+		f.Name, f.ProtoName, e.MapKey, e.ProtoType, skipNil)
+}
 
-	for i, s := range src {
-		resp[i] = {{ template "FuncName" . }}{{ template "ptrOnly" . }}(s, opts...)
+// formatWrapperField returns statements which fill up destination field of
+// field with google.protobuf wrapper type, see transformer.wrappers_as. Nil
+// source field remains zero value in destination.
+//
+// This function is mapped into template. See funcMap variable for details.
+func formatWrapperField(f Field, d Data) string {
+	e := f.Wrapper
+	if e == nil {
+		return ""
 	}
 
-	return resp
-}`, funcNameT, ptrT, srcParamT, starT, dstParamT, ptrOnlyT)
+	switch e.Kind {
+	case elemValue:
+		return formatValuePointerField(f, d)
+	case elemTime:
+		return formatStdTimeField(f, d)
+	case elemDuration:
+		return formatDurationField(f, d)
+	case elemOptional:
+		return formatOptionalField(f, d)
+	case elemCustom:
+		return formatCustomFuncField(f, d)
+	case elemStruct:
+		return formatStructField(f, d)
+	case elemBytes:
+		return formatBytesField(f, d)
+	case elemUUID:
+		return formatUUIDField(f, d)
+	case elemDecimal:
+		return formatDecimalField(f, d)
+	case elemNull:
+		return formatNullField(f, d)
+	}
 
-	ptrlst2ptrlstT = mt("ptrlst2ptrlst", `{{ template "lst2lst" .P true }}`, lst2lstT, funcNameT, ptrT, starT, srcParamT, dstParamT, ptrOnlyT)
+	if !d.Swapped {
+		v := fmt.Sprintf("src.%s.Value", f.ProtoName)
+		if !e.GoIsPointer {
+			if e.ProtoIsPointer {
+				v = fmt.Sprintf("src.%s.GetValue()", f.ProtoName)
+			}
+			return fmt.Sprintf("\ts.%s = %s\n", f.Name, v)
+		}
 
-	vallst2vallstT = mt("vallst2vallst", `{{ template "lst2lst" . }}`, lst2lstT, funcNameT, ptrT, starT, srcParamT, dstParamT, ptrOnlyT)
+		assign := fmt.Sprintf("s.%[1]s = new(%[2]s)\n\t*s.%[1]s = %[3]s\n", f.Name, e.GoType, v)
+		if !e.ProtoIsPointer {
+			return "\t" + assign
+		}
+		return fmt.Sprintf("\tif src.%s != nil {\n\t\t%s\t}\n", f.ProtoName, strings.Replace(assign, "\n\t", "\n\t\t", -1))
+	}
 
-	ptrlst2vallstT = mt("ptrlst2vallst", `func {{ template "FuncName" . }}{{ template "PtrValName" . }}List(src []{{ .SrcPointer }}{{ template "SrcParam" . }}) []{{ .DstPointer }}{{ template "DstParam" . }} {
-	resp := make([]{{ .DstPointer }}{{ template "DstParam" . }}, len(src))
+	amp := ""
+	if e.ProtoIsPointer {
+		amp = "&"
+	}
 
-	for i, s := range src {
-		{{- if .DstPointer  }}
-		g := {{ template "FuncName" . }}(s, opts...)
-		resp[i] = &g
-		{{ else }}
-		resp[i] = {{ template "FuncName" . }}(*s)
-		{{ end -}}
+	if !e.GoIsPointer {
+		return fmt.Sprintf("\ts.%s = %s%s{Value: src.%s}\n", f.ProtoName, amp, e.protoType(d), f.Name)
 	}
 
-	return resp
-}`, funcNameT, ptrValT, srcParamT, dstParamT)
+	return fmt.Sprintf("\tif src.%[1]s != nil {\n\t\ts.%[2]s = %[3]s%[4]s{Value: *src.%[1]s}\n\t}\n", f.Name, f.ProtoName, amp, e.protoType(d))
+}
 
-	ptr2vallstT = mt("ptr2vallst", `// {{ template "FuncName" . }}List is DEPRECATED. Use {{ template "FuncName" . }}{{ template "PtrValName" . }}List instead.
-func {{ template "FuncName" . }}List(src []{{ .SrcPointer }}{{ template "SrcParam" . }}) []{{ .DstPointer }}{{ template "DstParam" . }} {
-	return {{ template "FuncName" . }}{{ template "PtrValName" . }}List(src)
-}`, funcNameT, ptrValT, srcParamT, dstParamT)
+// formatValuePointerField returns statements which transform pointer of
+// standard type, e.g. *time.Time, into value and vice versa. Nil pointer
+// becomes zero value, zero value becomes nil pointer.
+func formatValuePointerField(f Field, d Data) string {
+	e := f.Wrapper
+
+	src, dst := f.ProtoName, f.Name
+	srcPtr := e.ProtoIsPointer
+	if d.Swapped {
+		src, dst = dst, src
+		srcPtr = e.GoIsPointer
+	}
 
-	tpls = []*template.Template{
-		funcNameT, srcParamT, dstParamT, ptrValT, ptrT, ptrOnlyT, starT, ptr2ptrT,
-		ptr2valT, val2ptrT, val2valT, lst2lstT, ptrlst2ptrlstT, vallst2vallstT,
-		ptrlst2vallstT, ptr2vallstT,
+	if srcPtr {
+		return fmt.Sprintf("\tif src.%[1]s != nil {\n\t\ts.%[2]s = *src.%[1]s\n\t}\n", src, dst)
 	}
 
-	// Executed with Data struct.
-	oneFuncitonSetT = `{{- template "ptr2ptr" . }}
+	notZero := fmt.Sprintf("!src.%s.IsZero()", src)
+	if e.GoType == "time.Duration" {
+		notZero = fmt.Sprintf("src.%s != 0", src)
+	}
 
-{{ template "ptrlst2ptrlst" . }}
+	return fmt.Sprintf("\tif %[1]s {\n\t\tv := src.%[2]s\n\t\ts.%[3]s = &v\n\t}\n", notZero, src, dst)
+}
 
-{{ template "ptr2val" . }}
+// formatStdTimeField returns statements which transform
+// google.protobuf.Timestamp structure into time.Time and vice versa without
+// helper functions. Nil timestamp becomes zero time, zero time becomes nil
+// timestamp.
+func formatStdTimeField(f Field, d Data) string {
+	e := f.Wrapper
+
+	if !d.Swapped {
+		v := fmt.Sprintf("time.Unix(src.%[1]s.Seconds, int64(src.%[1]s.Nanos)).UTC()", f.ProtoName)
+		assign := fmt.Sprintf("s.%s = %s\n", f.Name, v)
+		if e.GoIsPointer {
+			assign = fmt.Sprintf("t := %s\n\ts.%s = &t\n", v, f.Name)
+		}
 
-{{ template "ptrlst2vallst" . }}
+		if !e.ProtoIsPointer {
+			return "\t" + assign
+		}
+		return fmt.Sprintf("\tif src.%s != nil {\n\t\t%s\t}\n", f.ProtoName, strings.Replace(assign, "\n\t", "\n\t\t", -1))
+	}
 
-{{ template "ptr2vallst" . }}
+	amp := ""
+	if e.ProtoIsPointer {
+		amp = "&"
+	}
 
-{{ template "val2val" . }}
+	cond := fmt.Sprintf("!src.%s.IsZero()", f.Name)
+	if e.GoIsPointer {
+		cond = fmt.Sprintf("src.%s != nil", f.Name)
+	}
 
-{{ template "val2ptr" . }}
+	return fmt.Sprintf("\tif %[1]s {\n\t\ts.%[2]s = %[3]s%[4]s{Seconds: src.%[5]s.Unix(), Nanos: int32(src.%[5]s.Nanosecond())}\n\t}\n",
+		cond, f.ProtoName, amp, e.protoType(d), f.Name)
+}
 
-{{ template "vallst2vallst" . }}
+// formatDurationField returns statements which transform
+// google.protobuf.Duration field into time.Duration or integer model field and
+// vice versa without helper functions. Nil duration becomes zero, zero value
+// becomes nil duration.
+func formatDurationField(f Field, d Data) string {
+	e := f.Wrapper
+	std := e.ProtoType == "time.Duration"
+
+	if !d.Swapped {
+		v := "*src." + f.ProtoName
+		if !e.ProtoIsPointer {
+			v = "src." + f.ProtoName
+		}
+		if !std {
+			v = fmt.Sprintf("time.Duration(src.%[1]s.Seconds)*time.Second + time.Duration(src.%[1]s.Nanos)", f.ProtoName)
+		}
+		switch {
+		case e.Unit == "time.Nanosecond":
+			v = fmt.Sprintf("%s(%s)", e.GoType, v)
+		case e.Unit != "":
+			if !std {
+				v = "(" + v + ")"
+			}
+			v = fmt.Sprintf("%s(%s / %s)", e.GoType, v, e.Unit)
+		}
 
-`
+		assign := fmt.Sprintf("s.%s = %s\n", f.Name, v)
+		if e.GoIsPointer {
+			assign = fmt.Sprintf("v := %s\n\ts.%s = &v\n", v, f.Name)
+		}
 
-	oneofT = `
-type Oneof{{ .Decl }} interface {
-	GetStringValue() string
-	GetInt64Value() int64
-}
+		if !e.ProtoIsPointer {
+			return "\t" + assign
+		}
+		return fmt.Sprintf("\tif src.%s != nil {\n\t\t%s\t}\n", f.ProtoName, strings.Replace(assign, "\n\t", "\n\t\t", -1))
+	}
 
-func {{ .ProtoType }}To{{ .GoType }}(src Oneof{{ .Decl }}) string {
-	if s := src.GetStringValue(); s != "" {
-		return s
+	v := "src." + f.Name
+	if e.GoIsPointer {
+		v = "*src." + f.Name
+	}
+	switch {
+	case e.Unit == "time.Nanosecond":
+		v = fmt.Sprintf("time.Duration(%s)", v)
+	case e.Unit != "":
+		v = fmt.Sprintf("time.Duration(%s) * %s", v, e.Unit)
 	}
 
-	if i := src.GetInt64Value(); i != 0 {
-		return strconv.FormatInt(i, 10)
+	var assign string
+	switch {
+	case !std:
+		amp := ""
+		if e.ProtoIsPointer {
+			amp = "&"
+		}
+		assign = fmt.Sprintf("d := %s\n\ts.%s = %s%s{Seconds: int64(d / time.Second), Nanos: int32(d %% time.Second)}\n",
+			v, f.ProtoName, amp, e.protoType(d))
+	case e.ProtoIsPointer:
+		assign = fmt.Sprintf("d := %s\n\ts.%s = &d\n", v, f.ProtoName)
+	default:
+		assign = fmt.Sprintf("s.%s = %s\n", f.ProtoName, v)
 	}
 
-	return "<nil>"
+	cond := fmt.Sprintf("src.%s != 0", f.Name)
+	if e.GoIsPointer {
+		cond = fmt.Sprintf("src.%s != nil", f.Name)
+	}
+
+	return fmt.Sprintf("\tif %s {\n\t\t%s\t}\n", cond, strings.Replace(assign, "\n\t", "\n\t\t", -1))
 }
 
-func {{ .GoType }}To{{ .ProtoType }}(s string, dst *{{ .ProtoPackage }}.{{ .ProtoType }}, v string) {
-	i, err := strconv.ParseInt(s, 10, 64)
-	if err != nil  || v == "v2"{
-		dst.{{ .Decl }} = &{{ .ProtoPackage }}.{{ .ProtoType }}_StringValue{StringValue: s}
-		return
+// formatOptionalField returns statements which transform proto3 optional
+// field into pointer or value model field and vice versa. Pointers are not
+// shared between structures, pointed values are copied. Nil pointer becomes
+// zero value, value model field always sets proto field.
+func formatOptionalField(f Field, d Data) string {
+	e := f.Wrapper
+
+	if !d.Swapped {
+		if !e.GoIsPointer {
+			return fmt.Sprintf("\tif src.%[1]s != nil {\n\t\ts.%[2]s = *src.%[1]s\n\t}\n", f.ProtoName, f.Name)
+		}
+		return fmt.Sprintf("\tif src.%[1]s != nil {\n\t\tv := *src.%[1]s\n\t\ts.%[2]s = &v\n\t}\n", f.ProtoName, f.Name)
 	}
 
-	dst.{{ .Decl }} = &{{ .ProtoPackage }}.{{ .ProtoType }}_Int64Value{Int64Value: i}
-	return
+	if !e.GoIsPointer {
+		return fmt.Sprintf("\tv%[2]s := src.%[1]s\n\ts.%[2]s = &v%[2]s\n", f.Name, f.ProtoName)
+	}
+	return fmt.Sprintf("\tif src.%[1]s != nil {\n\t\tv := *src.%[1]s\n\t\ts.%[2]s = &v\n\t}\n", f.Name, f.ProtoName)
 }
 
-`
+// formatCustomFuncField returns statement which transforms field with
+// function of transformer.custom_pb_to_go or transformer.custom_go_to_pb
+// option, empty string if there is no function for the direction. Error of
+// function which returns value and error is returned, see failField.
+func formatCustomFuncField(f Field, d Data) string {
+	e := f.Wrapper
 
-	optionsT = `var version string
+	src, dst, fn := f.ProtoName, f.Name, e.ProtoToGo
+	if d.Swapped {
+		src, dst, fn = dst, src, e.GoToProto
+	}
 
-// TransformParam is a function option type.
-type TransformParam func()
+	if fn == "" {
+		return ""
+	}
 
-// WithVersion sets global version variable.
-func WithVersion(v string) TransformParam {
-	return func() {
-		version = v
+	ctx := ""
+	if d.WithContext {
+		ctx = "ctx, "
 	}
-}
 
-func applyOptions(opts ...TransformParam) {
-	for _, o := range opts {
-		o()
+	if !e.WithError {
+		return fmt.Sprintf("\ts.%s = %s(%ssrc.%s)\n", dst, fn, ctx, src)
 	}
+
+	return fmt.Sprintf("\tv%[1]s, err := %[2]s(%[5]ssrc.%[3]s)\n\tif err != nil {\n\t\t%[4]s\n\t}\n\ts.%[1]s = v%[1]s\n",
+		dst, fn, src, failField(src, d), ctx)
 }
 
-`
-)
+// formatStructField returns statements which transform google.protobuf.Struct
+// field with functions of ValueHelpers. Errors are handled like errors of
+// custom functions, see failField.
+func formatStructField(f Field, d Data) string {
+	e := *f.Wrapper
+	e.WithError = e.WithError || d.Swapped
+	f.Wrapper = &e
+	d.WithContext = false
 
-// templateWithHelpers initializes main oneFuncitonSetT template with given
-// name, adds there sub-templates and maps functions into template.
-func templateWithHelpers(name string) (*template.Template, error) {
-	t := template.
-		New(name).
-		Funcs(funcMap)
+	return formatCustomFuncField(f, d)
+}
 
-	for _, v := range tpls {
-		if _, err := t.AddParseTree(at(v)); err != nil {
-			return nil, err
-		}
+// formatBytesField returns statements which transform bytes field with
+// functions of transformer.bytes_converter option, bytes are parsed with an
+// error. Empty fields aren't parsed and leave model field zero. Errors are
+// handled like errors of custom functions, see failField.
+func formatBytesField(f Field, d Data) string {
+	e := *f.Wrapper
+	e.WithError = !d.Swapped
+	f.Wrapper = &e
+	d.WithContext = false
+
+	s := formatCustomFuncField(f, d)
+	if d.Swapped || s == "" {
+		return s
 	}
 
-	return t.Parse(oneFuncitonSetT)
+	return fmt.Sprintf("\tif len(src.%s) > 0 {\n\t%s\t}\n", f.ProtoName, strings.Replace(s, "\n\t", "\n\t\t", -1))
 }
 
-// Field represents one structure field.
-type Field struct {
-	// Field name in Go structure.
-	Name string
-	// Field name in .proto file.
-	ProtoName string
-	// Field type in .proto file.
-	ProtoType string
-	// Name of function which is used for converting proto field into Go one.
-	ProtoToGoType string
-	// Name of function which is used for converting Go field into proto one.
-	GoToProtoType string
-	// True if field in model is a pointer.
-	GoIsPointer bool
-	// True if field in .proto file has an option gogoproto.nullable = false
-	ProtoIsPointer bool
-	// It true, field GoToProtoType and ProtoToGoType functions will be used
-	// with prefix.
-	UsePackage bool
-	// The field has a value when it is used for the oneof migration from Int64 to String for the field
-	// TODO:  This is a specific case of OneOf which is used by BoldCommerce and needs to be removed from the plugin.
-	//        This field will be deprecated together with oneof.go once BoldCommerce update their code
-	OneofDecl string
-	Opts      string
-}
+// formatUUIDField returns statements which transform string or bytes field
+// into uuid.UUID and back, empty field is transformed into uuid.Nil or nil.
+// Parse errors are handled like errors of custom functions, see failField.
+func formatUUIDField(f Field, d Data) string {
+	e := f.Wrapper
+	pkg := e.GoType[:strings.LastIndex(e.GoType, ".")]
+
+	if d.Swapped {
+		cond, v := fmt.Sprintf("src.%s != %s.Nil", f.Name, pkg), fmt.Sprintf("src.%s.String()", f.Name)
+		if e.GoIsPointer {
+			cond = fmt.Sprintf("src.%s != nil", f.Name)
+		}
+		if e.ProtoType == "bytes" {
+			v = fmt.Sprintf("src.%s[:]", f.Name)
+		}
 
-// IsOneof returns true if Field has non-empty OneOf declaration.
-func (f Field) IsOneof() bool {
-	return f.OneofDecl != ""
-}
+		return fmt.Sprintf("\tif %s {\n\t\ts.%s = %s\n\t}\n", cond, f.ProtoName, v)
+	}
 
-// name based on swapped flag return Name or ProtoName for current Field.
-func (f Field) name(swapped bool) string {
-	if swapped {
-		return f.Name
+	fn, ref := pkg+".Parse", ""
+	if e.ProtoType == "bytes" {
+		fn = pkg + ".FromBytes"
 	}
-	return f.ProtoName
+	if e.GoIsPointer {
+		ref = "&"
+	}
+
+	return fmt.Sprintf("\tif len(src.%[3]s) > 0 {\n\t\tv%[1]s, err := %[2]s(src.%[3]s)\n\t\tif err != nil {\n\t\t\t%[4]s\n\t\t}\n\t\ts.%[1]s = %[5]sv%[1]s\n\t}\n",
+		f.Name, fn, f.ProtoName, failField(f.ProtoName, d), ref)
 }
 
-// convertFunc based on swapped flag and value of Field properties returns a type name
-// for current Field.
-func (f Field) convertFunc(swapped bool) string {
-	out := f.ProtoToGoType
-	if swapped {
-		out = f.GoToProtoType
+// formatDecimalField returns statements which transform string, int64 or
+// google.type.Money field into decimal.Decimal and back. Integer fields hold
+// decimal shifted by Scale digits, model decimal is rounded by Rounding method
+// if it has more digits than proto field. Parse errors of string fields are
+// handled like errors of custom functions, see failField.
+func formatDecimalField(f Field, d Data) string {
+	e := f.Wrapper
+	pkg := e.GoType[:strings.LastIndex(e.GoType, ".")]
+
+	switch {
+	case e.ProtoType == "string" && d.Swapped:
+		return fmt.Sprintf("\ts.%s = src.%s.String()\n", f.ProtoName, f.Name)
+	case e.ProtoType == "string":
+		return fmt.Sprintf("\tif len(src.%[3]s) > 0 {\n\t\tv%[1]s, err := %[2]s.NewFromString(src.%[3]s)\n\t\tif err != nil {\n\t\t\t%[4]s\n\t\t}\n\t\ts.%[1]s = v%[1]s\n\t}\n",
+			f.Name, pkg, f.ProtoName, failField(f.ProtoName, d))
+	case e.ProtoType == "int64" && d.Swapped:
+		shift := ""
+		if e.Scale != 0 {
+			shift = fmt.Sprintf(".Shift(%d)", e.Scale)
+		}
+		return fmt.Sprintf("\ts.%s = src.%s%s.%s(0).IntPart()\n", f.ProtoName, f.Name, shift, e.Rounding)
+	case e.ProtoType == "int64":
+		return fmt.Sprintf("\ts.%s = %s.New(src.%s, %d)\n", f.Name, pkg, f.ProtoName, -e.Scale)
+	case d.Swapped:
+		code := ""
+		switch {
+		case e.Currency == "":
+		case e.CurrencyType == "string":
+			code = fmt.Sprintf("CurrencyCode: src.%s, ", e.Currency)
+		default:
+			code = fmt.Sprintf("CurrencyCode: string(src.%s), ", e.Currency)
+		}
+		ref := ""
+		if e.ProtoIsPointer {
+			ref = "&"
+		}
+		return fmt.Sprintf("\tv%[1]s := src.%[2]s.%[3]s(9)\n\ts.%[1]s = %[4]s_type.Money{%[5]sUnits: v%[1]s.IntPart(), Nanos: int32(v%[1]s.Sub(%[6]s.New(v%[1]s.IntPart(), 0)).Shift(9).IntPart())}\n",
+			f.ProtoName, f.Name, e.Rounding, ref, code, pkg)
 	}
 
-	if f.GoIsPointer && f.ProtoIsPointer {
-		out += "Ptr"
+	out := fmt.Sprintf("s.%s = %s.New(src.%[3]s.Units, 0).Add(%[2]s.New(int64(src.%[3]s.Nanos), -9))\n", f.Name, pkg, f.ProtoName)
+	switch {
+	case e.Currency == "":
+	case e.CurrencyType == "string":
+		out += fmt.Sprintf("s.%s = src.%s.CurrencyCode\n", e.Currency, f.ProtoName)
+	default:
+		out += fmt.Sprintf("s.%s = %s(src.%s.CurrencyCode)\n", e.Currency, e.CurrencyType, f.ProtoName)
 	}
-	if !f.GoIsPointer && !f.ProtoIsPointer {
-		out += ""
-	}
-	list := strings.HasSuffix(out, "List")
-	if list {
-		out = strings.TrimSuffix(out, "List")
+
+	if !e.ProtoIsPointer {
+		return "\t" + strings.Replace(strings.TrimSuffix(out, "\n"), "\n", "\n\t", -1) + "\n"
 	}
 
-	suffix := ""
+	return fmt.Sprintf("\tif src.%s != nil {\n\t\t%s\n\t}\n", f.ProtoName, strings.Replace(strings.TrimSuffix(out, "\n"), "\n", "\n\t\t", -1))
+}
 
-	if !f.GoIsPointer && f.ProtoIsPointer {
-		if swapped {
-			suffix = "ValPtr"
-		} else {
-			suffix = "PtrVal"
+// formatNullField returns statements which transform scalar, wrapper or
+// google.protobuf.Timestamp field into nullable type with Valid flag and back.
+// Nil proto field becomes invalid value, invalid value becomes nil proto
+// field, values of other proto fields are always valid.
+func formatNullField(f Field, d Data) string {
+	e := f.Wrapper
+
+	conv := func(fn, v string) string {
+		if fn == "" {
+			return v
 		}
+		return fn + "(" + v + ")"
 	}
 
-	if f.GoIsPointer && !f.ProtoIsPointer {
-		if swapped {
-			suffix = "PtrVal"
-		} else {
-			suffix = "ValPtr"
+	if !d.Swapped {
+		v := "src." + f.ProtoName
+		switch {
+		case e.ProtoType == "Timestamp":
+			v = fmt.Sprintf("time.Unix(src.%[1]s.Seconds, int64(src.%[1]s.Nanos)).UTC()", f.ProtoName)
+		case e.ProtoType != "":
+			v += ".Value"
+		case e.ProtoIsPointer:
+			v = "*" + v
 		}
-	}
 
-	if suffix != "" {
-		out += suffix
-		if list {
-			out += "List"
+		assign := fmt.Sprintf("s.%s = %s{%s: %s, Valid: true}", f.Name, e.GoType, e.Value, conv(e.ProtoToGo, v))
+		if !e.ProtoIsPointer {
+			return "\t" + assign + "\n"
 		}
+		return fmt.Sprintf("\tif src.%s != nil {\n\t\t%s\n\t}\n", f.ProtoName, assign)
 	}
 
-	return out
+	v := conv(e.GoToProto, fmt.Sprintf("src.%s.%s", f.Name, e.Value))
+	amp := ""
+	if e.ProtoIsPointer {
+		amp = "&"
+	}
+
+	assign := ""
+	switch {
+	case e.ProtoType == "Timestamp":
+		assign = fmt.Sprintf("s.%[1]s = %[2]s%[3]s{Seconds: src.%[4]s.%[5]s.Unix(), Nanos: int32(src.%[4]s.%[5]s.Nanosecond())}",
+			f.ProtoName, amp, e.protoType(d), f.Name, e.Value)
+	case e.ProtoType != "":
+		assign = fmt.Sprintf("s.%s = %s%s{Value: %s}", f.ProtoName, amp, e.protoType(d), v)
+	case e.ProtoIsPointer:
+		assign = fmt.Sprintf("v := %s\n\t\ts.%s = &v", v, f.ProtoName)
+	default:
+		return fmt.Sprintf("\ts.%s = %s\n", f.ProtoName, v)
+	}
+
+	return fmt.Sprintf("\tif src.%s.Valid {\n\t\t%s\n\t}\n", f.Name, assign)
 }
 
-// formatOneofField returns text representation of Oneof field in structure for
-// template.
+// formatCallField returns statements which transform field f with
+// transformer of sub message which has transformer.with_errors or
+// transformer.with_context options.
 //
 // This function is mapped into template. See funcMap variable for details.
-func formatOneofField(f Field, swapped bool, pref string) string {
-	if !f.IsOneof() {
-		return fmt.Sprintf("/* field %q is not Oneof field*/", f.Name)
+func formatCallField(f Field, d Data) string {
+	src, dst := f.name(d.Swapped), f.name(!d.Swapped)
+
+	ctx := ""
+	if f.WithContext {
+		ctx = ctxArg(d) + ", "
 	}
 
-	out := "src." + f.ProtoName
-	if swapped {
-		out = fmt.Sprintf("&%s.%s{}", pref, f.ProtoType)
-	} else {
-		if f.ProtoToGoType != "" {
-			out = fmt.Sprintf("%s(src.%s)", f.ProtoToGoType, f.ProtoName)
-		}
+	call := fmt.Sprintf("%s(%ssrc.%s%s)", f.convertFunc(d.Swapped), ctx, src, f.Opts)
+	if !f.WithError {
+		return fmt.Sprintf("\ts.%s = %s\n", dst, call)
 	}
 
-	return out
+	return fmt.Sprintf("\tv%[1]s, err := %[2]s\n\tif err != nil {\n\t\t%[3]s\n\t}\n\ts.%[1]s = v%[1]s\n",
+		dst, call, failField(src, d))
 }
 
-// formatOneofInitField return text representation for filling up initialized
-// oneof fields.
-//
-// This function is mapped into template. See funcMap variable for details.
-func formatOneofInitField(f Field, swapped bool) string {
-	if !swapped || !f.IsOneof() {
-		return ""
+// ctxArg returns context argument of calls of transformers and functions
+// which accept context. Transformers of messages without
+// transformer.with_context option have no context, so context.TODO() is used.
+func ctxArg(d Data) string {
+	if d.WithContext {
+		return "ctx"
 	}
-	return fmt.Sprintf(" %s(src.%s, s.%s, version)", f.GoToProtoType, f.Name, f.ProtoName)
 
+	return "context.TODO()"
 }
 
-func formatComplexField(f Field, swapped bool) string {
-	if f.ProtoToGoType != "" {
-		return fmt.Sprintf(" %s(src.%s %s)", f.convertFunc(swapped), f.name(swapped), f.Opts)
+// failField returns statement which returns error of transformation of
+// source field src wrapped with field name. Such fields are allowed in
+// messages with transformer.with_errors option only, see fallibleFields.
+// In-place transformers return the error only.
+func failField(src string, d Data) string {
+	dst := d.Dst
+	if d.DstPref != "" {
+		dst = d.DstPref + "." + dst
 	}
 
-	return fmt.Sprintf("src.%s", f.name(swapped))
+	if d.Reuse {
+		return fmt.Sprintf("return fmt.Errorf(\"field %s: %%w\", err)", src)
+	}
+
+	return fmt.Sprintf("return %s{}, fmt.Errorf(\"field %s: %%w\", err)", dst, src)
 }
 
-// formatField returns a string with appropriate field convert functions for
-// using in template.
-func formatField(f Field, swapped bool, pref string) string {
-	left := f.name(!swapped)
+// fallibleFields returns names of model fields of fields which are
+// transformed by functions returning errors, e.g. parse errors of UUID fields.
+// forward and reverse are directions of generated transformers, proto to model
+// and model to proto ones.
+func fallibleFields(fields []Field, forward, reverse bool) []string {
+	var names []string
 
-	right := ""
-	if f.IsOneof() {
-		right = formatOneofField(f, swapped, pref)
-	} else {
-		right = formatComplexField(f, swapped)
+	for _, f := range fields {
+		if forward && !f.SkipPbToGo && fallible(f, false) || reverse && !f.SkipGoToPb && fallible(f, true) {
+			names = append(names, f.Name)
+		}
+	}
+
+	return names
+}
+
+// fallible returns true if transformation of field f in direction of swapped
+// flag returns error, i.e. formatting of the field calls failField.
+func fallible(f Field, swapped bool) bool {
+	if f.WithError || f.HelperCall != nil && f.HelperCall.Error {
+		return true
+	}
+
+	e := f.Wrapper
+	if e == nil {
+		return false
+	}
+
+	fn := e.ProtoToGo
+	if swapped {
+		fn = e.GoToProto
+	}
+
+	switch e.Kind {
+	case elemCustom:
+		return e.WithError && fn != ""
+	case elemStruct:
+		return (e.WithError || swapped) && fn != ""
+	case elemBytes:
+		return !swapped && fn != ""
+	case elemUUID:
+		return !swapped
+	case elemDecimal:
+		return !swapped && e.ProtoType == "string"
 	}
 
-	return fmt.Sprintf("%s: %s,", left, right)
+	return false
 }
 
 // OneofData contains info about OneOf fields.
@@ -382,6 +2856,11 @@ type Data struct {
 	Dst string
 	// Right (destination) part of transform function name.
 	DstFn string
+	// Names of transformers of the view and reverse ones without suffixes set
+	// by transformer.func_name_format_* options, e.g. ProductFromProto and
+	// ProductToProto. Empty names are made of SrcFn and DstFn.
+	Func        string
+	ReverseFunc string
 	// Contains "*" if destination structure is a pointer.
 	DstPointer string
 	// Field list of structure.
@@ -393,15 +2872,92 @@ type Data struct {
 	HelperPackage string
 	// Ptr is used in template for indication of pointer usage.
 	Ptr bool
+	// Package name of google.protobuf wrapper types.
+	WrappersPackage string
+	// If true, reverse functions, which transform model into proto message,
+	// are not generated.
+	NoReverse bool
+	// If true, forward functions, which transform proto message into model,
+	// are not generated, see transformer.direction.
+	NoForward bool
+	// Model structure fields checked by VerifyTransformers, empty if check is
+	// not generated.
+	ModelFields []modelField
+	// Fields of patch structure, empty if message has no transformer.go_patch
+	// option.
+	Patch []patchField
+	// Field mask paths of masked apply function, empty if message has no
+	// transformer.go_masked option.
+	Masked []maskedPath
+	// Model fields which can be set by message builder, empty if message has
+	// no transformer.go_builder option.
+	Builder []modelField
+	// If true, proto-JSON entry points are generated, message has
+	// transformer.go_json option.
+	JSON bool
+	// If true, transformers are added as methods of Converter structure.
+	Converter bool
+	// Fields of proto structure which are left empty by redacted model to
+	// proto transformers, see transformer.sensitive.
+	Sensitive redactedFields
+	// If true, in-place transformers, which set existing destination
+	// structures, are generated, message has transformer.go_into option.
+	Into bool
+	// If true, sync.Pool backed constructors of destination structures are
+	// generated, message has transformer.go_pool option. Pooled structures
+	// are filled by in-place transformers, so Into is set too.
+	Pool bool
+	// If true, fields are rendered into existing destination structure,
+	// slices and maps of its element-wise fields are reused, see InPlace.
+	Reuse bool
+	// If true, transformers return error as the second value, message has
+	// transformer.with_errors option.
+	WithErrors bool
+	// If true, nil repeated fields are transformed into empty slices, see
+	// transformer.empty_slice_on_nil.
+	EmptySliceOnNil bool
+	// If true, transformers accept context.Context as the first argument,
+	// message has transformer.with_context option.
+	WithContext bool
 }
 
-// swap swaps source and destination parameters for using in reverse functions.
-func (d *Data) swap() {
+// reverse returns a view of Data for rendering reverse functions, source and
+// destination parameters are swapped. Original Data is not changed, so forward
+// and reverse views can be rendered independently.
+func (d Data) reverse() Data {
 	d.SrcPref, d.DstPref = d.DstPref, d.SrcPref
 	d.Src, d.Dst = d.Dst, d.Src
 	d.SrcFn, d.DstFn = d.DstFn, d.SrcFn
+	d.Func, d.ReverseFunc = d.ReverseFunc, d.Func
 	d.SrcPointer, d.DstPointer = d.DstPointer, d.SrcPointer
 	d.Swapped = !d.Swapped
+
+	return d
+}
+
+// PoolName returns name of pool variable of destination structures of the
+// view, e.g. pbToProductPool. Used inside template.
+func (d Data) PoolName() string {
+	name := d.Func
+	if name == "" {
+		name = d.SrcFn + "To" + d.DstFn
+	}
+
+	return strings.ToLower(name[:1]) + name[1:] + "Pool"
+}
+
+// Skipped returns true if transformers of the view are not generated, i.e.
+// forward view of message with transformer.direction = GO_TO_PB. Used inside
+// template.
+func (d Data) Skipped() bool {
+	return d.NoForward && !d.Swapped
+}
+
+// FlipPointers swaps pointers of source and destination structures, e.g. for
+// rendering ValPtr list along with PtrVal one. Used inside template.
+func (d Data) FlipPointers() Data {
+	d.SrcPointer, d.DstPointer = d.DstPointer, d.SrcPointer
+	return d
 }
 
 // P sets Ptr flag of Data structure. Used inside template. Should be exported
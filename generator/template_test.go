@@ -63,10 +63,10 @@ var _ = Describe("Template", func() {
 				xEntry("go2proto", "proto2go", false, true, true, "go2protoValPtr"),
 				xEntry("go2proto", "proto2go", true, false, false, "proto2goValPtr"),
 				xEntry("go2proto", "proto2go", true, false, true, "go2protoPtrVal"),
-				xEntry("go2protoList", "proto2goList", false, false, false, "proto2go"),
-				xEntry("go2protoList", "proto2goList", false, false, true, "go2proto"),
-				xEntry("go2protoList", "proto2goList", true, true, false, "proto2goListPtr"),
-				xEntry("go2protoList", "proto2goList", true, true, true, "go2protoListPtr"),
+				xEntry("go2protoList", "proto2goList", false, false, false, "proto2goValList"),
+				xEntry("go2protoList", "proto2goList", false, false, true, "go2protoValList"),
+				xEntry("go2protoList", "proto2goList", true, true, false, "proto2goPtrList"),
+				xEntry("go2protoList", "proto2goList", true, true, true, "go2protoPtrList"),
 				xEntry("go2protoList", "proto2goList", true, false, false, "proto2goValPtrList"),
 				xEntry("go2protoList", "proto2goList", true, false, true, "go2protoPtrValList"),
 				xEntry("go2protoList", "proto2goList", false, true, false, "proto2goPtrValList"),
@@ -184,17 +184,740 @@ var _ = Describe("Template", func() {
 				ProtoType: "proto_type",
 				OneofDecl: "oneof_decl_name",
 			}, true, "prefix", "proto_name: &prefix.proto_type{},"),
+
+			Entry("Enum", Field{
+				Name:      "Status",
+				ProtoName: "State",
+				Enum:      &Enum{ProtoType: "State", GoType: "string", AsString: true},
+			}, false, "model", "Status: src.State.String(),"),
+		)
+	})
+
+	Describe("formatFieldNames", func() {
+
+		DescribeTable("check returns",
+			func(f Field, swapped bool, expected string) {
+				r := formatFieldNames(f, swapped)
+				Expect(r).To(Equal(expected))
+			},
+
+			Entry("Not swapped", Field{
+				Name:          "MapField1",
+				ProtoOrigName: "map_field_1",
+			}, false, `"map_field_1": "MapField1",`),
+
+			Entry("Swapped", Field{
+				Name:          "MapField1",
+				ProtoOrigName: "map_field_1",
+			}, true, `"MapField1": "map_field_1",`),
+		)
+	})
+
+	Describe("formatJSONNames", func() {
+
+		DescribeTable("check returns",
+			func(f Field, swapped bool, expected string) {
+				r := formatJSONNames(f, swapped)
+				Expect(r).To(Equal(expected))
+			},
+
+			Entry("Not swapped", Field{
+				ProtoJSONName: "mapField1",
+				GoJSONName:    "map_field",
+			}, false, `"mapField1": "map_field",`),
+
+			Entry("Swapped", Field{
+				ProtoJSONName: "mapField1",
+				GoJSONName:    "map_field",
+			}, true, `"map_field": "mapField1",`),
+		)
+	})
+
+	Describe("formatEmbeddedField", func() {
+
+		nonNullable := func(f Field) Field {
+			f.ProtoIsPointer = false
+			return f
+		}
+
+		var f = Field{
+			ProtoName:      "Address",
+			ProtoType:      "Address",
+			ProtoIsPointer: true,
+			EmbeddedFields: []Field{
+				{Name: "AddressCity", ProtoName: "City"},
+				{Name: "AddressZip", ProtoName: "Zip", ProtoToGoType: "p2g", GoToProtoType: "g2p"},
+			},
+		}
+
+		DescribeTable("check returns",
+			func(f Field, swapped bool, expected string) {
+				r := formatField(f, swapped, "pb")
+				Expect(r).To(Equal(expected))
+			},
+
+			Entry("Not swapped", f, false, `AddressCity: src.GetAddress().GetCity(),
+AddressZip:  p2g(src.GetAddress().GetZip() ),`),
+
+			Entry("Swapped", f, true, `Address: &pb.Address{
+City: src.AddressCity,
+Zip:  g2p(src.AddressZip ),
+},`),
+
+			Entry("Not swapped, non-nullable", nonNullable(f), false, `AddressCity: src.Address.GetCity(),
+AddressZip:  p2g(src.Address.GetZip() ),`),
+
+			Entry("Swapped, non-nullable", nonNullable(f), true, `Address: pb.Address{
+City: src.AddressCity,
+Zip:  g2p(src.AddressZip ),
+},`),
+		)
+	})
+
+	Describe("formatElemField", func() {
+
+		DescribeTable("check returns",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "Names", ProtoName: "ProtoNames", Elem: &e}
+				r := formatElemField(f, Data{Swapped: swapped, WrappersPackage: "types"})
+				Expect(r).To(Equal(expected))
+			},
+
+			Entry("Pointer to value", Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string", ProtoIsPointer: true}, false, `	if src.ProtoNames != nil {
+		s.Names = make([]string, len(src.ProtoNames))
+		for i, v := range src.ProtoNames {
+			s.Names[i] = v.GetValue()
+		}
+	}
+`),
+
+			Entry("Pointer to pointer", Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string", ProtoIsPointer: true, GoIsPointer: true}, false, `	if src.ProtoNames != nil {
+		s.Names = make([]*string, len(src.ProtoNames))
+		for i, v := range src.ProtoNames {
+			if v == nil {
+				continue
+			}
+			e := v.GetValue()
+			s.Names[i] = &e
+		}
+	}
+`),
+
+			Entry("Value to pointer, swapped", Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string", ProtoIsPointer: true}, true, `	if src.Names != nil {
+		s.ProtoNames = make([]*types.StringValue, len(src.Names))
+		for i, v := range src.Names {
+			e := types.StringValue{Value: v}
+			s.ProtoNames[i] = &e
+		}
+	}
+`),
+
+			Entry("Pointer to value, swapped", Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string", GoIsPointer: true}, true, `	if src.Names != nil {
+		s.ProtoNames = make([]types.StringValue, len(src.Names))
+		for i, v := range src.Names {
+			if v == nil {
+				continue
+			}
+			s.ProtoNames[i] = types.StringValue{Value: *v}
+		}
+	}
+`),
+
+			Entry("Helper function, pointer to value", Elem{Kind: elemFunc, ProtoType: "time.Time", GoType: "nulls.Time", ProtoIsPointer: true,
+				ProtoToGo: "TimeToNullsTime", GoToProto: "NullsTimeToTime"}, false, `	if src.ProtoNames != nil {
+		s.Names = make([]nulls.Time, len(src.ProtoNames))
+		for i, v := range src.ProtoNames {
+			if v == nil {
+				continue
+			}
+			s.Names[i] = TimeToNullsTime(*v)
+		}
+	}
+`),
+
+			Entry("Map of values", Elem{Kind: elemValue, ProtoType: "time.Time", GoType: "time.Time", MapKey: "string"}, false, `	if src.ProtoNames != nil {
+		s.Names = make(map[string]time.Time, len(src.ProtoNames))
+		for k, v := range src.ProtoNames {
+			s.Names[k] = v
+		}
+	}
+`),
+
+			Entry("Map of pointers, swapped", Elem{Kind: elemWrapper, ProtoType: "StringValue", GoType: "string", ProtoIsPointer: true, GoIsPointer: true, MapKey: "int64"}, true, `	if src.Names != nil {
+		s.ProtoNames = make(map[int64]*types.StringValue, len(src.Names))
+		for k, v := range src.Names {
+			if v == nil {
+				s.ProtoNames[k] = nil
+				continue
+			}
+			e := types.StringValue{Value: *v}
+			s.ProtoNames[k] = &e
+		}
+	}
+`),
+		)
+
+		It("transforms map of messages with transformers of the message", func() {
+			f := Field{Name: "Names", ProtoName: "ProtoNames", Elem: &Elem{
+				Kind: elemMessage, ProtoType: "Address", GoType: "Address", ProtoIsPointer: true, MapKey: "string",
+				ProtoToGo: "PbToAddressPtrVal", GoToProto: "AddressToPbValPtr",
+			}}
+			d := Data{SrcPref: "pb", DstPref: "model"}
+
+			Expect(formatElemField(f, d)).To(Equal(`	if src.ProtoNames != nil {
+		s.Names = make(map[string]model.Address, len(src.ProtoNames))
+		for k, v := range src.ProtoNames {
+			s.Names[k] = PbToAddressPtrVal(v, opts...)
+		}
+	}
+`))
+
+			Expect(formatElemField(f, d.reverse())).To(Equal(`	if src.Names != nil {
+		s.ProtoNames = make(map[string]*pb.Address, len(src.Names))
+		for k, v := range src.Names {
+			s.ProtoNames[k] = AddressToPbValPtr(v, opts...)
+		}
+	}
+`))
+		})
+
+		It("transforms map of list wrappers", func() {
+			f := Field{Name: "Names", ProtoName: "ProtoNames", Elem: &Elem{
+				Kind: elemList, ProtoType: "AddressList", GoType: "[]Address", ProtoIsPointer: true, MapKey: "string",
+				ProtoToGo: "PbToAddressPtrValList", GoToProto: "AddressToPbValPtrList", Items: "Items",
+			}}
+			d := Data{SrcPref: "pb", DstPref: "model"}
+
+			Expect(formatElemField(f, d)).To(Equal(`	if src.ProtoNames != nil {
+		s.Names = make(map[string][]model.Address, len(src.ProtoNames))
+		for k, v := range src.ProtoNames {
+			s.Names[k] = PbToAddressPtrValList(v.GetItems())
+		}
+	}
+`))
+
+			Expect(formatElemField(f, d.reverse())).To(Equal(`	if src.Names != nil {
+		s.ProtoNames = make(map[string]*pb.AddressList, len(src.Names))
+		for k, v := range src.Names {
+			e := pb.AddressList{Items: AddressToPbValPtrList(v)}
+			s.ProtoNames[k] = &e
+		}
+	}
+`))
+		})
+
+		It("transforms repeated enum element-wise", func() {
+			f := Field{Name: "Statuses", ProtoName: "ProtoStatuses", Elem: &Elem{
+				Kind: elemEnum, ProtoType: "Order_Status", GoType: "Status",
+				Enum: &Enum{ProtoType: "Order_Status", GoType: "Status", AsString: true},
+			}}
+			d := Data{SrcPref: "pb", DstPref: "model"}
+
+			Expect(formatElemField(f, d)).To(Equal(`	if src.ProtoStatuses != nil {
+		s.Statuses = make([]model.Status, len(src.ProtoStatuses))
+		for i, v := range src.ProtoStatuses {
+			s.Statuses[i] = model.Status(v.String())
+		}
+	}
+`))
+
+			Expect(formatElemField(f, d.reverse())).To(Equal(`	if src.Statuses != nil {
+		s.ProtoStatuses = make([]pb.Order_Status, len(src.Statuses))
+		for i, v := range src.Statuses {
+			s.ProtoStatuses[i] = pb.Order_Status(pb.Order_Status_value[string(v)])
+		}
+	}
+`))
+		})
+
+		It("transforms map into sorted pairs", func() {
+			f := Field{Name: "Labels", ProtoName: "ProtoLabels", Elem: &Elem{
+				Kind: elemPairs, ProtoType: "int64", GoType: "LabelPair", GoIsPointer: true, MapKey: "string",
+			}}
+			d := Data{SrcPref: "pb", DstPref: "model"}
+
+			Expect(formatElemField(f, d)).To(Equal(`	if src.ProtoLabels != nil {
+		keys := make([]string, 0, len(src.ProtoLabels))
+		for k := range src.ProtoLabels {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+		s.Labels = make([]*model.LabelPair, len(keys))
+		for i, k := range keys {
+			s.Labels[i] = &model.LabelPair{Key: k, Value: src.ProtoLabels[k]}
+		}
+	}
+`))
+
+			Expect(formatElemField(f, d.reverse())).To(Equal(`	if src.Labels != nil {
+		s.ProtoLabels = make(map[string]int64, len(src.Labels))
+		for _, v := range src.Labels {
+			if v == nil {
+				continue
+			}
+			s.ProtoLabels[v.Key] = v.Value
+		}
+	}
+`))
+		})
+
+		It("returns empty string for non-element fields", func() {
+			Expect(formatElemField(Field{}, Data{})).To(BeEmpty())
+		})
+	})
+
+	Describe("formatWrapperField", func() {
+
+		DescribeTable("check returns",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "Limit", ProtoName: "ProtoLimit", Wrapper: &e}
+				r := formatWrapperField(f, Data{Swapped: swapped, WrappersPackage: "types"})
+				Expect(r).To(Equal(expected))
+			},
+
+			Entry("Pointer to value", Elem{Kind: elemWrapper, ProtoType: "Int64Value", GoType: "int64", ProtoIsPointer: true}, false,
+				"\ts.Limit = src.ProtoLimit.GetValue()\n"),
+
+			Entry("Pointer to pointer", Elem{Kind: elemWrapper, ProtoType: "Int64Value", GoType: "int64", ProtoIsPointer: true, GoIsPointer: true}, false, `	if src.ProtoLimit != nil {
+		s.Limit = new(int64)
+		*s.Limit = src.ProtoLimit.Value
+	}
+`),
+
+			Entry("Value to pointer", Elem{Kind: elemWrapper, ProtoType: "Int64Value", GoType: "int64", GoIsPointer: true}, false, `	s.Limit = new(int64)
+	*s.Limit = src.ProtoLimit.Value
+`),
+
+			Entry("Value to pointer, swapped", Elem{Kind: elemWrapper, ProtoType: "Int64Value", GoType: "int64", ProtoIsPointer: true}, true,
+				"\ts.ProtoLimit = &types.Int64Value{Value: src.Limit}\n"),
+
+			Entry("Pointer to pointer, swapped", Elem{Kind: elemWrapper, ProtoType: "Int64Value", GoType: "int64", ProtoIsPointer: true, GoIsPointer: true}, true, `	if src.Limit != nil {
+		s.ProtoLimit = &types.Int64Value{Value: *src.Limit}
+	}
+`),
+
+			Entry("Pointer to value, swapped", Elem{Kind: elemWrapper, ProtoType: "Int64Value", GoType: "int64", GoIsPointer: true}, true, `	if src.Limit != nil {
+		s.ProtoLimit = types.Int64Value{Value: *src.Limit}
+	}
+`),
+		)
+
+		It("transforms between pointer and value of standard type", func() {
+			f := Field{Name: "Created", ProtoName: "ProtoCreated", Wrapper: &Elem{
+				Kind: elemValue, ProtoType: "time.Time", GoType: "time.Time", GoIsPointer: true,
+			}}
+
+			Expect(formatWrapperField(f, Data{})).To(Equal(`	if !src.ProtoCreated.IsZero() {
+		v := src.ProtoCreated
+		s.Created = &v
+	}
+`))
+			Expect(formatWrapperField(f, Data{Swapped: true})).To(Equal(`	if src.Created != nil {
+		s.ProtoCreated = *src.Created
+	}
+`))
+		})
+
+		DescribeTable("transforms Timestamp structure with generated code",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "Created", ProtoName: "ProtoCreated", Wrapper: &e}
+				Expect(formatWrapperField(f, Data{Swapped: swapped, WrappersPackage: "types"})).To(Equal(expected))
+			},
+
+			Entry("Pointer to value", Elem{Kind: elemTime, ProtoType: "Timestamp", GoType: "time.Time", ProtoIsPointer: true}, false, `	if src.ProtoCreated != nil {
+		s.Created = time.Unix(src.ProtoCreated.Seconds, int64(src.ProtoCreated.Nanos)).UTC()
+	}
+`),
+
+			Entry("Pointer to pointer", Elem{Kind: elemTime, ProtoType: "Timestamp", GoType: "time.Time", ProtoIsPointer: true, GoIsPointer: true}, false, `	if src.ProtoCreated != nil {
+		t := time.Unix(src.ProtoCreated.Seconds, int64(src.ProtoCreated.Nanos)).UTC()
+		s.Created = &t
+	}
+`),
+
+			Entry("Value to value", Elem{Kind: elemTime, ProtoType: "Timestamp", GoType: "time.Time"}, false,
+				"\ts.Created = time.Unix(src.ProtoCreated.Seconds, int64(src.ProtoCreated.Nanos)).UTC()\n"),
+
+			Entry("Value to pointer, swapped", Elem{Kind: elemTime, ProtoType: "Timestamp", GoType: "time.Time", ProtoIsPointer: true}, true, `	if !src.Created.IsZero() {
+		s.ProtoCreated = &types.Timestamp{Seconds: src.Created.Unix(), Nanos: int32(src.Created.Nanosecond())}
+	}
+`),
+
+			Entry("Pointer to value, swapped", Elem{Kind: elemTime, ProtoType: "Timestamp", GoType: "time.Time", GoIsPointer: true}, true, `	if src.Created != nil {
+		s.ProtoCreated = types.Timestamp{Seconds: src.Created.Unix(), Nanos: int32(src.Created.Nanosecond())}
+	}
+`),
+		)
+
+		DescribeTable("transforms Duration with generated code",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "Timeout", ProtoName: "ProtoTimeout", Wrapper: &e}
+				Expect(formatWrapperField(f, Data{Swapped: swapped, WrappersPackage: "types"})).To(Equal(expected))
+			},
+
+			Entry("Structure to value", Elem{Kind: elemDuration, ProtoType: "Duration", GoType: "time.Duration", ProtoIsPointer: true}, false, `	if src.ProtoTimeout != nil {
+		s.Timeout = time.Duration(src.ProtoTimeout.Seconds)*time.Second + time.Duration(src.ProtoTimeout.Nanos)
+	}
+`),
+
+			Entry("Structure to milliseconds pointer", Elem{Kind: elemDuration, ProtoType: "Duration", GoType: "int64", ProtoIsPointer: true, GoIsPointer: true, Unit: "time.Millisecond"}, false, `	if src.ProtoTimeout != nil {
+		v := int64((time.Duration(src.ProtoTimeout.Seconds)*time.Second + time.Duration(src.ProtoTimeout.Nanos)) / time.Millisecond)
+		s.Timeout = &v
+	}
+`),
+
+			Entry("Standard value to nanoseconds", Elem{Kind: elemDuration, ProtoType: "time.Duration", GoType: "int64", Unit: "time.Nanosecond"}, false,
+				"\ts.Timeout = int64(src.ProtoTimeout)\n"),
+
+			Entry("Value to structure, swapped", Elem{Kind: elemDuration, ProtoType: "Duration", GoType: "time.Duration", ProtoIsPointer: true}, true, `	if src.Timeout != 0 {
+		d := src.Timeout
+		s.ProtoTimeout = &types.Duration{Seconds: int64(d / time.Second), Nanos: int32(d % time.Second)}
+	}
+`),
+
+			Entry("Milliseconds pointer to standard pointer, swapped", Elem{Kind: elemDuration, ProtoType: "time.Duration", GoType: "int64", ProtoIsPointer: true, GoIsPointer: true, Unit: "time.Millisecond"}, true, `	if src.Timeout != nil {
+		d := time.Duration(*src.Timeout) * time.Millisecond
+		s.ProtoTimeout = &d
+	}
+`),
+
+			Entry("Nanoseconds to standard value, swapped", Elem{Kind: elemDuration, ProtoType: "time.Duration", GoType: "int64", Unit: "time.Nanosecond"}, true, `	if src.Timeout != 0 {
+		s.ProtoTimeout = time.Duration(src.Timeout)
+	}
+`),
+		)
+
+		DescribeTable("transforms proto3 optional fields",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "Nickname", ProtoName: "ProtoNickname", Wrapper: &e}
+				Expect(formatWrapperField(f, Data{Swapped: swapped})).To(Equal(expected))
+			},
+
+			Entry("Pointer to pointer", Elem{Kind: elemOptional, ProtoType: "string", GoType: "string", ProtoIsPointer: true, GoIsPointer: true}, false, `	if src.ProtoNickname != nil {
+		v := *src.ProtoNickname
+		s.Nickname = &v
+	}
+`),
+
+			Entry("Pointer to value", Elem{Kind: elemOptional, ProtoType: "string", GoType: "string", ProtoIsPointer: true}, false, `	if src.ProtoNickname != nil {
+		s.Nickname = *src.ProtoNickname
+	}
+`),
+
+			Entry("Pointer to pointer, swapped", Elem{Kind: elemOptional, ProtoType: "string", GoType: "string", ProtoIsPointer: true, GoIsPointer: true}, true, `	if src.Nickname != nil {
+		v := *src.Nickname
+		s.ProtoNickname = &v
+	}
+`),
+
+			Entry("Value to pointer, swapped", Elem{Kind: elemOptional, ProtoType: "string", GoType: "string", ProtoIsPointer: true}, true,
+				"\tvProtoNickname := src.Nickname\n\ts.ProtoNickname = &vProtoNickname\n"),
+		)
+
+		DescribeTable("transforms fields with custom functions",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "Price", ProtoName: "ProtoPrice", Wrapper: &e}
+				Expect(formatWrapperField(f, Data{Swapped: swapped, Dst: "Product", DstPref: "model", WithErrors: true})).To(Equal(expected))
+			},
+
+			Entry("Proto to model", Elem{Kind: elemCustom, ProtoToGo: "money.FromCents", GoToProto: "money.ToCents"}, false,
+				"\ts.Price = money.FromCents(src.ProtoPrice)\n"),
+			Entry("Model to proto", Elem{Kind: elemCustom, ProtoToGo: "money.FromCents", GoToProto: "money.ToCents"}, true,
+				"\ts.ProtoPrice = money.ToCents(src.Price)\n"),
+			Entry("No function for direction", Elem{Kind: elemCustom, ProtoToGo: "money.FromCents"}, true, ""),
+			Entry("Function with error", Elem{Kind: elemCustom, ProtoToGo: "money.ParseCents", WithError: true}, false, `	vPrice, err := money.ParseCents(src.ProtoPrice)
+	if err != nil {
+		return model.Product{}, fmt.Errorf("field ProtoPrice: %w", err)
+	}
+	s.Price = vPrice
+`),
+		)
+
+		DescribeTable("transforms Struct fields with value helpers",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "Meta", ProtoName: "ProtoMeta", Wrapper: &e}
+				Expect(formatWrapperField(f, Data{Swapped: swapped, WithContext: true, Dst: "Device", DstPref: "model", WithErrors: true})).To(Equal(expected))
+			},
+
+			Entry("Struct to map", Elem{Kind: elemStruct, ProtoToGo: "StructToMap", GoToProto: "MapToStruct"}, false,
+				"\ts.Meta = StructToMap(src.ProtoMeta)\n"),
+			Entry("Map to struct, swapped", Elem{Kind: elemStruct, ProtoToGo: "StructToMap", GoToProto: "MapToStruct"}, true, `	vProtoMeta, err := MapToStruct(src.Meta)
+	if err != nil {
+		return model.Device{}, fmt.Errorf("field Meta: %w", err)
+	}
+	s.ProtoMeta = vProtoMeta
+`),
+			Entry("Struct to JSON", Elem{Kind: elemStruct, ProtoToGo: "StructToJSON", GoToProto: "JSONToStruct", WithError: true}, false, `	vMeta, err := StructToJSON(src.ProtoMeta)
+	if err != nil {
+		return model.Device{}, fmt.Errorf("field ProtoMeta: %w", err)
+	}
+	s.Meta = vMeta
+`),
 		)
+
+		DescribeTable("transforms bytes fields with converter functions",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "ID", ProtoName: "ProtoID", Wrapper: &e}
+				Expect(formatWrapperField(f, Data{Swapped: swapped, WithContext: true, Dst: "Product", DstPref: "model", WithErrors: true})).To(Equal(expected))
+			},
+
+			Entry("Bytes to model, empty bytes aren't parsed", Elem{Kind: elemBytes, ProtoToGo: "uuid.FromBytes", GoToProto: "uuid.UUID.Bytes"}, false, `	if len(src.ProtoID) > 0 {
+		vID, err := uuid.FromBytes(src.ProtoID)
+		if err != nil {
+			return model.Product{}, fmt.Errorf("field ProtoID: %w", err)
+		}
+		s.ID = vID
+	}
+`),
+			Entry("Model to bytes", Elem{Kind: elemBytes, ProtoToGo: "uuid.FromBytes", GoToProto: "uuid.UUID.Bytes"}, true,
+				"\ts.ProtoID = uuid.UUID.Bytes(src.ID)\n"),
+			Entry("No function for direction", Elem{Kind: elemBytes, ProtoToGo: "uuid.FromBytes"}, true, ""),
+		)
+
+		DescribeTable("transforms UUID fields",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "ID", ProtoName: "ProtoID", Wrapper: &e}
+				Expect(formatWrapperField(f, Data{Swapped: swapped, Dst: "Product", DstPref: "model", WithErrors: true})).To(Equal(expected))
+			},
+
+			Entry("String to UUID", Elem{Kind: elemUUID, ProtoType: "string", GoType: "uuid.UUID"}, false, `	if len(src.ProtoID) > 0 {
+		vID, err := uuid.Parse(src.ProtoID)
+		if err != nil {
+			return model.Product{}, fmt.Errorf("field ProtoID: %w", err)
+		}
+		s.ID = vID
+	}
+`),
+			Entry("Bytes to UUID pointer", Elem{Kind: elemUUID, ProtoType: "bytes", GoType: "uuid.UUID", GoIsPointer: true}, false, `	if len(src.ProtoID) > 0 {
+		vID, err := uuid.FromBytes(src.ProtoID)
+		if err != nil {
+			return model.Product{}, fmt.Errorf("field ProtoID: %w", err)
+		}
+		s.ID = &vID
+	}
+`),
+			Entry("UUID to string", Elem{Kind: elemUUID, ProtoType: "string", GoType: "uuid.UUID"}, true,
+				"\tif src.ID != uuid.Nil {\n\t\ts.ProtoID = src.ID.String()\n\t}\n"),
+			Entry("UUID pointer to bytes", Elem{Kind: elemUUID, ProtoType: "bytes", GoType: "uuid.UUID", GoIsPointer: true}, true,
+				"\tif src.ID != nil {\n\t\ts.ProtoID = src.ID[:]\n\t}\n"),
+			Entry("Package imported with another name", Elem{Kind: elemUUID, ProtoType: "string", GoType: "guuid.UUID"}, true,
+				"\tif src.ID != guuid.Nil {\n\t\ts.ProtoID = src.ID.String()\n\t}\n"),
+		)
+
+		It("returns parse error of UUID field if message has transformer.with_errors option", func() {
+			f := Field{Name: "ID", ProtoName: "ProtoID", Wrapper: &Elem{Kind: elemUUID, ProtoType: "string", GoType: "uuid.UUID"}}
+
+			Expect(formatWrapperField(f, Data{Dst: "Product", DstPref: "model", WithErrors: true})).To(Equal(`	if len(src.ProtoID) > 0 {
+		vID, err := uuid.Parse(src.ProtoID)
+		if err != nil {
+			return model.Product{}, fmt.Errorf("field ProtoID: %w", err)
+		}
+		s.ID = vID
+	}
+`))
+		})
+
+		DescribeTable("transforms decimal fields",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "Price", ProtoName: "ProtoPrice", Wrapper: &e}
+				Expect(formatWrapperField(f, Data{Swapped: swapped, Dst: "Product", DstPref: "model", WithErrors: true})).To(Equal(expected))
+			},
+
+			Entry("String to decimal", Elem{Kind: elemDecimal, ProtoType: "string", GoType: "decimal.Decimal"}, false, `	if len(src.ProtoPrice) > 0 {
+		vPrice, err := decimal.NewFromString(src.ProtoPrice)
+		if err != nil {
+			return model.Product{}, fmt.Errorf("field ProtoPrice: %w", err)
+		}
+		s.Price = vPrice
+	}
+`),
+			Entry("Decimal to string", Elem{Kind: elemDecimal, ProtoType: "string", GoType: "decimal.Decimal"}, true,
+				"\ts.ProtoPrice = src.Price.String()\n"),
+			Entry("Cents to decimal", Elem{Kind: elemDecimal, ProtoType: "int64", GoType: "decimal.Decimal", Scale: 2, Rounding: "RoundBank"}, false,
+				"\ts.Price = decimal.New(src.ProtoPrice, -2)\n"),
+			Entry("Decimal to cents", Elem{Kind: elemDecimal, ProtoType: "int64", GoType: "decimal.Decimal", Scale: 2, Rounding: "RoundBank"}, true,
+				"\ts.ProtoPrice = src.Price.Shift(2).RoundBank(0).IntPart()\n"),
+			Entry("Decimal to units", Elem{Kind: elemDecimal, ProtoType: "int64", GoType: "decimal.Decimal", Rounding: "Truncate"}, true,
+				"\ts.ProtoPrice = src.Price.Truncate(0).IntPart()\n"),
+			Entry("Money to decimal", Elem{Kind: elemDecimal, ProtoType: "money", GoType: "decimal.Decimal", ProtoIsPointer: true, Currency: "Currency", CurrencyType: "billing.Currency"}, false, `	if src.ProtoPrice != nil {
+		s.Price = decimal.New(src.ProtoPrice.Units, 0).Add(decimal.New(int64(src.ProtoPrice.Nanos), -9))
+		s.Currency = billing.Currency(src.ProtoPrice.CurrencyCode)
+	}
+`),
+			Entry("Money value to decimal", Elem{Kind: elemDecimal, ProtoType: "money", GoType: "decimal.Decimal", Currency: "Currency", CurrencyType: "string"}, false,
+				"\ts.Price = decimal.New(src.ProtoPrice.Units, 0).Add(decimal.New(int64(src.ProtoPrice.Nanos), -9))\n\ts.Currency = src.ProtoPrice.CurrencyCode\n"),
+			Entry("Decimal to money", Elem{Kind: elemDecimal, ProtoType: "money", GoType: "decimal.Decimal", ProtoIsPointer: true, Rounding: "Round", Currency: "Currency", CurrencyType: "string"}, true, `	vProtoPrice := src.Price.Round(9)
+	s.ProtoPrice = &_type.Money{CurrencyCode: src.Currency, Units: vProtoPrice.IntPart(), Nanos: int32(vProtoPrice.Sub(decimal.New(vProtoPrice.IntPart(), 0)).Shift(9).IntPart())}
+`),
+			Entry("Decimal to money value without currency", Elem{Kind: elemDecimal, ProtoType: "money", GoType: "decimal.Decimal", Rounding: "Round"}, true, `	vProtoPrice := src.Price.Round(9)
+	s.ProtoPrice = _type.Money{Units: vProtoPrice.IntPart(), Nanos: int32(vProtoPrice.Sub(decimal.New(vProtoPrice.IntPart(), 0)).Shift(9).IntPart())}
+`),
+		)
+
+		DescribeTable("transforms nullable model fields",
+			func(e Elem, swapped bool, expected string) {
+				f := Field{Name: "Email", ProtoName: "ProtoEmail", Wrapper: &e}
+				Expect(formatWrapperField(f, Data{Swapped: swapped, WrappersPackage: "types"})).To(Equal(expected))
+			},
+
+			Entry("Scalar to null", Elem{Kind: elemNull, GoType: "sql.NullString", Value: "String"}, false,
+				"\ts.Email = sql.NullString{String: src.ProtoEmail, Valid: true}\n"),
+			Entry("Null to scalar", Elem{Kind: elemNull, GoType: "sql.NullString", Value: "String"}, true,
+				"\ts.ProtoEmail = src.Email.String\n"),
+			Entry("Optional to null", Elem{Kind: elemNull, GoType: "sql.NullString", Value: "String", ProtoIsPointer: true}, false,
+				"\tif src.ProtoEmail != nil {\n\t\ts.Email = sql.NullString{String: *src.ProtoEmail, Valid: true}\n\t}\n"),
+			Entry("Null to optional", Elem{Kind: elemNull, GoType: "sql.NullString", Value: "String", ProtoIsPointer: true}, true,
+				"\tif src.Email.Valid {\n\t\tv := src.Email.String\n\t\ts.ProtoEmail = &v\n\t}\n"),
+			Entry("Wrapper to null", Elem{Kind: elemNull, ProtoType: "Int32Value", GoType: "pgtype.Int8", Value: "Int64", ProtoIsPointer: true, ProtoToGo: "int64", GoToProto: "int32"}, false,
+				"\tif src.ProtoEmail != nil {\n\t\ts.Email = pgtype.Int8{Int64: int64(src.ProtoEmail.Value), Valid: true}\n\t}\n"),
+			Entry("Null to wrapper", Elem{Kind: elemNull, ProtoType: "Int32Value", GoType: "pgtype.Int8", Value: "Int64", ProtoIsPointer: true, ProtoToGo: "int64", GoToProto: "int32"}, true,
+				"\tif src.Email.Valid {\n\t\ts.ProtoEmail = &types.Int32Value{Value: int32(src.Email.Int64)}\n\t}\n"),
+			Entry("Timestamp value to null", Elem{Kind: elemNull, ProtoType: "Timestamp", GoType: "sql.NullTime", Value: "Time"}, false,
+				"\ts.Email = sql.NullTime{Time: time.Unix(src.ProtoEmail.Seconds, int64(src.ProtoEmail.Nanos)).UTC(), Valid: true}\n"),
+			Entry("Null to timestamp", Elem{Kind: elemNull, ProtoType: "Timestamp", GoType: "sql.NullTime", Value: "Time", ProtoIsPointer: true}, true,
+				"\tif src.Email.Valid {\n\t\ts.ProtoEmail = &types.Timestamp{Seconds: src.Email.Time.Unix(), Nanos: int32(src.Email.Time.Nanosecond())}\n\t}\n"),
+		)
+
+		It("returns empty string for non-wrapper fields", func() {
+			Expect(formatWrapperField(Field{Name: "Name"}, Data{})).To(BeEmpty())
+		})
+
+		It("passes context to custom function if message has transformer.with_context option", func() {
+			f := Field{Name: "Note", ProtoName: "Note", Wrapper: &Elem{Kind: elemCustom, ProtoToGo: "crypto.Open", GoToProto: "crypto.Seal"}}
+
+			Expect(formatWrapperField(f, Data{WithContext: true})).To(Equal("\ts.Note = crypto.Open(ctx, src.Note)\n"))
+			Expect(formatWrapperField(f, Data{WithContext: true, Swapped: true})).To(Equal("\ts.Note = crypto.Seal(ctx, src.Note)\n"))
+		})
+
+		It("returns error of custom function if message has transformer.with_errors option", func() {
+			f := Field{Name: "Price", ProtoName: "ProtoPrice", Wrapper: &Elem{Kind: elemCustom, ProtoToGo: "money.ParseCents", WithError: true}}
+
+			Expect(formatWrapperField(f, Data{Dst: "Product", DstPref: "model", WithErrors: true})).To(Equal(`	vPrice, err := money.ParseCents(src.ProtoPrice)
+	if err != nil {
+		return model.Product{}, fmt.Errorf("field ProtoPrice: %w", err)
+	}
+	s.Price = vPrice
+`))
+		})
 	})
 
-	Describe("Data.Swap", func() {
+	Describe("formatCallField", func() {
 
-		Context("when Swap() called", func() {
+		f := Field{Name: "Address", ProtoName: "Addr", ProtoToGoType: "PbToAddress", GoToProtoType: "AddressToPb",
+			ProtoIsPointer: true, GoIsPointer: true, Opts: ", opts...", WithError: true}
 
-			var d *Data
+		DescribeTable("check returns",
+			func(d Data, expected string) {
+				Expect(formatCallField(f, d)).To(Equal(expected))
+			},
+
+			Entry("Parent with errors", Data{Dst: "Customer", DstPref: "model", WithErrors: true}, `	vAddress, err := PbToAddressPtr(src.Addr, opts...)
+	if err != nil {
+		return model.Customer{}, fmt.Errorf("field Addr: %w", err)
+	}
+	s.Address = vAddress
+`),
+			Entry("Reverse", Data{Dst: "Customer", DstPref: "pb", WithErrors: true, Swapped: true}, `	vAddr, err := AddressToPbPtr(src.Address, opts...)
+	if err != nil {
+		return pb.Customer{}, fmt.Errorf("field Address: %w", err)
+	}
+	s.Addr = vAddr
+`),
+		)
+
+		DescribeTable("passes context to transformers of messages with transformer.with_context option",
+			func(d Data, expected string) {
+				f := Field{Name: "Address", ProtoName: "Addr", ProtoToGoType: "PbToAddress", GoToProtoType: "AddressToPb",
+					ProtoIsPointer: true, GoIsPointer: true, Opts: ", opts...", WithContext: true}
+				Expect(formatCallField(f, d)).To(Equal(expected))
+			},
+
+			Entry("Parent with context", Data{WithContext: true}, "\ts.Address = PbToAddressPtr(ctx, src.Addr, opts...)\n"),
+			Entry("Parent without context", Data{}, "\ts.Address = PbToAddressPtr(context.TODO(), src.Addr, opts...)\n"),
+			Entry("Reverse", Data{WithContext: true, Swapped: true}, "\ts.Addr = AddressToPbPtr(ctx, src.Address, opts...)\n"),
+		)
+	})
+
+	Describe("fallibleFields", func() {
+
+		fields := []Field{
+			{Name: "ID", Wrapper: &Elem{Kind: elemUUID}},
+			{Name: "Price", Wrapper: &Elem{Kind: elemDecimal, ProtoType: "int64"}},
+			{Name: "Meta", Wrapper: &Elem{Kind: elemStruct, ProtoToGo: "StructToMap", GoToProto: "MapToStruct"}},
+			{Name: "Cents", Wrapper: &Elem{Kind: elemCustom, ProtoToGo: "ParseCents", WithError: true}},
+			{Name: "Address", WithError: true, SkipGoToPb: true},
+			{Name: "Total", HelperCall: &helperSignature{Error: true}, SkipPbToGo: true},
+			{Name: "Title"},
+		}
+
+		DescribeTable("returns fields transformations of which return errors",
+			func(forward, reverse bool, expected []string) {
+				Expect(fallibleFields(fields, forward, reverse)).To(Equal(expected))
+			},
+
+			Entry("Both directions", true, true, []string{"ID", "Meta", "Cents", "Address", "Total"}),
+			Entry("Proto to model", true, false, []string{"ID", "Cents", "Address"}),
+			Entry("Model to proto", false, true, []string{"Meta", "Total"}),
+			Entry("No transformers", false, false, []string(nil)),
+		)
+	})
+
+	Describe("Enum.convert", func() {
+
+		DescribeTable("check returns",
+			func(e Enum, swapped bool, pref, expected string) {
+				Expect(e.convert("src.Status", swapped, pref)).To(Equal(expected))
+			},
+
+			Entry("Name", Enum{ProtoType: "Order_Status", GoType: "string", AsString: true}, false, "model",
+				"src.Status.String()"),
+			Entry("Name into model type", Enum{ProtoType: "Order_Status", GoType: "Status", AsString: true}, false, "model",
+				"model.Status(src.Status.String())"),
+			Entry("Number", Enum{ProtoType: "Order_Status", GoType: "int32"}, false, "model",
+				"int32(src.Status)"),
+			Entry("Name, swapped", Enum{ProtoType: "Order_Status", GoType: "string", AsString: true}, true, "pb",
+				"pb.Order_Status(pb.Order_Status_value[src.Status])"),
+			Entry("Name of model type, swapped", Enum{ProtoType: "Order_Status", GoType: "Status", AsString: true}, true, "pb",
+				"pb.Order_Status(pb.Order_Status_value[string(src.Status)])"),
+			Entry("Number, swapped", Enum{ProtoType: "Order_Status", GoType: "int32"}, true, "pb",
+				"pb.Order_Status(src.Status)"),
+		)
+	})
+
+	Describe("flatFields", func() {
+
+		It("replaces embedded fields with sub message fields", func() {
+			r := flatFields([]Field{
+				{Name: "ID"},
+				{ProtoName: "Address", EmbeddedFields: []Field{{Name: "AddressCity"}, {Name: "AddressZip"}}},
+			})
+			Expect(r).To(Equal([]Field{{Name: "ID"}, {Name: "AddressCity"}, {Name: "AddressZip"}}))
+		})
+	})
+
+	Describe("schemaHash", func() {
+		a := Field{Signature: "id LABEL_OPTIONAL TYPE_INT64 => ID int64"}
+		b := Field{Signature: "name LABEL_OPTIONAL TYPE_STRING => Name string"}
+
+		It("does not depend on fields order", func() {
+			Expect(schemaHash([]Field{a, b})).To(Equal(schemaHash([]Field{b, a})))
+		})
+
+		It("includes embedded fields", func() {
+			Expect(schemaHash([]Field{a, {EmbeddedFields: []Field{b}}})).To(Equal(schemaHash([]Field{a, b})))
+			Expect(schemaHash([]Field{a, b})).NotTo(Equal(schemaHash([]Field{a})))
+		})
+	})
+
+	Describe("Data.reverse", func() {
+
+		Context("when reverse() called", func() {
+
+			var d Data
 
 			BeforeEach(func() {
-				d = &Data{
+				d = Data{
 					Src:        "src",
 					Dst:        "dst",
 					SrcPref:    "src_pref",
@@ -207,9 +930,8 @@ var _ = Describe("Template", func() {
 				}
 			})
 
-			It("swap some fields", func() {
-				d.swap()
-				Expect(*d).To(MatchFields(IgnoreExtras, Fields{
+			It("returns data with swapped fields", func() {
+				Expect(d.reverse()).To(MatchFields(IgnoreExtras, Fields{
 					"Src":        Equal("dst"),
 					"Dst":        Equal("src"),
 					"SrcPref":    Equal("dst_pref"),
@@ -221,6 +943,13 @@ var _ = Describe("Template", func() {
 					"Swapped":    BeTrue(),
 				}))
 			})
+
+			It("does not change original data", func() {
+				_ = d.reverse()
+				Expect(d.Src).To(Equal("src"))
+				Expect(d.SrcPref).To(Equal("src_pref"))
+				Expect(d.Swapped).To(BeFalse())
+			})
 		})
 	})
 
@@ -283,6 +1012,163 @@ var _ = Describe("Template", func() {
 
 	})
 
+	Describe("json template", func() {
+		d := Data{
+			Src:        "Order",
+			SrcPref:    "pb",
+			SrcFn:      "Pb",
+			SrcPointer: "*",
+			Dst:        "Order",
+			DstPref:    "model",
+			DstFn:      "Order",
+			JSON:       true,
+		}
+
+		It("adds proto-JSON entry points", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "json", d)).To(Succeed())
+			Expect(w.String()).To(ContainSubstring(`func JSONToOrder(data []byte, opts ...TransformParam) (model.Order, error) {
+	var src pb.Order
+	if err := protojson.Unmarshal(data, protov1.MessageV2(&src)); err != nil {
+		return model.Order{}, err
+	}
+
+	return PbToOrderPtrVal(&src, opts...), nil
+}`))
+			Expect(w.String()).To(ContainSubstring(`func OrderToJSON(src model.Order, opts ...TransformParam) ([]byte, error) {
+	return protojson.Marshal(protov1.MessageV2(OrderToPbValPtr(src, opts...)))
+}`))
+		})
+
+		It("does not add model to JSON function if reverse functions are disabled", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			nd := d
+			nd.NoReverse = true
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "json", nd)).To(Succeed())
+			Expect(w.String()).To(ContainSubstring("func JSONToOrder("))
+			Expect(w.String()).NotTo(ContainSubstring("func OrderToJSON("))
+		})
+
+		It("does not add JSON to model function if forward functions are not generated", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			nd := d
+			nd.NoForward = true
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "json", nd)).To(Succeed())
+			Expect(w.String()).To(HavePrefix("// OrderToJSON transforms"))
+			Expect(w.String()).NotTo(ContainSubstring("func JSONToOrder("))
+		})
+	})
+
+	Describe("patch template", func() {
+		d := Data{
+			Src:        "Customer",
+			SrcPref:    "pb",
+			SrcFn:      "Pb",
+			SrcPointer: "*",
+			Dst:        "Customer",
+			DstPref:    "model",
+			DstFn:      "Customer",
+			Patch: []patchField{
+				{Name: "Name", Type: "*string", Cond: `src.Name != ""`, Ref: true, Changed: "dst.Name != *p.Name"},
+				{Name: "BornAt", Type: "*time.Time", Ref: true, Changed: "!dst.BornAt.Equal(*p.BornAt)"},
+				{Name: "Tags", Type: "[]string", Cond: "len(src.Tags) > 0", Changed: "!reflect.DeepEqual(dst.Tags, p.Tags)"},
+			},
+		}
+
+		It("adds ApplyChanges method which returns names of changed fields", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "patch", d)).To(Succeed())
+			Expect(w.String()).To(ContainSubstring(`func (p CustomerPatch) ApplyChanges(dst *model.Customer) []string {
+	var changed []string
+	if p.Name != nil && dst.Name != *p.Name {
+		dst.Name = *p.Name
+		changed = append(changed, "Name")
+	}
+	if p.BornAt != nil && !dst.BornAt.Equal(*p.BornAt) {
+		dst.BornAt = *p.BornAt
+		changed = append(changed, "BornAt")
+	}
+	if p.Tags != nil && !reflect.DeepEqual(dst.Tags, p.Tags) {
+		dst.Tags = p.Tags
+		changed = append(changed, "Tags")
+	}
+
+	return changed
+}`))
+		})
+	})
+
+	Describe("redacted template", func() {
+		d := Data{
+			Src:        "Customer",
+			SrcPref:    "pb",
+			SrcFn:      "Pb",
+			SrcPointer: "*",
+			Dst:        "Customer",
+			DstPref:    "model",
+			DstFn:      "Customer",
+			Sensitive: redactedFields{
+				{Path: "Name", Zero: "empty.Name"},
+				{Path: "Email", Zero: "empty.Email"},
+			},
+		}
+
+		It("adds model to proto function which leaves sensitive fields empty", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "redacted", d.reverse())).To(Succeed())
+			Expect(w.String()).To(ContainSubstring(`func CustomerToPbRedacted(src model.Customer, opts ...TransformParam) pb.Customer {
+	dst := CustomerToPb(src, opts...)
+
+	var empty pb.Customer
+	dst.Name = empty.Name
+	dst.Email = empty.Email
+
+	return dst
+}`))
+			Expect(w.String()).To(ContainSubstring("func CustomerToPbRedactedPtr(src *model.Customer, opts ...TransformParam) *pb.Customer {"))
+		})
+
+		It("clears oneof cases and fields of embedded sub messages", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			cd := d
+			cd.Sensitive = redactedFields{
+				{Path: "Secret", Case: "*pb.Customer_Ssn"},
+				{Path: "Contact.Phone", Zero: "(&pb.Contact{}).Phone", Cond: "dst.Contact != nil"},
+			}
+
+			w := &bytes.Buffer{}
+			Expect(t.ExecuteTemplate(w, "redacted", cd.reverse())).To(Succeed())
+			Expect(w.String()).NotTo(ContainSubstring("var empty"))
+			Expect(w.String()).To(ContainSubstring(`	dst := CustomerToPb(src, opts...)
+	if _, ok := dst.Secret.(*pb.Customer_Ssn); ok {
+		dst.Secret = nil
+	}
+	if dst.Contact != nil {
+		dst.Contact.Phone = (&pb.Contact{}).Phone
+	}
+
+	return dst
+}`))
+		})
+	})
+
 	Describe("Template parts", func() {
 		var w *bytes.Buffer
 
@@ -403,6 +1289,41 @@ var _ = Describe("Template", func() {
 
 	d := SrcFnToDstFn(*src, opts...)
 	return &d
+}`),
+				Entry("With errors", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+					WithErrors: true,
+				}, `func SrcFnToDstFnPtr(src *SrcPref.Src, opts ...TransformParam) (*DstPref.Dst, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	d, err := SrcFnToDstFn(*src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}`),
+				Entry("With context", Data{
+					Src:         "Src",
+					SrcFn:       "SrcFn",
+					SrcPref:     "SrcPref",
+					Dst:         "Dst",
+					DstFn:       "DstFn",
+					DstPref:     "DstPref",
+					WithContext: true,
+				}, `func SrcFnToDstFnPtr(ctx context.Context, src *SrcPref.Src, opts ...TransformParam) *DstPref.Dst {
+	if src == nil {
+		return nil
+	}
+
+	d := SrcFnToDstFn(ctx, *src, opts...)
+	return &d
 }`),
 			)
 		})
@@ -427,6 +1348,21 @@ var _ = Describe("Template", func() {
 		return DstPref.Dst{}
 	}
 
+	return SrcFnToDstFn(*src, opts...)
+}`),
+				Entry("With errors", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+					WithErrors: true,
+				}, `func SrcFnToDstFnPtrVal(src *SrcPref.Src, opts ...TransformParam) (DstPref.Dst, error) {
+	if src == nil {
+		return DstPref.Dst{}, nil
+	}
+
 	return SrcFnToDstFn(*src, opts...)
 }`),
 			)
@@ -450,6 +1386,21 @@ var _ = Describe("Template", func() {
 				}, `func SrcFnToDstFnValPtr(src SrcPref.Src, opts ...TransformParam) *DstPref.Dst {
 	d := SrcFnToDstFn(src, opts...)
 	return &d
+}`),
+				Entry("With errors", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+					WithErrors: true,
+				}, `func SrcFnToDstFnValPtr(src SrcPref.Src, opts ...TransformParam) (*DstPref.Dst, error) {
+	d, err := SrcFnToDstFn(src, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
 }`),
 			)
 		})
@@ -505,6 +1456,163 @@ var _ = Describe("Template", func() {
 
 
 
+	return s
+}`),
+				Entry("With errors", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+					WithErrors: true,
+					Fields: []Field{
+						{Name: "ID", ProtoName: "Id"},
+						{Name: "Address", ProtoName: "Address", ProtoToGoType: "PbToAddress", GoToProtoType: "AddressToPb",
+							GoIsPointer: true, ProtoIsPointer: true, Opts: ", opts...", WithError: true},
+					},
+				}, `func SrcFnToDstFn(src SrcPref.Src, opts ...TransformParam) (DstPref.Dst, error) {
+	s := DstPref.Dst{
+			ID: src.Id,
+	}
+
+	applyOptions(opts...)
+
+
+
+	vAddress, err := PbToAddressPtr(src.Address, opts...)
+	if err != nil {
+		return DstPref.Dst{}, fmt.Errorf("field Address: %w", err)
+	}
+	s.Address = vAddress
+
+	return s, nil
+}`),
+				Entry("With context", Data{
+					Src:         "Src",
+					SrcFn:       "SrcFn",
+					SrcPref:     "SrcPref",
+					Dst:         "Dst",
+					DstFn:       "DstFn",
+					DstPref:     "DstPref",
+					WithContext: true,
+					Fields: []Field{
+						{Name: "ID", ProtoName: "Id"},
+						{Name: "Address", ProtoName: "Address", ProtoToGoType: "PbToAddress", GoToProtoType: "AddressToPb",
+							GoIsPointer: true, ProtoIsPointer: true, Opts: ", opts...", WithContext: true},
+					},
+				}, `func SrcFnToDstFn(ctx context.Context, src SrcPref.Src, opts ...TransformParam) DstPref.Dst {
+	s := DstPref.Dst{
+			ID: src.Id,
+	}
+
+	applyOptions(opts...)
+
+
+
+	s.Address = PbToAddressPtr(ctx, src.Address, opts...)
+
+	return s
+}`),
+				Entry("Promoted field", Data{
+					Src:     "Src",
+					SrcFn:   "SrcFn",
+					SrcPref: "SrcPref",
+					Dst:     "Dst",
+					DstFn:   "DstFn",
+					DstPref: "DstPref",
+					Fields: []Field{
+						{Name: "Name", ProtoName: "Name"},
+						{Name: "ID", ProtoName: "Id", Promoted: true},
+					},
+				}, `func SrcFnToDstFn(src SrcPref.Src, opts ...TransformParam) DstPref.Dst {
+	s := DstPref.Dst{
+			Name: src.Name,
+	}
+
+	applyOptions(opts...)
+
+
+
+	s.ID = src.Id
+
+	return s
+}`),
+				Entry("Promoted field, swapped", Data{
+					Src:     "Src",
+					SrcFn:   "SrcFn",
+					SrcPref: "SrcPref",
+					Dst:     "Dst",
+					DstFn:   "DstFn",
+					DstPref: "DstPref",
+					Swapped: true,
+					Fields: []Field{
+						{Name: "Name", ProtoName: "Name"},
+						{Name: "ID", ProtoName: "Id", Promoted: true},
+					},
+				}, `func SrcFnToDstFn(src SrcPref.Src, opts ...TransformParam) DstPref.Dst {
+	s := DstPref.Dst{
+			Name: src.Name,
+			Id: src.ID,
+	}
+
+	applyOptions(opts...)
+
+
+
+	return s
+}`),
+				Entry("ent edge", Data{
+					Src:     "Src",
+					SrcFn:   "SrcFn",
+					SrcPref: "SrcPref",
+					Dst:     "Dst",
+					DstFn:   "DstFn",
+					DstPref: "DstPref",
+					Fields: []Field{
+						{Name: "Name", ProtoName: "Name"},
+						{Name: "Edges.Lead", ProtoName: "Lead", ProtoToGoType: "PbToUserPtr", GoToProtoType: "UserToPbPtr", Edge: true},
+					},
+				}, `func SrcFnToDstFn(src SrcPref.Src, opts ...TransformParam) DstPref.Dst {
+	s := DstPref.Dst{
+			Name: src.Name,
+	}
+
+	applyOptions(opts...)
+
+
+
+	if src.Lead != nil {
+		s.Edges.Lead =  PbToUserPtr(src.Lead )
+	}
+
+	return s
+}`),
+				Entry("ent edge, swapped", Data{
+					Src:     "Src",
+					SrcFn:   "SrcFn",
+					SrcPref: "SrcPref",
+					Dst:     "Dst",
+					DstFn:   "DstFn",
+					DstPref: "DstPref",
+					Swapped: true,
+					Fields: []Field{
+						{Name: "Name", ProtoName: "Name"},
+						{Name: "Edges.Lead", ProtoName: "Lead", ProtoToGoType: "PbToUserPtr", GoToProtoType: "UserToPbPtr", Edge: true},
+					},
+				}, `func SrcFnToDstFn(src SrcPref.Src, opts ...TransformParam) DstPref.Dst {
+	s := DstPref.Dst{
+			Name: src.Name,
+	}
+
+	applyOptions(opts...)
+
+
+
+	if src.Edges.Lead != nil {
+		s.Lead =  UserToPbPtr(src.Edges.Lead )
+	}
+
 	return s
 }`),
 			)
@@ -533,6 +1641,27 @@ var _ = Describe("Template", func() {
 	}
 
 	return resp
+}`),
+				Entry("With errors", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+					WithErrors: true,
+				}, `func SrcFnToDstFnValList(src []SrcPref.Src, opts ...TransformParam) ([]DstPref.Dst, error) {
+	resp := make([]DstPref.Dst, len(src))
+
+	for i, s := range src {
+		d, err := SrcFnToDstFn(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = d
+	}
+
+	return resp, nil
 }`),
 			)
 		})
@@ -600,20 +1729,207 @@ var _ = Describe("Template", func() {
 					Expect(w.String()).To(Equal(expected))
 				},
 				Entry("Ptr", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					SrcPointer: "*",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+				}, `func SrcFnToDstFnPtrValList(src []*SrcPref.Src, opts ...TransformParam) []DstPref.Dst {
+	resp := make([]DstPref.Dst, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, SrcFnToDstFn(*s, opts...))
+	}
+
+	return resp
+}`),
+				Entry("With errors", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					SrcPointer: "*",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+					WithErrors: true,
+				}, `func SrcFnToDstFnPtrValList(src []*SrcPref.Src, opts ...TransformParam) ([]DstPref.Dst, error) {
+	resp := make([]DstPref.Dst, 0, len(src))
+
+	for i, s := range src {
+		if s == nil {
+			continue
+		}
+		g, err := SrcFnToDstFn(*s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp = append(resp, g)
+	}
+
+	return resp, nil
+}`),
+				Entry("ValPtr", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+					DstPointer: "*",
+				}, `func SrcFnToDstFnValPtrList(src []SrcPref.Src, opts ...TransformParam) []*DstPref.Dst {
+	resp := make([]*DstPref.Dst, len(src))
+
+	for i, s := range src {
+		g := SrcFnToDstFn(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}`),
+				Entry("ValPtr with errors", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+					DstPointer: "*",
+					WithErrors: true,
+				}, `func SrcFnToDstFnValPtrList(src []SrcPref.Src, opts ...TransformParam) ([]*DstPref.Dst, error) {
+	resp := make([]*DstPref.Dst, len(src))
+
+	for i, s := range src {
+		g, err := SrcFnToDstFn(s, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		resp[i] = &g
+	}
+
+	return resp, nil
+}`),
+			)
+		})
+
+		Context("when execute template vallst2ptrlstT", func() {
+
+			DescribeTable("check result",
+				func(d Data, expected string) {
+					err := vallst2ptrlstT.Execute(w, d)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(w.String()).To(Equal(expected))
+				},
+				Entry("Not swapped", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					SrcPointer: "*",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+				}, `func SrcFnToDstFnValPtrList(src []SrcPref.Src, opts ...TransformParam) []*DstPref.Dst {
+	resp := make([]*DstPref.Dst, len(src))
+
+	for i, s := range src {
+		g := SrcFnToDstFn(s, opts...)
+		resp[i] = &g
+	}
+
+	return resp
+}`),
+				Entry("Swapped", Data{
+					Src:        "Src",
+					SrcFn:      "SrcFn",
+					SrcPref:    "SrcPref",
+					Dst:        "Dst",
+					DstFn:      "DstFn",
+					DstPref:    "DstPref",
+					DstPointer: "*",
+					Swapped:    true,
+				}, `func SrcFnToDstFnPtrValList(src []*SrcPref.Src, opts ...TransformParam) []DstPref.Dst {
+	resp := make([]DstPref.Dst, 0, len(src))
+
+	for _, s := range src {
+		if s == nil {
+			continue
+		}
+		resp = append(resp, SrcFnToDstFn(*s, opts...))
+	}
+
+	return resp
+}`),
+			)
+		})
+
+		Context("when execute template fieldNamesT", func() {
+
+			DescribeTable("check result",
+				func(d Data, expected string) {
+					err := fieldNamesT.Execute(w, d)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(w.String()).To(Equal(expected))
+				},
+				Entry("Not swapped", Data{
 					Src:     "Src",
 					SrcFn:   "SrcFn",
 					SrcPref: "SrcPref",
 					Dst:     "Dst",
 					DstFn:   "DstFn",
 					DstPref: "DstPref",
-				}, `func SrcFnToDstFnPtrValList(src []SrcPref.Src, opts ...TransformParam) []DstPref.Dst {
-	resp := make([]DstPref.Dst, len(src))
+					Fields: []Field{
+						{Name: "ID", ProtoOrigName: "id"},
+						{Name: "MapField1", ProtoOrigName: "map_field_1"},
+					},
+				}, `// SrcFnToDstFnFieldNames maps SrcPref.Src field names to DstPref.Dst field names.
+var SrcFnToDstFnFieldNames = map[string]string{
+	"id": "ID",
+	"map_field_1": "MapField1",
+}`),
+				Entry("Swapped", Data{
+					Src:     "Src",
+					SrcFn:   "SrcFn",
+					Dst:     "Dst",
+					DstFn:   "DstFn",
+					Swapped: true,
+					Fields: []Field{
+						{Name: "ID", ProtoOrigName: "id"},
+					},
+				}, `// SrcFnToDstFnFieldNames maps Src field names to Dst field names.
+var SrcFnToDstFnFieldNames = map[string]string{
+	"ID": "id",
+}`),
+			)
+		})
 
-	for i, s := range src {
-		resp[i] = SrcFnToDstFn(*s)
-		}
+		Context("when execute template jsonNamesT", func() {
 
-	return resp
+			DescribeTable("check result",
+				func(d Data, expected string) {
+					err := jsonNamesT.Execute(w, d)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(w.String()).To(Equal(expected))
+				},
+				Entry("Ignored field", Data{
+					Src:     "Src",
+					SrcFn:   "SrcFn",
+					SrcPref: "SrcPref",
+					Dst:     "Dst",
+					DstFn:   "DstFn",
+					DstPref: "DstPref",
+					Fields: []Field{
+						{ProtoJSONName: "id", GoJSONName: "id"},
+						{ProtoJSONName: "secret", GoJSONName: "-"},
+						{ProtoJSONName: "mapField1", GoJSONName: "map_field"},
+					},
+				}, `// SrcFnToDstFnJSONNames maps SrcPref.Src JSON field names to DstPref.Dst JSON field names.
+var SrcFnToDstFnJSONNames = map[string]string{
+	"id": "id",
+	"mapField1": "map_field",
 }`),
 			)
 		})
@@ -635,7 +1951,7 @@ var _ = Describe("Template", func() {
 					DstPref: "DstPref",
 				}, `// SrcFnToDstFnList is DEPRECATED. Use SrcFnToDstFnPtrValList instead.
 func SrcFnToDstFnList(src []SrcPref.Src, opts ...TransformParam) []DstPref.Dst {
-	return SrcFnToDstFnPtrValList(src)
+	return SrcFnToDstFnPtrValList(src, opts...)
 }`),
 			)
 		})
@@ -3,3 +3,7 @@ package model
 type Product struct {
 	ID int `db:"id" json:"id"`
 }
+
+type ProductDTO struct {
+	ID int64 `json:"id"`
+}
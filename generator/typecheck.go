@@ -0,0 +1,147 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	gotypes "go/types"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// maxTypeErrors is a number of type errors of package reported by
+// TypeCheckWriter, the rest of errors are counted only.
+const maxTypeErrors = 10
+
+// TypeCheckWriter type-checks generated Go files with go/types, so generated
+// code which doesn't compile, e.g. because of mismatched types of proto and
+// model fields, is reported as plugin error instead of build error later.
+// Files are type-checked on Close together with the rest of Go files of
+// their directories and packages they import, such as models and proto
+// structures, which are loaded from source code. Paths of generated files
+// are relative to working directory, i.e. output directory of plugin is
+// expected to be the working directory of protoc. All files are written into
+// underlying FileWriter as is.
+type TypeCheckWriter struct {
+	w     FileWriter
+	files map[string]map[string]string
+	dirs  []string
+}
+
+// NewTypeCheckWriter returns TypeCheckWriter which writes files into w.
+func NewTypeCheckWriter(w FileWriter) *TypeCheckWriter {
+	return &TypeCheckWriter{w: w, files: map[string]map[string]string{}}
+}
+
+// WriteFile keeps content of Go file for type checking and writes file into
+// underlying FileWriter.
+func (tw *TypeCheckWriter) WriteFile(name, content string) error {
+	if path.Ext(name) == ".go" && !strings.HasSuffix(name, "_test.go") {
+		dir := path.Dir(name)
+		if _, ok := tw.files[dir]; !ok {
+			tw.files[dir] = map[string]string{}
+			tw.dirs = append(tw.dirs, dir)
+		}
+		tw.files[dir][path.Base(name)] = content
+	}
+
+	return tw.w.WriteFile(name, content)
+}
+
+// Close type-checks generated packages, type errors are reported as plugin
+// error, and closes underlying FileWriter.
+func (tw *TypeCheckWriter) Close() error {
+	problems := []string{}
+	for _, dir := range tw.dirs {
+		errs, err := typeCheckDir(dir, tw.files[dir])
+		if err != nil {
+			return err
+		}
+		problems = append(problems, errs...)
+	}
+
+	if len(problems) > 0 {
+		msg := "generated code doesn't type-check:\n" + strings.Join(problems, "\n")
+		ew, ok := tw.w.(errorWriter)
+		if !ok {
+			return errors.New(msg)
+		}
+		if err := ew.WriteError(msg); err != nil {
+			return err
+		}
+	}
+
+	return tw.w.Close()
+}
+
+// typeCheckDir returns type errors of Go package of directory dir, which
+// consists of generated files by their names and files of the directory
+// which aren't replaced by generated ones. Positions of errors are relative
+// to working directory, e.g. transform/product_transformer.go:12:3.
+func typeCheckDir(dir string, generated map[string]string) ([]string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for name := range generated {
+		names = append(names, name)
+	}
+
+	existing, _ := filepath.Glob(filepath.Join(abs, "*.go"))
+	for _, p := range existing {
+		name := filepath.Base(p)
+		if _, ok := generated[name]; ok || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if match, err := build.Default.MatchFile(abs, name); err != nil || !match {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fset := token.NewFileSet()
+	files := []*ast.File{}
+	for _, name := range names {
+		var src interface{}
+		if content, ok := generated[name]; ok {
+			src = content
+		}
+
+		f, err := parser.ParseFile(fset, filepath.Join(abs, name), src, 0)
+		if err != nil {
+			return []string{relativePosition(abs, dir, err.Error())}, nil
+		}
+		files = append(files, f)
+	}
+
+	errs := []string{}
+	conf := gotypes.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			errs = append(errs, relativePosition(abs, dir, err.Error()))
+		},
+	}
+	// errors are collected by conf.Error.
+	_, _ = conf.Check(dir, fset, files, nil)
+
+	if len(errs) > maxTypeErrors {
+		errs = append(errs[:maxTypeErrors], fmt.Sprintf("%s: %d more errors", dir, len(errs)-maxTypeErrors))
+	}
+
+	return errs, nil
+}
+
+// relativePosition replaces absolute directory abs in message msg of error
+// by directory dir as it's written into plugin response.
+func relativePosition(abs, dir, msg string) string {
+	return strings.Replace(msg, abs+string(filepath.Separator), dir+"/", -1)
+}
@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var _ = Describe("TypeCheckWriter", func() {
+
+	transformer := func(idType string) string {
+		return `package transform
+
+import model "github.com/ZacxDev/protoc-gen-struct-transformer/generator/testdata"
+
+func PbToProduct(id ` + idType + `, opts ...TransformParam) model.Product {
+	return model.Product{ID: id}
+}
+`
+	}
+
+	const options = "package transform\n\ntype TransformParam struct{}\n"
+
+	var buf *bytes.Buffer
+
+	BeforeEach(func() {
+		buf = &bytes.Buffer{}
+	})
+
+	It("writes files which type-check", func() {
+		tw := NewTypeCheckWriter(NewStreamWriter(buf))
+
+		Expect(tw.WriteFile("testdata/transform/product_transformer.go", transformer("int"))).To(Succeed())
+		Expect(tw.WriteFile("testdata/transform/options.go", options)).To(Succeed())
+		Expect(tw.WriteFile("testdata/transform/coverage.json", "{}")).To(Succeed())
+		Expect(tw.Close()).To(Succeed())
+
+		Expect(buf.String()).To(ContainSubstring("// >>> file: testdata/transform/product_transformer.go\n"))
+		Expect(buf.String()).To(ContainSubstring("// >>> file: testdata/transform/coverage.json\n"))
+	})
+
+	It("reports type errors as plugin error", func() {
+		tw := NewTypeCheckWriter(NewResponseWriter(buf))
+
+		Expect(tw.WriteFile("testdata/transform/product_transformer.go", transformer("int64"))).To(Succeed())
+		Expect(tw.Close()).To(Succeed())
+
+		resp := &pluginpb.CodeGeneratorResponse{}
+		Expect(proto.Unmarshal(buf.Bytes(), resp)).To(Succeed())
+		Expect(resp.File).To(HaveLen(1))
+		Expect(resp.GetError()).To(HavePrefix("generated code doesn't type-check:\n"))
+		Expect(resp.GetError()).To(ContainSubstring("testdata/transform/product_transformer.go:5:36: undefined: TransformParam"))
+		Expect(resp.GetError()).To(ContainSubstring("testdata/transform/product_transformer.go:6:27: cannot use id (variable of type int64) as int value"))
+	})
+
+	It("returns type errors if writer can't report errors", func() {
+		tw := NewTypeCheckWriter(NewPackageWriter(NewStreamWriter(buf)))
+
+		Expect(tw.WriteFile("testdata/transform/options.go", "package transform\n\nvar x int = \"x\"\n")).To(Succeed())
+		Expect(tw.Close()).To(MatchError(HavePrefix("generated code doesn't type-check:\ntestdata/transform/options.go:3:13: cannot use \"x\"")))
+	})
+})
@@ -1,6 +1,6 @@
 package generator
 
-import "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+import "google.golang.org/protobuf/types/descriptorpb"
 
 type typeRel struct {
 	pbType     string
@@ -10,13 +10,43 @@ type typeRel struct {
 
 // types contains protobuf types.
 // default mapping for similar but non-equal types.
-var types = map[descriptor.FieldDescriptorProto_Type]typeRel{
-	descriptor.FieldDescriptorProto_TYPE_INT32:  typeRel{pbType: "int32", goType: "int"},
-	descriptor.FieldDescriptorProto_TYPE_INT64:  typeRel{pbType: "int64", goType: "int"},
-	descriptor.FieldDescriptorProto_TYPE_UINT32: typeRel{pbType: "uint32", goType: "uint"},
-	descriptor.FieldDescriptorProto_TYPE_UINT64: typeRel{pbType: "uint64", goType: "uint"},
-	descriptor.FieldDescriptorProto_TYPE_FLOAT:  typeRel{pbType: "", goType: "float32"},
-	descriptor.FieldDescriptorProto_TYPE_DOUBLE: typeRel{pbType: "", goType: "float64"},
-	descriptor.FieldDescriptorProto_TYPE_BOOL:   typeRel{pbType: "", goType: "bool"},
-	descriptor.FieldDescriptorProto_TYPE_STRING: typeRel{pbType: "", goType: "string"},
+var types = map[descriptorpb.FieldDescriptorProto_Type]typeRel{
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:  typeRel{pbType: "int32", goType: "int"},
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:  typeRel{pbType: "int64", goType: "int"},
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32: typeRel{pbType: "uint32", goType: "uint"},
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64: typeRel{pbType: "uint64", goType: "uint"},
+	descriptorpb.FieldDescriptorProto_TYPE_FLOAT:  typeRel{pbType: "", goType: "float32"},
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE: typeRel{pbType: "", goType: "float64"},
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:   typeRel{pbType: "", goType: "bool"},
+	descriptorpb.FieldDescriptorProto_TYPE_STRING: typeRel{pbType: "", goType: "string"},
+}
+
+// wrappers contains Go types of values of google.protobuf wrapper types.
+var wrappers = map[string]string{
+	".google.protobuf.DoubleValue": "float64",
+	".google.protobuf.FloatValue":  "float32",
+	".google.protobuf.Int64Value":  "int64",
+	".google.protobuf.UInt64Value": "uint64",
+	".google.protobuf.Int32Value":  "int32",
+	".google.protobuf.UInt32Value": "uint32",
+	".google.protobuf.BoolValue":   "bool",
+	".google.protobuf.StringValue": "string",
+	".google.protobuf.BytesValue":  "[]byte",
+}
+
+// stdTypes contains Go types of google.protobuf Timestamp and Duration, which
+// are expected to be generated as standard Go types (gogoproto.stdtime and
+// gogoproto.stdduration).
+var stdTypes = map[string]string{
+	".google.protobuf.Timestamp": "time.Time",
+	".google.protobuf.Duration":  "time.Duration",
+}
+
+// isElemWKT returns true if google.protobuf well-known type t can be
+// transformed element by element inside repeated and map fields.
+func isElemWKT(t string) bool {
+	_, w := wrappers[t]
+	_, s := stdTypes[t]
+
+	return w || s
 }
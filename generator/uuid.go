@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// uuidType is a type of model fields which are transformed into UUIDs without
+// transformer.uuid option.
+const uuidType = "uuid.UUID"
+
+// processUUIDField returns *Field for singular string or bytes field fdp
+// which is transformed into model field gf of type uuid.UUID, see
+// transformer.uuid option. Nil is returned for other fields and for fields
+// with transformer.bytes_converter option.
+func processUUIDField(fdp *descriptorpb.FieldDescriptorProto, pname, gname string, gf source.FieldInfo) (*Field, error) {
+	pt := "string"
+	switch {
+	case fdp.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED:
+		return nil, nil
+	case fdp.GetType() == descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		if hasOption(fdp.Options, options.E_BytesConverter) {
+			return nil, nil
+		}
+		pt = "bytes"
+	case fdp.GetType() != descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		return nil, nil
+	}
+
+	opt := getBoolOption(fdp.Options, options.E_Uuid)
+	if !opt && gf.Type != uuidType {
+		return nil, nil
+	}
+
+	if gf.IsSlice || gf.Key != "" || !strings.Contains(gf.Type, ".") {
+		if !opt {
+			return nil, nil
+		}
+		return nil, newLoggableError("field %s: option (%s) requires model field of type %s or *%s, got %s",
+			gname, options.E_Uuid.Name, uuidType, uuidType, gf.GoType()).
+			withHint("change type of model field or remove the option")
+	}
+
+	return &Field{
+		Name:      gname,
+		ProtoName: pname,
+		Wrapper: &Elem{
+			Kind:        elemUUID,
+			ProtoType:   pt,
+			GoType:      gf.Type,
+			GoIsPointer: gf.IsPointer,
+		},
+	}, nil
+}
@@ -0,0 +1,72 @@
+package generator
+
+import (
+	"github.com/ZacxDev/protoc-gen-struct-transformer/options"
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("UUID fields", func() {
+
+	typBytes := descriptorpb.FieldDescriptorProto_TYPE_BYTES
+
+	field := func(typ *descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{Name: sp("id"), Type: typ, Options: &descriptorpb.FieldOptions{}}
+	}
+
+	It("detects model fields of type uuid.UUID", func() {
+		f, err := processUUIDField(field(&typString), "Id", "ID", source.FieldInfo{Type: "uuid.UUID"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f).To(Equal(&Field{Name: "ID", ProtoName: "Id", Wrapper: &Elem{Kind: elemUUID, ProtoType: "string", GoType: "uuid.UUID"}}))
+	})
+
+	It("transforms bytes fields into pointers", func() {
+		f, err := processUUIDField(field(&typBytes), "Id", "ID", source.FieldInfo{Type: "uuid.UUID", IsPointer: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper).To(Equal(&Elem{Kind: elemUUID, ProtoType: "bytes", GoType: "uuid.UUID", GoIsPointer: true}))
+	})
+
+	It("returns nil for other fields", func() {
+		Expect(processUUIDField(field(&typString), "Id", "ID", source.FieldInfo{Type: "string"})).To(BeNil())
+		Expect(processUUIDField(field(&typInt64), "Id", "ID", source.FieldInfo{Type: "uuid.UUID"})).To(BeNil())
+
+		fdp := field(&typString)
+		fdp.Label = &typRepeated
+		Expect(processUUIDField(fdp, "Id", "ID", source.FieldInfo{Type: "uuid.UUID", IsSlice: true})).To(BeNil())
+	})
+
+	It("leaves bytes fields with bytes_converter option to converter functions", func() {
+		fdp := field(&typBytes)
+		proto.SetExtension(fdp.Options, options.E_BytesConverter, "uuid.FromBytes")
+		Expect(processUUIDField(fdp, "Id", "ID", source.FieldInfo{Type: "uuid.UUID"})).To(BeNil())
+	})
+
+	It("uses package of model field with uuid option", func() {
+		fdp := field(&typString)
+		proto.SetExtension(fdp.Options, options.E_Uuid, true)
+
+		f, err := processUUIDField(fdp, "Id", "ID", source.FieldInfo{Type: "guuid.UUID"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper.GoType).To(Equal("guuid.UUID"))
+	})
+
+	It("returns error with hint for other model types with uuid option", func() {
+		fdp := field(&typString)
+		proto.SetExtension(fdp.Options, options.E_Uuid, true)
+
+		_, err := processUUIDField(fdp, "Id", "ID", source.FieldInfo{Type: "string"})
+		Expect(err).To(BeAssignableToTypeOf(loggableError{}))
+		Expect(err.Error()).To(Equal("field ID: option (transformer.uuid) requires model field of type uuid.UUID or *uuid.UUID, got string; hint: change type of model field or remove the option"))
+	})
+
+	It("takes precedence over simple fields", func() {
+		s := source.Structure{"ID": source.FieldInfo{Type: "uuid.UUID"}}
+
+		f, err := processField(nil, field(&typString), MessageOptionList{}, s, policies{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Wrapper.Kind).To(Equal(elemUUID))
+	})
+})
@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// googleProtobufValue is a FQTN of google.protobuf.Value message.
+	googleProtobufValue = ".google.protobuf.Value"
+	// googleProtobufListValue is a FQTN of google.protobuf.ListValue message.
+	googleProtobufListValue = ".google.protobuf.ListValue"
+	// googleProtobufStruct is a FQTN of google.protobuf.Struct message.
+	googleProtobufStruct = ".google.protobuf.Struct"
+)
+
+// UsesValues returns true if any message of proto file has a field of type
+// google.protobuf.Value, google.protobuf.ListValue or google.protobuf.Struct,
+// such files require functions from ValueHelpers.
+func UsesValues(f *descriptorpb.FileDescriptorProto) bool {
+	for _, fm := range fileMessages(f.MessageType, "") {
+		for _, fd := range fm.desc.Field {
+			if t := fd.GetTypeName(); t == googleProtobufValue || t == googleProtobufListValue || t == googleProtobufStruct {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ValueHelpers returns file content with functions for transforming
+// google.protobuf.Value, ListValue and Struct fields into interface{},
+// []interface{} and map[string]interface{} values and vice versa.
+func ValueHelpers(packageName string) (string, error) {
+	w := output()
+	fmt.Fprintln(w, "\npackage", packageName)
+
+	if err := valueT.Execute(w, nil); err != nil {
+		return "", err
+	}
+
+	return w.String(), nil
+}
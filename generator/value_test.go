@@ -0,0 +1,54 @@
+package generator
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var _ = Describe("Value", func() {
+
+	DescribeTable("UsesValues",
+		func(typeName string, expected bool) {
+			f := &descriptorpb.FileDescriptorProto{
+				MessageType: []*descriptorpb.DescriptorProto{{
+					Name: sp("Event"),
+					NestedType: []*descriptorpb.DescriptorProto{{
+						Name: sp("Payload"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							{Name: sp("data"), Type: &typMessage, TypeName: sp(typeName)},
+						},
+					}},
+				}},
+			}
+			Expect(UsesValues(f)).To(Equal(expected))
+		},
+
+		Entry("Value field", ".google.protobuf.Value", true),
+		Entry("ListValue field", ".google.protobuf.ListValue", true),
+		Entry("Struct field", ".google.protobuf.Struct", true),
+		Entry("No value fields", ".google.protobuf.Timestamp", false),
+	)
+
+	Describe("ValueHelpers", func() {
+
+		BeforeEach(func() {
+			version = "v1.1.1"
+		})
+
+		It("returns value converters", func() {
+			r, err := ValueHelpers("one")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r).To(HavePrefix("// Code generated by protoc-gen-struct-transformer, version: v1.1.1. DO NOT EDIT.\n\npackage one\n"))
+			Expect(r).To(ContainSubstring("func ValueToInterface(v *types.Value) interface{} {"))
+			Expect(r).To(ContainSubstring("func InterfaceToValue(v interface{}) *types.Value {"))
+			Expect(r).To(ContainSubstring("func ListValueToSlice(l *types.ListValue) []interface{} {"))
+			Expect(r).To(ContainSubstring("func SliceToListValue(s []interface{}) *types.ListValue {"))
+			Expect(r).To(ContainSubstring("func StructToMap(s *types.Struct) map[string]interface{} {"))
+			Expect(r).To(ContainSubstring("func MapToStruct(m map[string]interface{}) (*types.Struct, error) {"))
+			Expect(r).To(ContainSubstring("func StructToJSON(s *types.Struct) (json.RawMessage, error) {"))
+			Expect(r).To(ContainSubstring("func JSONToStruct(raw json.RawMessage) (*types.Struct, error) {"))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+)
+
+// verifyModels is true if model structures are registered for
+// VerifyTransformers check, see SetVerify.
+var verifyModels bool
+
+// SetVerify turns on registration of model structures used by transformers,
+// they are checked at runtime by VerifyTransformers, see VerifyHelpers.
+func SetVerify(on bool) {
+	verifyModels = on
+}
+
+// modelField is a name and a type of model structure field which is used by
+// transformation functions.
+type modelField struct {
+	Name string
+	Type string
+}
+
+// aliasTypes maps Go alias types into types which are reported by reflect
+// package.
+var aliasTypes = map[string]string{
+	"byte": "uint8",
+	"rune": "int32",
+}
+
+// modelFields returns names and types of model structure s fields used by
+// fields, list is sorted by field name. Types are written as parser sees
+// them: slice and map fields are described by their element type.
+func modelFields(fields []Field, s source.Structure) []modelField {
+	var mf []modelField
+	seen := map[string]bool{}
+
+	for _, f := range flatFields(fields) {
+		fi, ok := s[f.Name]
+		if !ok || seen[f.Name] {
+			continue
+		}
+		seen[f.Name] = true
+
+		if t, ok := aliasTypes[fi.Type]; ok {
+			fi.Type = t
+		}
+
+		mf = append(mf, modelField{Name: f.Name, Type: fi.String()})
+	}
+
+	sort.Slice(mf, func(i, j int) bool { return mf[i].Name < mf[j].Name })
+
+	return mf
+}
+
+// VerifyHelpers returns file content with VerifyTransformers function which
+// checks model structures registered by generated transformers. If onInit is
+// true, check is performed in init function and failed check panics.
+func VerifyHelpers(packageName string, onInit bool) (string, error) {
+	w := output()
+	fmt.Fprintln(w, "\npackage", packageName)
+
+	if err := verifyHelpersT.Execute(w, onInit); err != nil {
+		return "", err
+	}
+
+	return w.String(), nil
+}
@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Verify", func() {
+
+	Describe("modelFields", func() {
+
+		It("returns sorted model fields used by transformations", func() {
+			s := source.Structure{
+				"ID":      {Type: "int64"},
+				"Name":    {Type: "string", IsPointer: true},
+				"Data":    {Type: "byte"},
+				"City":    {Type: "string"},
+				"Ignored": {Type: "string"},
+			}
+			fields := []Field{
+				{Name: "Name"},
+				{Name: "ID"},
+				{Name: "Data"},
+				{ProtoName: "Address", EmbeddedFields: []Field{{Name: "City"}}},
+				{Name: "Unknown"},
+			}
+
+			Expect(modelFields(fields, s)).To(Equal([]modelField{
+				{Name: "City", Type: "string"},
+				{Name: "Data", Type: "uint8"},
+				{Name: "ID", Type: "int64"},
+				{Name: "Name", Type: "*string"},
+			}))
+		})
+	})
+
+	Describe("VerifyHelpers", func() {
+
+		It("adds init function if requested", func() {
+			content, err := VerifyHelpers("transform", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("\npackage transform\n"))
+			Expect(content).To(ContainSubstring("func VerifyTransformers() error {"))
+			Expect(content).To(ContainSubstring("func init() {"))
+		})
+
+		It("does not add init function by default", func() {
+			content, err := VerifyHelpers("transform", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("func VerifyTransformers() error {"))
+			Expect(content).NotTo(ContainSubstring("func init() {"))
+		})
+	})
+
+	Describe("verify template", func() {
+
+		It("registers model structure", func() {
+			t, err := templateWithHelpers("test")
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &bytes.Buffer{}
+			err = t.ExecuteTemplate(w, "verify", Data{
+				SrcFn:       "Pb",
+				Dst:         "Product",
+				DstPref:     "model",
+				DstFn:       "Product",
+				ModelFields: []modelField{{Name: "ID", Type: "int64"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(w.String()).To(Equal(`// model.Product fields used by PbToProduct are checked by VerifyTransformers.
+var _ = registerVerifier(reflect.TypeOf(model.Product{}), [][2]string{
+	{"ID", "int64"},
+})`))
+		})
+	})
+})
@@ -3,11 +3,16 @@ module github.com/ZacxDev/protoc-gen-struct-transformer
 go 1.13
 
 require (
-	github.com/gogo/protobuf v1.3.1
-	github.com/golang/protobuf v1.3.2
+	github.com/gogo/googleapis v1.4.1
+	github.com/gogo/protobuf v1.3.2
+	github.com/gogo/status v1.1.1
+	github.com/golang/protobuf v1.5.3
+	github.com/google/go-cmp v0.5.5
 	github.com/iancoleman/strcase v0.0.0-20191112232945-16388991a334
 	github.com/onsi/ginkgo v1.10.1
 	github.com/onsi/gomega v1.7.0
 	github.com/pkg/errors v0.8.1
-	golang.org/x/tools v0.0.0-20200122042241-dc16b66866f1
+	golang.org/x/tools v0.0.0-20210106214847-113979e3529a
+	google.golang.org/grpc v1.12.0
+	google.golang.org/protobuf v1.31.0
 )
@@ -1,6 +1,6 @@
 // Transformation function generator for gRPC.
 //
-// Overview
+// # Overview
 //
 // Protocol buffers complier (protoc) https://github.com/protocolbuffers/protobuf
 // generates structures based on message definition in *.proto file. It's
@@ -21,18 +21,43 @@ import (
 	"path/filepath"
 
 	"github.com/ZacxDev/protoc-gen-struct-transformer/generator"
-	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
-	"github.com/golang/protobuf/proto"
 	"golang.org/x/tools/imports"
 )
 
 var (
 	packageName       = flag.String("package", "fallback", "Package name for generated functions.")
+	fallbackPackage   = flag.String("fallback-package", "", `Package name for generated functions if package would create an import cycle with models, proto structures or helpers, default is package name with "transform" suffix.`)
 	helperPackageName = flag.String("helper-package", "", "Package name for helper functions.")
+	helperPackagePath = flag.String("helper-package-path", "", "Import path of package with helper functions, package is imported into generated files. Last element of path is used as package name if helper-package is empty.")
 	versionFlag       = flag.Bool("version", false, "Print current version.")
 	goimports         = flag.Bool("goimports", false, "Perform goimports on generated file.")
+	gofumpt           = flag.Bool("gofumpt", false, "Format generated transformers with stricter gofumpt-style rules: no empty lines at the beginning and the end of blocks, standard imports are grouped first.")
 	debug             = flag.Bool("debug", false, "Add debug information to generated file.")
+	debugFile         = flag.Bool("debug-file", false, "Write resolved options, matched fields and functions which transform them as JSON next to each generated file, e.g. product_transformer.debug.json.")
 	usePackageInPath  = flag.Bool("use-package-in-path", true, "If true, package parameter will be used in path for output file.")
+	headerTemplate    = flag.String("header-template", "", "Path to file with text/template for header of generated files.")
+	customTemplateDir = flag.String("custom-template-dir", "", "Path to directory with .tmpl files which replace or extend templates of generated transformers.")
+	includeFiles      = flag.String("include-files", "", "Comma-separated list of glob patterns of .proto files which are processed, all files by default.")
+	excludeFiles      = flag.String("exclude-files", "", "Comma-separated list of glob patterns of .proto files which are not processed.")
+	includeMessages   = flag.String("include-messages", "", "Comma-separated list of glob patterns of messages which transformers are generated for, all messages by default.")
+	excludeMessages   = flag.String("exclude-messages", "", "Comma-separated list of glob patterns of messages which transformers are not generated for.")
+	disableReverse    = flag.Bool("disable-reverse", false, "Do not generate functions which transform models into proto messages.")
+	strict            = flag.Bool("strict", false, "Fail generation if proto fields are not transformed into model fields or exported model fields are not covered by proto messages, unless fields are skipped explicitly.")
+	coverageReport    = flag.String("coverage-report", "", "Path of generated JSON report with unmapped proto and model fields of each message, e.g. transform/coverage.json.")
+	lintOnly          = flag.Bool("lint-only", false, "Validate options, models and field mappings without generating transformers, problems are reported as plugin error, see lint-report.")
+	lintReport        = flag.String("lint-report", "", "Path of generated text file with problems found in lint-only mode instead of plugin error, e.g. lint.txt.")
+	stream            = flag.Bool("stream", false, "Write generated files into stdout as plain text with marked file boundaries instead of plugin response, for debugging only.")
+	modelFirst        = flag.Bool("model-first", false, "Treat model structures as the source of truth: generation fails if exported model fields are not covered by proto messages.")
+	benchmarks        = flag.Bool("benchmarks", false, "Write _bench_test.go file next to each generated file with benchmarks of transformers of messages, lists of messages and messages with sub messages.")
+	converter         = flag.Bool("converter", false, "Add transformers as methods of Converter structure, which holds dependencies of transformers.")
+	optIn             = flag.Bool("opt-in", false, "Process only messages with transformer options, such as go_struct, other messages are ignored without comments in generated files.")
+	paths             = flag.String("paths", "import", `Output paths mode: "import" - files are placed into package directory, see use-package-in-path, "source_relative" - files are placed next to .proto files.`)
+	module            = flag.String("module", "", "Prefix which is removed from output paths in import mode, e.g. github.com/acme/api, like module parameter of protoc-gen-go.")
+	outputMode        = flag.String("output-mode", "file", `Output files mode: "file" - one file is generated for each .proto file, "package" - generated files of each Go package are combined into one file.`)
+	verify            = flag.String("verify", "", `Generate VerifyTransformers function which checks model structures at runtime: "func" - explicit call only, "init" - call from init function.`)
+	fuzzTests         = flag.Bool("fuzz-tests", false, "Write _fuzz_test.go file next to each generated file with Go 1.18 fuzz tests which transform messages decoded from random data into models and back, tests use github.com/google/go-cmp.")
+	roundTripTests    = flag.Bool("round-trip-tests", false, "Write _test.go file next to each generated file with tests which check that messages transformed into models and back are equal to the original ones, tests use github.com/google/go-cmp.")
+	typecheck         = flag.Bool("typecheck", false, "Type-check generated files together with models and proto structures loaded from source code, type errors are reported as plugin error. Output directory is expected to be the working directory of protoc.")
 )
 
 func main() {
@@ -42,27 +67,87 @@ func main() {
 		os.Exit(0)
 	}
 
-	var gogoreq plugin.CodeGeneratorRequest
-
-	data, err := ioutil.ReadAll(os.Stdin)
+	req, err := generator.ReadRequest(os.Stdin)
 	must(err)
-	must(proto.Unmarshal(data, &gogoreq))
 
 	// Convert incoming parameters into CLI flags.
-	must(generator.SetParameters(flag.CommandLine, gogoreq.Parameter))
+	must(generator.SetParameters(flag.CommandLine, req.Parameter))
+
+	must(generator.SetFileFilter(*includeFiles, *excludeFiles))
+	must(generator.SetMessageFilter(*includeMessages, *excludeMessages))
+	must(generator.SetFallbackPackage(*fallbackPackage))
+	generator.SetOptIn(*optIn)
+	generator.SetGofumpt(*gofumpt)
+	generator.SetStrict(*strict)
+	generator.SetModelFirst(*modelFirst)
+	generator.SetDisableReverse(*disableReverse)
+	generator.SetConverter(*converter)
+	generator.SetVerify(*verify != "")
+	generator.SetDebugFile(*debugFile)
+	generator.SetRoundTripTests(*roundTripTests)
+	generator.SetFuzzTests(*fuzzTests)
+	generator.SetBenchmarks(*benchmarks)
+	must(generator.SetPaths(*paths, *module))
+
+	if *verify != "" && *verify != "func" && *verify != "init" {
+		must(fmt.Errorf("verify: unknown value %q, should be one of: func, init", *verify))
+	}
+
+	if *headerTemplate != "" {
+		tpl, err := ioutil.ReadFile(*headerTemplate)
+		must(err)
+		must(generator.SetHeader(string(tpl)))
+	}
+
+	must(generator.SetTemplateDir(*customTemplateDir))
 
-	resp := &plugin.CodeGeneratorResponse{}
+	if *outputMode != "file" && *outputMode != "package" {
+		must(fmt.Errorf("output-mode: unknown value %q, should be one of: file, package", *outputMode))
+	}
+
+	// Files are written into response right after processing, generated
+	// content is not kept in memory, unless files are combined by package.
+	var resp generator.FileWriter = generator.NewResponseWriter(os.Stdout)
+	if *stream {
+		resp = generator.NewStreamWriter(os.Stdout)
+	}
+	// In lint-only mode generated code is discarded and errors of files are
+	// collected, so problems of all files are reported at once.
+	fail := must
+	if *lintOnly {
+		lw := generator.NewLintWriter(resp, *lintReport)
+		resp, fail = lw, lw.Fail
+	} else {
+		// generated files are type-checked as they are written, i.e. combined
+		// ones in package output mode.
+		if *typecheck {
+			resp = generator.NewTypeCheckWriter(resp)
+		}
+		if *outputMode == "package" {
+			resp = generator.NewPackageWriter(resp)
+		}
+	}
 	optPath := ""
+	useStatus := false
+	useValues := false
+	statusDetails := []string{}
 
-	messages, err := generator.CollectAllMessages(gogoreq)
+	must(generator.InheritPackageOptions(req))
+
+	messages, err := generator.CollectAllMessages(req)
 	must(err)
+	must(generator.CheckMessageCycles(messages, req.FileToGenerate))
+	must(generator.ResolvePackage(req, packageName, *helperPackageName, *helperPackagePath, *usePackageInPath))
 
-	for _, f := range gogoreq.ProtoFile {
+	for i, f := range req.ProtoFile {
+		// Message descriptors are kept by messages list, the rest of file
+		// descriptor is not needed after processing.
+		req.ProtoFile[i] = nil
 
-		filename, content, err := generator.ProcessFile(f, packageName, helperPackageName, messages, *debug, *usePackageInPath)
+		filename, content, err := generator.ProcessFile(f, packageName, helperPackageName, helperPackagePath, messages, *debug, *usePackageInPath)
 		if err != nil {
 			if err != generator.ErrFileSkipped {
-				must(err)
+				fail(err)
 			}
 			continue
 		}
@@ -75,12 +160,47 @@ func main() {
 			continue
 		}
 
-		resp.File = append(resp.File, &plugin.CodeGeneratorResponse_File{
-			Name:    proto.String(filename + "__test_"),
-			Content: proto.String(content),
-		})
+		must(resp.WriteFile(filename, content))
+
+		debugPath, debugContent, err := generator.DebugFile(filename)
+		must(err)
+		if debugPath != "" {
+			must(resp.WriteFile(debugPath, debugContent))
+		}
+
+		if testPath, testContent := generator.RoundTripTests(filename); testPath != "" {
+			testContent, err = runGoimports(testPath, testContent)
+			must(err)
+			must(resp.WriteFile(testPath, testContent))
+		}
+
+		if fuzzPath, fuzzContent := generator.FuzzTests(filename); fuzzPath != "" {
+			fuzzContent, err = runGoimports(fuzzPath, fuzzContent)
+			must(err)
+			must(resp.WriteFile(fuzzPath, fuzzContent))
+		}
+
+		if benchPath, benchContent := generator.Benchmarks(filename); benchPath != "" {
+			benchContent, err = runGoimports(benchPath, benchContent)
+			must(err)
+			must(resp.WriteFile(benchPath, benchContent))
+		}
+
+		sumPath, sumContent, err := generator.SumTypes(f, messages)
+		must(err)
+		if sumPath != "" {
+			sumContent, err = runGoimports(sumPath, sumContent)
+			must(err)
+			must(resp.WriteFile(sumPath, sumContent))
+		}
 
 		optPath = filename
+		useStatus = useStatus || generator.UsesStatus(f)
+		useValues = useValues || generator.UsesValues(f)
+
+		details, err := generator.StatusDetails(f)
+		must(err)
+		statusDetails = append(statusDetails, details...)
 	}
 
 	if optPath != "" {
@@ -93,18 +213,64 @@ func main() {
 			}
 		}
 
-		resp.File = append(resp.File, &plugin.CodeGeneratorResponse_File{
-			Name:    proto.String(optPath),
-			Content: proto.String(content),
-		})
+		must(resp.WriteFile(optPath, content))
+
+		if useStatus || len(statusDetails) > 0 {
+			statusPath := filepath.Dir(optPath) + "/status.go"
+
+			content, err := generator.StatusHelpers(*packageName, statusDetails)
+			must(err)
+
+			content, err = runGoimports(statusPath, content)
+			must(err)
+
+			must(resp.WriteFile(statusPath, content))
+		}
+
+		if useValues {
+			valuesPath := filepath.Dir(optPath) + "/values.go"
+
+			content, err := generator.ValueHelpers(*packageName)
+			must(err)
+
+			content, err = runGoimports(valuesPath, content)
+			must(err)
+
+			must(resp.WriteFile(valuesPath, content))
+		}
+
+		if *converter {
+			converterPath := filepath.Dir(optPath) + "/converter.go"
+
+			content, err := generator.ConverterHelpers(*packageName)
+			must(err)
+
+			content, err = runGoimports(converterPath, content)
+			must(err)
+
+			must(resp.WriteFile(converterPath, content))
+		}
+
+		if *verify != "" {
+			verifyPath := filepath.Dir(optPath) + "/verify.go"
+
+			content, err := generator.VerifyHelpers(*packageName, *verify == "init")
+			must(err)
+
+			content, err = runGoimports(verifyPath, content)
+			must(err)
+
+			must(resp.WriteFile(verifyPath, content))
+		}
 	}
 
-	// Send back the results.
-	data, err = proto.Marshal(resp)
-	must(err)
+	if *coverageReport != "" {
+		content, err := generator.CoverageReport()
+		must(err)
+		must(resp.WriteFile(*coverageReport, content))
+	}
 
-	_, err = os.Stdout.Write(data)
-	must(err)
+	must(resp.Close())
 }
 
 func must(err error) {
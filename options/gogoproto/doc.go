@@ -0,0 +1,7 @@
+// Package gogoproto contains gogoproto options, such as nullable and stdtime,
+// for descriptors of google.golang.org/protobuf, so options of proto files
+// generated with gogo/protobuf are read without gogo runtime.
+//
+// gogo.pb.go is generated from gogoproto/gogo.proto of gogo/protobuf with
+// protoc-gen-go, see generate-annotations target of Makefile.
+package gogoproto
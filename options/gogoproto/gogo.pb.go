@@ -0,0 +1,1298 @@
+// Protocol Buffers for Go with Gadgets
+//
+// Copyright (c) 2013, The GoGo Authors. All rights reserved.
+// http://github.com/gogo/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: gogoproto/gogo.proto
+
+package gogoproto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var file_gogoproto_gogo_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.EnumOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         62001,
+		Name:          "gogoproto.goproto_enum_prefix",
+		Tag:           "varint,62001,opt,name=goproto_enum_prefix",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         62021,
+		Name:          "gogoproto.goproto_enum_stringer",
+		Tag:           "varint,62021,opt,name=goproto_enum_stringer",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         62022,
+		Name:          "gogoproto.enum_stringer",
+		Tag:           "varint,62022,opt,name=enum_stringer",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         62023,
+		Name:          "gogoproto.enum_customname",
+		Tag:           "bytes,62023,opt,name=enum_customname",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         62024,
+		Name:          "gogoproto.enumdecl",
+		Tag:           "varint,62024,opt,name=enumdecl",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         66001,
+		Name:          "gogoproto.enumvalue_customname",
+		Tag:           "bytes,66001,opt,name=enumvalue_customname",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63001,
+		Name:          "gogoproto.goproto_getters_all",
+		Tag:           "varint,63001,opt,name=goproto_getters_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63002,
+		Name:          "gogoproto.goproto_enum_prefix_all",
+		Tag:           "varint,63002,opt,name=goproto_enum_prefix_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63003,
+		Name:          "gogoproto.goproto_stringer_all",
+		Tag:           "varint,63003,opt,name=goproto_stringer_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63004,
+		Name:          "gogoproto.verbose_equal_all",
+		Tag:           "varint,63004,opt,name=verbose_equal_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63005,
+		Name:          "gogoproto.face_all",
+		Tag:           "varint,63005,opt,name=face_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63006,
+		Name:          "gogoproto.gostring_all",
+		Tag:           "varint,63006,opt,name=gostring_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63007,
+		Name:          "gogoproto.populate_all",
+		Tag:           "varint,63007,opt,name=populate_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63008,
+		Name:          "gogoproto.stringer_all",
+		Tag:           "varint,63008,opt,name=stringer_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63009,
+		Name:          "gogoproto.onlyone_all",
+		Tag:           "varint,63009,opt,name=onlyone_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63013,
+		Name:          "gogoproto.equal_all",
+		Tag:           "varint,63013,opt,name=equal_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63014,
+		Name:          "gogoproto.description_all",
+		Tag:           "varint,63014,opt,name=description_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63015,
+		Name:          "gogoproto.testgen_all",
+		Tag:           "varint,63015,opt,name=testgen_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63016,
+		Name:          "gogoproto.benchgen_all",
+		Tag:           "varint,63016,opt,name=benchgen_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63017,
+		Name:          "gogoproto.marshaler_all",
+		Tag:           "varint,63017,opt,name=marshaler_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63018,
+		Name:          "gogoproto.unmarshaler_all",
+		Tag:           "varint,63018,opt,name=unmarshaler_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63019,
+		Name:          "gogoproto.stable_marshaler_all",
+		Tag:           "varint,63019,opt,name=stable_marshaler_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63020,
+		Name:          "gogoproto.sizer_all",
+		Tag:           "varint,63020,opt,name=sizer_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63021,
+		Name:          "gogoproto.goproto_enum_stringer_all",
+		Tag:           "varint,63021,opt,name=goproto_enum_stringer_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63022,
+		Name:          "gogoproto.enum_stringer_all",
+		Tag:           "varint,63022,opt,name=enum_stringer_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63023,
+		Name:          "gogoproto.unsafe_marshaler_all",
+		Tag:           "varint,63023,opt,name=unsafe_marshaler_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63024,
+		Name:          "gogoproto.unsafe_unmarshaler_all",
+		Tag:           "varint,63024,opt,name=unsafe_unmarshaler_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63025,
+		Name:          "gogoproto.goproto_extensions_map_all",
+		Tag:           "varint,63025,opt,name=goproto_extensions_map_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63026,
+		Name:          "gogoproto.goproto_unrecognized_all",
+		Tag:           "varint,63026,opt,name=goproto_unrecognized_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63027,
+		Name:          "gogoproto.gogoproto_import",
+		Tag:           "varint,63027,opt,name=gogoproto_import",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63028,
+		Name:          "gogoproto.protosizer_all",
+		Tag:           "varint,63028,opt,name=protosizer_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63029,
+		Name:          "gogoproto.compare_all",
+		Tag:           "varint,63029,opt,name=compare_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63030,
+		Name:          "gogoproto.typedecl_all",
+		Tag:           "varint,63030,opt,name=typedecl_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63031,
+		Name:          "gogoproto.enumdecl_all",
+		Tag:           "varint,63031,opt,name=enumdecl_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63032,
+		Name:          "gogoproto.goproto_registration",
+		Tag:           "varint,63032,opt,name=goproto_registration",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63033,
+		Name:          "gogoproto.messagename_all",
+		Tag:           "varint,63033,opt,name=messagename_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63034,
+		Name:          "gogoproto.goproto_sizecache_all",
+		Tag:           "varint,63034,opt,name=goproto_sizecache_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         63035,
+		Name:          "gogoproto.goproto_unkeyed_all",
+		Tag:           "varint,63035,opt,name=goproto_unkeyed_all",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64001,
+		Name:          "gogoproto.goproto_getters",
+		Tag:           "varint,64001,opt,name=goproto_getters",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64003,
+		Name:          "gogoproto.goproto_stringer",
+		Tag:           "varint,64003,opt,name=goproto_stringer",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64004,
+		Name:          "gogoproto.verbose_equal",
+		Tag:           "varint,64004,opt,name=verbose_equal",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64005,
+		Name:          "gogoproto.face",
+		Tag:           "varint,64005,opt,name=face",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64006,
+		Name:          "gogoproto.gostring",
+		Tag:           "varint,64006,opt,name=gostring",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64007,
+		Name:          "gogoproto.populate",
+		Tag:           "varint,64007,opt,name=populate",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         67008,
+		Name:          "gogoproto.stringer",
+		Tag:           "varint,67008,opt,name=stringer",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64009,
+		Name:          "gogoproto.onlyone",
+		Tag:           "varint,64009,opt,name=onlyone",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64013,
+		Name:          "gogoproto.equal",
+		Tag:           "varint,64013,opt,name=equal",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64014,
+		Name:          "gogoproto.description",
+		Tag:           "varint,64014,opt,name=description",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64015,
+		Name:          "gogoproto.testgen",
+		Tag:           "varint,64015,opt,name=testgen",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64016,
+		Name:          "gogoproto.benchgen",
+		Tag:           "varint,64016,opt,name=benchgen",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64017,
+		Name:          "gogoproto.marshaler",
+		Tag:           "varint,64017,opt,name=marshaler",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64018,
+		Name:          "gogoproto.unmarshaler",
+		Tag:           "varint,64018,opt,name=unmarshaler",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64019,
+		Name:          "gogoproto.stable_marshaler",
+		Tag:           "varint,64019,opt,name=stable_marshaler",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64020,
+		Name:          "gogoproto.sizer",
+		Tag:           "varint,64020,opt,name=sizer",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64023,
+		Name:          "gogoproto.unsafe_marshaler",
+		Tag:           "varint,64023,opt,name=unsafe_marshaler",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64024,
+		Name:          "gogoproto.unsafe_unmarshaler",
+		Tag:           "varint,64024,opt,name=unsafe_unmarshaler",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64025,
+		Name:          "gogoproto.goproto_extensions_map",
+		Tag:           "varint,64025,opt,name=goproto_extensions_map",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64026,
+		Name:          "gogoproto.goproto_unrecognized",
+		Tag:           "varint,64026,opt,name=goproto_unrecognized",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64028,
+		Name:          "gogoproto.protosizer",
+		Tag:           "varint,64028,opt,name=protosizer",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64029,
+		Name:          "gogoproto.compare",
+		Tag:           "varint,64029,opt,name=compare",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64030,
+		Name:          "gogoproto.typedecl",
+		Tag:           "varint,64030,opt,name=typedecl",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64033,
+		Name:          "gogoproto.messagename",
+		Tag:           "varint,64033,opt,name=messagename",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64034,
+		Name:          "gogoproto.goproto_sizecache",
+		Tag:           "varint,64034,opt,name=goproto_sizecache",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64035,
+		Name:          "gogoproto.goproto_unkeyed",
+		Tag:           "varint,64035,opt,name=goproto_unkeyed",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         65001,
+		Name:          "gogoproto.nullable",
+		Tag:           "varint,65001,opt,name=nullable",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         65002,
+		Name:          "gogoproto.embed",
+		Tag:           "varint,65002,opt,name=embed",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         65003,
+		Name:          "gogoproto.customtype",
+		Tag:           "bytes,65003,opt,name=customtype",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         65004,
+		Name:          "gogoproto.customname",
+		Tag:           "bytes,65004,opt,name=customname",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         65005,
+		Name:          "gogoproto.jsontag",
+		Tag:           "bytes,65005,opt,name=jsontag",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         65006,
+		Name:          "gogoproto.moretags",
+		Tag:           "bytes,65006,opt,name=moretags",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         65007,
+		Name:          "gogoproto.casttype",
+		Tag:           "bytes,65007,opt,name=casttype",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         65008,
+		Name:          "gogoproto.castkey",
+		Tag:           "bytes,65008,opt,name=castkey",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         65009,
+		Name:          "gogoproto.castvalue",
+		Tag:           "bytes,65009,opt,name=castvalue",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         65010,
+		Name:          "gogoproto.stdtime",
+		Tag:           "varint,65010,opt,name=stdtime",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         65011,
+		Name:          "gogoproto.stdduration",
+		Tag:           "varint,65011,opt,name=stdduration",
+		Filename:      "gogoproto/gogo.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         65012,
+		Name:          "gogoproto.wktpointer",
+		Tag:           "varint,65012,opt,name=wktpointer",
+		Filename:      "gogoproto/gogo.proto",
+	},
+}
+
+// Extension fields to descriptorpb.EnumOptions.
+var (
+	// optional bool goproto_enum_prefix = 62001;
+	E_GoprotoEnumPrefix = &file_gogoproto_gogo_proto_extTypes[0]
+	// optional bool goproto_enum_stringer = 62021;
+	E_GoprotoEnumStringer = &file_gogoproto_gogo_proto_extTypes[1]
+	// optional bool enum_stringer = 62022;
+	E_EnumStringer = &file_gogoproto_gogo_proto_extTypes[2]
+	// optional string enum_customname = 62023;
+	E_EnumCustomname = &file_gogoproto_gogo_proto_extTypes[3]
+	// optional bool enumdecl = 62024;
+	E_Enumdecl = &file_gogoproto_gogo_proto_extTypes[4]
+)
+
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// optional string enumvalue_customname = 66001;
+	E_EnumvalueCustomname = &file_gogoproto_gogo_proto_extTypes[5]
+)
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional bool goproto_getters_all = 63001;
+	E_GoprotoGettersAll = &file_gogoproto_gogo_proto_extTypes[6]
+	// optional bool goproto_enum_prefix_all = 63002;
+	E_GoprotoEnumPrefixAll = &file_gogoproto_gogo_proto_extTypes[7]
+	// optional bool goproto_stringer_all = 63003;
+	E_GoprotoStringerAll = &file_gogoproto_gogo_proto_extTypes[8]
+	// optional bool verbose_equal_all = 63004;
+	E_VerboseEqualAll = &file_gogoproto_gogo_proto_extTypes[9]
+	// optional bool face_all = 63005;
+	E_FaceAll = &file_gogoproto_gogo_proto_extTypes[10]
+	// optional bool gostring_all = 63006;
+	E_GostringAll = &file_gogoproto_gogo_proto_extTypes[11]
+	// optional bool populate_all = 63007;
+	E_PopulateAll = &file_gogoproto_gogo_proto_extTypes[12]
+	// optional bool stringer_all = 63008;
+	E_StringerAll = &file_gogoproto_gogo_proto_extTypes[13]
+	// optional bool onlyone_all = 63009;
+	E_OnlyoneAll = &file_gogoproto_gogo_proto_extTypes[14]
+	// optional bool equal_all = 63013;
+	E_EqualAll = &file_gogoproto_gogo_proto_extTypes[15]
+	// optional bool description_all = 63014;
+	E_DescriptionAll = &file_gogoproto_gogo_proto_extTypes[16]
+	// optional bool testgen_all = 63015;
+	E_TestgenAll = &file_gogoproto_gogo_proto_extTypes[17]
+	// optional bool benchgen_all = 63016;
+	E_BenchgenAll = &file_gogoproto_gogo_proto_extTypes[18]
+	// optional bool marshaler_all = 63017;
+	E_MarshalerAll = &file_gogoproto_gogo_proto_extTypes[19]
+	// optional bool unmarshaler_all = 63018;
+	E_UnmarshalerAll = &file_gogoproto_gogo_proto_extTypes[20]
+	// optional bool stable_marshaler_all = 63019;
+	E_StableMarshalerAll = &file_gogoproto_gogo_proto_extTypes[21]
+	// optional bool sizer_all = 63020;
+	E_SizerAll = &file_gogoproto_gogo_proto_extTypes[22]
+	// optional bool goproto_enum_stringer_all = 63021;
+	E_GoprotoEnumStringerAll = &file_gogoproto_gogo_proto_extTypes[23]
+	// optional bool enum_stringer_all = 63022;
+	E_EnumStringerAll = &file_gogoproto_gogo_proto_extTypes[24]
+	// optional bool unsafe_marshaler_all = 63023;
+	E_UnsafeMarshalerAll = &file_gogoproto_gogo_proto_extTypes[25]
+	// optional bool unsafe_unmarshaler_all = 63024;
+	E_UnsafeUnmarshalerAll = &file_gogoproto_gogo_proto_extTypes[26]
+	// optional bool goproto_extensions_map_all = 63025;
+	E_GoprotoExtensionsMapAll = &file_gogoproto_gogo_proto_extTypes[27]
+	// optional bool goproto_unrecognized_all = 63026;
+	E_GoprotoUnrecognizedAll = &file_gogoproto_gogo_proto_extTypes[28]
+	// optional bool gogoproto_import = 63027;
+	E_GogoprotoImport = &file_gogoproto_gogo_proto_extTypes[29]
+	// optional bool protosizer_all = 63028;
+	E_ProtosizerAll = &file_gogoproto_gogo_proto_extTypes[30]
+	// optional bool compare_all = 63029;
+	E_CompareAll = &file_gogoproto_gogo_proto_extTypes[31]
+	// optional bool typedecl_all = 63030;
+	E_TypedeclAll = &file_gogoproto_gogo_proto_extTypes[32]
+	// optional bool enumdecl_all = 63031;
+	E_EnumdeclAll = &file_gogoproto_gogo_proto_extTypes[33]
+	// optional bool goproto_registration = 63032;
+	E_GoprotoRegistration = &file_gogoproto_gogo_proto_extTypes[34]
+	// optional bool messagename_all = 63033;
+	E_MessagenameAll = &file_gogoproto_gogo_proto_extTypes[35]
+	// optional bool goproto_sizecache_all = 63034;
+	E_GoprotoSizecacheAll = &file_gogoproto_gogo_proto_extTypes[36]
+	// optional bool goproto_unkeyed_all = 63035;
+	E_GoprotoUnkeyedAll = &file_gogoproto_gogo_proto_extTypes[37]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional bool goproto_getters = 64001;
+	E_GoprotoGetters = &file_gogoproto_gogo_proto_extTypes[38]
+	// optional bool goproto_stringer = 64003;
+	E_GoprotoStringer = &file_gogoproto_gogo_proto_extTypes[39]
+	// optional bool verbose_equal = 64004;
+	E_VerboseEqual = &file_gogoproto_gogo_proto_extTypes[40]
+	// optional bool face = 64005;
+	E_Face = &file_gogoproto_gogo_proto_extTypes[41]
+	// optional bool gostring = 64006;
+	E_Gostring = &file_gogoproto_gogo_proto_extTypes[42]
+	// optional bool populate = 64007;
+	E_Populate = &file_gogoproto_gogo_proto_extTypes[43]
+	// optional bool stringer = 67008;
+	E_Stringer = &file_gogoproto_gogo_proto_extTypes[44]
+	// optional bool onlyone = 64009;
+	E_Onlyone = &file_gogoproto_gogo_proto_extTypes[45]
+	// optional bool equal = 64013;
+	E_Equal = &file_gogoproto_gogo_proto_extTypes[46]
+	// optional bool description = 64014;
+	E_Description = &file_gogoproto_gogo_proto_extTypes[47]
+	// optional bool testgen = 64015;
+	E_Testgen = &file_gogoproto_gogo_proto_extTypes[48]
+	// optional bool benchgen = 64016;
+	E_Benchgen = &file_gogoproto_gogo_proto_extTypes[49]
+	// optional bool marshaler = 64017;
+	E_Marshaler = &file_gogoproto_gogo_proto_extTypes[50]
+	// optional bool unmarshaler = 64018;
+	E_Unmarshaler = &file_gogoproto_gogo_proto_extTypes[51]
+	// optional bool stable_marshaler = 64019;
+	E_StableMarshaler = &file_gogoproto_gogo_proto_extTypes[52]
+	// optional bool sizer = 64020;
+	E_Sizer = &file_gogoproto_gogo_proto_extTypes[53]
+	// optional bool unsafe_marshaler = 64023;
+	E_UnsafeMarshaler = &file_gogoproto_gogo_proto_extTypes[54]
+	// optional bool unsafe_unmarshaler = 64024;
+	E_UnsafeUnmarshaler = &file_gogoproto_gogo_proto_extTypes[55]
+	// optional bool goproto_extensions_map = 64025;
+	E_GoprotoExtensionsMap = &file_gogoproto_gogo_proto_extTypes[56]
+	// optional bool goproto_unrecognized = 64026;
+	E_GoprotoUnrecognized = &file_gogoproto_gogo_proto_extTypes[57]
+	// optional bool protosizer = 64028;
+	E_Protosizer = &file_gogoproto_gogo_proto_extTypes[58]
+	// optional bool compare = 64029;
+	E_Compare = &file_gogoproto_gogo_proto_extTypes[59]
+	// optional bool typedecl = 64030;
+	E_Typedecl = &file_gogoproto_gogo_proto_extTypes[60]
+	// optional bool messagename = 64033;
+	E_Messagename = &file_gogoproto_gogo_proto_extTypes[61]
+	// optional bool goproto_sizecache = 64034;
+	E_GoprotoSizecache = &file_gogoproto_gogo_proto_extTypes[62]
+	// optional bool goproto_unkeyed = 64035;
+	E_GoprotoUnkeyed = &file_gogoproto_gogo_proto_extTypes[63]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional bool nullable = 65001;
+	E_Nullable = &file_gogoproto_gogo_proto_extTypes[64]
+	// optional bool embed = 65002;
+	E_Embed = &file_gogoproto_gogo_proto_extTypes[65]
+	// optional string customtype = 65003;
+	E_Customtype = &file_gogoproto_gogo_proto_extTypes[66]
+	// optional string customname = 65004;
+	E_Customname = &file_gogoproto_gogo_proto_extTypes[67]
+	// optional string jsontag = 65005;
+	E_Jsontag = &file_gogoproto_gogo_proto_extTypes[68]
+	// optional string moretags = 65006;
+	E_Moretags = &file_gogoproto_gogo_proto_extTypes[69]
+	// optional string casttype = 65007;
+	E_Casttype = &file_gogoproto_gogo_proto_extTypes[70]
+	// optional string castkey = 65008;
+	E_Castkey = &file_gogoproto_gogo_proto_extTypes[71]
+	// optional string castvalue = 65009;
+	E_Castvalue = &file_gogoproto_gogo_proto_extTypes[72]
+	// optional bool stdtime = 65010;
+	E_Stdtime = &file_gogoproto_gogo_proto_extTypes[73]
+	// optional bool stdduration = 65011;
+	E_Stdduration = &file_gogoproto_gogo_proto_extTypes[74]
+	// optional bool wktpointer = 65012;
+	E_Wktpointer = &file_gogoproto_gogo_proto_extTypes[75]
+)
+
+var File_gogoproto_gogo_proto protoreflect.FileDescriptor
+
+var file_gogoproto_gogo_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x3a, 0x4e, 0x0a, 0x13, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x65,
+	0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb1, 0xe4, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x3a, 0x52, 0x0a, 0x15, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x65,
+	0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc5, 0xe4, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x6e, 0x75, 0x6d, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x3a, 0x43, 0x0a, 0x0d, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc6, 0xe4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x65, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x3a, 0x47, 0x0a, 0x0f,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc7, 0xe4,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x75, 0x6d, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x3a, 0x0a, 0x08, 0x65, 0x6e, 0x75, 0x6d, 0x64, 0x65, 0x63,
+	0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xc8, 0xe4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6e, 0x75, 0x6d, 0x64, 0x65, 0x63,
+	0x6c, 0x3a, 0x56, 0x0a, 0x14, 0x65, 0x6e, 0x75, 0x6d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1, 0x83, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x6e, 0x75, 0x6d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x4e, 0x0a, 0x13, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x6c, 0x6c,
+	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x99,
+	0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x47,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x41, 0x6c, 0x6c, 0x3a, 0x55, 0x0a, 0x17, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x9a, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x41, 0x6c, 0x6c,
+	0x3a, 0x50, 0x0a, 0x14, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x9b, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41,
+	0x6c, 0x6c, 0x3a, 0x4a, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x5f, 0x65, 0x71,
+	0x75, 0x61, 0x6c, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x9c, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x3a, 0x39,
+	0x0a, 0x08, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x9d, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x3a, 0x41, 0x0a, 0x0c, 0x67, 0x6f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x9e, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x67, 0x6f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6c, 0x3a, 0x41, 0x0a, 0x0c,
+	0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x9f, 0xec, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x3a,
+	0x41, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa0, 0xec,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41,
+	0x6c, 0x6c, 0x3a, 0x3f, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x6f, 0x6e, 0x65, 0x5f, 0x61, 0x6c,
+	0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xa1, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x6e, 0x6c, 0x79, 0x6f, 0x6e, 0x65,
+	0x41, 0x6c, 0x6c, 0x3a, 0x3b, 0x0a, 0x09, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x5f, 0x61, 0x6c, 0x6c,
+	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa5,
+	0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x41, 0x6c, 0x6c,
+	0x3a, 0x47, 0x0a, 0x0f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xa6, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x3a, 0x3f, 0x0a, 0x0b, 0x74, 0x65, 0x73,
+	0x74, 0x67, 0x65, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa7, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x74, 0x65, 0x73, 0x74, 0x67, 0x65, 0x6e, 0x41, 0x6c, 0x6c, 0x3a, 0x41, 0x0a, 0x0c, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x67, 0x65, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa8, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x67, 0x65, 0x6e, 0x41, 0x6c, 0x6c, 0x3a, 0x43, 0x0a,
+	0x0d, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa9, 0xec, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x41,
+	0x6c, 0x6c, 0x3a, 0x47, 0x0a, 0x0f, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65,
+	0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xaa, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x6e, 0x6d,
+	0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x3a, 0x50, 0x0a, 0x14, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x5f,
+	0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xab, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x3a, 0x3b, 0x0a,
+	0x09, 0x73, 0x69, 0x7a, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xac, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x73, 0x69, 0x7a, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x3a, 0x59, 0x0a, 0x19, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xad, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x41, 0x6c, 0x6c, 0x3a, 0x4a, 0x0a, 0x11, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xae, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x65, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x3a, 0x50, 0x0a, 0x14, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x73,
+	0x68, 0x61, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xaf, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72,
+	0x41, 0x6c, 0x6c, 0x3a, 0x54, 0x0a, 0x16, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x75, 0x6e,
+	0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb0, 0xec, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x14, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x55, 0x6e, 0x6d, 0x61, 0x72,
+	0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x3a, 0x5b, 0x0a, 0x1a, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb1, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x4d, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x3a, 0x58, 0x0a, 0x18, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61,
+	0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0xb2, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x55, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x6c, 0x6c,
+	0x3a, 0x49, 0x0a, 0x10, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xb3, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x45, 0x0a, 0x0e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x69, 0x7a, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb4, 0xec, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x69, 0x7a, 0x65, 0x72, 0x41,
+	0x6c, 0x6c, 0x3a, 0x3f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x6c,
+	0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xb5, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x41, 0x6c, 0x6c, 0x3a, 0x41, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x65, 0x63, 0x6c, 0x5f,
+	0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xb6, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64,
+	0x65, 0x63, 0x6c, 0x41, 0x6c, 0x6c, 0x3a, 0x41, 0x0a, 0x0c, 0x65, 0x6e, 0x75, 0x6d, 0x64, 0x65,
+	0x63, 0x6c, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb7, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e,
+	0x75, 0x6d, 0x64, 0x65, 0x63, 0x6c, 0x41, 0x6c, 0x6c, 0x3a, 0x51, 0x0a, 0x14, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xb8, 0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x47, 0x0a, 0x0f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x12,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb9, 0xec,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x41, 0x6c, 0x6c, 0x3a, 0x52, 0x0a, 0x15, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xba, 0xec, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x69, 0x7a,
+	0x65, 0x63, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6c, 0x6c, 0x3a, 0x4e, 0x0a, 0x13, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x6e, 0x6b, 0x65, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c,
+	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xbb,
+	0xec, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x55,
+	0x6e, 0x6b, 0x65, 0x79, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x3a, 0x4a, 0x0a, 0x0f, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x81, 0xf4,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x3a, 0x4c, 0x0a, 0x10, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x83, 0xf4, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x3a, 0x46, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x5f, 0x65,
+	0x71, 0x75, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x84, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x3a, 0x35, 0x0a, 0x04, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x85, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x61,
+	0x63, 0x65, 0x3a, 0x3d, 0x0a, 0x08, 0x67, 0x6f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1f,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x86, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x67, 0x6f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x3a, 0x3d, 0x0a, 0x08, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x87,
+	0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x3a, 0x3d, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc0, 0x8b,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x3a,
+	0x3b, 0x0a, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x89, 0xf4, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x6f, 0x6e, 0x65, 0x3a, 0x37, 0x0a, 0x05,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8d, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x3a, 0x43, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8e, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3b, 0x0a, 0x07, 0x74, 0x65,
+	0x73, 0x74, 0x67, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8f, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x74, 0x65, 0x73, 0x74, 0x67, 0x65, 0x6e, 0x3a, 0x3d, 0x0a, 0x08, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x67, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x90, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x67, 0x65, 0x6e, 0x3a, 0x3f, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61,
+	0x6c, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x91, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x3a, 0x43, 0x0a, 0x0b, 0x75, 0x6e, 0x6d, 0x61, 0x72,
+	0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x92, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x3a, 0x4c, 0x0a, 0x10,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72,
+	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x93, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x3a, 0x37, 0x0a, 0x05, 0x73, 0x69,
+	0x7a, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x94, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x69,
+	0x7a, 0x65, 0x72, 0x3a, 0x4c, 0x0a, 0x10, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x6d, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65,
+	0x72, 0x3a, 0x50, 0x0a, 0x12, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x75, 0x6e, 0x6d, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x98, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61,
+	0x6c, 0x65, 0x72, 0x3a, 0x57, 0x0a, 0x16, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x12, 0x1f, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x99,
+	0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x3a, 0x54, 0x0a, 0x14,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e,
+	0x69, 0x7a, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x9a, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a,
+	0x65, 0x64, 0x3a, 0x41, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x69, 0x7a, 0x65, 0x72,
+	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x9c, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x3b, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x9d, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x3a, 0x3d, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x64, 0x65, 0x63, 0x6c, 0x12, 0x1f,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x9e, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x64, 0x65, 0x63,
+	0x6c, 0x3a, 0x43, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xa1, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x4e, 0x0a, 0x11, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa2, 0xf4, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x69, 0x7a,
+	0x65, 0x63, 0x61, 0x63, 0x68, 0x65, 0x3a, 0x4a, 0x0a, 0x0f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x5f, 0x75, 0x6e, 0x6b, 0x65, 0x79, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa3, 0xf4, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x6e, 0x6b, 0x65, 0x79,
+	0x65, 0x64, 0x3a, 0x3b, 0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe9, 0xfb,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x3a,
+	0x35, 0x0a, 0x05, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xea, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x3a, 0x3f, 0x0a, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xeb, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x3f, 0x0a, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xec, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x39, 0x0a, 0x07, 0x6a, 0x73, 0x6f, 0x6e,
+	0x74, 0x61, 0x67, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xed, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x73, 0x6f, 0x6e,
+	0x74, 0x61, 0x67, 0x3a, 0x3b, 0x0a, 0x08, 0x6d, 0x6f, 0x72, 0x65, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xee,
+	0xfb, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x72, 0x65, 0x74, 0x61, 0x67, 0x73,
+	0x3a, 0x3b, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x74, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xef, 0xfb, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x74, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x39, 0x0a,
+	0x07, 0x63, 0x61, 0x73, 0x74, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf0, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x61, 0x73, 0x74, 0x6b, 0x65, 0x79, 0x3a, 0x3d, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x74,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf1, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61,
+	0x73, 0x74, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x39, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xf2, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x64, 0x74, 0x69,
+	0x6d, 0x65, 0x3a, 0x41, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0xf3, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x74, 0x64, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3f, 0x0a, 0x0a, 0x77, 0x6b, 0x74, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xf4, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x6b, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x45, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x42, 0x0a, 0x47,
+	0x6f, 0x47, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+}
+
+var file_gogoproto_gogo_proto_goTypes = []interface{}{
+	(*descriptorpb.EnumOptions)(nil),      // 0: google.protobuf.EnumOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 1: google.protobuf.EnumValueOptions
+	(*descriptorpb.FileOptions)(nil),      // 2: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil),   // 3: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 4: google.protobuf.FieldOptions
+}
+var file_gogoproto_gogo_proto_depIdxs = []int32{
+	0,  // 0: gogoproto.goproto_enum_prefix:extendee -> google.protobuf.EnumOptions
+	0,  // 1: gogoproto.goproto_enum_stringer:extendee -> google.protobuf.EnumOptions
+	0,  // 2: gogoproto.enum_stringer:extendee -> google.protobuf.EnumOptions
+	0,  // 3: gogoproto.enum_customname:extendee -> google.protobuf.EnumOptions
+	0,  // 4: gogoproto.enumdecl:extendee -> google.protobuf.EnumOptions
+	1,  // 5: gogoproto.enumvalue_customname:extendee -> google.protobuf.EnumValueOptions
+	2,  // 6: gogoproto.goproto_getters_all:extendee -> google.protobuf.FileOptions
+	2,  // 7: gogoproto.goproto_enum_prefix_all:extendee -> google.protobuf.FileOptions
+	2,  // 8: gogoproto.goproto_stringer_all:extendee -> google.protobuf.FileOptions
+	2,  // 9: gogoproto.verbose_equal_all:extendee -> google.protobuf.FileOptions
+	2,  // 10: gogoproto.face_all:extendee -> google.protobuf.FileOptions
+	2,  // 11: gogoproto.gostring_all:extendee -> google.protobuf.FileOptions
+	2,  // 12: gogoproto.populate_all:extendee -> google.protobuf.FileOptions
+	2,  // 13: gogoproto.stringer_all:extendee -> google.protobuf.FileOptions
+	2,  // 14: gogoproto.onlyone_all:extendee -> google.protobuf.FileOptions
+	2,  // 15: gogoproto.equal_all:extendee -> google.protobuf.FileOptions
+	2,  // 16: gogoproto.description_all:extendee -> google.protobuf.FileOptions
+	2,  // 17: gogoproto.testgen_all:extendee -> google.protobuf.FileOptions
+	2,  // 18: gogoproto.benchgen_all:extendee -> google.protobuf.FileOptions
+	2,  // 19: gogoproto.marshaler_all:extendee -> google.protobuf.FileOptions
+	2,  // 20: gogoproto.unmarshaler_all:extendee -> google.protobuf.FileOptions
+	2,  // 21: gogoproto.stable_marshaler_all:extendee -> google.protobuf.FileOptions
+	2,  // 22: gogoproto.sizer_all:extendee -> google.protobuf.FileOptions
+	2,  // 23: gogoproto.goproto_enum_stringer_all:extendee -> google.protobuf.FileOptions
+	2,  // 24: gogoproto.enum_stringer_all:extendee -> google.protobuf.FileOptions
+	2,  // 25: gogoproto.unsafe_marshaler_all:extendee -> google.protobuf.FileOptions
+	2,  // 26: gogoproto.unsafe_unmarshaler_all:extendee -> google.protobuf.FileOptions
+	2,  // 27: gogoproto.goproto_extensions_map_all:extendee -> google.protobuf.FileOptions
+	2,  // 28: gogoproto.goproto_unrecognized_all:extendee -> google.protobuf.FileOptions
+	2,  // 29: gogoproto.gogoproto_import:extendee -> google.protobuf.FileOptions
+	2,  // 30: gogoproto.protosizer_all:extendee -> google.protobuf.FileOptions
+	2,  // 31: gogoproto.compare_all:extendee -> google.protobuf.FileOptions
+	2,  // 32: gogoproto.typedecl_all:extendee -> google.protobuf.FileOptions
+	2,  // 33: gogoproto.enumdecl_all:extendee -> google.protobuf.FileOptions
+	2,  // 34: gogoproto.goproto_registration:extendee -> google.protobuf.FileOptions
+	2,  // 35: gogoproto.messagename_all:extendee -> google.protobuf.FileOptions
+	2,  // 36: gogoproto.goproto_sizecache_all:extendee -> google.protobuf.FileOptions
+	2,  // 37: gogoproto.goproto_unkeyed_all:extendee -> google.protobuf.FileOptions
+	3,  // 38: gogoproto.goproto_getters:extendee -> google.protobuf.MessageOptions
+	3,  // 39: gogoproto.goproto_stringer:extendee -> google.protobuf.MessageOptions
+	3,  // 40: gogoproto.verbose_equal:extendee -> google.protobuf.MessageOptions
+	3,  // 41: gogoproto.face:extendee -> google.protobuf.MessageOptions
+	3,  // 42: gogoproto.gostring:extendee -> google.protobuf.MessageOptions
+	3,  // 43: gogoproto.populate:extendee -> google.protobuf.MessageOptions
+	3,  // 44: gogoproto.stringer:extendee -> google.protobuf.MessageOptions
+	3,  // 45: gogoproto.onlyone:extendee -> google.protobuf.MessageOptions
+	3,  // 46: gogoproto.equal:extendee -> google.protobuf.MessageOptions
+	3,  // 47: gogoproto.description:extendee -> google.protobuf.MessageOptions
+	3,  // 48: gogoproto.testgen:extendee -> google.protobuf.MessageOptions
+	3,  // 49: gogoproto.benchgen:extendee -> google.protobuf.MessageOptions
+	3,  // 50: gogoproto.marshaler:extendee -> google.protobuf.MessageOptions
+	3,  // 51: gogoproto.unmarshaler:extendee -> google.protobuf.MessageOptions
+	3,  // 52: gogoproto.stable_marshaler:extendee -> google.protobuf.MessageOptions
+	3,  // 53: gogoproto.sizer:extendee -> google.protobuf.MessageOptions
+	3,  // 54: gogoproto.unsafe_marshaler:extendee -> google.protobuf.MessageOptions
+	3,  // 55: gogoproto.unsafe_unmarshaler:extendee -> google.protobuf.MessageOptions
+	3,  // 56: gogoproto.goproto_extensions_map:extendee -> google.protobuf.MessageOptions
+	3,  // 57: gogoproto.goproto_unrecognized:extendee -> google.protobuf.MessageOptions
+	3,  // 58: gogoproto.protosizer:extendee -> google.protobuf.MessageOptions
+	3,  // 59: gogoproto.compare:extendee -> google.protobuf.MessageOptions
+	3,  // 60: gogoproto.typedecl:extendee -> google.protobuf.MessageOptions
+	3,  // 61: gogoproto.messagename:extendee -> google.protobuf.MessageOptions
+	3,  // 62: gogoproto.goproto_sizecache:extendee -> google.protobuf.MessageOptions
+	3,  // 63: gogoproto.goproto_unkeyed:extendee -> google.protobuf.MessageOptions
+	4,  // 64: gogoproto.nullable:extendee -> google.protobuf.FieldOptions
+	4,  // 65: gogoproto.embed:extendee -> google.protobuf.FieldOptions
+	4,  // 66: gogoproto.customtype:extendee -> google.protobuf.FieldOptions
+	4,  // 67: gogoproto.customname:extendee -> google.protobuf.FieldOptions
+	4,  // 68: gogoproto.jsontag:extendee -> google.protobuf.FieldOptions
+	4,  // 69: gogoproto.moretags:extendee -> google.protobuf.FieldOptions
+	4,  // 70: gogoproto.casttype:extendee -> google.protobuf.FieldOptions
+	4,  // 71: gogoproto.castkey:extendee -> google.protobuf.FieldOptions
+	4,  // 72: gogoproto.castvalue:extendee -> google.protobuf.FieldOptions
+	4,  // 73: gogoproto.stdtime:extendee -> google.protobuf.FieldOptions
+	4,  // 74: gogoproto.stdduration:extendee -> google.protobuf.FieldOptions
+	4,  // 75: gogoproto.wktpointer:extendee -> google.protobuf.FieldOptions
+	76, // [76:76] is the sub-list for method output_type
+	76, // [76:76] is the sub-list for method input_type
+	76, // [76:76] is the sub-list for extension type_name
+	0,  // [0:76] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_gogoproto_gogo_proto_init() }
+func file_gogoproto_gogo_proto_init() {
+	if File_gogoproto_gogo_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gogoproto_gogo_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 76,
+			NumServices:   0,
+		},
+		GoTypes:           file_gogoproto_gogo_proto_goTypes,
+		DependencyIndexes: file_gogoproto_gogo_proto_depIdxs,
+		ExtensionInfos:    file_gogoproto_gogo_proto_extTypes,
+	}.Build()
+	File_gogoproto_gogo_proto = out.File
+	file_gogoproto_gogo_proto_rawDesc = nil
+	file_gogoproto_gogo_proto_goTypes = nil
+	file_gogoproto_gogo_proto_depIdxs = nil
+}
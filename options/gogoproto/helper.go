@@ -0,0 +1,63 @@
+package gogoproto
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// IsNullable returns false if field has gogoproto.nullable option which
+// equals to false, i.e. message field is generated as value.
+func IsNullable(field *descriptorpb.FieldDescriptorProto) bool {
+	return getBool(field, E_Nullable, true)
+}
+
+// IsStdTime returns true if Timestamp field is generated as time.Time.
+func IsStdTime(field *descriptorpb.FieldDescriptorProto) bool {
+	return getBool(field, E_Stdtime, false)
+}
+
+// IsStdDuration returns true if Duration field is generated as time.Duration.
+func IsStdDuration(field *descriptorpb.FieldDescriptorProto) bool {
+	return getBool(field, E_Stdduration, false)
+}
+
+// IsCustomType returns true if field has gogoproto.customtype option.
+func IsCustomType(field *descriptorpb.FieldDescriptorProto) bool {
+	return GetCustomType(field) != ""
+}
+
+// GetCustomType returns value of gogoproto.customtype option of field.
+func GetCustomType(field *descriptorpb.FieldDescriptorProto) string {
+	return getString(field, E_Customtype)
+}
+
+// GetCastType returns value of gogoproto.casttype option of field.
+func GetCastType(field *descriptorpb.FieldDescriptorProto) string {
+	return getString(field, E_Casttype)
+}
+
+// GetCustomName returns value of gogoproto.customname option of field.
+func GetCustomName(field *descriptorpb.FieldDescriptorProto) string {
+	return getString(field, E_Customname)
+}
+
+// getBool returns value of bool option xt of field, def is returned if
+// option isn't set.
+func getBool(field *descriptorpb.FieldDescriptorProto, xt protoreflect.ExtensionType, def bool) bool {
+	if field.GetOptions() == nil || !proto.HasExtension(field.GetOptions(), xt) {
+		return def
+	}
+
+	return proto.GetExtension(field.GetOptions(), xt).(bool)
+}
+
+// getString returns value of string option xt of field, empty string is
+// returned if option isn't set.
+func getString(field *descriptorpb.FieldDescriptorProto, xt protoreflect.ExtensionType) string {
+	if field.GetOptions() == nil {
+		return ""
+	}
+
+	return proto.GetExtension(field.GetOptions(), xt).(string)
+}
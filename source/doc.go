@@ -1,5 +1,6 @@
 // Package source contains functions for parsing Go source files.
 //
 // This package is used for extract information about structures and their
-// fields from Go source files.
+// fields from Go source files. Structures are parsed with go/ast, field types
+// are resolved with go/types, so package of source files must type-check.
 package source
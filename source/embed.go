@@ -0,0 +1,116 @@
+package source
+
+import "strings"
+
+// promotedField is a field promoted from embedded structure at depth of
+// embedding, 1 for fields of directly embedded structures.
+type promotedField struct {
+	fi    FieldInfo
+	depth int
+}
+
+// promoteFields adds fields of embedded structures of sl into structures
+// which embed them, the same way as Go promotes them: fields of outer
+// structure shadow promoted fields, fields which are promoted at the same
+// depth from several structures are ambiguous and are not added. Only value
+// embeddings of structures of the same list are supported. Promoted fields
+// have FieldInfo.Promoted set.
+func promoteFields(sl StructureList) {
+	promoted := map[string]map[string]promotedField{}
+	for name := range sl {
+		promoted[name] = promotedOf(sl, name, map[string]bool{name: true})
+	}
+
+	for name, fields := range promoted {
+		for fname, pf := range fields {
+			sl[name][fname] = pf.fi
+		}
+	}
+}
+
+// promotedOf returns fields promoted into structure name of sl. Seen contains
+// names of structures of the current embedding chain, they are not embedded
+// again.
+func promotedOf(sl StructureList, name string, seen map[string]bool) map[string]promotedField {
+	out := map[string]promotedField{}
+	ambiguous := map[string]int{}
+
+	add := func(fname string, pf promotedField) {
+		if _, ok := sl[name][fname]; ok {
+			return
+		}
+		if d, ok := ambiguous[fname]; ok && d <= pf.depth {
+			return
+		}
+
+		prev, ok := out[fname]
+		switch {
+		case !ok || pf.depth < prev.depth:
+			out[fname] = pf
+		case pf.depth == prev.depth:
+			delete(out, fname)
+			ambiguous[fname] = pf.depth
+		}
+	}
+
+	for key, emb := range sl[name] {
+		if !strings.HasPrefix(key, "embedded_") || emb.IsPointer || emb.IsSlice || emb.Key != "" {
+			continue
+		}
+
+		inner, ok := sl[emb.Type]
+		if !ok || seen[emb.Type] {
+			continue
+		}
+
+		chain := map[string]bool{emb.Type: true}
+		for n := range seen {
+			chain[n] = true
+		}
+
+		for fname, fi := range inner {
+			if strings.HasPrefix(fname, "embedded_") || strings.HasPrefix(fname, "unsupported_") {
+				continue
+			}
+			fi.Promoted = emb.Type
+			add(fname, promotedField{fi: fi, depth: 1})
+		}
+
+		for fname, pf := range promotedOf(sl, emb.Type, chain) {
+			pf.fi.Promoted = emb.Type + "." + pf.fi.Promoted
+			pf.depth++
+			add(fname, pf)
+		}
+	}
+
+	return out
+}
+
+// gormModelFields are fields of gorm.Model structure of gorm.io/gorm package.
+var gormModelFields = Structure{
+	"ID":        {Type: "uint"},
+	"CreatedAt": {Type: "time.Time"},
+	"UpdatedAt": {Type: "time.Time"},
+	"DeletedAt": {Type: "gorm.DeletedAt"},
+}
+
+// PromoteGormModel adds fields of embedded gorm.Model into structures of sl
+// which embed it, fields of structures shadow promoted ones. Source of
+// gorm.Model isn't parsed, its fields are known. Promoted fields have
+// FieldInfo.Promoted set to Model.
+func PromoteGormModel(sl StructureList) {
+	for _, s := range sl {
+		for key, emb := range s {
+			if !strings.HasPrefix(key, "embedded_") || emb.Type != "gorm.Model" || emb.IsPointer || emb.IsSlice {
+				continue
+			}
+
+			for fname, fi := range gormModelFields {
+				if _, ok := s[fname]; !ok {
+					fi.Promoted = "Model"
+					s[fname] = fi
+				}
+			}
+		}
+	}
+}
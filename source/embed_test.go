@@ -0,0 +1,105 @@
+package source
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Embed", func() {
+
+	parse := func(src string) StructureList {
+		sl, err := Parse("file.go", bytes.NewReader([]byte(src)))
+		Expect(err).NotTo(HaveOccurred())
+		promoteFields(sl)
+		return sl
+	}
+
+	It("promotes fields of embedded structures", func() {
+		sl := parse(`package model
+
+type (
+	Audit struct {
+		CreatedBy string
+	}
+
+	BaseModel struct {
+		Audit
+		ID   int64
+		Name string
+	}
+
+	Product struct {
+		BaseModel
+		Name string
+	}
+)`)
+
+		Expect(sl["Product"]).To(Equal(Structure{
+			"embedded_0": {Type: "BaseModel"},
+			"Name":       {Type: "string"},
+			"ID":         {Type: "int64", Promoted: "BaseModel"},
+			"CreatedBy":  {Type: "string", Promoted: "BaseModel.Audit"},
+		}))
+		Expect(sl["BaseModel"]).To(HaveKeyWithValue("CreatedBy", FieldInfo{Type: "string", Promoted: "Audit"}))
+	})
+
+	It("skips ambiguous fields and pointer embeddings", func() {
+		sl := parse(`package model
+
+type (
+	Created struct {
+		ID int64
+		At string
+	}
+
+	Updated struct {
+		ID int64
+	}
+
+	Deleted struct {
+		By string
+	}
+
+	Product struct {
+		Created
+		Updated
+		*Deleted
+	}
+)`)
+
+		Expect(sl["Product"]).To(HaveKeyWithValue("At", FieldInfo{Type: "string", Promoted: "Created"}))
+		Expect(sl["Product"]).NotTo(HaveKey("ID"))
+		Expect(sl["Product"]).NotTo(HaveKey("By"))
+	})
+
+	It("promotes fields of embedded gorm.Model", func() {
+		sl := parse(`package model
+
+import "gorm.io/gorm"
+
+type (
+	Product struct {
+		gorm.Model
+		ID   string
+		Name string
+	}
+
+	Order struct {
+		*gorm.Model
+	}
+)`)
+		PromoteGormModel(sl)
+
+		Expect(sl["Product"]).To(Equal(Structure{
+			"embedded_0": {Type: "gorm.Model"},
+			"ID":         {Type: "string"},
+			"Name":       {Type: "string"},
+			"CreatedAt":  {Type: "time.Time", Promoted: "Model"},
+			"UpdatedAt":  {Type: "time.Time", Promoted: "Model"},
+			"DeletedAt":  {Type: "gorm.DeletedAt", Promoted: "Model"},
+		}))
+		Expect(sl["Order"]).NotTo(HaveKey("ID"))
+	})
+})
@@ -0,0 +1,45 @@
+package source
+
+import "go/ast"
+
+// entEdgesField is a name of field of ent entities which holds loaded edges.
+const entEdgesField = "Edges"
+
+// PromoteEntEdges adds edges of ent entities of sl into entities themselves
+// with FieldInfo.Edge set, so proto fields are matched with edges by names.
+// Entity is a structure, e.g. User, with Edges field of UserEdges structure
+// which tracks loaded edges in loadedTypes field, as ent generates them.
+// Fields of entities shadow edges.
+func PromoteEntEdges(sl StructureList) {
+	for name, s := range sl {
+		edges, ok := entEdges(sl, name)
+		if !ok {
+			continue
+		}
+
+		for fname, fi := range edges {
+			if _, ok := s[fname]; ok || !ast.IsExported(fname) {
+				continue
+			}
+
+			fi.Edge = true
+			s[fname] = fi
+		}
+	}
+}
+
+// entEdges returns Edges structure of ent entity name of sl, false if name
+// isn't an entity.
+func entEdges(sl StructureList, name string) (Structure, bool) {
+	fi, ok := sl[name][entEdgesField]
+	if !ok || fi.Type != name+entEdgesField || fi.IsPointer || fi.IsSlice || fi.Key != "" {
+		return nil, false
+	}
+
+	edges, ok := sl[fi.Type]
+	if _, loaded := edges["loadedTypes"]; !ok || !loaded {
+		return nil, false
+	}
+
+	return edges, true
+}
@@ -0,0 +1,63 @@
+package source
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ent", func() {
+
+	parse := func(src string) StructureList {
+		sl, err := Parse("file.go", bytes.NewReader([]byte(src)))
+		Expect(err).NotTo(HaveOccurred())
+		PromoteEntEdges(sl)
+		return sl
+	}
+
+	It("adds edges into entities", func() {
+		sl := parse(`package ent
+
+type (
+	User struct {
+		config
+		ID    int
+		Name  string
+		Edges UserEdges
+	}
+
+	UserEdges struct {
+		Pets        []*Pet
+		Name        *Pet
+		loadedTypes [2]bool
+	}
+
+	Pet struct {
+		ID int
+	}
+)`)
+
+		Expect(sl["User"]).To(HaveKeyWithValue("Pets", FieldInfo{Type: "Pet", IsPointer: true, IsSlice: true, Edge: true}))
+		Expect(sl["User"]).To(HaveKeyWithValue("Name", FieldInfo{Type: "string"}))
+		Expect(sl["User"]).NotTo(HaveKey("loadedTypes"))
+	})
+
+	It("skips structures which aren't entities", func() {
+		sl := parse(`package model
+
+type (
+	User struct {
+		Edges UserEdges
+	}
+
+	UserEdges struct {
+		Pets []*Pet
+	}
+
+	Pet struct{}
+)`)
+
+		Expect(sl["User"]).NotTo(HaveKey("Pets"))
+	})
+})
@@ -9,6 +9,30 @@ type (
 		Type string
 		// Equals true if field is a pointer.
 		IsPointer bool
+		// Field tag without backquotes, e.g. json:"id" db:"id".
+		Tag string
+		// Key type for map fields, empty for other fields. For maps Type and
+		// IsPointer describe map value.
+		Key string
+		// Equals true if field or map value is a slice. For slices Type and
+		// IsPointer describe slice element.
+		IsSlice bool
+		// Equals true if field has //transformer:skip directive, such fields
+		// are not filled by transformers.
+		Skip bool
+		// Name of proto field which the field is transformed from, set by
+		// //transformer:from=proto_field directive.
+		From string
+		// Name of embedded structure which the field is promoted from, e.g.
+		// BaseModel, or dot-separated chain of names for fields of deeply
+		// embedded structures. Empty for fields declared in structure itself.
+		Promoted string
+		// Equals true if field is an edge of ent entity, which is held by Edges
+		// field of the entity, see PromoteEntEdges.
+		Edge bool
+		// Equals true if field type, which is not a pointer, slice or map, has
+		// method Equal which compares values of the type, e.g. time.Time.
+		Equal bool
 	}
 
 	// Structure is a set of fields of one structure.
@@ -33,3 +57,62 @@ func (fi FieldInfo) String() string {
 	}
 	return fi.Type
 }
+
+// GoType returns full Go type of field as it's declared in structure, e.g.
+// []*time.Time or map[string]int.
+func (fi FieldInfo) GoType() string {
+	t := fi.String()
+	if fi.IsSlice {
+		t = "[]" + t
+	}
+	if fi.Key != "" {
+		t = "map[" + fi.Key + "]" + t
+	}
+	return t
+}
+
+// NullType describes model type which holds value with validity flag, such as
+// sql.NullString.
+type NullType struct {
+	// Name of field which holds value, e.g. String.
+	Value string
+	// Go type of value, e.g. string.
+	Type string
+}
+
+// nullTypes are nullable types of database/sql,
+// github.com/jackc/pgx/v5/pgtype and gorm.io/gorm packages by type names.
+var nullTypes = map[string]NullType{
+	"sql.NullString":     {Value: "String", Type: "string"},
+	"sql.NullInt64":      {Value: "Int64", Type: "int64"},
+	"sql.NullInt32":      {Value: "Int32", Type: "int32"},
+	"sql.NullInt16":      {Value: "Int16", Type: "int16"},
+	"sql.NullByte":       {Value: "Byte", Type: "byte"},
+	"sql.NullFloat64":    {Value: "Float64", Type: "float64"},
+	"sql.NullBool":       {Value: "Bool", Type: "bool"},
+	"sql.NullTime":       {Value: "Time", Type: "time.Time"},
+	"pgtype.Text":        {Value: "String", Type: "string"},
+	"pgtype.Int8":        {Value: "Int64", Type: "int64"},
+	"pgtype.Int4":        {Value: "Int32", Type: "int32"},
+	"pgtype.Int2":        {Value: "Int16", Type: "int16"},
+	"pgtype.Float8":      {Value: "Float64", Type: "float64"},
+	"pgtype.Float4":      {Value: "Float32", Type: "float32"},
+	"pgtype.Bool":        {Value: "Bool", Type: "bool"},
+	"pgtype.Timestamptz": {Value: "Time", Type: "time.Time"},
+	"pgtype.Timestamp":   {Value: "Time", Type: "time.Time"},
+	"pgtype.Date":        {Value: "Time", Type: "time.Time"},
+	// gorm.DeletedAt is declared as sql.NullTime.
+	"gorm.DeletedAt": {Value: "Time", Type: "time.Time"},
+}
+
+// Null returns description of type of field fi if it's a nullable type of
+// database/sql, pgtype or gorm package with Valid flag, e.g. sql.NullInt64. Types
+// are detected by names, pointers, slices and maps are not nullable types.
+func (fi FieldInfo) Null() (NullType, bool) {
+	if fi.IsPointer || fi.IsSlice || fi.Key != "" {
+		return NullType{}, false
+	}
+
+	nt, ok := nullTypes[fi.Type]
+	return nt, ok
+}
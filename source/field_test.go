@@ -0,0 +1,37 @@
+package source
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FieldInfo", func() {
+
+	DescribeTable("GoType",
+		func(fi FieldInfo, expected string) {
+			Expect(fi.GoType()).To(Equal(expected))
+		},
+
+		Entry("Value", FieldInfo{Type: "int"}, "int"),
+		Entry("Pointer", FieldInfo{Type: "time.Time", IsPointer: true}, "*time.Time"),
+		Entry("Slice of pointers", FieldInfo{Type: "string", IsPointer: true, IsSlice: true}, "[]*string"),
+		Entry("Map", FieldInfo{Type: "nulls.Int", Key: "string"}, "map[string]nulls.Int"),
+		Entry("Map of slices", FieldInfo{Type: "Address", IsSlice: true, Key: "int64"}, "map[int64][]Address"),
+	)
+
+	DescribeTable("Null",
+		func(fi FieldInfo, expected NullType, ok bool) {
+			nt, isNull := fi.Null()
+			Expect(isNull).To(Equal(ok))
+			Expect(nt).To(Equal(expected))
+		},
+
+		Entry("sql.NullString", FieldInfo{Type: "sql.NullString"}, NullType{Value: "String", Type: "string"}, true),
+		Entry("sql.NullTime", FieldInfo{Type: "sql.NullTime"}, NullType{Value: "Time", Type: "time.Time"}, true),
+		Entry("gorm.DeletedAt", FieldInfo{Type: "gorm.DeletedAt"}, NullType{Value: "Time", Type: "time.Time"}, true),
+		Entry("pgtype.Int8", FieldInfo{Type: "pgtype.Int8"}, NullType{Value: "Int64", Type: "int64"}, true),
+		Entry("Pointer", FieldInfo{Type: "sql.NullString", IsPointer: true}, NullType{}, false),
+		Entry("Other type", FieldInfo{Type: "nulls.String"}, NullType{}, false),
+	)
+})
@@ -0,0 +1,185 @@
+package source
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Imports returns import paths of packages imported by Go source file path by
+// package names they are used with in the file, e.g.
+// "billing": "github.com/org/billing". Names of imports without explicit name
+// are resolved with go/packages, because package name may differ from the
+// last element of import path.
+func Imports(path string) (map[string]string, error) {
+	node, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := map[string]string{}
+	unnamed := []string{}
+
+	for _, spec := range node.Imports {
+		ip, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if spec.Name == nil {
+			unnamed = append(unnamed, ip)
+			continue
+		}
+
+		if n := spec.Name.Name; n != "_" && n != "." {
+			imports[n] = ip
+		}
+	}
+
+	if len(unnamed) == 0 {
+		return imports, nil
+	}
+
+	pkgs, err := loadPackages(packages.NeedName, filepath.Dir(path), unnamed...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pkg := range pkgs {
+		imports[pkg.Name] = pkg.PkgPath
+	}
+
+	return imports, nil
+}
+
+// PackageOf returns name and import path of Go package pattern, which is
+// resolved relatively to directory dir, e.g. "." for package of the
+// directory.
+func PackageOf(dir, pattern string) (string, string, error) {
+	pkgs, err := loadPackages(packages.NeedName, dir, pattern)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(pkgs) != 1 {
+		return "", "", fmt.Errorf("pattern %s matches %d packages, want one", pattern, len(pkgs))
+	}
+
+	return pkgs[0].Name, pkgs[0].PkgPath, nil
+}
+
+// ParsePackage loads Go package importPath, which is resolved relatively to
+// directory dir, and returns list of structures declared in the package.
+// Structures are parsed like structures of source file, see Parse, field types
+// are resolved with type information of the package, see resolveTypes, and
+// fields of embedded structures are promoted, see promoteFields.
+func ParsePackage(dir, importPath string) (StructureList, error) {
+	pkgs, infos, err := loadTypes(dir, importPath)
+	if err != nil {
+		return nil, err
+	}
+
+	info := StructureList{}
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, inspect(info))
+		}
+	}
+
+	resolveTypes(info, pkgs, infos)
+	promoteFields(info)
+
+	return info, nil
+}
+
+// ParseDir loads Go package of directory dir and returns list of its exported
+// structures together with paths of its source files. Files which are
+// excluded by build constraints are parsed too, structures of files of the
+// build take precedence over structures with the same names of such files.
+func ParseDir(dir string) (StructureList, []string, error) {
+	return parseExported(dir, ".")
+}
+
+// ParseImportPath loads Go package importPath, which is resolved relatively
+// to working directory, e.g. from module cache, and returns list of its
+// exported structures together with paths of its source files, see ParseDir.
+func ParseImportPath(importPath string) (StructureList, []string, error) {
+	return parseExported("", importPath)
+}
+
+// parseExported returns exported structures and source files of package
+// pattern loaded from directory dir.
+func parseExported(dir, pattern string) (StructureList, []string, error) {
+	pkgs, infos, err := loadTypes(dir, pattern)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, files := StructureList{}, []string{}
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, inspect(info))
+		}
+		files = append(files, pkg.GoFiles...)
+
+		for _, path := range pkg.IgnoredFiles {
+			if filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+				continue
+			}
+
+			sl, err := Parse(path, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for name, s := range sl {
+				if _, ok := info[name]; !ok {
+					info[name] = s
+				}
+			}
+			files = append(files, path)
+		}
+	}
+
+	resolveTypes(info, pkgs, infos)
+	promoteFields(info)
+
+	for name := range info {
+		if !ast.IsExported(name) {
+			delete(info, name)
+		}
+	}
+
+	return info, files, nil
+}
+
+// loadPackages loads packages patterns with go/packages, the first error of
+// loaded packages is returned.
+func loadPackages(mode packages.LoadMode, dir string, patterns ...string) ([]*packages.Package, error) {
+	return load(&packages.Config{Mode: mode, Dir: dir}, patterns...)
+}
+
+// load loads packages patterns with go/packages configuration cfg, see
+// loadPackages.
+func load(cfg *packages.Config, patterns ...string) ([]*packages.Package, error) {
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("package %s: %s", pkg.PkgPath, pkg.Errors[0])
+		}
+	}
+
+	return pkgs, nil
+}
@@ -0,0 +1,71 @@
+package source
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Package", func() {
+
+	const modelsFile = "../example/model/model.go"
+
+	It("returns imports of source file by package names", func() {
+		imports, err := Imports(modelsFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(imports).To(Equal(map[string]string{
+			"sql":     "database/sql",
+			"json":    "encoding/json",
+			"time":    "time",
+			"billing": "github.com/ZacxDev/protoc-gen-struct-transformer/example/billing",
+			"decimal": "github.com/ZacxDev/protoc-gen-struct-transformer/example/decimal",
+			"nulls":   "github.com/ZacxDev/protoc-gen-struct-transformer/example/nulls",
+			"uuid":    "github.com/ZacxDev/protoc-gen-struct-transformer/example/uuid",
+		}))
+	})
+
+	It("returns name and import path of package", func() {
+		name, path, err := PackageOf("../example/model", ".")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("model"))
+		Expect(path).To(Equal("github.com/ZacxDev/protoc-gen-struct-transformer/example/model"))
+	})
+
+	It("returns structures of package", func() {
+		sl, err := ParsePackage("../example/model", "github.com/ZacxDev/protoc-gen-struct-transformer/example/billing")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sl).To(Equal(StructureList{
+			"Address": {
+				"Street": {Type: "string"},
+				"City":   {Type: "string"},
+			},
+		}))
+	})
+
+	It("returns exported structures of package directory", func() {
+		sl, files, err := ParseDir("testdata/models")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sl).To(Equal(StructureList{
+			"Account": {
+				"ID":   {Type: "int64"},
+				"Name": {Type: "string"},
+			},
+			"LegacyAccount": {
+				"ID": {Type: "int64"},
+			},
+		}))
+		Expect(files).To(HaveLen(2))
+	})
+
+	It("returns exported structures of package by import path", func() {
+		sl, files, err := ParseImportPath("github.com/ZacxDev/protoc-gen-struct-transformer/source/testdata/models")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sl).To(HaveKey("Account"))
+		Expect(sl).To(HaveKey("LegacyAccount"))
+		Expect(files).To(HaveLen(2))
+	})
+
+	It("returns an error if package can not be loaded", func() {
+		_, err := ParsePackage(".", "github.com/ZacxDev/protoc-gen-struct-transformer/example/unknown")
+		Expect(err).To(HaveOccurred())
+	})
+})
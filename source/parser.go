@@ -6,10 +6,16 @@ import (
 	"go/parser"
 	"go/token"
 	"io"
+	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
+// directivePrefix is a prefix of comments with transformer directives, such
+// as //transformer:skip or //transformer:from=proto_field.
+const directivePrefix = "//transformer:"
+
 // inspect is a function which is run for each node in source file. See go/ast
 // package for details.
 func inspect(output StructureList) func(n ast.Node) bool {
@@ -47,22 +53,27 @@ func inspect(output StructureList) func(n ast.Node) bool {
 				embeddedCounter++
 			}
 
+			tag := ""
+			if field.Tag != nil {
+				tag, _ = strconv.Unquote(field.Tag.Value)
+			}
+
 			switch t := field.Type.(type) {
 			case *ast.Ident: // simple types e.g. int, string, etc.
-				output[structName][fname] = FieldInfo{Type: t.Name}
+				output[structName][fname] = FieldInfo{Type: t.Name, Tag: tag}
 
 			case *ast.SelectorExpr: // types like time.Time, time.Duration, nulls.String
 				typ := fmt.Sprintf("%s.%s", t.X.(*ast.Ident).Name, t.Sel.Name)
-				output[structName][fname] = FieldInfo{Type: typ}
+				output[structName][fname] = FieldInfo{Type: typ, Tag: tag}
 
 			case *ast.StarExpr: // pointer to something
 				switch se := t.X.(type) {
 				case *ast.Ident: // *SomeStruct, *string, *int etc.
 					typ := se.Name
-					output[structName][fname] = FieldInfo{Type: typ, IsPointer: true}
+					output[structName][fname] = FieldInfo{Type: typ, IsPointer: true, Tag: tag}
 				case *ast.SelectorExpr: // *time.Time
 					typ := fmt.Sprintf("%s.%s", se.X.(*ast.Ident).Name, se.Sel.Name)
-					output[structName][fname] = FieldInfo{Type: typ, IsPointer: true}
+					output[structName][fname] = FieldInfo{Type: typ, IsPointer: true, Tag: tag}
 				default:
 					typ := fmt.Sprintf("%s", reflect.TypeOf(t))
 					output[structName]["unsupported_star_expr_"+typ] = FieldInfo{Type: fmt.Sprintf("%T", se)}
@@ -72,31 +83,147 @@ func inspect(output StructureList) func(n ast.Node) bool {
 			case *ast.ArrayType:
 				typ := "empty_type"
 				switch at := t.Elt.(type) {
-				case *ast.SelectorExpr:
-					typ = at.Sel.Name
+				case *ast.SelectorExpr: // []time.Time, []nulls.String
+					typ = fmt.Sprintf("%s.%s", at.X.(*ast.Ident).Name, at.Sel.Name)
 				case *ast.Ident:
 					typ = at.Name
+				case *ast.InterfaceType: // []interface{}
+					if !isEmptyInterface(at) {
+						output[structName]["unsupported_interface_"+fname] = FieldInfo{Type: fmt.Sprintf("%T", at)}
+						continue
+					}
+					typ = emptyInterface
+				case *ast.StarExpr: // slice of pointers: []*string, []*Struct
+					switch se := at.X.(type) {
+					case *ast.SelectorExpr:
+						typ = fmt.Sprintf("%s.%s", se.X.(*ast.Ident).Name, se.Sel.Name)
+					case *ast.Ident:
+						typ = se.Name
+					default:
+						typ := fmt.Sprintf("%s", reflect.TypeOf(t))
+						output[structName]["unsupported_array_type_"+typ] = FieldInfo{Type: fmt.Sprintf("%T", se)}
+						return true
+					}
+					output[structName][fname] = FieldInfo{Type: typ, IsPointer: true, IsSlice: true, Tag: tag}
+					continue
 				default:
 					typ := fmt.Sprintf("%s", reflect.TypeOf(t))
 					output[structName]["unsupported_array_type_"+typ] = FieldInfo{Type: fmt.Sprintf("%T", at)}
 					return true
 				}
-				output[structName][fname] = FieldInfo{Type: typ}
+				output[structName][fname] = FieldInfo{Type: typ, IsSlice: true, Tag: tag}
+
+			case *ast.InterfaceType: // interface{}
+				if !isEmptyInterface(t) {
+					output[structName]["unsupported_interface_"+fname] = FieldInfo{Type: fmt.Sprintf("%T", t)}
+					continue
+				}
+				output[structName][fname] = FieldInfo{Type: emptyInterface, Tag: tag}
+
+			case *ast.MapType: // map[string]int, map[string]*time.Time etc.
+				fi, ok := mapValue(t)
+				if !ok {
+					output[structName]["unsupported_map_type_"+fname] = FieldInfo{Type: fmt.Sprintf("%T", t.Value)}
+					continue
+				}
+				fi.Tag = tag
+				output[structName][fname] = fi
 
 			default:
 				typ := fmt.Sprintf("%s", reflect.TypeOf(t))
 				output[structName]["unsupported_"+typ] = FieldInfo{Type: typ}
 			}
 		}
+
+		for _, field := range s.Fields.List {
+			for _, name := range field.Names {
+				if fi, ok := output[structName][name.Name]; ok {
+					output[structName][name.Name] = applyDirectives(fi, field.Doc, field.Comment)
+				}
+			}
+		}
 		return false
 	}
 }
 
+// applyDirectives sets fields of fi from transformer directives found in
+// comment groups. Unknown directives are ignored.
+func applyDirectives(fi FieldInfo, groups ...*ast.CommentGroup) FieldInfo {
+	for _, g := range groups {
+		if g == nil {
+			continue
+		}
+
+		for _, c := range g.List {
+			if !strings.HasPrefix(c.Text, directivePrefix) {
+				continue
+			}
+
+			d := strings.TrimSpace(strings.TrimPrefix(c.Text, directivePrefix))
+			switch {
+			case d == "skip":
+				fi.Skip = true
+			case strings.HasPrefix(d, "from="):
+				fi.From = strings.TrimSpace(strings.TrimPrefix(d, "from="))
+			}
+		}
+	}
+
+	return fi
+}
+
+// emptyInterface is a type name of fields of empty interface type.
+const emptyInterface = "interface{}"
+
+// isEmptyInterface returns true if t is an interface without methods.
+func isEmptyInterface(t *ast.InterfaceType) bool {
+	return t.Methods == nil || len(t.Methods.List) == 0
+}
+
+// mapValue returns information about map field. Only maps with keys of basic
+// types and values of basic, selector, pointer or empty interface types or
+// slices of such types are supported.
+func mapValue(t *ast.MapType) (FieldInfo, bool) {
+	key, ok := t.Key.(*ast.Ident)
+	if !ok {
+		return FieldInfo{}, false
+	}
+
+	fi := FieldInfo{Key: key.Name}
+
+	val := t.Value
+	if at, ok := val.(*ast.ArrayType); ok && at.Len == nil {
+		fi.IsSlice = true
+		val = at.Elt
+	}
+
+	if se, ok := val.(*ast.StarExpr); ok {
+		fi.IsPointer = true
+		val = se.X
+	}
+
+	switch v := val.(type) {
+	case *ast.Ident:
+		fi.Type = v.Name
+	case *ast.SelectorExpr:
+		fi.Type = fmt.Sprintf("%s.%s", v.X.(*ast.Ident).Name, v.Sel.Name)
+	case *ast.InterfaceType: // map[string]interface{}
+		if !isEmptyInterface(v) {
+			return FieldInfo{}, false
+		}
+		fi.Type = emptyInterface
+	default:
+		return FieldInfo{}, false
+	}
+
+	return fi, true
+}
+
 // Parse gets path to source file or content of source file as a io.Reader and
 // run inspect functions on it. Function returns list of structures with their
 // fields.
 func Parse(path string, src io.Reader) (StructureList, error) {
-	node, err := parser.ParseFile(token.NewFileSet(), path, src, 0)
+	node, err := parser.ParseFile(token.NewFileSet(), path, src, parser.ParseComments)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +235,42 @@ func Parse(path string, src io.Reader) (StructureList, error) {
 	return info, nil
 }
 
+// ParseFiles parses source files paths, see Parse, and returns merged list of
+// their structures. Files are expected to belong to the same package, so an
+// error is returned if structure is declared in several files. Field types
+// are resolved with type information of the package, see resolveTypes, fields
+// of embedded structures are promoted, see promoteFields.
+func ParseFiles(paths []string) (StructureList, error) {
+	info := StructureList{}
+	files := map[string]string{}
+
+	for _, path := range paths {
+		sl, err := Parse(path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, s := range sl {
+			if prev, ok := files[name]; ok {
+				return nil, fmt.Errorf("structure %q is declared in %s and %s", name, prev, path)
+			}
+			files[name] = path
+			info[name] = s
+		}
+	}
+
+	if len(paths) > 0 {
+		pkgs, infos, err := loadTypes(filepath.Dir(paths[0]), ".")
+		if err != nil {
+			return nil, err
+		}
+		resolveTypes(info, pkgs, infos)
+	}
+	promoteFields(info)
+
+	return info, nil
+}
+
 // Lookup return structure by name from parsed source file or an error if
 // structure with such name not found.
 func Lookup(sl StructureList, structName string) (Structure, error) {
@@ -118,7 +118,7 @@ type (
 			"MyStruct": {
 				"ID":           {Type: "int", IsPointer: false},
 				"Name":         {Type: "string", IsPointer: false},
-				"SubMyStructs": {Type: "int", IsPointer: false},
+				"SubMyStructs": {Type: "int", IsPointer: false, IsSlice: true},
 			},
 		}),
 
@@ -134,7 +134,21 @@ type (
 			"MyStruct": {
 				"ID":   {Type: "int", IsPointer: false},
 				"Name": {Type: "string", IsPointer: false},
-				"Tags": {Type: "String", IsPointer: false},
+				"Tags": {Type: "nulls.String", IsPointer: false, IsSlice: true},
+			},
+		}),
+
+		Entry("File with one struct, fields are of pointer slice type.", `package model
+
+type (
+	MyStruct struct {
+		Names []*string
+		Tags  []*nulls.String
+	}
+)`, StructureList{
+			"MyStruct": {
+				"Names": {Type: "string", IsPointer: true, IsSlice: true},
+				"Tags":  {Type: "nulls.String", IsPointer: true, IsSlice: true},
 			},
 		}),
 
@@ -154,6 +168,22 @@ type (
 			},
 		}),
 
+		Entry("File with one struct, fields have tags.", `package model
+
+type (
+	MyStruct struct {
+		ID   int     `+"`db:\"id\" json:\"id\"`"+`
+		Name *string `+"`json:\"name,omitempty\"`"+`
+		Tags []string
+	}
+)`, StructureList{
+			"MyStruct": {
+				"ID":   {Type: "int", IsPointer: false, Tag: `db:"id" json:"id"`},
+				"Name": {Type: "string", IsPointer: true, Tag: `json:"name,omitempty"`},
+				"Tags": {Type: "string", IsPointer: false, IsSlice: true},
+			},
+		}),
+
 		Entry("File with one struct, field is of pointer type.", `package model
 
 type (
@@ -186,6 +216,62 @@ type (
 			},
 		}),
 
+		Entry("File with one struct, fields are of map type.", `package model
+
+type (
+	MyStruct struct {
+		Names    map[string]string
+		Times    map[int64]*time.Time
+		Counters map[string]nulls.Int
+		Lists    map[string][]string
+		Meta     map[string]interface{}
+	}
+)`, StructureList{
+			"MyStruct": {
+				"Names":    {Type: "string", Key: "string"},
+				"Times":    {Type: "time.Time", IsPointer: true, Key: "int64"},
+				"Counters": {Type: "nulls.Int", Key: "string"},
+				"Lists":    {Type: "string", IsSlice: true, Key: "string"},
+				"Meta":     {Type: "interface{}", Key: "string"},
+			},
+		}),
+
+		Entry("File with one struct, fields are of empty interface type.", `package model
+
+type (
+	MyStruct struct {
+		V  interface{}
+		L  []interface{}
+		S  fmt.Stringer
+		IS interface{ String() string }
+	}
+)`, StructureList{
+			"MyStruct": {
+				"V":                        {Type: "interface{}"},
+				"L":                        {Type: "interface{}", IsSlice: true},
+				"S":                        {Type: "fmt.Stringer"},
+				"unsupported_interface_IS": {Type: "*ast.InterfaceType"},
+			},
+		}),
+
+		Entry("File with one struct, fields have directives.", `package model
+
+type (
+	MyStruct struct {
+		// Code is filled from legacy field.
+		//transformer:from=legacy_code
+		Code string
+		Cache []string //transformer:skip
+		Name string // transformer:skip is not a directive
+	}
+)`, StructureList{
+			"MyStruct": {
+				"Code":  {Type: "string", From: "legacy_code"},
+				"Cache": {Type: "string", IsSlice: true, Skip: true},
+				"Name":  {Type: "string"},
+			},
+		}),
+
 		Entry("File with one struct, field is of unsupported type.", `package model
 
 type (
@@ -199,12 +285,44 @@ type (
 			"MyStruct": {
 				"I":                                   {Type: "int", IsPointer: false},
 				"unsupported_*ast.FuncType":           {Type: "*ast.FuncType", IsPointer: false},
-				"unsupported_*ast.MapType":            {Type: "*ast.MapType", IsPointer: false},
+				"M":                                   {Type: "string", IsPointer: false, Key: "int"},
 				"unsupported_star_expr_*ast.StarExpr": {Type: "*ast.MapType", IsPointer: false},
 			},
 		}),
 	)
 
+	Describe("ParseFiles", func() {
+
+		It("merges structures of files", func() {
+			sl, err := ParseFiles([]string{"../example/model/model.go", "../example/model/memo.go"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sl).To(HaveKey("Product"))
+			Expect(sl).To(HaveKey("Memo"))
+		})
+
+		It("resolves types of fields with type information of package", func() {
+			sl, err := ParseFiles([]string{"testdata/aliases/profile.go"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sl).To(Equal(StructureList{
+				"Profile": {
+					"ID":      {Type: "string"},
+					"Friends": {Type: "string", IsSlice: true},
+					"Tags":    {Type: "string", IsSlice: true},
+					"Scores":  {Type: "int64", IsPointer: true, Key: "string"},
+					"Seen":    {Type: "time.Time", IsPointer: true},
+					"Level":   {Type: "Level"},
+					"Cursor":  {Type: "Tags", IsPointer: true},
+					"Meta":    {Type: "interface{}", Key: "string"},
+				},
+			}))
+		})
+
+		It("returns an error if structure is declared in several files", func() {
+			_, err := ParseFiles([]string{"../example/model/memo.go", "../example/model/memo.go"})
+			Expect(err).To(MatchError(MatchRegexp(`structure "\w+" is declared in \.\./example/model/memo\.go and \.\./example/model/memo\.go`)))
+		})
+	})
+
 	Describe("Lookup", func() {
 
 		Context("when call Lookup with existing struct", func() {
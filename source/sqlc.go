@@ -0,0 +1,35 @@
+package source
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// sqlcHeader is a prefix of header comment of files generated by sqlc.
+const sqlcHeader = "// Code generated by sqlc."
+
+// SqlcGenerated returns true if source files paths are generated by sqlc,
+// i.e. any of them has header comment of sqlc before package clause. Files
+// which can't be parsed are skipped.
+func SqlcGenerated(paths []string) bool {
+	for _, path := range paths {
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly|parser.ParseComments)
+		if err != nil {
+			continue
+		}
+
+		for _, cg := range f.Comments {
+			if cg.Pos() > f.Package {
+				break
+			}
+			for _, c := range cg.List {
+				if strings.HasPrefix(c.Text, sqlcHeader) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,18 @@
+package source
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sqlc", func() {
+
+	It("detects files generated by sqlc", func() {
+		Expect(SqlcGenerated([]string{"testdata/models/models.go", "testdata/sqlc/models.go"})).To(BeTrue())
+	})
+
+	It("returns false for other files", func() {
+		Expect(SqlcGenerated([]string{"testdata/models/models.go", "testdata/missing.go"})).To(BeFalse())
+		Expect(SqlcGenerated(nil)).To(BeFalse())
+	})
+})
@@ -0,0 +1,21 @@
+package aliases
+
+import (
+	"time"
+
+	"github.com/ZacxDev/protoc-gen-struct-transformer/source/testdata/aliases/ext"
+)
+
+// Account has fields of alias types of another package and of types with
+// Equal method.
+type Account struct {
+	Code   ext.Code
+	Codes  ext.Codes
+	Owner  *ext.Owner
+	Person ext.Person
+	Labels map[ID]Level
+	Extra  ext.Any
+	Name   string `json:"name"`
+	Since  time.Time
+	Until  *time.Time
+}
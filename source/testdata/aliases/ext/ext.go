@@ -0,0 +1,18 @@
+package ext
+
+type (
+	// ID is an alias of string.
+	ID = string
+	// Code is an alias of alias.
+	Code = ID
+	// Codes is a named slice type of aliases.
+	Codes []Code
+	// Person is a structure, it's not resolved.
+	Person struct {
+		Name string
+	}
+	// Owner is an alias of structure.
+	Owner = Person
+	// Any is an alias of empty interface.
+	Any = interface{}
+)
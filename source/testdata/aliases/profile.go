@@ -0,0 +1,13 @@
+package aliases
+
+// Profile has fields of types which are declared in another file.
+type Profile struct {
+	ID      ID
+	Friends []ID
+	Tags    Tags
+	Scores  Scores
+	Seen    *Stamp
+	Level   Level
+	Cursor  *Tags
+	Meta    Metadata
+}
@@ -0,0 +1,18 @@
+package aliases
+
+import "time"
+
+type (
+	// ID is an alias of string.
+	ID = string
+	// Tags is a named slice type.
+	Tags []string
+	// Scores is a named map type.
+	Scores map[string]*int64
+	// Stamp is an alias of type of another package.
+	Stamp = time.Time
+	// Level is a named type of basic type, it's not resolved.
+	Level int32
+	// Metadata is a named map type with values of empty interface type.
+	Metadata map[string]interface{}
+)
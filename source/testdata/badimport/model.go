@@ -0,0 +1,8 @@
+package badimport
+
+import "github.com/ZacxDev/protoc-gen-struct-transformer/source/testdata/missing"
+
+// Model has field of type of package which doesn't exist.
+type Model struct {
+	ID missing.ID
+}
@@ -0,0 +1,6 @@
+package broken
+
+// Model has field of undeclared type.
+type Model struct {
+	ID Missing
+}
@@ -0,0 +1,9 @@
+//go:build legacy
+// +build legacy
+
+package models
+
+// LegacyAccount is a structure of file excluded by build constraints.
+type LegacyAccount struct {
+	ID int64
+}
@@ -0,0 +1,13 @@
+package models
+
+type (
+	// Account is a structure of files of the build.
+	Account struct {
+		ID   int64
+		Name string
+	}
+
+	account struct {
+		id int64
+	}
+)
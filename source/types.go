@@ -0,0 +1,331 @@
+package source
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"runtime"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// typesMode is a mode of go/packages which loads syntax of packages and of
+// their dependencies, which are type-checked by checkTypes. Packages are not
+// type-checked by go/packages: loader of golang.org/x/tools version which
+// generator is built with can't read export data and type sizes of recent Go
+// versions.
+const typesMode = packages.NeedName | packages.NeedFiles | packages.NeedSyntax |
+	packages.NeedImports | packages.NeedDeps
+
+// loadTypes loads Go package pattern, which is resolved relatively to
+// directory dir, with typesMode and returns the packages together with type
+// information of them and of their dependencies. Load and type errors of the
+// package are returned.
+func loadTypes(dir, pattern string) ([]*packages.Package, map[*packages.Package]*types.Info, error) {
+	fset := token.NewFileSet()
+
+	pkgs, err := load(&packages.Config{Mode: typesMode, Dir: dir, Fset: fset}, pattern)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	infos, err := checkTypes(fset, pkgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pkgs, infos, nil
+}
+
+// importerFunc implements types.Importer.
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+// checkTypes type-checks syntax of packages pkgs and of their dependencies
+// with go/types and returns type information of all of them. Dependencies are
+// checked before packages which import them, their function bodies are not
+// checked and their errors are reported by packages which use broken
+// declarations. The first type error of pkgs is returned.
+func checkTypes(fset *token.FileSet, pkgs []*packages.Package) (map[*packages.Package]*types.Info, error) {
+	roots := map[*packages.Package]bool{}
+	for _, pkg := range pkgs {
+		roots[pkg] = true
+	}
+
+	checked := map[*packages.Package]*types.Package{}
+	infos := map[*packages.Package]*types.Info{}
+	sizes := types.SizesFor("gc", runtime.GOARCH)
+
+	var firstErr error
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		if pkg.PkgPath == "unsafe" {
+			checked[pkg] = types.Unsafe
+			return
+		}
+
+		var pkgErr error
+		conf := types.Config{
+			Importer: importerFunc(func(path string) (*types.Package, error) {
+				if path == "unsafe" {
+					return types.Unsafe, nil
+				}
+				if imp := checked[pkg.Imports[path]]; imp != nil {
+					return imp, nil
+				}
+				return nil, fmt.Errorf("package %s is not loaded", path)
+			}),
+			IgnoreFuncBodies: !roots[pkg],
+			FakeImportC:      true,
+			Sizes:            sizes,
+			Error: func(err error) {
+				if pkgErr == nil {
+					pkgErr = err
+				}
+			},
+		}
+
+		info := &types.Info{
+			Types: map[ast.Expr]types.TypeAndValue{},
+			Defs:  map[*ast.Ident]types.Object{},
+			Uses:  map[*ast.Ident]types.Object{},
+		}
+		checked[pkg], _ = conf.Check(pkg.PkgPath, fset, pkg.Syntax, info)
+		infos[pkg] = info
+
+		if roots[pkg] && pkgErr != nil && firstErr == nil {
+			firstErr = fmt.Errorf("package %s: %s", pkg.PkgPath, pkgErr)
+		}
+	})
+
+	return infos, firstErr
+}
+
+// resolveTypes replaces types of fields of structures sl, which are declared
+// in packages pkgs, by types of fields of *types.Struct of the structures,
+// type information of packages and of their dependencies is taken from infos,
+// see loadTypes. Aliases, e.g. type ID = string, are replaced by types they
+// stand for, named slice and map types, e.g. type Tags []string, are replaced
+// by their underlying types. Such types may be declared in any file of the
+// package. Other named types are left as is.
+func resolveTypes(sl StructureList, pkgs []*packages.Package, infos map[*packages.Package]*types.Info) {
+	aliases := aliasTypes(infos)
+
+	for _, pkg := range pkgs {
+		info := infos[pkg]
+		if info == nil {
+			continue
+		}
+
+		for _, f := range pkg.Syntax {
+			for _, decl := range f.Decls {
+				gd, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+
+				for _, spec := range gd.Specs {
+					ts, ok := spec.(*ast.TypeSpec)
+					if !ok || sl[ts.Name.Name] == nil {
+						continue
+					}
+
+					tn, ok := info.Defs[ts.Name].(*types.TypeName)
+					if !ok {
+						continue
+					}
+
+					st, ok := tn.Type().Underlying().(*types.Struct)
+					if !ok {
+						continue
+					}
+
+					resolveStruct(sl[ts.Name.Name], st, aliases, qualifier(tn.Pkg()))
+				}
+			}
+		}
+	}
+}
+
+// resolveStruct replaces types of fields of structure s by types of fields
+// of st, see resolveField. Embedded fields are left as is, they are promoted
+// later, see promoteFields.
+func resolveStruct(s Structure, st *types.Struct, aliases map[*types.TypeName]types.Type, qf types.Qualifier) {
+	for i := 0; i < st.NumFields(); i++ {
+		v := st.Field(i)
+		if v.Embedded() {
+			continue
+		}
+
+		fi, ok := s[v.Name()]
+		if !ok {
+			continue
+		}
+
+		if r, ok := resolveField(fi, v.Type(), aliases, qf); ok {
+			fi = r
+		}
+		fi.Equal = hasEqual(v.Type(), aliases)
+		s[v.Name()] = fi
+	}
+}
+
+// hasEqual returns true if t is a named type with method Equal(t) bool, e.g.
+// time.Time. Such values are compared with the method, since they may be
+// equal with different representations, e.g. in different time zones.
+func hasEqual(t types.Type, aliases map[*types.TypeName]types.Type) bool {
+	t, ok := aliasTarget(t, aliases)
+	if !ok {
+		return false
+	}
+
+	if _, ok := t.(*types.Named); !ok {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, "Equal")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 || !types.Identical(sig.Params().At(0).Type(), t) {
+		return false
+	}
+
+	res, ok := sig.Results().At(0).Type().Underlying().(*types.Basic)
+	return ok && res.Kind() == types.Bool
+}
+
+// qualifier returns qualifier of type names of package pkg: types of pkg are
+// not qualified, types of other packages are qualified by package names.
+func qualifier(pkg *types.Package) types.Qualifier {
+	return func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+		return p.Name()
+	}
+}
+
+// aliasTypes returns types which aliases stand for by alias type names, the
+// aliases are taken from declarations of packages of type information infos.
+// Aliases are looked up in declarations, rather than unwrapped with go/types,
+// because aliases are separate types only in recent Go versions.
+func aliasTypes(infos map[*packages.Package]*types.Info) map[*types.TypeName]types.Type {
+	aliases := map[*types.TypeName]types.Type{}
+
+	for pkg, info := range infos {
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, func(n ast.Node) bool {
+				ts, ok := n.(*ast.TypeSpec)
+				if !ok || !ts.Assign.IsValid() {
+					return true
+				}
+
+				if tn, ok := info.Defs[ts.Name].(*types.TypeName); ok {
+					aliases[tn] = info.TypeOf(ts.Type)
+				}
+
+				return true
+			})
+		}
+	}
+
+	return aliases
+}
+
+// aliasTarget returns type which alias t stands for, see aliasTypes, t itself
+// if it's not an alias. Second value is false if declaration of alias is not
+// loaded. Universe aliases, such as any, are replaced by underlying types.
+func aliasTarget(t types.Type, aliases map[*types.TypeName]types.Type) (types.Type, bool) {
+	for {
+		o, ok := t.(interface{ Obj() *types.TypeName })
+		if !ok || !o.Obj().IsAlias() {
+			return t, true
+		}
+
+		if o.Obj().Pkg() == nil {
+			return t.Underlying(), true
+		}
+
+		rhs, ok := aliases[o.Obj()]
+		if !ok || rhs == nil {
+			return t, false
+		}
+		t = rhs
+	}
+}
+
+// resolveField returns fi with type resolved from Go type t of the field.
+// False is returned if type doesn't need resolving, i.e. it has neither
+// aliases nor named slice and map types, or resolved type can't be described
+// by FieldInfo, e.g. slice of slices.
+func resolveField(fi FieldInfo, t types.Type, aliases map[*types.TypeName]types.Type, qf types.Qualifier) (FieldInfo, bool) {
+	resolved := false
+
+	// resolve returns t without aliases, ok is false if alias can't be
+	// resolved.
+	resolve := func(t types.Type) (types.Type, bool) {
+		u, ok := aliasTarget(t, aliases)
+		resolved = resolved || u != t
+		return u, ok
+	}
+
+	t, ok := resolve(t)
+	if !ok {
+		return fi, false
+	}
+
+	if _, ok := t.(*types.Named); ok {
+		switch u := t.Underlying().(type) {
+		case *types.Slice, *types.Map:
+			t, resolved = u, true
+		}
+	}
+
+	r := FieldInfo{Tag: fi.Tag, Skip: fi.Skip, From: fi.From}
+	if m, ok := t.(*types.Map); ok {
+		k, ok := resolve(m.Key())
+		if !ok {
+			return fi, false
+		}
+		if t, ok = resolve(m.Elem()); !ok {
+			return fi, false
+		}
+		r.Key = types.TypeString(k, qf)
+	}
+	if sl, ok := t.(*types.Slice); ok {
+		if t, ok = resolve(sl.Elem()); !ok {
+			return fi, false
+		}
+		r.IsSlice = true
+	}
+	if p, ok := t.(*types.Pointer); ok {
+		if t, ok = resolve(p.Elem()); !ok {
+			return fi, false
+		}
+		r.IsPointer = true
+	}
+
+	if !resolved {
+		return fi, false
+	}
+
+	switch it := t.(type) {
+	case *types.Basic, *types.Named:
+	case *types.Interface:
+		if !it.Empty() {
+			return fi, false
+		}
+		r.Type = emptyInterface
+		return r, true
+	default:
+		return fi, false
+	}
+
+	r.Type = types.TypeString(t, qf)
+	return r, true
+}
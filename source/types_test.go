@@ -0,0 +1,45 @@
+package source
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Types", func() {
+
+	It("resolves aliases of types of another package", func() {
+		sl, err := ParseFiles([]string{"testdata/aliases/account.go"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sl).To(Equal(StructureList{
+			"Account": {
+				"Code":   {Type: "string"},
+				"Codes":  {Type: "string", IsSlice: true},
+				"Owner":  {Type: "ext.Person", IsPointer: true},
+				"Person": {Type: "ext.Person"},
+				"Labels": {Type: "Level", Key: "string"},
+				"Extra":  {Type: emptyInterface},
+				"Name":   {Type: "string", Tag: `json:"name"`},
+				"Since":  {Type: "time.Time", Equal: true},
+				"Until":  {Type: "time.Time", IsPointer: true},
+			},
+		}))
+	})
+
+	It("resolves types of structures of package", func() {
+		sl, _, err := ParseDir("testdata/aliases")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sl).To(HaveKey("Profile"))
+		Expect(sl["Profile"]["Scores"]).To(Equal(FieldInfo{Type: "int64", IsPointer: true, Key: "string"}))
+		Expect(sl["Account"]["Codes"]).To(Equal(FieldInfo{Type: "string", IsSlice: true}))
+	})
+
+	It("returns type errors of package", func() {
+		_, err := ParseFiles([]string{"testdata/broken/model.go"})
+		Expect(err).To(MatchError(MatchRegexp(`^package github\.com/ZacxDev/protoc-gen-struct-transformer/source/testdata/broken: .*model\.go:5:5: undefined: Missing$`)))
+	})
+
+	It("returns load errors of package", func() {
+		_, _, err := ParseDir("testdata/badimport")
+		Expect(err).To(MatchError(ContainSubstring("source/testdata/missing")))
+	})
+})